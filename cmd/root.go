@@ -103,10 +103,12 @@ func loadConfiguration() *config.Config {
 
 	if err := k9sCfg.Load(config.K9sConfigFile); err != nil {
 		log.Warn().Msg("Unable to locate K9s config. Generating new configuration...")
+		k9sCfg.SetFirstRun(true)
 	}
 
 	if demoMode != nil {
 		k9sCfg.SetDemoMode(*demoMode)
+		k9sCfg.K9s.OverrideDemo(*demoMode)
 	}
 	if *k9sFlags.RefreshRate != config.DefaultRefreshRate {
 		k9sCfg.K9s.OverrideRefreshRate(*k9sFlags.RefreshRate)
@@ -120,10 +122,40 @@ func loadConfiguration() *config.Config {
 		k9sCfg.K9s.OverrideReadOnly(*k9sFlags.ReadOnly)
 	}
 
+	offline := k9sFlags.Offline != nil && *k9sFlags.Offline != ""
+	if offline {
+		name := client.OfflineName
+		k8sFlags.Context = &name
+		k9sCfg.SetOffline(true)
+		k9sCfg.K9s.OverrideReadOnly(true)
+	}
+
 	if k9sFlags.Command != nil {
 		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	}
 
+	if isBoolSet(k9sFlags.LowColor) || isBoolSet(k9sFlags.ASCII) {
+		k9sCfg.K9s.OverrideRenderMode(config.RenderMode{
+			LowColor: isBoolSet(k9sFlags.LowColor),
+			ASCII:    isBoolSet(k9sFlags.ASCII),
+		})
+	}
+
+	if err := config.LoadLocale(k9sCfg.K9s.Locale); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load locale %q. Using default messages", k9sCfg.K9s.Locale)
+	}
+
+	if isBoolSet(k9sFlags.ScreenReader) {
+		k9sCfg.K9s.OverrideScreenReader(true)
+	}
+
+	if k9sFlags.Profile != nil && *k9sFlags.Profile != config.DefaultProfile {
+		k9sCfg.K9s.OverrideProfile(*k9sFlags.Profile)
+		if k9sCfg.K9s.ActiveProfile() == nil {
+			log.Warn().Msgf("Unknown profile %q. Using base configuration", *k9sFlags.Profile)
+		}
+	}
+
 	if isBoolSet(k9sFlags.AllNamespaces) && k9sCfg.SetActiveNamespace(client.AllNamespaces) != nil {
 		log.Error().Msg("Setting active namespace")
 	}
@@ -131,7 +163,16 @@ func loadConfiguration() *config.Config {
 	if err := k9sCfg.Refine(k8sFlags); err != nil {
 		log.Panic().Err(err)
 	}
-	k9sCfg.SetConnection(client.InitConnectionOrDie(k8sCfg))
+	if offline {
+		conn, err := client.NewOfflineClient(k8sCfg, *k9sFlags.Offline)
+		if err != nil {
+			log.Panic().Err(err).Msgf("Unable to load offline resources")
+		}
+		k9sCfg.SetConnection(conn)
+	} else {
+		k8sCfg.SetCompression(k9sCfg.Compression)
+		k9sCfg.SetConnection(client.InitConnectionOrDie(k8sCfg))
+	}
 
 	// Try to access server version if that fail. Connectivity issue?
 	if !k9sCfg.GetConnection().CheckConnectivity() {
@@ -169,7 +210,7 @@ func initTransientFlags() {
 		demoMode,
 		"demo",
 		false,
-		"Enable demo mode to show keyboard commands",
+		"Enable demo mode to show keyboard commands and redact resource names",
 	)
 }
 
@@ -211,6 +252,36 @@ func initK9sFlags() {
 		false,
 		"Disable all commands that modify the cluster",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Profile,
+		"profile",
+		config.DefaultProfile,
+		"Specify a named configuration profile to layer over the base configuration",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.LowColor,
+		"low-color",
+		false,
+		"Restrict colors to the 16 ANSI colors",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.ASCII,
+		"ascii",
+		false,
+		"Use ASCII only borders and glyphs",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.ScreenReader,
+		"screen-reader",
+		false,
+		"Enable screen reader friendly output",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Offline,
+		"offline",
+		"",
+		"Browse resources from yaml or json dumps in the given file or directory instead of a live cluster",
+	)
 }
 
 func initK8sFlags() {
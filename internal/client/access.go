@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	cacheRulesKey = "rules"
+	matchAll      = "*"
+)
+
+// AllowedByRules returns true if any of the given rules grants the verb on the resource.
+// Rules scoped to specific resource names are ignored.
+func AllowedByRules(rules []authorizationv1.ResourceRule, gvr, verb string) bool {
+	spec := NewGVR(gvr)
+	res := spec.R()
+	if sub := spec.SubResource(); sub != "" {
+		res += "/" + sub
+	}
+	for _, r := range rules {
+		if len(r.ResourceNames) > 0 {
+			continue
+		}
+		if matches(r.APIGroups, spec.G()) && matchesResource(r.Resources, res) && matches(r.Verbs, verb) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matches(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == matchAll || v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesResource(rr []string, res string) bool {
+	for _, r := range rr {
+		if r == matchAll || r == res {
+			return true
+		}
+		// Matches wildcard subresources ie pods/*.
+		if strings.HasSuffix(r, "/"+matchAll) && strings.HasPrefix(res, strings.TrimSuffix(r, matchAll)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rulesReview tracks a namespace rules review outcome.
+type rulesReview struct {
+	rules    []authorizationv1.ResourceRule
+	complete bool
+}
+
+// RulesFor returns the user resource rules in a given namespace and whether
+// the rules are complete. Incomplete rules only tell what is granted, not what
+// is denied. Rules are fetched once per namespace using a SelfSubjectRulesReview
+// and cached.
+func (a *APIClient) RulesFor(ns string) ([]authorizationv1.ResourceRule, bool) {
+	if ns == AllNamespaces {
+		return nil, false
+	}
+	key := cacheRulesKey + ":" + ns
+	if v, ok := a.cache.Get(key); ok {
+		if r, ok := v.(rulesReview); ok {
+			return r.rules, r.complete
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), CallTimeout)
+	defer cancel()
+	review := authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: ns},
+	}
+	resp, err := a.DialOrDie().AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, &review, metav1.CreateOptions{})
+	if err != nil {
+		log.Debug().Err(err).Msgf("Rules review failed for %q", ns)
+		a.cache.Add(key, rulesReview{}, cacheExpiry)
+		return nil, false
+	}
+	r := rulesReview{rules: resp.Status.ResourceRules, complete: !resp.Status.Incomplete}
+	a.cache.Add(key, r, cacheExpiry)
+
+	return r.rules, r.complete
+}
@@ -0,0 +1,38 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestAllowedByRules(t *testing.T) {
+	rules := []authorizationv1.ResourceRule{
+		{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list"}},
+		{APIGroups: []string{""}, Resources: []string{"pods/*"}, Verbs: []string{"create"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+		{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"fred"}, Verbs: []string{"get"}},
+	}
+
+	uu := map[string]struct {
+		gvr, verb string
+		e         bool
+	}{
+		"core":        {gvr: "v1/pods", verb: "list", e: true},
+		"coreDenied":  {gvr: "v1/pods", verb: "delete"},
+		"subresource": {gvr: "v1/pods:log", verb: "get", e: true},
+		"subWildcard": {gvr: "v1/pods:exec", verb: "create", e: true},
+		"wildcards":   {gvr: "apps/v1/deployments", verb: "patch", e: true},
+		"otherGroup":  {gvr: "batch/v1/jobs", verb: "get"},
+		"named":       {gvr: "v1/secrets", verb: "get"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.AllowedByRules(rules, u.gvr, u.verb))
+		})
+	}
+}
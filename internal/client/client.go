@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -23,7 +24,7 @@ import (
 )
 
 const (
-	cacheSize        = 100
+	cacheSize        = 500
 	cacheExpiry      = 5 * time.Minute
 	cacheMXKey       = "metrics"
 	cacheMXAPIKey    = "metricsAPI"
@@ -67,6 +68,25 @@ func InitConnectionOrDie(config *Config) *APIClient {
 	return &a
 }
 
+// InitConnection initializes a connection from a given configuration.
+// Unlike InitConnectionOrDie, it errors out if the api server can't be reached.
+func InitConnection(config *Config) (*APIClient, error) {
+	if _, err := config.RESTConfig(); err != nil {
+		return nil, err
+	}
+	a := APIClient{
+		config: config,
+		cache:  cache.NewLRUExpireCache(cacheSize),
+	}
+	if !a.CheckConnectivity() {
+		ctx, _ := config.CurrentContextName()
+		return nil, fmt.Errorf("unable to reach the api server for context %q", ctx)
+	}
+	_ = a.supportsMetricsResources()
+
+	return &a, nil
+}
+
 func makeSAR(ns, gvr string) *authorizationv1.SelfSubjectAccessReview {
 	if ns == ClusterScope {
 		ns = AllNamespaces
@@ -123,38 +143,53 @@ func (a *APIClient) clearCache() {
 }
 
 // CanI checks if user has access to a certain resource.
+// Access is cached per verb. Verbs granted by the namespace rules review skip the
+// access review altogether. Failed or partially evaluated reviews are not cached.
 func (a *APIClient) CanI(ns, gvr string, verbs []string) (auth bool, err error) {
-	// log.Debug().Msgf("Check Access %q::%q", ns, gvr)
 	if IsClusterWide(ns) {
 		ns = AllNamespaces
 	}
-	key := makeCacheKey(ns, gvr, verbs)
-	if v, ok := a.cache.Get(key); ok {
-		if auth, ok = v.(bool); ok {
-			return auth, nil
+	pending := make([]string, 0, len(verbs))
+	for _, v := range verbs {
+		key := makeCacheKey(ns, gvr, []string{v})
+		if c, ok := a.cache.Get(key); ok {
+			if allowed, ok := c.(bool); ok {
+				if !allowed {
+					return false, nil
+				}
+				continue
+			}
 		}
+		if rr, _ := a.RulesFor(ns); AllowedByRules(rr, gvr, v) {
+			a.cache.Add(key, true, cacheExpiry)
+			continue
+		}
+		pending = append(pending, v)
+	}
+	if len(pending) == 0 {
+		return true, nil
 	}
-	dial, sar := a.DialOrDie().AuthorizationV1().SelfSubjectAccessReviews(), makeSAR(ns, gvr)
 
+	dial, sar := a.DialOrDie().AuthorizationV1().SelfSubjectAccessReviews(), makeSAR(ns, gvr)
 	ctx, cancel := context.WithTimeout(context.Background(), CallTimeout)
 	defer cancel()
-	for _, v := range verbs {
+	for _, v := range pending {
+		key := makeCacheKey(ns, gvr, []string{v})
 		sar.Spec.ResourceAttributes.Verb = v
 		resp, err := dial.Create(ctx, sar, metav1.CreateOptions{})
 		if err != nil {
 			log.Warn().Err(err).Msgf("  Dial Failed!")
-			a.cache.Add(key, false, cacheExpiry)
 			return auth, err
 		}
+		if resp.Status.EvaluationError == "" {
+			a.cache.Add(key, resp.Status.Allowed, cacheExpiry)
+		}
 		if !resp.Status.Allowed {
-			a.cache.Add(key, false, cacheExpiry)
 			return auth, fmt.Errorf("`%s access denied for user on %q:%s", v, ns, gvr)
 		}
 	}
 
-	auth = true
-	a.cache.Add(key, true, cacheExpiry)
-	return
+	return true, nil
 }
 
 // CurrentNamespaceName return namespace name set via either cli arg or cluster config.
@@ -280,7 +315,7 @@ func (a *APIClient) RestConfigOrDie() *restclient.Config {
 }
 
 // CachedDiscoveryOrDie returns a cached discovery client.
-func (a *APIClient) CachedDiscoveryOrDie() *disk.CachedDiscoveryClient {
+func (a *APIClient) CachedDiscoveryOrDie() discovery.CachedDiscoveryInterface {
 	a.mx.Lock()
 	defer a.mx.Unlock()
 
@@ -346,6 +381,7 @@ func (a *APIClient) SwitchContext(ctx string) error {
 	a.reset()
 	_ = a.supportsMetricsResources()
 	ResetMetrics()
+	watches.Reset()
 
 	return nil
 }
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// Compression tracks api responses compression negotiation settings.
+type Compression struct {
+	// Lists negotiates gzip compressed api responses ie large resource lists.
+	Lists bool
+	// Logs negotiates gzip compressed logs downloads. Followed logs and
+	// watches are never compressed so events are not held back.
+	Logs bool
+}
+
+// CompressionFunc returns a cluster compression settings.
+type CompressionFunc func(cluster string) Compression
+
+// compressionTransport negotiates compressed responses explicitly so
+// compression can be turned on or off per request kind.
+type compressionTransport struct {
+	rt  http.RoundTripper
+	cfg Compression
+}
+
+func newCompressionTransport(rt http.RoundTripper, cfg Compression) http.RoundTripper {
+	return &compressionTransport{rt: rt, cfg: cfg}
+}
+
+// RoundTrip negotiates the request encoding and decompresses gzip responses.
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") != "" || isUpgrade(req) {
+		return t.rt.RoundTrip(req)
+	}
+
+	gz := t.negotiate(req)
+	req = utilnet.CloneRequest(req)
+	if gz {
+		req.Header.Set("Accept-Encoding", "gzip")
+	} else {
+		req.Header.Set("Accept-Encoding", "identity")
+	}
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil || !gz || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength, resp.Uncompressed = -1, true
+
+	return resp, nil
+}
+
+func (t *compressionTransport) negotiate(req *http.Request) bool {
+	q := req.URL.Query()
+	if q.Get("watch") == "true" || q.Get("follow") == "true" || strings.Contains(req.URL.Path, "/watch/") {
+		return false
+	}
+	if strings.HasSuffix(req.URL.Path, "/log") {
+		return t.cfg.Logs
+	}
+
+	return t.cfg.Lists
+}
+
+func isUpgrade(req *http.Request) bool {
+	return req.Header.Get("Upgrade") != "" || strings.Contains(strings.ToLower(req.Header.Get("Connection")), "upgrade")
+}
+
+// gzipBody lazily decompresses a response body on first read.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+// Read reads decompressed bytes.
+func (g *gzipBody) Read(p []byte) (int, error) {
+	if g.err != nil {
+		return 0, g.err
+	}
+	if g.zr == nil {
+		if g.zr, g.err = gzip.NewReader(g.body); g.err != nil {
+			return 0, g.err
+		}
+	}
+
+	return g.zr.Read(p)
+}
+
+// Close closes the underlying body.
+func (g *gzipBody) Close() error {
+	return g.body.Close()
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCompressionTransport(t *testing.T) {
+	uu := map[string]struct {
+		cfg      Compression
+		url      string
+		header   http.Header
+		encoding string
+	}{
+		"list": {
+			cfg:      Compression{Lists: true},
+			url:      "https://fred/api/v1/pods",
+			encoding: "gzip",
+		},
+		"listOff": {
+			cfg:      Compression{Logs: true},
+			url:      "https://fred/api/v1/pods",
+			encoding: "identity",
+		},
+		"logs": {
+			cfg:      Compression{Logs: true},
+			url:      "https://fred/api/v1/namespaces/blee/pods/p1/log?container=c1",
+			encoding: "gzip",
+		},
+		"logsOff": {
+			cfg:      Compression{Lists: true},
+			url:      "https://fred/api/v1/namespaces/blee/pods/p1/log",
+			encoding: "identity",
+		},
+		"follow": {
+			cfg:      Compression{Lists: true, Logs: true},
+			url:      "https://fred/api/v1/namespaces/blee/pods/p1/log?follow=true",
+			encoding: "identity",
+		},
+		"watch": {
+			cfg:      Compression{Lists: true, Logs: true},
+			url:      "https://fred/api/v1/pods?watch=true",
+			encoding: "identity",
+		},
+		"legacyWatch": {
+			cfg:      Compression{Lists: true, Logs: true},
+			url:      "https://fred/api/v1/watch/pods",
+			encoding: "identity",
+		},
+		"preset": {
+			cfg:      Compression{Lists: true},
+			url:      "https://fred/api/v1/pods",
+			header:   http.Header{"Accept-Encoding": []string{"br"}},
+			encoding: "br",
+		},
+		"upgrade": {
+			cfg:    Compression{Lists: true},
+			url:    "https://fred/api/v1/namespaces/blee/pods/p1/exec",
+			header: http.Header{"Connection": []string{"Upgrade"}, "Upgrade": []string{"SPDY/3.1"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var encoding string
+			tr := newCompressionTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				encoding = req.Header.Get("Accept-Encoding")
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(&bytes.Buffer{})}, nil
+			}), u.cfg)
+			req, err := http.NewRequest(http.MethodGet, u.url, nil)
+			assert.Nil(t, err)
+			if u.header != nil {
+				req.Header = u.header
+			}
+
+			_, err = tr.RoundTrip(req)
+			assert.Nil(t, err)
+			assert.Equal(t, u.encoding, encoding)
+		})
+	}
+}
+
+func TestCompressionTransportDecompress(t *testing.T) {
+	var zipped bytes.Buffer
+	w := gzip.NewWriter(&zipped)
+	_, err := w.Write([]byte(`{"kind":"PodList"}`))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	tr := newCompressionTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Encoding": []string{"gzip"}, "Content-Length": []string{"42"}},
+			ContentLength: 42,
+			Body:          ioutil.NopCloser(bytes.NewReader(zipped.Bytes())),
+		}, nil
+	}), Compression{Lists: true})
+	req, err := http.NewRequest(http.MethodGet, "https://fred/api/v1/pods", nil)
+	assert.Nil(t, err)
+
+	resp, err := tr.RoundTrip(req)
+	assert.Nil(t, err)
+	raw, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Body.Close())
+	assert.Equal(t, `{"kind":"PodList"}`, string(raw))
+	assert.True(t, resp.Uncompressed)
+	assert.Equal(t, int64(-1), resp.ContentLength)
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 
@@ -26,6 +27,9 @@ type Config struct {
 	currentContext string
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
+	inCluster      bool
+	offline        bool
+	compression    CompressionFunc
 	mutex          *sync.RWMutex
 }
 
@@ -60,6 +64,18 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ForContext returns a new configuration targeting another kubeconfig context.
+func (c *Config) ForContext(name string) (*Config, error) {
+	if _, err := c.GetContext(name); err != nil {
+		return nil, fmt.Errorf("context %s does not exist", name)
+	}
+	f := genericclioptions.NewConfigFlags(false)
+	f.KubeConfig, f.Context = c.flags.KubeConfig, &name
+	f.Impersonate, f.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
+
+	return NewConfig(f), nil
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -100,12 +116,44 @@ func (c *Config) Contexts() (map[string]*clientcmdapi.Context, error) {
 	return cfg.Contexts, nil
 }
 
+// InClusterMode returns true if connected via the pod service account.
+func (c *Config) InClusterMode() bool {
+	if _, err := c.RawConfig(); err != nil {
+		return false
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.inCluster
+}
+
+// SetOffline toggles offline mode and resets the current configuration.
+func (c *Config) SetOffline(b bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.offline = b
+	c.reset()
+}
+
+// SetCompression sets the clusters compression settings and resets the
+// current api connection configuration.
+func (c *Config) SetCompression(f CompressionFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.compression, c.restConfig = f, nil
+}
+
 // DelContext remove a given context from the configuration.
 func (c *Config) DelContext(n string) error {
 	cfg, err := c.RawConfig()
 	if err != nil {
 		return err
 	}
+	if c.InClusterMode() {
+		return errors.New("contexts can not be deleted while running in-cluster")
+	}
 	delete(cfg.Contexts, n)
 
 	return clientcmd.ModifyConfig(c.clientConfig.ConfigAccess(), cfg, true)
@@ -285,12 +333,23 @@ func (c *Config) RawConfig() (clientcmdapi.Config, error) {
 		c.reset()
 	}
 
+	if c.rawConfig == nil && c.offline {
+		cfg := OfflineRawConfig()
+		c.rawConfig, c.currentContext = &cfg, cfg.CurrentContext
+		return cfg, nil
+	}
+
 	if c.rawConfig == nil {
 		c.ensureConfig()
 		cfg, err := c.clientConfig.RawConfig()
 		if err != nil {
 			return cfg, err
 		}
+		c.inCluster = isEmptyConfig(cfg) && IsInCluster()
+		if c.inCluster {
+			log.Debug().Msg("No kubeconfig found. Using in-cluster service account")
+			cfg = InClusterRawConfig()
+		}
 		c.rawConfig = &cfg
 		c.currentContext = cfg.CurrentContext
 	}
@@ -310,6 +369,17 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	}
 	c.restConfig.QPS = defaultQPS
 	c.restConfig.Burst = defaultBurst
+	if c.compression != nil {
+		cluster, err := c.CurrentClusterName()
+		if err != nil {
+			log.Warn().Err(err).Msgf("No cluster compression settings")
+		}
+		cfg := c.compression(cluster)
+		c.restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newCompressionTransport(rt, cfg)
+		})
+	}
+	c.restConfig.Wrap(watches.Wrap)
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
 
 	return c.restConfig, nil
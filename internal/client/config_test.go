@@ -128,6 +128,26 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigForContext(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	other, err := cfg.ForContext("blee")
+	assert.Nil(t, err)
+	ctx, err := other.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.NotEqual(t, "blee", ctx)
+
+	_, err = cfg.ForContext("zorg")
+	assert.NotNil(t, err)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
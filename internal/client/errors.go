@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrorKind represents a class of api failures.
+type ErrorKind string
+
+const (
+	// ErrKindUnknown represents an unclassified failure.
+	ErrKindUnknown ErrorKind = "Unknown"
+	// ErrKindRBAC represents an authorization failure.
+	ErrKindRBAC ErrorKind = "RBAC"
+	// ErrKindNotFound represents a missing resource.
+	ErrKindNotFound ErrorKind = "NotFound"
+	// ErrKindConflict represents a stale or duplicate resource.
+	ErrKindConflict ErrorKind = "Conflict"
+	// ErrKindWebhook represents an admission webhook denial.
+	ErrKindWebhook ErrorKind = "WebhookDenied"
+	// ErrKindTimeout represents a timed out call.
+	ErrKindTimeout ErrorKind = "Timeout"
+
+	clusterScopeDenial = "at the cluster scope"
+)
+
+var errorHints = map[ErrorKind]string{
+	ErrKindRBAC:     "Check your permissions using the rbac view or ask your cluster admin for access.",
+	ErrKindNotFound: "The resource may have been deleted. Refresh the view and retry.",
+	ErrKindConflict: "The resource changed since it was loaded. Refresh the view and retry or edit the latest revision.",
+	ErrKindWebhook:  "An admission webhook rejected the request. Edit the resource to satisfy the policy listed in the message.",
+	ErrKindTimeout:  "The api server did not respond in time. Check your connection and retry.",
+}
+
+// ErrorReport describes a classified api failure.
+type ErrorReport struct {
+	Kind    ErrorKind
+	Message string
+	Hint    string
+	Status  *metav1.Status
+	// ClusterScoped tracks authorization failures on cluster wide requests.
+	ClusterScoped bool
+}
+
+// Known returns true if the failure was classified.
+func (e ErrorReport) Known() bool {
+	return e.Kind != ErrKindUnknown
+}
+
+// ClassifyError returns a report describing the failure and how to remediate it.
+func ClassifyError(err error) ErrorReport {
+	r := ErrorReport{Kind: ErrKindUnknown, Message: err.Error()}
+	var s apierrors.APIStatus
+	if errors.As(err, &s) {
+		st := s.Status()
+		r.Status = &st
+	}
+	r.Kind = errorKind(err)
+	r.Hint = errorHints[r.Kind]
+	r.ClusterScoped = r.Kind == ErrKindRBAC && strings.Contains(r.Message, clusterScopeDenial)
+
+	return r
+}
+
+func errorKind(err error) ErrorKind {
+	// Api helpers do not unwrap errors.
+	var s apierrors.APIStatus
+	if errors.As(err, &s) {
+		if e, ok := s.(error); ok {
+			err = e
+		}
+	}
+	var ne net.Error
+	switch {
+	case isWebhookDenial(err):
+		return ErrKindWebhook
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return ErrKindRBAC
+	case apierrors.IsNotFound(err):
+		return ErrKindNotFound
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return ErrKindConflict
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return ErrKindTimeout
+	case errors.As(err, &ne) && ne.Timeout():
+		return ErrKindTimeout
+	case strings.Contains(err.Error(), "access denied for user"):
+		return ErrKindRBAC
+	}
+
+	return ErrKindUnknown
+}
+
+func isWebhookDenial(err error) bool {
+	s, ok := err.(apierrors.APIStatus)
+	if !ok {
+		return false
+	}
+	msg := s.Status().Message
+
+	return strings.Contains(msg, "admission webhook") && strings.Contains(msg, "denied")
+}
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestClassifyError(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	webhook := &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusBadRequest,
+		Reason:  metav1.StatusReasonBadRequest,
+		Message: `admission webhook "deny.k9s.io" denied the request: no latest tags`,
+	}}
+
+	uu := map[string]struct {
+		err     error
+		kind    client.ErrorKind
+		status  bool
+		cluster bool
+	}{
+		"forbidden": {err: apierrors.NewForbidden(gr, "fred", errors.New("nope")), kind: client.ErrKindRBAC, status: true},
+		"forbiddenCluster": {
+			err:  apierrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, "", errors.New(`User "fred" cannot list resource "nodes" in API group "" at the cluster scope`)),
+			kind: client.ErrKindRBAC, status: true, cluster: true,
+		},
+		"canI":     {err: errors.New("`delete access denied for user on \"default\":v1/pods"), kind: client.ErrKindRBAC},
+		"notFound": {err: apierrors.NewNotFound(gr, "fred"), kind: client.ErrKindNotFound, status: true},
+		"conflict": {err: apierrors.NewConflict(gr, "fred", errors.New("stale")), kind: client.ErrKindConflict, status: true},
+		"wrapped":  {err: fmt.Errorf("scale failed: %w", apierrors.NewConflict(gr, "fred", errors.New("stale"))), kind: client.ErrKindConflict, status: true},
+		"webhook":  {err: webhook, kind: client.ErrKindWebhook, status: true},
+		"timeout":  {err: apierrors.NewTimeoutError("slow", 1), kind: client.ErrKindTimeout, status: true},
+		"deadline": {err: context.DeadlineExceeded, kind: client.ErrKindTimeout},
+		"unknown":  {err: errors.New("blee"), kind: client.ErrKindUnknown},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := client.ClassifyError(u.err)
+			assert.Equal(t, u.kind, r.Kind)
+			assert.Equal(t, u.err.Error(), r.Message)
+			assert.Equal(t, u.status, r.Status != nil)
+			assert.Equal(t, u.kind != client.ErrKindUnknown, r.Hint != "")
+			assert.Equal(t, u.cluster, r.ClusterScoped)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// InClusterName represents the context, cluster and user names used when running inside a cluster.
+const InClusterName = "in-cluster"
+
+// ServiceAccountDir represents the location of the pod service account credentials.
+var ServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// IsInCluster checks if K9s is running inside a pod with a mounted service account.
+func IsInCluster() bool {
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" || os.Getenv("KUBERNETES_SERVICE_PORT") == "" {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(ServiceAccountDir, "token"))
+
+	return err == nil
+}
+
+// InClusterNamespace returns the namespace of the pod K9s is running in.
+func InClusterNamespace() (string, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(ServiceAccountDir, "namespace"))
+	if err != nil {
+		return "", err
+	}
+	ns := strings.TrimSpace(string(raw))
+	if ns == "" {
+		return "", errors.New("no in-cluster namespace found")
+	}
+
+	return ns, nil
+}
+
+// InClusterRawConfig returns a synthetic kubeconfig describing the in-cluster connection.
+func InClusterRawConfig() clientcmdapi.Config {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[InClusterName] = &clientcmdapi.Cluster{
+		Server:               "https://" + os.Getenv("KUBERNETES_SERVICE_HOST") + ":" + os.Getenv("KUBERNETES_SERVICE_PORT"),
+		CertificateAuthority: filepath.Join(ServiceAccountDir, "ca.crt"),
+	}
+	cfg.AuthInfos[InClusterName] = &clientcmdapi.AuthInfo{
+		TokenFile: filepath.Join(ServiceAccountDir, "token"),
+	}
+	ctx := clientcmdapi.Context{
+		Cluster:  InClusterName,
+		AuthInfo: InClusterName,
+	}
+	if ns, err := InClusterNamespace(); err == nil {
+		ctx.Namespace = ns
+	}
+	cfg.Contexts[InClusterName] = &ctx
+	cfg.CurrentContext = InClusterName
+
+	return *cfg
+}
+
+func isEmptyConfig(cfg clientcmdapi.Config) bool {
+	return len(cfg.Contexts) == 0 && cfg.CurrentContext == ""
+}
@@ -0,0 +1,55 @@
+package client_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInCluster(t *testing.T) {
+	defer setupInCluster("testdata/serviceaccount")()
+
+	assert.True(t, client.IsInCluster())
+	ns, err := client.InClusterNamespace()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ns)
+
+	cfg := client.InClusterRawConfig()
+	assert.Equal(t, client.InClusterName, cfg.CurrentContext)
+	assert.Equal(t, "fred", cfg.Contexts[client.InClusterName].Namespace)
+	assert.Equal(t, "https://10.0.0.1:443", cfg.Clusters[client.InClusterName].Server)
+}
+
+func TestInClusterNoServiceAccount(t *testing.T) {
+	defer setupInCluster("testdata/not_there")()
+
+	assert.False(t, client.IsInCluster())
+	_, err := client.InClusterNamespace()
+	assert.NotNil(t, err)
+}
+
+func TestInClusterNoEnv(t *testing.T) {
+	d := client.ServiceAccountDir
+	client.ServiceAccountDir = "testdata/serviceaccount"
+	defer func() { client.ServiceAccountDir = d }()
+	os.Unsetenv("KUBERNETES_SERVICE_HOST")
+
+	assert.False(t, client.IsInCluster())
+}
+
+// Helpers...
+
+func setupInCluster(dir string) func() {
+	d := client.ServiceAccountDir
+	client.ServiceAccountDir = dir
+	os.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	os.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	return func() {
+		client.ServiceAccountDir = d
+		os.Unsetenv("KUBERNETES_SERVICE_HOST")
+		os.Unsetenv("KUBERNETES_SERVICE_PORT")
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// NamespaceGuard restricts the namespaces users can view or act on. A nil
+// guard allows all namespaces.
+type NamespaceGuard struct {
+	allow, deny []*regexp.Regexp
+	err         error
+}
+
+// NewDenyAllGuard returns a guard rejecting all namespaces, used when the
+// namespace restrictions can't be honored.
+func NewDenyAllGuard(err error) *NamespaceGuard {
+	return &NamespaceGuard{err: err}
+}
+
+// Err returns the reason all namespaces are denied if any.
+func (g *NamespaceGuard) Err() error {
+	if g == nil {
+		return nil
+	}
+
+	return g.err
+}
+
+// NewNamespaceGuard returns a guard allowing namespaces matching one of the
+// allow patterns and none of the deny patterns. Patterns match the whole
+// namespace name. Returns nil if no patterns are given.
+func NewNamespaceGuard(allow, deny []string) (*NamespaceGuard, error) {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil, nil
+	}
+
+	var (
+		g   NamespaceGuard
+		err error
+	)
+	if g.allow, err = compileNSPatterns(allow); err != nil {
+		return nil, err
+	}
+	if g.deny, err = compileNSPatterns(deny); err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// Allowed returns true if the given namespace can be viewed. All namespaces
+// and cluster scope are always allowed, their resources get filtered instead.
+func (g *NamespaceGuard) Allowed(ns string) bool {
+	if g == nil || IsClusterWide(ns) {
+		return true
+	}
+	if g.err != nil {
+		return false
+	}
+	for _, rx := range g.deny {
+		if rx.MatchString(ns) {
+			return false
+		}
+	}
+	if len(g.allow) == 0 {
+		return true
+	}
+	for _, rx := range g.allow {
+		if rx.MatchString(ns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check returns an error if the given namespace is off limits.
+func (g *NamespaceGuard) Check(ns string) error {
+	if g.Allowed(ns) {
+		return nil
+	}
+	if g.err != nil {
+		return fmt.Errorf("namespace %q is off limits: %w", ns, g.err)
+	}
+
+	return fmt.Errorf("namespace %q is off limits in this context", ns)
+}
+
+func compileNSPatterns(ss []string) ([]*regexp.Regexp, error) {
+	rr := make([]*regexp.Regexp, 0, len(ss))
+	for _, s := range ss {
+		rx, err := CompileNSPattern(s)
+		if err != nil {
+			return nil, err
+		}
+		rr = append(rr, rx)
+	}
+
+	return rr, nil
+}
+
+// CompileNSPattern compiles a namespace pattern matching whole names.
+func CompileNSPattern(s string) (*regexp.Regexp, error) {
+	rx, err := regexp.Compile(`\A(?:` + s + `)\z`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace pattern %q: %w", s, err)
+	}
+
+	return rx, nil
+}
@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceGuardAllowed(t *testing.T) {
+	uu := map[string]struct {
+		allow, deny []string
+		ns          string
+		e           bool
+	}{
+		"none": {
+			ns: "kube-system",
+			e:  true,
+		},
+		"allowed": {
+			allow: []string{"team-a-.*", "default"},
+			ns:    "team-a-dev",
+			e:     true,
+		},
+		"notAllowed": {
+			allow: []string{"team-a-.*", "default"},
+			ns:    "team-b-dev",
+		},
+		"anchored": {
+			allow: []string{"team-a"},
+			ns:    "team-a-dev",
+		},
+		"denied": {
+			deny: []string{"kube-.*"},
+			ns:   "kube-system",
+		},
+		"denyWins": {
+			allow: []string{"team-a-.*"},
+			deny:  []string{".*-prod"},
+			ns:    "team-a-prod",
+		},
+		"allNamespaces": {
+			allow: []string{"team-a-.*"},
+			ns:    client.NamespaceAll,
+			e:     true,
+		},
+		"clusterScope": {
+			allow: []string{"team-a-.*"},
+			ns:    client.ClusterScope,
+			e:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			g, err := client.NewNamespaceGuard(u.allow, u.deny)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, g.Allowed(u.ns))
+			assert.Equal(t, u.e, g.Check(u.ns) == nil)
+		})
+	}
+}
+
+func TestNamespaceGuardInvalid(t *testing.T) {
+	_, err := client.NewNamespaceGuard([]string{"team-(a"}, nil)
+
+	assert.NotNil(t, err)
+}
+
+func TestNamespaceGuardDenyAll(t *testing.T) {
+	g := client.NewDenyAllGuard(errors.New("boom"))
+
+	assert.False(t, g.Allowed("default"))
+	assert.True(t, g.Allowed(client.AllNamespaces))
+	assert.EqualError(t, g.Check("default"), `namespace "default" is off limits: boom`)
+}
@@ -0,0 +1,465 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	versioned "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// OfflineName represents the context, cluster and user names used in offline mode.
+const OfflineName = "offline"
+
+// ErrOffline indicates an operation requiring a live cluster.
+var ErrOffline = errors.New("not available in offline mode")
+
+var offlineVerbs = []string{"get", "list", "watch"}
+
+// OfflineRawConfig returns a synthetic kubeconfig describing an offline session.
+func OfflineRawConfig() clientcmdapi.Config {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[OfflineName] = &clientcmdapi.Cluster{Server: OfflineName}
+	cfg.AuthInfos[OfflineName] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[OfflineName] = &clientcmdapi.Context{
+		Cluster:  OfflineName,
+		AuthInfo: OfflineName,
+	}
+	cfg.CurrentContext = OfflineName
+
+	return *cfg
+}
+
+// OfflineClient serves resources loaded from dump files in lieu of a live cluster.
+type OfflineClient struct {
+	config    *Config
+	objs      map[schema.GroupVersionResource][]*unstructured.Unstructured
+	client    kubernetes.Interface
+	dClient   dynamic.Interface
+	discovery discovery.CachedDiscoveryInterface
+	nss       []v1.Namespace
+}
+
+// NewOfflineClient returns a connection backed by the resources dumped in path.
+func NewOfflineClient(config *Config, path string) (*OfflineClient, error) {
+	oo, err := LoadDumps(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, fmt.Errorf("no resources found in %q", path)
+	}
+	config.SetOffline(true)
+	o := OfflineClient{
+		config: config,
+		objs:   make(map[schema.GroupVersionResource][]*unstructured.Unstructured),
+	}
+	o.load(oo)
+	log.Debug().Msgf("Offline mode loaded %d resources from %q", len(oo), path)
+
+	return &o, nil
+}
+
+func (o *OfflineClient) load(oo []*unstructured.Unstructured) {
+	namespaced := make(map[schema.GroupVersionResource]bool)
+	kinds := make(map[schema.GroupVersionResource]string)
+	nss := make(map[string]*unstructured.Unstructured)
+	for _, u := range oo {
+		gvk := u.GroupVersionKind()
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		o.objs[gvr] = append(o.objs[gvr], u)
+		kinds[gvr] = gvk.Kind
+		if ns := u.GetNamespace(); ns != "" {
+			namespaced[gvr] = true
+			if _, ok := nss[ns]; !ok {
+				nss[ns] = nil
+			}
+		}
+		if gvr == nsGVR {
+			nss[u.GetName()] = u
+		}
+	}
+	for n, u := range nss {
+		if u == nil {
+			u = offlineNamespace(n)
+			o.objs[nsGVR], kinds[nsGVR] = append(o.objs[nsGVR], u), "Namespace"
+		}
+		var ns v1.Namespace
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ns); err == nil {
+			o.nss = append(o.nss, ns)
+		}
+	}
+	sort.Slice(o.nss, func(i, j int) bool {
+		return o.nss[i].Name < o.nss[j].Name
+	})
+
+	cs := k8sfake.NewSimpleClientset(typedObjects(o.objs)...)
+	cs.Resources = apiResources(kinds, namespaced)
+	o.client, o.dClient = cs, &offlineDynamic{objs: o.objs}
+	o.discovery = memory.NewMemCacheClient(&fakediscovery.FakeDiscovery{
+		Fake:               &cs.Fake,
+		FakedServerVersion: &version.Info{GitVersion: OfflineName},
+	})
+}
+
+var nsGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+func offlineNamespace(n string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": n},
+		"status":     map[string]interface{}{"phase": "Active"},
+	}}
+
+	return &u
+}
+
+func apiResources(kinds map[schema.GroupVersionResource]string, namespaced map[schema.GroupVersionResource]bool) []*metav1.APIResourceList {
+	gg := make(map[string]*metav1.APIResourceList)
+	for gvr, k := range kinds {
+		gv := gvr.GroupVersion().String()
+		l, ok := gg[gv]
+		if !ok {
+			l = &metav1.APIResourceList{GroupVersion: gv}
+			gg[gv] = l
+		}
+		l.APIResources = append(l.APIResources, metav1.APIResource{
+			Name:         gvr.Resource,
+			SingularName: strings.ToLower(k),
+			Kind:         k,
+			Namespaced:   namespaced[gvr],
+			Verbs:        offlineVerbs,
+		})
+	}
+	ll := make([]*metav1.APIResourceList, 0, len(gg))
+	for _, l := range gg {
+		ll = append(ll, l)
+	}
+
+	return ll
+}
+
+func typedObjects(m map[schema.GroupVersionResource][]*unstructured.Unstructured) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(m))
+	for _, uu := range m {
+		for _, u := range uu {
+			o, err := scheme.Scheme.New(u.GroupVersionKind())
+			if err != nil {
+				continue
+			}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, o); err != nil {
+				log.Warn().Err(err).Msgf("Offline conversion failed for %s", u.GetName())
+				continue
+			}
+			oo = append(oo, o)
+		}
+	}
+
+	return oo
+}
+
+// LoadDumps loads all resources from a yaml or json dump file or a directory of dumps.
+// Lists such as kubectl get -o json outputs are flattened.
+func LoadDumps(path string) ([]*unstructured.Unstructured, error) {
+	var ff []string
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".yaml", ".yml", ".json":
+			ff = append(ff, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[string]int)
+	var oo []*unstructured.Unstructured
+	for _, f := range ff {
+		uu, err := loadDump(f)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load dump %q: %w", f, err)
+		}
+		for _, u := range uu {
+			k := u.GroupVersionKind().String() + "|" + FQN(u.GetNamespace(), u.GetName())
+			if i, ok := idx[k]; ok {
+				oo[i] = u
+				continue
+			}
+			idx[k] = len(oo)
+			oo = append(oo, u)
+		}
+	}
+
+	return oo, nil
+}
+
+func loadDump(path string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing dump %q", path)
+		}
+	}()
+
+	var oo []*unstructured.Unstructured
+	d := yaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var raw json.RawMessage
+		if err := d.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		var u unstructured.Unstructured
+		if err := u.UnmarshalJSON(raw); err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid resource in %q", path)
+			continue
+		}
+		if !u.IsList() {
+			if u.GetKind() != "" && u.GetName() != "" {
+				oo = append(oo, &u)
+			}
+			continue
+		}
+		err := u.EachListItem(func(o runtime.Object) error {
+			if i, ok := o.(*unstructured.Unstructured); ok && i.GetKind() != "" && i.GetName() != "" {
+				oo = append(oo, i)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return oo, nil
+}
+
+// ActiveCluster returns the current cluster name.
+func (o *OfflineClient) ActiveCluster() string {
+	return OfflineName
+}
+
+// IsActiveNamespace returns true if namespaces matches.
+func (o *OfflineClient) IsActiveNamespace(ns string) bool {
+	if o.ActiveNamespace() == AllNamespaces {
+		return true
+	}
+	return o.ActiveNamespace() == ns
+}
+
+// ActiveNamespace returns the current namespace.
+func (o *OfflineClient) ActiveNamespace() string {
+	ns, err := o.config.CurrentNamespaceName()
+	if err != nil {
+		return AllNamespaces
+	}
+	return ns
+}
+
+// CanI only grants read access to the dumped resources.
+func (o *OfflineClient) CanI(ns, gvr string, verbs []string) (bool, error) {
+	for _, v := range verbs {
+		if !in(offlineVerbs, v) {
+			return false, fmt.Errorf("`%s %w", v, ErrOffline)
+		}
+	}
+
+	return true, nil
+}
+
+// ServerVersion returns the offline server version info.
+func (o *OfflineClient) ServerVersion() (*version.Info, error) {
+	return o.discovery.ServerVersion()
+}
+
+// ValidNamespaces returns all namespaces found in the dumps.
+func (o *OfflineClient) ValidNamespaces() ([]v1.Namespace, error) {
+	return o.nss, nil
+}
+
+// CheckConnectivity always succeeds offline.
+func (o *OfflineClient) CheckConnectivity() bool {
+	return true
+}
+
+// Config return a kubernetes configuration.
+func (o *OfflineClient) Config() *Config {
+	return o.config
+}
+
+// HasMetrics returns false since dumps carry no metrics.
+func (o *OfflineClient) HasMetrics() bool {
+	return false
+}
+
+// DialOrDie returns a typed client serving the dumped resources.
+func (o *OfflineClient) DialOrDie() kubernetes.Interface {
+	return o.client
+}
+
+// RestConfigOrDie returns an empty rest config.
+func (o *OfflineClient) RestConfigOrDie() *restclient.Config {
+	return &restclient.Config{Host: OfflineName}
+}
+
+// CachedDiscoveryOrDie returns a discovery client describing the dumped resources.
+func (o *OfflineClient) CachedDiscoveryOrDie() discovery.CachedDiscoveryInterface {
+	return o.discovery
+}
+
+// DynDialOrDie returns a dynamic client serving the dumped resources.
+func (o *OfflineClient) DynDialOrDie() dynamic.Interface {
+	return o.dClient
+}
+
+// MXDial errors out since dumps carry no metrics.
+func (o *OfflineClient) MXDial() (*versioned.Clientset, error) {
+	return nil, fmt.Errorf("metrics %w", ErrOffline)
+}
+
+// SwitchContext errors out since offline mode has a single context.
+func (o *OfflineClient) SwitchContext(ctx string) error {
+	return fmt.Errorf("context switch %w", ErrOffline)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func in(ll []string, s string) bool {
+	for _, l := range ll {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+type offlineDynamic struct {
+	objs map[schema.GroupVersionResource][]*unstructured.Unstructured
+}
+
+// Resource returns a read only handle on the dumped resources.
+func (d *offlineDynamic) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &offlineResource{gvr: gvr, objs: d.objs[gvr]}
+}
+
+type offlineResource struct {
+	gvr  schema.GroupVersionResource
+	ns   string
+	objs []*unstructured.Unstructured
+}
+
+func (r *offlineResource) Namespace(ns string) dynamic.ResourceInterface {
+	res := *r
+	res.ns = ns
+	return &res
+}
+
+func (r *offlineResource) Get(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+	for _, o := range r.objs {
+		if o.GetName() == name && (r.ns == "" || o.GetNamespace() == r.ns) {
+			return o.DeepCopy(), nil
+		}
+	}
+
+	return nil, kerrors.NewNotFound(r.gvr.GroupResource(), name)
+}
+
+func (r *offlineResource) List(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	sel, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	var l unstructured.UnstructuredList
+	l.SetAPIVersion(r.gvr.GroupVersion().String())
+	l.SetKind("List")
+	l.SetResourceVersion("1")
+	for _, o := range r.objs {
+		if r.ns != "" && o.GetNamespace() != r.ns {
+			continue
+		}
+		if !sel.Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+		l.Items = append(l.Items, *o.DeepCopy())
+	}
+
+	return &l, nil
+}
+
+// Watch never fires since dumps are static.
+func (r *offlineResource) Watch(context.Context, metav1.ListOptions) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
+func (r *offlineResource) Create(context.Context, *unstructured.Unstructured, metav1.CreateOptions, ...string) (*unstructured.Unstructured, error) {
+	return nil, r.readOnly("create")
+}
+
+func (r *offlineResource) Update(context.Context, *unstructured.Unstructured, metav1.UpdateOptions, ...string) (*unstructured.Unstructured, error) {
+	return nil, r.readOnly("update")
+}
+
+func (r *offlineResource) UpdateStatus(context.Context, *unstructured.Unstructured, metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return nil, r.readOnly("update")
+}
+
+func (r *offlineResource) Delete(context.Context, string, metav1.DeleteOptions, ...string) error {
+	return r.readOnly("delete")
+}
+
+func (r *offlineResource) DeleteCollection(context.Context, metav1.DeleteOptions, metav1.ListOptions) error {
+	return r.readOnly("delete")
+}
+
+func (r *offlineResource) Patch(context.Context, string, types.PatchType, []byte, metav1.PatchOptions, ...string) (*unstructured.Unstructured, error) {
+	return nil, r.readOnly("patch")
+}
+
+func (r *offlineResource) readOnly(verb string) error {
+	return fmt.Errorf("%s %s %w", verb, r.gvr.Resource, ErrOffline)
+}
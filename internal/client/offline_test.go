@@ -0,0 +1,77 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestLoadDumps(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    int
+	}{
+		"dir":  {path: "testdata/dumps", e: 4},
+		"list": {path: "testdata/dumps/pods.json", e: 2},
+		"docs": {path: "testdata/dumps/dp.yml", e: 2},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := client.LoadDumps(u.path)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, len(oo))
+		})
+	}
+}
+
+func TestOfflineClient(t *testing.T) {
+	cfg := client.NewConfig(genericclioptions.NewConfigFlags(false))
+	c, err := client.NewOfflineClient(cfg, "testdata/dumps")
+	assert.Nil(t, err)
+
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, client.OfflineName, ctx)
+
+	nn, err := c.ValidNamespaces()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(nn))
+	assert.Equal(t, "blee", nn[0].Name)
+	assert.Equal(t, "zorg", nn[1].Name)
+
+	pods := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	ll, err := c.DynDialOrDie().Resource(pods).Namespace("blee").List(context.Background(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ll.Items))
+	ll, err = c.DynDialOrDie().Resource(pods).List(context.Background(), metav1.ListOptions{LabelSelector: "app=wilma"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ll.Items))
+	assert.Equal(t, "wilma", ll.Items[0].GetName())
+
+	_, err = c.DynDialOrDie().Resource(pods).Namespace("blee").Get(context.Background(), "wilma", metav1.GetOptions{})
+	assert.NotNil(t, err)
+	assert.NotNil(t, c.DynDialOrDie().Resource(pods).Namespace("blee").Delete(context.Background(), "fred", metav1.DeleteOptions{}))
+
+	po, err := c.DialOrDie().CoreV1().Pods("zorg").Get(context.Background(), "wilma", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "nginx", po.Spec.Containers[0].Image)
+
+	ok, err := c.CanI("blee", "v1/pods", []string{"list", "watch"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = c.CanI("blee", "v1/pods", []string{"delete"})
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+
+	rr, err := c.CachedDiscoveryOrDie().ServerResourcesForGroupVersion("apps/v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "deployments", rr.APIResources[0].Name)
+	assert.True(t, rr.APIResources[0].Namespaced)
+}
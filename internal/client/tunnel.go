@@ -3,6 +3,13 @@ package client
 // PortTunnel represents a host tunnel port mapper.
 type PortTunnel struct {
 	Address, LocalPort, ContainerPort string
+
+	// Mirror names a file recording the forwarded http traffic. Blank disables mirroring.
+	Mirror string
+	// MirrorBodyCap caps the recorded bodies size. Zero only records headers.
+	MirrorBodyCap int64
+	// AutoPort picks a free local port when the requested one is blank or busy.
+	AutoPort bool
 }
 
 // PortMap returns a port mapping.
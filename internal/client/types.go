@@ -3,7 +3,7 @@ package client
 import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/version"
-	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -79,7 +79,7 @@ type Connection interface {
 	SwitchContext(ctx string) error
 
 	// CachedDiscoveryOrDie connects to discovery client.
-	CachedDiscoveryOrDie() *disk.CachedDiscoveryClient
+	CachedDiscoveryOrDie() discovery.CachedDiscoveryInterface
 
 	// RestConfigOrDie connects to rest client.
 	RestConfigOrDie() *restclient.Config
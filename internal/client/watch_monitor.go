@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	watchBackoffBase = 1 * time.Second
+	watchBackoffCap  = 1 * time.Minute
+	// watchMinLife tracks how long a healthy watch lasts. Informers restarting
+	// shorter lived watches, ie on 410 Gone, are backed off.
+	watchMinLife = 5 * time.Second
+)
+
+var watches = NewWatchMonitor()
+
+// Watches returns the active cluster watches monitor.
+func Watches() *WatchMonitor {
+	return watches
+}
+
+// WatchStat tracks a resource informer watches health.
+type WatchStat struct {
+	GVR         string        `json:"gvr"`
+	Watches     int           `json:"watches"`
+	Reconnects  int           `json:"reconnects"`
+	Failures    int           `json:"failures"`
+	Backoff     time.Duration `json:"backoff,omitempty"`
+	LastError   string        `json:"lastError,omitempty"`
+	LastErrorAt time.Time     `json:"lastErrorAt,omitempty"`
+}
+
+type watchState struct {
+	WatchStat
+
+	failures int
+}
+
+// WatchMonitor guards informers against watch reconnect storms. Informers
+// requests for a resource that keeps failing are delayed by a jittered
+// exponential backoff, so resources don't relist in lock step.
+type WatchMonitor struct {
+	stats map[string]*watchState
+	mx    sync.Mutex
+}
+
+// NewWatchMonitor returns a new monitor.
+func NewWatchMonitor() *WatchMonitor {
+	return &WatchMonitor{stats: make(map[string]*watchState)}
+}
+
+// Reset clears the watches stats, ie on context switch.
+func (w *WatchMonitor) Reset() {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	w.stats = make(map[string]*watchState)
+}
+
+// Stats returns the watches stats ordered by resource.
+func (w *WatchMonitor) Stats() []WatchStat {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	ss := make([]WatchStat, 0, len(w.stats))
+	for _, s := range w.stats {
+		ss = append(ss, s.WatchStat)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].GVR < ss[j].GVR
+	})
+
+	return ss
+}
+
+// Wrap returns a transport guarding informers requests.
+func (w *WatchMonitor) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &watchTransport{rt: rt, monitor: w}
+}
+
+// backoff returns how long to delay a resource informer request.
+func (w *WatchMonitor) backoff(gvr string) time.Duration {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	s, ok := w.stats[gvr]
+	if !ok || s.failures == 0 {
+		return 0
+	}
+	d := watchBackoffBase << uint(s.failures-1)
+	if d > watchBackoffCap || d <= 0 {
+		d = watchBackoffCap
+	}
+	s.Backoff = d
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func (w *WatchMonitor) started(gvr string) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	s := w.state(gvr)
+	if s.Watches > 0 {
+		s.Reconnects++
+	}
+	s.Watches++
+}
+
+func (w *WatchMonitor) failed(gvr string, err error) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	s := w.state(gvr)
+	s.failures++
+	s.Failures++
+	s.LastError, s.LastErrorAt = err.Error(), time.Now()
+}
+
+func (w *WatchMonitor) healthy(gvr string) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	s := w.state(gvr)
+	s.failures, s.Backoff = 0, 0
+}
+
+func (w *WatchMonitor) state(gvr string) *watchState {
+	s, ok := w.stats[gvr]
+	if !ok {
+		s = &watchState{WatchStat: WatchStat{GVR: gvr}}
+		w.stats[gvr] = s
+	}
+
+	return s
+}
+
+// watchTransport tracks informers watches and backs off failing ones.
+type watchTransport struct {
+	rt      http.RoundTripper
+	monitor *WatchMonitor
+}
+
+// RoundTrip delays failing resources informer requests and tracks watches.
+func (t *watchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	watch, ok := informerRequest(req)
+	if !ok {
+		return t.rt.RoundTrip(req)
+	}
+	gvr := watchGVR(req.URL.Path)
+	if d := t.monitor.backoff(gvr); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			t.monitor.failed(gvr, err)
+		}
+		return resp, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		t.monitor.failed(gvr, fmt.Errorf("%s %s", strings.ToLower(req.Method), resp.Status))
+		return resp, nil
+	}
+	if !watch {
+		return resp, nil
+	}
+	t.monitor.started(gvr)
+	resp.Body = &watchBody{ReadCloser: resp.Body, gvr: gvr, monitor: t.monitor, start: time.Now()}
+
+	return resp, nil
+}
+
+// watchBody tracks a watch stream lifetime and failure.
+type watchBody struct {
+	io.ReadCloser
+
+	gvr     string
+	monitor *WatchMonitor
+	start   time.Time
+	err     error
+	once    sync.Once
+}
+
+// Read reads the watch stream, tracking how the stream ended.
+func (b *watchBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && b.err == nil {
+		b.err = err
+	}
+
+	return n, err
+}
+
+// Close closes the stream and accounts for its health. Streams closed by
+// the informer itself are not accounted for.
+func (b *watchBody) Close() error {
+	b.once.Do(func() {
+		switch {
+		case b.err == nil || errors.Is(b.err, context.Canceled):
+		case b.err != io.EOF:
+			b.monitor.failed(b.gvr, b.err)
+		case time.Since(b.start) < watchMinLife:
+			b.monitor.failed(b.gvr, errors.New("watch closed early, resource version likely expired"))
+		default:
+			b.monitor.healthy(b.gvr)
+		}
+	})
+
+	return b.ReadCloser.Close()
+}
+
+// informerRequest checks for informers watches or lists. Informers list from
+// the watch cache, ie resourceVersion=0.
+func informerRequest(req *http.Request) (watch bool, ok bool) {
+	if req.Method != http.MethodGet {
+		return false, false
+	}
+	q := req.URL.Query()
+	if q.Get("watch") == "true" || q.Get("watch") == "1" {
+		return true, true
+	}
+
+	return false, q.Get("resourceVersion") == "0"
+}
+
+// watchGVR returns a watch request resource, ie apps/v1/deployments.
+func watchGVR(path string) string {
+	tokens := strings.Split(strings.Trim(path, "/"), "/")
+	var n int
+	switch tokens[0] {
+	case "api":
+		n = 1
+	case "apis":
+		n = 2
+	default:
+		return path
+	}
+	tokens = tokens[1:]
+	if len(tokens) <= n {
+		return path
+	}
+	gv, tokens := strings.Join(tokens[:n], "/"), tokens[n:]
+	if tokens[0] == "watch" {
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 2 && tokens[0] == "namespaces" {
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 {
+		return path
+	}
+
+	return gv + "/" + tokens[0]
+}
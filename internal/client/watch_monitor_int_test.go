@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchGVR(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"core":       {path: "/api/v1/pods", e: "v1/pods"},
+		"namespaced": {path: "/api/v1/namespaces/fred/pods", e: "v1/pods"},
+		"namespaces": {path: "/api/v1/namespaces", e: "v1/namespaces"},
+		"group":      {path: "/apis/apps/v1/namespaces/fred/deployments", e: "apps/v1/deployments"},
+		"legacy":     {path: "/api/v1/watch/namespaces/fred/pods", e: "v1/pods"},
+		"discovery":  {path: "/apis/apps/v1", e: "/apis/apps/v1"},
+		"other":      {path: "/version", e: "/version"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, watchGVR(u.path))
+		})
+	}
+}
+
+func TestInformerRequest(t *testing.T) {
+	uu := map[string]struct {
+		method, url string
+		watch, ok   bool
+	}{
+		"watch":  {method: http.MethodGet, url: "https://fred/api/v1/pods?watch=true&resourceVersion=10", watch: true, ok: true},
+		"list":   {method: http.MethodGet, url: "https://fred/api/v1/pods?resourceVersion=0&limit=500", ok: true},
+		"get":    {method: http.MethodGet, url: "https://fred/api/v1/pods"},
+		"update": {method: http.MethodPut, url: "https://fred/api/v1/namespaces/blee/pods/p1?resourceVersion=0"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req, err := http.NewRequest(u.method, u.url, nil)
+			assert.Nil(t, err)
+			watch, ok := informerRequest(req)
+			assert.Equal(t, u.watch, watch)
+			assert.Equal(t, u.ok, ok)
+		})
+	}
+}
+
+func TestWatchMonitorBackoff(t *testing.T) {
+	m := NewWatchMonitor()
+	assert.Equal(t, time.Duration(0), m.backoff("v1/pods"))
+
+	for i := 1; i <= 10; i++ {
+		m.failed("v1/pods", errors.New("boom"))
+		d := watchBackoffBase << uint(i-1)
+		if d > watchBackoffCap {
+			d = watchBackoffCap
+		}
+		b := m.backoff("v1/pods")
+		assert.True(t, b >= d/2 && b <= d, "backoff %v out of %v range", b, d)
+	}
+	m.healthy("v1/pods")
+	assert.Equal(t, time.Duration(0), m.backoff("v1/pods"))
+
+	ss := m.Stats()
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, 10, ss[0].Failures)
+	assert.Equal(t, "boom", ss[0].LastError)
+}
+
+func TestWatchTransport(t *testing.T) {
+	m := NewWatchMonitor()
+	var status int
+	tr := m.Wrap(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if status == 0 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: ioutil.NopCloser(&bytes.Buffer{})}, nil
+	}))
+	watch := func() *http.Response {
+		req, err := http.NewRequest(http.MethodGet, "https://fred/apis/apps/v1/deployments?watch=true", nil)
+		assert.Nil(t, err)
+		resp, _ := tr.RoundTrip(req)
+		return resp
+	}
+
+	status = http.StatusOK
+	resp := watch()
+	// Informer stops the watch.
+	assert.Nil(t, resp.Body.Close())
+	resp = watch()
+	_, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Body.Close())
+
+	ss := m.Stats()
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, "apps/v1/deployments", ss[0].GVR)
+	assert.Equal(t, 2, ss[0].Watches)
+	assert.Equal(t, 1, ss[0].Reconnects)
+	assert.Equal(t, 1, ss[0].Failures)
+	assert.Equal(t, "watch closed early, resource version likely expired", ss[0].LastError)
+
+	m.Reset()
+	status = 0
+	assert.Nil(t, watch())
+	ss = m.Stats()
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, 0, ss[0].Watches)
+	assert.Equal(t, 1, ss[0].Failures)
+	assert.Equal(t, "connection reset by peer", ss[0].LastError)
+}
+
+func TestWatchBodyFailure(t *testing.T) {
+	m := NewWatchMonitor()
+	b := watchBody{
+		ReadCloser: ioutil.NopCloser(io.MultiReader(bytes.NewReader([]byte("{}")), errReader{})),
+		gvr:        "v1/pods",
+		monitor:    m,
+		start:      time.Now(),
+	}
+	_, err := ioutil.ReadAll(&b)
+	assert.NotNil(t, err)
+	assert.Nil(t, b.Close())
+	assert.Nil(t, b.Close())
+
+	ss := m.Stats()
+	assert.Equal(t, 1, ss[0].Failures)
+	assert.Equal(t, "unexpected EOF", ss[0].LastError)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
@@ -70,6 +70,18 @@ func (a *Aliases) Clear() {
 	}
 }
 
+// Prune removes aliases to resources matching the given predicate.
+func (a *Aliases) Prune(skip func(gvr string) bool) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for k, gvr := range a.Alias {
+		if skip(gvr) {
+			delete(a.Alias, k)
+		}
+	}
+}
+
 // Get retrieves an alias.
 func (a *Aliases) Get(k string) (string, bool) {
 	a.mx.RLock()
@@ -150,6 +162,7 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("portforwards", "portforward", "pf")
 	a.declare("benchmarks", "benchmark", "be")
 	a.declare("screendumps", "screendump", "sd")
+	a.declare("trash", "tr")
 	a.declare("pulses", "pulse", "pu", "hz")
 	a.declare("xrays", "xray", "x")
 }
@@ -85,3 +85,18 @@ func TestAliasesSave(t *testing.T) {
 	assert.Nil(t, a.LoadFileAliases("/tmp/a.yml"))
 	assert.Equal(t, 2, len(a.Alias))
 }
+
+func TestAliasesPrune(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("v1/events", "ev", "events")
+	a.Define("v1/pods", "po")
+	a.Prune(func(gvr string) bool { return gvr == "v1/events" })
+
+	_, ok := a.Get("ev")
+	assert.False(t, ok)
+	_, ok = a.Get("events")
+	assert.False(t, ok)
+	gvr, ok := a.Get("po")
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", gvr)
+}
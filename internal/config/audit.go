@@ -0,0 +1,68 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// AuditSourcePod tails the audit log file from a pod.
+	AuditSourcePod = "pod"
+	// AuditSourceLoki polls the audit events from a Loki endpoint.
+	AuditSourceLoki = "loki"
+
+	defaultAuditFile       = "/var/log/kubernetes/audit/audit.log"
+	defaultAuditLokiQuery  = `{job="kubernetes-audit"}`
+	defaultAuditBufferSize = 500
+)
+
+// Audit tracks where to tail the api server audit events from.
+type Audit struct {
+	// Source tracks the audit events source, either pod or loki.
+	Source string `yaml:"source"`
+	// Pod tracks the namespace/name of a pod with access to the audit log.
+	Pod string `yaml:"pod,omitempty"`
+	// Container tracks the pod container to tail the log from.
+	Container string `yaml:"container,omitempty"`
+	// File tracks the audit log file location in the pod.
+	File string `yaml:"file,omitempty"`
+	// LokiURL tracks the Loki endpoint the audit events are shipped to.
+	LokiURL string `yaml:"lokiURL,omitempty"`
+	// Query tracks the Loki log query selecting the audit events.
+	Query string `yaml:"query,omitempty"`
+	// BufferSize tracks the max number of events kept in the view.
+	BufferSize int `yaml:"bufferSize,omitempty"`
+}
+
+// NewAudit returns a new audit configuration.
+func NewAudit() *Audit {
+	return &Audit{
+		Source:     AuditSourcePod,
+		File:       defaultAuditFile,
+		Query:      defaultAuditLokiQuery,
+		BufferSize: defaultAuditBufferSize,
+	}
+}
+
+// Validate checks the audit configuration and make sure we're cool. If not use defaults.
+func (a *Audit) Validate() {
+	switch a.Source {
+	case AuditSourcePod, AuditSourceLoki:
+	case "":
+		a.Source = AuditSourcePod
+		if a.LokiURL != "" {
+			a.Source = AuditSourceLoki
+		}
+	default:
+		log.Warn().Msgf("[Config] Invalid audit source %q. Using %s", a.Source, AuditSourcePod)
+		a.Source = AuditSourcePod
+	}
+	if a.File == "" {
+		a.File = defaultAuditFile
+	}
+	if a.Query == "" {
+		a.Query = defaultAuditLokiQuery
+	}
+	if a.BufferSize <= 0 {
+		a.BufferSize = defaultAuditBufferSize
+	}
+}
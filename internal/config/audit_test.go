@@ -0,0 +1,43 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditValidate(t *testing.T) {
+	const (
+		file  = "/var/log/kubernetes/audit/audit.log"
+		query = `{job="kubernetes-audit"}`
+	)
+
+	uu := map[string]struct {
+		a, e config.Audit
+	}{
+		"defaults": {
+			e: config.Audit{Source: config.AuditSourcePod, File: file, Query: query, BufferSize: 500},
+		},
+		"loki": {
+			a: config.Audit{LokiURL: "http://loki:3100", Query: `{app="audit"}`, BufferSize: 100},
+			e: config.Audit{Source: config.AuditSourceLoki, LokiURL: "http://loki:3100", File: file, Query: `{app="audit"}`, BufferSize: 100},
+		},
+		"pod": {
+			a: config.Audit{Source: config.AuditSourcePod, Pod: "kube-system/jump", File: "/audit.log"},
+			e: config.Audit{Source: config.AuditSourcePod, Pod: "kube-system/jump", File: "/audit.log", Query: query, BufferSize: 500},
+		},
+		"toast": {
+			a: config.Audit{Source: "blee", BufferSize: -1},
+			e: config.Audit{Source: config.AuditSourcePod, File: file, Query: query, BufferSize: 500},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.a.Validate()
+			assert.Equal(t, u.e, u.a)
+		})
+	}
+}
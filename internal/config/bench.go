@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
 
@@ -103,7 +104,51 @@ func (s *Bench) load(path string) error {
 		return err
 	}
 
-	return yaml.Unmarshal(f, &s)
+	if err := yaml.Unmarshal(f, &s); err != nil {
+		return err
+	}
+
+	s.resolveSecrets()
+
+	return nil
+}
+
+// resolveSecrets resolves the benchmarks secrets, skipping the benchmarks
+// whose secrets can't be resolved.
+func (s *Bench) resolveSecrets() {
+	if s.Benchmarks == nil {
+		return
+	}
+	for _, cc := range []map[string]BenchConfig{s.Benchmarks.Services, s.Benchmarks.Containers} {
+		for k, c := range cc {
+			if err := c.resolveSecrets(); err != nil {
+				log.Warn().Err(err).Msgf("Skipping benchmark %s", k)
+				delete(cc, k)
+				continue
+			}
+			cc[k] = c
+		}
+	}
+}
+
+func (b *BenchConfig) resolveSecrets() error {
+	var err error
+	if b.Auth.User, err = ResolveSecret(b.Auth.User); err != nil {
+		return err
+	}
+	if b.Auth.Password, err = ResolveSecret(b.Auth.Password); err != nil {
+		return err
+	}
+	for k, vv := range b.HTTP.Headers {
+		for i, v := range vv {
+			if vv[i], err = ResolveSecret(v); err != nil {
+				return err
+			}
+		}
+		b.HTTP.Headers[k] = vv
+	}
+
+	return nil
 }
 
 // DefaultBenchSpec returns a default bench spec.
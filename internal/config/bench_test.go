@@ -2,6 +2,7 @@ package config
 
 import (
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -188,3 +189,31 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchSecrets(t *testing.T) {
+	os.Setenv("K9S_TEST_BENCH_TOKEN", "Bearer duh")
+	os.Setenv("K9S_TEST_BENCH_USER", "fred")
+	defer func() {
+		os.Unsetenv("K9S_TEST_BENCH_TOKEN")
+		os.Unsetenv("K9S_TEST_BENCH_USER")
+	}()
+
+	b, err := NewBench("testdata/b_secrets.yml")
+	assert.Nil(t, err)
+
+	svc := b.Benchmarks.Services["default/nginx"]
+	assert.Equal(t, "fred", svc.Auth.User)
+	assert.Equal(t, "blee", svc.Auth.Password)
+	assert.Equal(t, "Bearer duh", svc.HTTP.Headers.Get("Authorization"))
+}
+
+func TestBenchSecretsMissing(t *testing.T) {
+	b, err := NewBench("testdata/b_secrets.yml")
+	assert.Nil(t, err)
+
+	_, ok := b.Benchmarks.Services["default/nginx"]
+	assert.False(t, ok)
+	svc, ok := b.Benchmarks.Services["default/fred"]
+	assert.True(t, ok)
+	assert.Equal(t, "blee", svc.Auth.Password)
+}
@@ -0,0 +1,45 @@
+package config
+
+const defaultBundleLogLines = 1000
+
+// DefaultBundleResources lists the resources collected in a support bundle by default.
+var DefaultBundleResources = []string{
+	"v1/pods",
+	"v1/services",
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/persistentvolumeclaims",
+	"v1/events",
+	"v1/nodes",
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"apps/v1/replicasets",
+	"batch/v1/jobs",
+}
+
+// Bundle tracks support bundle options.
+type Bundle struct {
+	Resources []string `yaml:"resources"`
+	Redact    bool     `yaml:"redact"`
+	LogLines  int      `yaml:"logLines"`
+}
+
+// NewBundle returns a new support bundle configuration.
+func NewBundle() *Bundle {
+	return &Bundle{
+		Resources: DefaultBundleResources,
+		Redact:    true,
+		LogLines:  defaultBundleLogLines,
+	}
+}
+
+// Validate checks the bundle configuration and make sure we're cool. If not use defaults.
+func (b *Bundle) Validate() {
+	if len(b.Resources) == 0 {
+		b.Resources = DefaultBundleResources
+	}
+	if b.LogLines < 0 {
+		b.LogLines = defaultBundleLogLines
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+const defaultBlastRadius = 1
+
+// Chaos tracks chaos actions options.
+type Chaos struct {
+	// BlastRadius caps the number of pods killed per action.
+	BlastRadius int `yaml:"blastRadius"`
+	// Contexts lists the contexts chaos actions are allowed on. Blank denies all.
+	Contexts []string `yaml:"contexts,omitempty"`
+}
+
+// NewChaos returns a new chaos configuration.
+func NewChaos() *Chaos {
+	return &Chaos{BlastRadius: defaultBlastRadius}
+}
+
+// Validate checks the chaos configuration and make sure we're cool. If not use defaults.
+func (c *Chaos) Validate() {
+	if c.BlastRadius <= 0 {
+		c.BlastRadius = defaultBlastRadius
+	}
+}
+
+// IsAllowed returns true if chaos actions are explicitly permitted on the
+// given context.
+func (c *Chaos) IsAllowed(ctx string) bool {
+	for _, n := range c.Contexts {
+		if n == ctx {
+			return true
+		}
+	}
+
+	return false
+}
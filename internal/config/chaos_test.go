@@ -0,0 +1,37 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChaosValidate(t *testing.T) {
+	c := config.Chaos{BlastRadius: -1}
+	c.Validate()
+
+	assert.Equal(t, 1, c.BlastRadius)
+}
+
+func TestChaosIsAllowed(t *testing.T) {
+	uu := map[string]struct {
+		contexts []string
+		ctx      string
+		e        bool
+	}{
+		"none":    {ctx: "prod"},
+		"allowed": {contexts: []string{"staging", "dev"}, ctx: "dev", e: true},
+		"denied":  {contexts: []string{"staging", "dev"}, ctx: "prod"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewChaos()
+			c.Contexts = u.contexts
+
+			assert.Equal(t, u.e, c.IsAllowed(u.ctx))
+		})
+	}
+}
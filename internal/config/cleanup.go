@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+const defaultCleanupMaxAge = time.Hour
+
+// PodCleanup tracks completed and evicted pods cleanup options.
+type PodCleanup struct {
+	Enabled    bool   `yaml:"enabled"`
+	AutoDelete bool   `yaml:"autoDelete"`
+	MaxAge     string `yaml:"maxAge"`
+}
+
+// NewPodCleanup returns a new cleanup configuration.
+func NewPodCleanup() *PodCleanup {
+	return &PodCleanup{
+		MaxAge: defaultCleanupMaxAge.String(),
+	}
+}
+
+// Validate checks the cleanup configuration and make sure we're cool. If not use defaults.
+func (p *PodCleanup) Validate() {
+	if d, err := time.ParseDuration(p.MaxAge); err != nil || d <= 0 {
+		p.MaxAge = defaultCleanupMaxAge.String()
+	}
+}
+
+// Age returns the minimum age of a pod eligible for cleanup.
+func (p *PodCleanup) Age() time.Duration {
+	d, err := time.ParseDuration(p.MaxAge)
+	if err != nil || d <= 0 {
+		return defaultCleanupMaxAge
+	}
+
+	return d
+}
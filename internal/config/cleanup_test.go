@@ -0,0 +1,51 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCleanupValidate(t *testing.T) {
+	uu := map[string]struct {
+		age string
+		e   time.Duration
+	}{
+		"default": {
+			age: config.NewPodCleanup().MaxAge,
+			e:   time.Hour,
+		},
+		"custom": {
+			age: "30m",
+			e:   30 * time.Minute,
+		},
+		"bad": {
+			age: "blee",
+			e:   time.Hour,
+		},
+		"negative": {
+			age: "-5m",
+			e:   time.Hour,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.PodCleanup{MaxAge: u.age}
+			c.Validate()
+			assert.Equal(t, u.e, c.Age())
+		})
+	}
+}
+
+func TestGetPodCleanup(t *testing.T) {
+	k := config.NewK9s()
+	assert.False(t, k.GetPodCleanup().Enabled)
+
+	k.PodCleanup = &config.PodCleanup{Enabled: true, MaxAge: "2h"}
+	assert.True(t, k.GetPodCleanup().Enabled)
+	assert.Equal(t, 2*time.Hour, k.GetPodCleanup().Age())
+}
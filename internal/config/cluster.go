@@ -6,10 +6,15 @@ import (
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace    *Namespace    `yaml:"namespace"`
-	View         *View         `yaml:"view"`
-	FeatureGates *FeatureGates `yaml:"featureGates"`
-	ShellPod     *ShellPod     `yaml:"shellPod"`
+	Namespace     *Namespace      `yaml:"namespace"`
+	View          *View           `yaml:"view"`
+	FeatureGates  *FeatureGates   `yaml:"featureGates"`
+	ShellPod      *ShellPod       `yaml:"shellPod"`
+	Confirmations Confirmations   `yaml:"confirmations,omitempty"`
+	Forwards      ForwardProfiles `yaml:"portForwards,omitempty"`
+	LogBackend    *LogBackend     `yaml:"logBackend,omitempty"`
+	Prometheus    *Prometheus     `yaml:"prometheus,omitempty"`
+	Compression   *Compression    `yaml:"compression,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -22,6 +27,22 @@ func NewCluster() *Cluster {
 	}
 }
 
+// keepSession carries over the active namespace and view.
+func (c *Cluster) keepSession(cur *Cluster) {
+	if cur.Namespace != nil {
+		if c.Namespace == nil {
+			c.Namespace = NewNamespace()
+		}
+		c.Namespace.Active = cur.Namespace.Active
+	}
+	if cur.View != nil {
+		if c.View == nil {
+			c.View = NewView()
+		}
+		c.View.Active = cur.View.Active
+	}
+}
+
 // Validate a cluster config.
 func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 	if c.Namespace == nil {
@@ -41,4 +62,22 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 		c.ShellPod = NewShellPod()
 	}
 	c.ShellPod.Validate(conn, ks)
+	c.Confirmations.Validate()
+	c.Forwards = c.Forwards.Validate()
+	if c.LogBackend != nil {
+		c.LogBackend.Validate()
+	}
+	if c.Prometheus != nil {
+		c.Prometheus.Validate()
+	}
+}
+
+// GetPrometheus returns the Prometheus configuration, discovering the
+// datasource if not configured.
+func (c *Cluster) GetPrometheus() *Prometheus {
+	if c.Prometheus == nil {
+		return NewPrometheus()
+	}
+
+	return c.Prometheus
 }
@@ -0,0 +1,22 @@
+package config
+
+import "github.com/derailed/k9s/internal/client"
+
+// Compression tracks a cluster api responses compression settings. Compression
+// is negotiated by default, which cuts bandwidth on slow or remote links at
+// the cost of some api server cpu.
+type Compression struct {
+	// Disable turns off compressed api responses, ie large resource lists.
+	Disable bool `yaml:"disable"`
+	// DisableLogs turns off compressed logs downloads.
+	DisableLogs bool `yaml:"disableLogs"`
+}
+
+// Settings returns the api client compression settings.
+func (c *Compression) Settings() client.Compression {
+	if c == nil {
+		return client.Compression{Lists: true, Logs: true}
+	}
+
+	return client.Compression{Lists: !c.Disable, Logs: !c.DisableLogs}
+}
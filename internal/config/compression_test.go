@@ -0,0 +1,39 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionSettings(t *testing.T) {
+	uu := map[string]struct {
+		c *config.Compression
+		e client.Compression
+	}{
+		"none": {
+			e: client.Compression{Lists: true, Logs: true},
+		},
+		"empty": {
+			c: &config.Compression{},
+			e: client.Compression{Lists: true, Logs: true},
+		},
+		"noLists": {
+			c: &config.Compression{Disable: true},
+			e: client.Compression{Logs: true},
+		},
+		"noLogs": {
+			c: &config.Compression{DisableLogs: true},
+			e: client.Compression{Lists: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.c.Settings())
+		})
+	}
+}
@@ -1,28 +1,43 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 var (
 	// K9sHome represent K9s home directory.
-	K9sHome = filepath.Join(mustK9sHome(), ".k9s")
+	K9sHome = k9sHome()
 	// K9sConfigFile represents K9s config file location.
 	K9sConfigFile = filepath.Join(K9sHome, "config.yml")
+	// K9sContextsDir represents the location of per context configurations.
+	K9sContextsDir = contextsDir(K9sConfigFile)
 	// K9sLogs represents K9s log.
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
 	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sTrashDir represents a directory where manifests of deleted resources are persisted.
+	K9sTrashDir = filepath.Join(K9sHome, "trash")
+	// K9sRecordingsDir represents a directory where shell sessions recordings are persisted.
+	K9sRecordingsDir = filepath.Join(K9sHome, "recordings")
+	// K9sUsageFile represents the local usage insights file location.
+	K9sUsageFile = filepath.Join(K9sHome, "usage.yml")
+	// K9sHistoryFile represents the command prompt history file location.
+	K9sHistoryFile = filepath.Join(K9sHome, "history.yml")
+	// K9sPaletteFile represents the command palette ranks file location.
+	K9sPaletteFile = filepath.Join(K9sHome, "palette.yml")
 )
 
 type (
@@ -46,10 +61,21 @@ type (
 
 	// Config tracks K9s configuration options.
 	Config struct {
-		K9s      *K9s `yaml:"k9s"`
-		client   client.Connection
-		settings KubeSettings
-		demoMode bool
+		K9s       *K9s `yaml:"k9s"`
+		client    client.Connection
+		settings  KubeSettings
+		demoMode  bool
+		firstRun  bool
+		offline   bool
+		contexts  map[string]string
+		writes    map[string][]byte
+		writesMx  sync.Mutex
+		podNS     string
+		podNSOnce sync.Once
+	}
+
+	contextGetter interface {
+		GetContext(n string) (*clientcmdapi.Context, error)
 	}
 )
 
@@ -68,11 +94,32 @@ func (c *Config) SetDemoMode(b bool) {
 	c.demoMode = b
 }
 
+// FirstRun returns true if no K9s configuration existed on startup.
+func (c *Config) FirstRun() bool {
+	return c.firstRun
+}
+
+// SetFirstRun sets the first run state.
+func (c *Config) SetFirstRun(b bool) {
+	c.firstRun = b
+}
+
+// SetOffline sets the offline mode.
+func (c *Config) SetOffline(b bool) {
+	c.offline = b
+}
+
 // Refine the configuration based on cli args.
 func (c *Config) Refine(flags *genericclioptions.ConfigFlags) error {
-	cfg, err := flags.ToRawKubeConfigLoader().RawConfig()
-	if err != nil {
-		return err
+	cfg := client.OfflineRawConfig()
+	if !c.offline {
+		var err error
+		if cfg, err = flags.ToRawKubeConfigLoader().RawConfig(); err != nil {
+			return err
+		}
+	}
+	if len(cfg.Contexts) == 0 && client.IsInCluster() {
+		cfg = client.InClusterRawConfig()
 	}
 
 	if isSet(flags.Context) {
@@ -105,6 +152,66 @@ func (c *Config) Refine(flags *genericclioptions.ConfigFlags) error {
 		}
 	}
 
+	if ns := c.LockedNamespace(); ns != "" {
+		return c.SetActiveNamespace(ns)
+	}
+
+	return nil
+}
+
+// ConfirmLevel returns the confirmation level for a given action.
+// Cluster specific levels take precedence over the global ones.
+func (c *Config) ConfirmLevel(action string) ConfirmLevel {
+	if cl, ok := c.K9s.Clusters[c.K9s.CurrentCluster]; ok && cl != nil {
+		if l, ok := cl.Confirmations.Level(action); ok {
+			return l
+		}
+	}
+	if l, ok := c.K9s.Confirmations.Level(action); ok {
+		return l
+	}
+
+	if l, ok := defaultConfirmLevels[action]; ok {
+		return l
+	}
+
+	return DefaultConfirmLevel
+}
+
+// LockedNamespace returns the namespace K9s is locked to or blank if none.
+func (c *Config) LockedNamespace() string {
+	if c.K9s.InCluster == nil || !c.K9s.InCluster.LockNamespace {
+		return ""
+	}
+
+	return c.podNamespace()
+}
+
+// podNamespace returns the namespace of the pod K9s runs in if any. The
+// service account is only read once as it can't change.
+func (c *Config) podNamespace() string {
+	c.podNSOnce.Do(func() {
+		if !client.IsInCluster() {
+			return
+		}
+		ns, err := client.InClusterNamespace()
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to lock namespace")
+			return
+		}
+		c.podNS = ns
+	})
+
+	return c.podNS
+}
+
+// NamespaceGuard returns the current cluster namespace guard or nil if all
+// namespaces are visible.
+func (c *Config) NamespaceGuard() *client.NamespaceGuard {
+	if cl := c.CurrentCluster(); cl != nil && cl.Namespace != nil {
+		return cl.Namespace.Guard()
+	}
+
 	return nil
 }
 
@@ -152,6 +259,12 @@ func (c *Config) FavNamespaces() []string {
 
 // SetActiveNamespace set the active namespace in the current cluster.
 func (c *Config) SetActiveNamespace(ns string) error {
+	if locked := c.LockedNamespace(); locked != "" && ns != locked {
+		return fmt.Errorf("namespace is locked to %q", locked)
+	}
+	if err := c.NamespaceGuard().Check(ns); err != nil {
+		return err
+	}
 	if c.K9s.ActiveCluster() != nil {
 		return c.K9s.ActiveCluster().Namespace.SetActive(ns, c.settings)
 	}
@@ -183,6 +296,15 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// Compression returns a cluster api responses compression settings.
+func (c *Config) Compression(cluster string) client.Compression {
+	if cl, ok := c.K9s.Clusters[cluster]; ok {
+		return cl.Compression.Settings()
+	}
+
+	return (*Compression)(nil).Settings()
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.client
@@ -211,6 +333,134 @@ func (c *Config) Load(path string) error {
 	if c.K9s.Logger == nil {
 		c.K9s.Logger = NewLogger()
 	}
+
+	return c.loadContexts(contextsDir(path))
+}
+
+// Reload refreshes user settings from disk while preserving the session state.
+func (c *Config) Reload(path string) error {
+	fresh := NewConfig(c.settings)
+	if err := fresh.Load(path); err != nil {
+		return err
+	}
+
+	k := fresh.K9s
+	k.validateDefaults()
+	if k.Thresholds == nil {
+		k.Thresholds = NewThreshold()
+	}
+	if c.K9s.Locale != k.Locale {
+		if err := LoadLocale(k.Locale); err != nil {
+			log.Warn().Err(err).Msgf("Unable to load locale %q", k.Locale)
+		}
+	}
+	if k.Clusters == nil {
+		k.Clusters = make(map[string]*Cluster)
+	}
+	for name, cl := range c.K9s.Clusters {
+		if fcl, ok := k.Clusters[name]; ok {
+			fcl.keepSession(cl)
+			continue
+		}
+		k.Clusters[name] = cl
+	}
+	k.keepSession(c.K9s)
+	*c.K9s = *k
+	for cl, ctx := range fresh.contexts {
+		if _, ok := c.contexts[cl]; !ok {
+			c.trackContext(cl, ctx)
+		}
+	}
+
+	return nil
+}
+
+// loadContexts loads the per context configurations into the clusters the
+// contexts point to.
+func (c *Config) loadContexts(dir string) error {
+	if !IsSplitConfig(dir) {
+		return nil
+	}
+	ee, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if c.K9s.Clusters == nil {
+		c.K9s.Clusters = make(map[string]*Cluster, len(ee))
+	}
+	for _, e := range ee {
+		if !e.IsDir() {
+			continue
+		}
+		raw, err := ioutil.ReadFile(filepath.Join(dir, e.Name(), clusterConfigFile))
+		if err != nil {
+			continue
+		}
+		var cl Cluster
+		if err := yaml.Unmarshal(raw, &cl); err != nil {
+			log.Error().Err(err).Msgf("[Config] Unable to load context config %q", e.Name())
+			continue
+		}
+		name := c.contextCluster(e.Name())
+		c.K9s.Clusters[name] = &cl
+		c.trackContext(name, e.Name())
+	}
+
+	return nil
+}
+
+// contextCluster returns the cluster a context points to or the context
+// name if it can't be resolved.
+func (c *Config) contextCluster(ctx string) string {
+	if g, ok := c.settings.(contextGetter); ok {
+		if kc, err := g.GetContext(ctx); err == nil && kc.Cluster != "" {
+			return kc.Cluster
+		}
+	}
+
+	return ctx
+}
+
+// clusterContext returns the context a cluster configuration is saved under.
+func (c *Config) clusterContext(cluster string) string {
+	if ctx, ok := c.contexts[cluster]; ok {
+		return ctx
+	}
+
+	return cluster
+}
+
+func (c *Config) trackContext(cluster, ctx string) {
+	if c.contexts == nil {
+		c.contexts = make(map[string]string)
+	}
+	c.contexts[cluster] = ctx
+}
+
+// OwnWrite returns true if the file on disk was last written by this config.
+func (c *Config) OwnWrite(path string) bool {
+	c.writesMx.Lock()
+	raw, ok := c.writes[path]
+	c.writesMx.Unlock()
+	if !ok {
+		return false
+	}
+	disk, err := ioutil.ReadFile(path)
+
+	return err == nil && bytes.Equal(raw, disk)
+}
+
+func (c *Config) writeFile(path string, raw []byte) error {
+	if err := ioutil.WriteFile(path, raw, 0644); err != nil {
+		return err
+	}
+	c.writesMx.Lock()
+	defer c.writesMx.Unlock()
+	if c.writes == nil {
+		c.writes = make(map[string][]byte)
+	}
+	c.writes[path] = raw
+
 	return nil
 }
 
@@ -225,12 +475,41 @@ func (c *Config) Save() error {
 // SaveFile K9s configuration to disk.
 func (c *Config) SaveFile(path string) error {
 	EnsurePath(path, DefaultDirMod)
+	if dir := contextsDir(path); IsSplitConfig(dir) {
+		return c.saveSplit(path, dir)
+	}
 	cfg, err := yaml.Marshal(c)
 	if err != nil {
 		log.Error().Msgf("[Config] Unable to save K9s config file: %v", err)
 		return err
 	}
-	return ioutil.WriteFile(path, cfg, 0644)
+	return c.writeFile(path, cfg)
+}
+
+func (c *Config) saveSplit(path, dir string) error {
+	if c.K9s.CurrentCluster != "" && c.K9s.CurrentContext != "" {
+		c.trackContext(c.K9s.CurrentCluster, c.K9s.CurrentContext)
+	}
+	for name, cl := range c.K9s.Clusters {
+		raw, err := yaml.Marshal(cl)
+		if err != nil {
+			return err
+		}
+		f := filepath.Join(dir, c.clusterContext(name), clusterConfigFile)
+		EnsurePath(f, DefaultDirMod)
+		if err := c.writeFile(f, raw); err != nil {
+			return err
+		}
+	}
+
+	k := *c.K9s
+	k.Clusters = nil
+	cfg, err := yaml.Marshal(Config{K9s: &k})
+	if err != nil {
+		log.Error().Msgf("[Config] Unable to save K9s config file: %v", err)
+		return err
+	}
+	return c.writeFile(path, cfg)
 }
 
 // Validate the configuration.
@@ -3,9 +3,11 @@ package config_test
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	m "github.com/petergtz/pegomock"
 	"github.com/rs/zerolog"
@@ -247,6 +249,120 @@ func TestConfigReset(t *testing.T) {
 	assert.Equal(t, resetConfig, string(raw))
 }
 
+func TestConfigLoadSplit(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/split/config.yml"))
+
+	assert.Equal(t, 3, cfg.K9s.RefreshRate)
+	assert.Equal(t, 2, len(cfg.K9s.Clusters))
+	assert.Equal(t, "kube-system", cfg.K9s.Clusters["minikube"].Namespace.Active)
+	assert.Equal(t, "svc", cfg.K9s.Clusters["minikube"].View.Active)
+	assert.True(t, cfg.K9s.Clusters["minikube"].FeatureGates.NodeShell)
+	assert.Equal(t, "fred", cfg.K9s.Clusters["fred"].Namespace.Active)
+}
+
+func TestConfigSaveSplit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-split")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "contexts"), 0755))
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/split/config.yml"))
+	path := filepath.Join(dir, "config.yml")
+	assert.Nil(t, cfg.SaveFile(path))
+
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(raw), "clusters:")
+	_, err = os.Stat(filepath.Join(dir, "contexts", "fred", "config.yml"))
+	assert.Nil(t, err)
+	assert.True(t, cfg.OwnWrite(path))
+	assert.Nil(t, ioutil.WriteFile(path, append(raw, '\n'), 0644))
+	assert.False(t, cfg.OwnWrite(path))
+
+	reload := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, reload.Load(path))
+	assert.Equal(t, 2, len(reload.K9s.Clusters))
+	assert.Equal(t, "svc", reload.K9s.Clusters["minikube"].View.Active)
+}
+
+func TestConfigReload(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	cfg.K9s.CurrentContext = "fred"
+	cfg.K9s.OverrideReadOnly(true)
+
+	assert.Nil(t, cfg.Reload("testdata/split/config.yml"))
+	assert.Equal(t, 3, cfg.K9s.RefreshRate)
+	assert.Equal(t, "fred", cfg.K9s.CurrentContext)
+	assert.True(t, cfg.K9s.GetReadOnly())
+	assert.True(t, cfg.K9s.Clusters["minikube"].FeatureGates.NodeShell)
+	assert.Equal(t, "ctx", cfg.K9s.Clusters["minikube"].View.Active)
+}
+
+func TestConfigReloadSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-reload")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	cfg.K9s.Clusters["minikube"].Namespace.Active = "fred"
+
+	raw := `k9s:
+  screenReader: true
+  usageInsights: true
+  excludeResources:
+    - secrets
+  viewers:
+    application/json: jq
+  clusters:
+    minikube:
+      namespace:
+        active: default
+        deny:
+          - kube-*
+      view:
+        active: po
+`
+	path := filepath.Join(dir, "config.yml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(raw), 0644))
+	assert.Nil(t, cfg.Reload(path))
+
+	assert.True(t, cfg.K9s.ScreenReader)
+	assert.True(t, cfg.K9s.UsageInsights)
+	assert.Equal(t, []string{"secrets"}, cfg.K9s.ExcludeResources)
+	assert.Equal(t, "jq", cfg.K9s.Viewers["application/json"])
+	ns := cfg.K9s.Clusters["minikube"].Namespace
+	assert.Equal(t, []string{"kube-*"}, ns.Deny)
+	assert.Equal(t, "fred", ns.Active)
+	assert.Equal(t, "ctx", cfg.K9s.Clusters["minikube"].View.Active)
+	assert.Equal(t, "minikube", cfg.K9s.CurrentContext)
+}
+
+func TestConfigLockedNamespace(t *testing.T) {
+	d := client.ServiceAccountDir
+	client.ServiceAccountDir = "../client/testdata/serviceaccount"
+	os.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	os.Setenv("KUBERNETES_SERVICE_PORT", "443")
+	defer func() {
+		client.ServiceAccountDir = d
+		os.Unsetenv("KUBERNETES_SERVICE_HOST")
+		os.Unsetenv("KUBERNETES_SERVICE_PORT")
+	}()
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	assert.Equal(t, "", cfg.LockedNamespace())
+
+	cfg.K9s.InCluster = &config.InCluster{LockNamespace: true}
+	assert.Equal(t, "fred", cfg.LockedNamespace())
+	assert.NotNil(t, cfg.SetActiveNamespace("default"))
+	assert.Nil(t, cfg.SetActiveNamespace("fred"))
+	assert.Equal(t, "fred", cfg.ActiveNamespace())
+}
+
 // Helpers...
 
 func TestSetup(t *testing.T) {
@@ -0,0 +1,87 @@
+package config
+
+const (
+	// ConfirmNone performs actions without confirmation.
+	ConfirmNone ConfirmLevel = "none"
+
+	// ConfirmYesNo requires a yes/no confirmation.
+	ConfirmYesNo ConfirmLevel = "yesno"
+
+	// ConfirmTyped requires the resource name to be typed.
+	ConfirmTyped ConfirmLevel = "typed"
+
+	// DefaultConfirmLevel represents the stock confirmation level.
+	DefaultConfirmLevel = ConfirmYesNo
+)
+
+const (
+	// ActionDelete represents resource deletions.
+	ActionDelete = "delete"
+
+	// ActionRestart represents workload restarts.
+	ActionRestart = "restart"
+
+	// ActionCordon represents node cordon/uncordon.
+	ActionCordon = "cordon"
+
+	// ActionPlugin represents plugin invocations.
+	ActionPlugin = "plugin"
+
+	// ActionPause represents workload pause/resume.
+	ActionPause = "pause"
+
+	// ActionClone represents resource copies into another context.
+	ActionClone = "clone"
+
+	// ActionChaos represents random pod kills.
+	ActionChaos = "chaos"
+
+	// ActionScale represents scale ups exceeding the cluster capacity.
+	ActionScale = "scale"
+
+	// ActionPromote represents progressive rollouts promote, abort and retry.
+	ActionPromote = "promote"
+
+	// ActionRollback represents helm release rollbacks.
+	ActionRollback = "rollback"
+)
+
+// defaultConfirmLevels tracks actions requiring a stronger confirmation by default.
+var defaultConfirmLevels = map[string]ConfirmLevel{
+	ActionChaos: ConfirmTyped,
+}
+
+// ConfirmLevel represents an action confirmation level.
+type ConfirmLevel string
+
+// IsValid checks if the confirmation level is known.
+func (l ConfirmLevel) IsValid() bool {
+	switch l {
+	case ConfirmNone, ConfirmYesNo, ConfirmTyped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Confirmations maps actions to confirmation levels.
+type Confirmations map[string]ConfirmLevel
+
+// Level returns the confirmation level for the given action if set.
+func (c Confirmations) Level(action string) (ConfirmLevel, bool) {
+	l, ok := c[action]
+	if !ok || !l.IsValid() {
+		return "", false
+	}
+
+	return l, true
+}
+
+// Validate drops unknown confirmation levels.
+func (c Confirmations) Validate() {
+	for k, v := range c {
+		if !v.IsValid() {
+			delete(c, k)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmLevel(t *testing.T) {
+	uu := map[string]struct {
+		global, cluster config.Confirmations
+		action          string
+		e               config.ConfirmLevel
+	}{
+		"default": {
+			action: config.ActionDelete,
+			e:      config.DefaultConfirmLevel,
+		},
+		"global": {
+			global: config.Confirmations{config.ActionDelete: config.ConfirmNone},
+			action: config.ActionDelete,
+			e:      config.ConfirmNone,
+		},
+		"cluster": {
+			global:  config.Confirmations{config.ActionDelete: config.ConfirmNone},
+			cluster: config.Confirmations{config.ActionDelete: config.ConfirmTyped},
+			action:  config.ActionDelete,
+			e:       config.ConfirmTyped,
+		},
+		"otherAction": {
+			cluster: config.Confirmations{config.ActionDelete: config.ConfirmTyped},
+			action:  config.ActionRestart,
+			e:       config.DefaultConfirmLevel,
+		},
+		"invalid": {
+			global: config.Confirmations{config.ActionDelete: "blee"},
+			action: config.ActionDelete,
+			e:      config.DefaultConfirmLevel,
+		},
+		"chaosDefault": {
+			action: config.ActionChaos,
+			e:      config.ConfirmTyped,
+		},
+		"chaosOverride": {
+			global: config.Confirmations{config.ActionChaos: config.ConfirmYesNo},
+			action: config.ActionChaos,
+			e:      config.ConfirmYesNo,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewConfig(nil)
+			cfg.K9s.CurrentCluster = "fred"
+			cfg.K9s.Confirmations = u.global
+			cl := config.NewCluster()
+			cl.Confirmations = u.cluster
+			cfg.K9s.Clusters["fred"] = cl
+
+			assert.Equal(t, u.e, cfg.ConfirmLevel(u.action))
+		})
+	}
+}
+
+func TestConfirmationsValidate(t *testing.T) {
+	c := config.Confirmations{
+		config.ActionDelete:  config.ConfirmTyped,
+		config.ActionRestart: "blee",
+	}
+	c.Validate()
+
+	assert.Equal(t, config.Confirmations{config.ActionDelete: config.ConfirmTyped}, c)
+}
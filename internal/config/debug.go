@@ -0,0 +1,24 @@
+package config
+
+// DefaultDebugImage tracks the default ephemeral debug container image.
+const DefaultDebugImage = "busybox:1.32"
+
+// DebugContainer tracks pods ephemeral debug containers options.
+type DebugContainer struct {
+	// Image tracks the debug container image.
+	Image string `yaml:"image"`
+	// Command overrides the image entrypoint if set.
+	Command []string `yaml:"command,omitempty"`
+}
+
+// NewDebugContainer returns a new debug container configuration.
+func NewDebugContainer() *DebugContainer {
+	return &DebugContainer{Image: DefaultDebugImage}
+}
+
+// Validate checks the debug container configuration and make sure we're cool. If not use defaults.
+func (d *DebugContainer) Validate() {
+	if d.Image == "" {
+		d.Image = DefaultDebugImage
+	}
+}
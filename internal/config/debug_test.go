@@ -0,0 +1,30 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugContainerValidate(t *testing.T) {
+	uu := map[string]struct {
+		d, e config.DebugContainer
+	}{
+		"defaults": {
+			e: config.DebugContainer{Image: config.DefaultDebugImage},
+		},
+		"custom": {
+			d: config.DebugContainer{Image: "nicolaka/netshoot", Command: []string{"zsh"}},
+			e: config.DebugContainer{Image: "nicolaka/netshoot", Command: []string{"zsh"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.d.Validate()
+			assert.Equal(t, u.e, u.d)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+const defaultDemoFlashDelay = 6
+
+// Demo tracks presentation mode options.
+type Demo struct {
+	Enabled    bool `yaml:"enabled"`
+	ShowKeys   bool `yaml:"showKeys"`
+	Redact     bool `yaml:"redact"`
+	FlashDelay int  `yaml:"flashDelay"`
+}
+
+// NewDemo returns a new demo configuration.
+func NewDemo() *Demo {
+	return &Demo{
+		ShowKeys:   true,
+		Redact:     true,
+		FlashDelay: defaultDemoFlashDelay,
+	}
+}
+
+// Validate checks the demo configuration and make sure we're cool. If not use defaults.
+func (d *Demo) Validate() {
+	if d.FlashDelay <= 0 {
+		d.FlashDelay = defaultDemoFlashDelay
+	}
+}
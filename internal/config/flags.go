@@ -9,6 +9,9 @@ const (
 
 	// DefaultCommand represents the default command to run.
 	DefaultCommand = ""
+
+	// DefaultProfile represents the default configuration profile.
+	DefaultProfile = ""
 )
 
 // Flags represents K9s configuration flags.
@@ -19,6 +22,11 @@ type Flags struct {
 	Command       *string
 	AllNamespaces *bool
 	ReadOnly      *bool
+	Profile       *string
+	LowColor      *bool
+	ASCII         *bool
+	ScreenReader  *bool
+	Offline       *string
 }
 
 // NewFlags returns new configuration flags.
@@ -30,6 +38,11 @@ func NewFlags() *Flags {
 		Command:       strPtr(DefaultCommand),
 		AllNamespaces: boolPtr(false),
 		ReadOnly:      boolPtr(false),
+		Profile:       strPtr(DefaultProfile),
+		LowColor:      boolPtr(false),
+		ASCII:         boolPtr(false),
+		ScreenReader:  boolPtr(false),
+		Offline:       strPtr(""),
 	}
 }
 
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ForwardProfile tracks a port-forward to re-establish on startup.
+type ForwardProfile struct {
+	// Path tracks the last known target pod ie ns/pod.
+	Path string `yaml:"path"`
+	// Container tracks the target container.
+	Container string `yaml:"container"`
+	// LocalPort tracks the local port.
+	LocalPort string `yaml:"localPort"`
+	// ContainerPort tracks the container port.
+	ContainerPort string `yaml:"containerPort"`
+	// Address tracks the local addresses to listen on.
+	Address string `yaml:"address,omitempty"`
+	// Selector matches a replacement pod once the target pod is gone.
+	Selector map[string]string `yaml:"selector,omitempty"`
+	// MatchExpressions further narrow the replacement pod selector.
+	MatchExpressions []metav1.LabelSelectorRequirement `yaml:"matchExpressions,omitempty"`
+}
+
+// ID returns the profile identifier.
+func (f ForwardProfile) ID() string {
+	return f.Path + ":" + f.Container
+}
+
+// ForwardProfiles represents a collection of port-forward profiles.
+type ForwardProfiles []ForwardProfile
+
+// Validate drops incomplete profiles.
+func (ff ForwardProfiles) Validate() ForwardProfiles {
+	if len(ff) == 0 {
+		return ff
+	}
+	pp := make(ForwardProfiles, 0, len(ff))
+	for _, f := range ff {
+		if f.Path == "" || f.Container == "" || f.ContainerPort == "" {
+			log.Warn().Msgf("[Config] Invalid port-forward profile %q. Skipping", f.ID())
+			continue
+		}
+		pp = append(pp, f)
+	}
+
+	return pp
+}
+
+// Find returns a profile matching the given id.
+func (ff ForwardProfiles) Find(id string) (ForwardProfile, bool) {
+	for _, f := range ff {
+		if f.ID() == id {
+			return f, true
+		}
+	}
+
+	return ForwardProfile{}, false
+}
+
+// Upsert adds a profile or replaces the one with the same id. The previous id
+// allows to track a forward that moved to a replacement pod.
+func (ff ForwardProfiles) Upsert(prev string, p ForwardProfile) ForwardProfiles {
+	for i, f := range ff {
+		if id := f.ID(); id == prev || id == p.ID() {
+			ff[i] = p
+			return ff
+		}
+	}
+
+	return append(ff, p)
+}
+
+// Remove deletes a profile matching the given id.
+func (ff ForwardProfiles) Remove(id string) (ForwardProfiles, bool) {
+	for i, f := range ff {
+		if f.ID() == id {
+			return append(ff[:i], ff[i+1:]...), true
+		}
+	}
+
+	return ff, false
+}
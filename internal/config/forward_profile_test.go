@@ -0,0 +1,76 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardProfilesValidate(t *testing.T) {
+	ff := config.ForwardProfiles{
+		{Path: "default/p1", Container: "c1", LocalPort: "8080", ContainerPort: "80"},
+		{Path: "default/p2", LocalPort: "8081", ContainerPort: "80"},
+		{Path: "default/p3", Container: "c1", LocalPort: "8082"},
+	}
+
+	ff = ff.Validate()
+	assert.Equal(t, 1, len(ff))
+	assert.Equal(t, "default/p1:c1", ff[0].ID())
+}
+
+func TestForwardProfilesUpsert(t *testing.T) {
+	uu := map[string]struct {
+		prev string
+		p    config.ForwardProfile
+		e    []string
+	}{
+		"add": {
+			prev: "default/p3:c1",
+			p:    config.ForwardProfile{Path: "default/p3", Container: "c1"},
+			e:    []string{"default/p1:c1", "default/p2:c1", "default/p3:c1"},
+		},
+		"replace": {
+			prev: "default/p2:c1",
+			p:    config.ForwardProfile{Path: "default/p2", Container: "c1", LocalPort: "9000"},
+			e:    []string{"default/p1:c1", "default/p2:c1"},
+		},
+		"moved": {
+			prev: "default/p1:c1",
+			p:    config.ForwardProfile{Path: "default/p4", Container: "c1"},
+			e:    []string{"default/p4:c1", "default/p2:c1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff := config.ForwardProfiles{
+				{Path: "default/p1", Container: "c1"},
+				{Path: "default/p2", Container: "c1"},
+			}
+			ff = ff.Upsert(u.prev, u.p)
+			ids := make([]string, 0, len(ff))
+			for _, f := range ff {
+				ids = append(ids, f.ID())
+			}
+			assert.Equal(t, u.e, ids)
+			f, ok := ff.Find(u.p.ID())
+			assert.True(t, ok)
+			assert.Equal(t, u.p, f)
+		})
+	}
+}
+
+func TestForwardProfilesRemove(t *testing.T) {
+	ff := config.ForwardProfiles{
+		{Path: "default/p1", Container: "c1"},
+		{Path: "default/p2", Container: "c1"},
+	}
+
+	ff, ok := ff.Remove("default/p1:c1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(ff))
+	_, ok = ff.Remove("default/p1:c1")
+	assert.False(t, ok)
+}
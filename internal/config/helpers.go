@@ -10,6 +10,11 @@ import (
 )
 
 const (
+	// K9sConfigEnv names the env var to override the K9s configuration directory.
+	K9sConfigEnv = "K9SCONFIG"
+
+	clusterConfigFile = "config.yml"
+
 	// DefaultDirMod default unix perms for k9s directory.
 	DefaultDirMod os.FileMode = 0755
 	// DefaultFileMod default unix perms for k9s files.
@@ -37,6 +42,40 @@ func InNSList(nn []interface{}, ns string) bool {
 	return InList(ss, ns)
 }
 
+// IsSplitConfig checks if per cluster configurations are stored in the given directory.
+func IsSplitConfig(dir string) bool {
+	fi, err := os.Stat(dir)
+	return err == nil && fi.IsDir()
+}
+
+// IsContextConfigFile checks if the given path is a per context configuration file.
+func IsContextConfigFile(path string) bool {
+	return filepath.Base(path) == clusterConfigFile && filepath.Dir(filepath.Dir(path)) == K9sContextsDir
+}
+
+func contextsDir(path string) string {
+	return filepath.Join(filepath.Dir(path), "contexts")
+}
+
+// k9sHome honors K9SCONFIG first, then an existing legacy ~/.k9s directory
+// and finally the XDG config directory.
+func k9sHome() string {
+	if env := os.Getenv(K9sConfigEnv); env != "" {
+		return env
+	}
+	home := mustK9sHome()
+	legacy := filepath.Join(home, ".k9s")
+	if _, err := os.Stat(legacy); err == nil {
+		return legacy
+	}
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	if xdg == "" {
+		xdg = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(xdg, "k9s")
+}
+
 func mustK9sHome() string {
 	usr, err := user.Current()
 	if err != nil {
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultHistorySize tracks the number of commands remembered per context.
+	DefaultHistorySize = 50
+	// MaxHistorySize caps the number of commands remembered per context.
+	MaxHistorySize = 1000
+)
+
+// CommandHistory tracks the command prompt history options.
+type CommandHistory struct {
+	// Size tracks the number of commands remembered per context.
+	Size int `yaml:"size"`
+}
+
+// NewCommandHistory returns a new command history configuration.
+func NewCommandHistory() *CommandHistory {
+	return &CommandHistory{Size: DefaultHistorySize}
+}
+
+// Validate checks the history configuration and make sure we're cool. If not use defaults.
+func (h *CommandHistory) Validate() {
+	switch {
+	case h.Size <= 0:
+		h.Size = DefaultHistorySize
+	case h.Size > MaxHistorySize:
+		log.Warn().Msgf("[Config] Command history size %d is too large. Using %d", h.Size, MaxHistorySize)
+		h.Size = MaxHistorySize
+	}
+}
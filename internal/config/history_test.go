@@ -0,0 +1,28 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandHistoryValidate(t *testing.T) {
+	uu := map[string]struct {
+		size, e int
+	}{
+		"defaults": {e: config.DefaultHistorySize},
+		"custom":   {size: 200, e: 200},
+		"negative": {size: -1, e: config.DefaultHistorySize},
+		"toast":    {size: 5000, e: config.MaxHistorySize},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := config.CommandHistory{Size: u.size}
+			h.Validate()
+			assert.Equal(t, u.e, h.Size)
+		})
+	}
+}
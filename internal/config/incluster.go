@@ -0,0 +1,6 @@
+package config
+
+// InCluster tracks options used when K9s runs inside a cluster.
+type InCluster struct {
+	LockNamespace bool `yaml:"lockNamespace"`
+}
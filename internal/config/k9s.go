@@ -1,6 +1,11 @@
 package config
 
-import "github.com/derailed/k9s/internal/client"
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
 
 const defaultRefreshRate = 2
 
@@ -10,15 +15,44 @@ type K9s struct {
 	Headless          bool                `yaml:"headless"`
 	ReadOnly          bool                `yaml:"readOnly"`
 	NoIcons           bool                `yaml:"noIcons"`
+	LowColor          bool                `yaml:"lowColor,omitempty"`
+	ASCII             bool                `yaml:"ascii,omitempty"`
+	ScreenReader      bool                `yaml:"screenReader,omitempty"`
+	Locale            string              `yaml:"locale,omitempty"`
 	Logger            *Logger             `yaml:"logger"`
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
 	Thresholds        Threshold           `yaml:"thresholds"`
+	Profile           string              `yaml:"profile,omitempty"`
+	Profiles          Profiles            `yaml:"profiles,omitempty"`
+	InCluster         *InCluster          `yaml:"inCluster,omitempty"`
+	Demo              *Demo               `yaml:"demo,omitempty"`
+	Confirmations     Confirmations       `yaml:"confirmations,omitempty"`
+	PodCleanup        *PodCleanup         `yaml:"podCleanup,omitempty"`
+	Bundle            *Bundle             `yaml:"bundle,omitempty"`
+	Chaos             *Chaos              `yaml:"chaos,omitempty"`
+	PortForwards      *PortForwards       `yaml:"portForwards,omitempty"`
+	ShellRecording    *ShellRecording     `yaml:"shellRecording,omitempty"`
+	DebugContainer    *DebugContainer     `yaml:"debugContainer,omitempty"`
+	Privacy           *Privacy            `yaml:"privacy,omitempty"`
+	Audit             *Audit              `yaml:"audit,omitempty"`
+	UsageInsights     bool                `yaml:"usageInsights,omitempty"`
+	QuickActions      bool                `yaml:"quickActions,omitempty"`
+	EnableMouse       bool                `yaml:"enableMouse,omitempty"`
+	CommandHistory    *CommandHistory     `yaml:"commandHistory,omitempty"`
+	RefreshRates      map[string]int      `yaml:"refreshRates,omitempty"`
+	ExcludeResources  []string            `yaml:"excludeResources,omitempty"`
+	TrashSecrets      bool                `yaml:"trashSecrets,omitempty"`
+	Viewers           map[string]string   `yaml:"viewers,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualReadOnly    *bool
 	manualCommand     *string
+	manualProfile     *string
+	manualRenderMode  *RenderMode
+	manualReader      *bool
+	manualDemo        *bool
 }
 
 // NewK9s create a new K9s configuration.
@@ -51,9 +85,191 @@ func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
 }
 
+// OverrideProfile set the active profile manually.
+func (k *K9s) OverrideProfile(p string) {
+	k.manualProfile = &p
+}
+
+// ActiveProfileName returns the name of the active profile or blank if none.
+func (k *K9s) ActiveProfileName() string {
+	if k.manualProfile != nil && *k.manualProfile != "" {
+		return *k.manualProfile
+	}
+
+	return k.Profile
+}
+
+// ActiveProfile returns the active profile or nil if none is set.
+func (k *K9s) ActiveProfile() *Profile {
+	name := k.ActiveProfileName()
+	if name == "" {
+		return nil
+	}
+	p, ok := k.Profiles[name]
+	if !ok {
+		return nil
+	}
+
+	return p
+}
+
+// SetActiveProfile switches the active profile. A blank name resets to the base configuration.
+func (k *K9s) SetActiveProfile(name string) error {
+	if name != "" {
+		if _, ok := k.Profiles[name]; !ok {
+			return fmt.Errorf("no profile named %q found", name)
+		}
+	}
+	k.Profile, k.manualProfile = name, nil
+
+	return nil
+}
+
+// OverrideRenderMode set the rendering mode manually.
+func (k *K9s) OverrideRenderMode(m RenderMode) {
+	k.manualRenderMode = &m
+}
+
+// OverrideScreenReader set the screen reader mode manually.
+func (k *K9s) OverrideScreenReader(b bool) {
+	k.manualReader = &b
+}
+
+// GetScreenReader returns the screen reader setting.
+func (k *K9s) GetScreenReader() bool {
+	if k.manualReader != nil && *k.manualReader {
+		return true
+	}
+
+	return k.ScreenReader
+}
+
+// OverrideDemo set the demo mode manually.
+func (k *K9s) OverrideDemo(b bool) {
+	k.manualDemo = &b
+}
+
+// GetPodCleanup returns the pod cleanup settings.
+func (k *K9s) GetPodCleanup() *PodCleanup {
+	if k.PodCleanup == nil {
+		return NewPodCleanup()
+	}
+
+	return k.PodCleanup
+}
+
+// GetBundle returns the support bundle settings.
+func (k *K9s) GetBundle() *Bundle {
+	if k.Bundle == nil {
+		return NewBundle()
+	}
+
+	return k.Bundle
+}
+
+// GetChaos returns the chaos actions settings.
+func (k *K9s) GetChaos() *Chaos {
+	if k.Chaos == nil {
+		return NewChaos()
+	}
+
+	return k.Chaos
+}
+
+// GetPortForwards returns the port-forwards settings.
+func (k *K9s) GetPortForwards() *PortForwards {
+	if k.PortForwards == nil {
+		return NewPortForwards()
+	}
+
+	return k.PortForwards
+}
+
+// GetShellRecording returns the shell sessions recording settings.
+func (k *K9s) GetShellRecording() *ShellRecording {
+	if k.ShellRecording == nil {
+		return NewShellRecording()
+	}
+
+	return k.ShellRecording
+}
+
+// GetCommandHistory returns the command prompt history settings.
+func (k *K9s) GetCommandHistory() *CommandHistory {
+	if k.CommandHistory == nil {
+		return NewCommandHistory()
+	}
+
+	return k.CommandHistory
+}
+
+// GetDebugContainer returns the pods ephemeral debug containers settings.
+func (k *K9s) GetDebugContainer() *DebugContainer {
+	if k.DebugContainer == nil {
+		return NewDebugContainer()
+	}
+
+	return k.DebugContainer
+}
+
+// GetPrivacy returns the privacy mode settings.
+func (k *K9s) GetPrivacy() *Privacy {
+	if k.Privacy == nil {
+		return NewPrivacy()
+	}
+
+	return k.Privacy
+}
+
+// GetAudit returns the audit events source settings.
+func (k *K9s) GetAudit() *Audit {
+	if k.Audit == nil {
+		return NewAudit()
+	}
+
+	return k.Audit
+}
+
+// GetDemo returns the demo settings or nil if demo mode is off.
+func (k *K9s) GetDemo() *Demo {
+	if k.manualDemo != nil && *k.manualDemo {
+		if k.Demo == nil {
+			return NewDemo()
+		}
+		return k.Demo
+	}
+	if k.Demo == nil || !k.Demo.Enabled {
+		return nil
+	}
+
+	return k.Demo
+}
+
+// GetRenderMode returns the rendering mode combining terminal detection, config and cli overrides.
+// Screen reader mode implies ASCII rendering.
+func (k *K9s) GetRenderMode() RenderMode {
+	m := DetectRenderMode()
+	m.LowColor = m.LowColor || k.LowColor
+	m.ASCII = m.ASCII || k.ASCII || k.GetScreenReader()
+	if k.manualRenderMode != nil {
+		m.LowColor = m.LowColor || k.manualRenderMode.LowColor
+		m.ASCII = m.ASCII || k.manualRenderMode.ASCII
+	}
+
+	return m
+}
+
+// GetNoIcons returns the icons setting.
+func (k *K9s) GetNoIcons() bool {
+	return k.NoIcons || k.GetRenderMode().ASCII
+}
+
 // GetHeadless returns headless setting.
 func (k *K9s) GetHeadless() bool {
 	h := k.Headless
+	if p := k.ActiveProfile(); p != nil && p.Headless != nil {
+		h = *p.Headless
+	}
 	if k.manualHeadless != nil && *k.manualHeadless {
 		h = *k.manualHeadless
 	}
@@ -64,6 +280,9 @@ func (k *K9s) GetHeadless() bool {
 // GetRefreshRate returns the current refresh rate.
 func (k *K9s) GetRefreshRate() int {
 	rate := k.RefreshRate
+	if p := k.ActiveProfile(); p != nil && p.RefreshRate > 0 {
+		rate = p.RefreshRate
+	}
 	if k.manualRefreshRate != 0 {
 		rate = k.manualRefreshRate
 	}
@@ -71,9 +290,27 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
+// GetRefreshRateFor returns the refresh rate of a given view, using the first
+// matching name. A zero rate means the view only refreshes on demand.
+func (k *K9s) GetRefreshRateFor(names ...string) int {
+	if k.manualRefreshRate != 0 {
+		return k.manualRefreshRate
+	}
+	for _, n := range names {
+		if rate, ok := k.RefreshRates[n]; ok {
+			return rate
+		}
+	}
+
+	return k.GetRefreshRate()
+}
+
 // GetReadOnly returns the readonly setting.
 func (k *K9s) GetReadOnly() bool {
 	readOnly := k.ReadOnly
+	if p := k.ActiveProfile(); p != nil && p.ReadOnly != nil {
+		readOnly = *p.ReadOnly
+	}
 	if k.manualReadOnly != nil && *k.manualReadOnly {
 		readOnly = *k.manualReadOnly
 	}
@@ -94,6 +331,14 @@ func (k *K9s) ActiveCluster() *Cluster {
 	return k.Clusters[k.CurrentCluster]
 }
 
+// keepSession carries over the session state and command line overrides.
+func (k *K9s) keepSession(cur *K9s) {
+	k.CurrentContext, k.CurrentCluster = cur.CurrentContext, cur.CurrentCluster
+	k.manualRefreshRate, k.manualHeadless, k.manualReadOnly = cur.manualRefreshRate, cur.manualHeadless, cur.manualReadOnly
+	k.manualCommand, k.manualProfile, k.manualRenderMode = cur.manualCommand, cur.manualProfile, cur.manualRenderMode
+	k.manualReader, k.manualDemo = cur.manualReader, cur.manualDemo
+}
+
 func (k *K9s) validateDefaults() {
 	if k.RefreshRate <= 0 {
 		k.RefreshRate = defaultRefreshRate
@@ -135,6 +380,49 @@ func (k *K9s) Validate(c client.Connection, ks KubeSettings) {
 	}
 	k.Thresholds.Validate(c, ks)
 
+	k.Profiles.Validate()
+	if k.Demo != nil {
+		k.Demo.Validate()
+	}
+	k.Confirmations.Validate()
+	if k.PodCleanup != nil {
+		k.PodCleanup.Validate()
+	}
+	if k.Bundle != nil {
+		k.Bundle.Validate()
+	}
+	if k.Chaos != nil {
+		k.Chaos.Validate()
+	}
+	if k.PortForwards != nil {
+		k.PortForwards.Validate()
+	}
+	if k.ShellRecording != nil {
+		k.ShellRecording.Validate()
+	}
+	if k.DebugContainer != nil {
+		k.DebugContainer.Validate()
+	}
+	if k.Privacy != nil {
+		k.Privacy.Validate()
+	}
+	if k.Audit != nil {
+		k.Audit.Validate()
+	}
+	if k.CommandHistory != nil {
+		k.CommandHistory.Validate()
+	}
+	validateViewers(k.Viewers)
+	for n, rate := range k.RefreshRates {
+		if rate < 0 {
+			delete(k.RefreshRates, n)
+		}
+	}
+	if _, ok := k.Profiles[k.Profile]; k.Profile != "" && !ok {
+		log.Warn().Msgf("[Config] Unknown profile %q. Using base configuration", k.Profile)
+		k.Profile = ""
+	}
+
 	if ctx, err := ks.CurrentContextName(); err == nil && len(k.CurrentContext) == 0 {
 		k.CurrentContext = ctx
 		k.CurrentCluster = ""
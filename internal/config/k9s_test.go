@@ -79,3 +79,83 @@ func TestK9sActiveCluster(t *testing.T) {
 	assert.Equal(t, "kube-system", cl.Namespace.Active)
 	assert.Equal(t, 5, len(cl.Namespace.Favorites))
 }
+
+func TestK9sRefreshRateFor(t *testing.T) {
+	uu := map[string]struct {
+		rates  map[string]int
+		manual int
+		names  []string
+		e      int
+	}{
+		"default": {
+			names: []string{"v1/pods", "pods"},
+			e:     2,
+		},
+		"gvr": {
+			rates: map[string]int{"v1/pods": 10},
+			names: []string{"v1/pods", "pods"},
+			e:     10,
+		},
+		"alias": {
+			rates: map[string]int{"pods": 5},
+			names: []string{"v1/pods", "pods"},
+			e:     5,
+		},
+		"frozen": {
+			rates: map[string]int{"v1/events": 0},
+			names: []string{"v1/events", "events"},
+		},
+		"manual": {
+			rates:  map[string]int{"v1/pods": 10},
+			manual: 7,
+			names:  []string{"v1/pods", "pods"},
+			e:      7,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewK9s()
+			cfg.RefreshRates = u.rates
+			if u.manual != 0 {
+				cfg.OverrideRefreshRate(u.manual)
+			}
+			assert.Equal(t, u.e, cfg.GetRefreshRateFor(u.names...))
+		})
+	}
+}
+
+func TestK9sDemo(t *testing.T) {
+	k := config.NewK9s()
+	assert.Nil(t, k.GetDemo())
+
+	k.Demo = &config.Demo{Enabled: true}
+	k.Demo.Validate()
+	assert.Equal(t, &config.Demo{Enabled: true, FlashDelay: 6}, k.GetDemo())
+
+	k.Demo = nil
+	k.OverrideDemo(true)
+	assert.Equal(t, config.NewDemo(), k.GetDemo())
+}
+
+func TestK9sViewerFor(t *testing.T) {
+	k := config.NewK9s()
+	k.Viewers = map[string]string{
+		config.ContentYAML: "bat --paging=always -l yaml",
+		config.ContentDiff: " ",
+	}
+	mc := NewMockConnection()
+	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+	mk := NewMockKubeSettings()
+	m.When(mk.CurrentContextName()).ThenReturn("ctx1", nil)
+	m.When(mk.CurrentClusterName()).ThenReturn("c1", nil)
+	m.When(mk.ClusterNames()).ThenReturn([]string{"c1"}, nil)
+	m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"default"})
+	k.Validate(mc, mk)
+
+	assert.Equal(t, []string{"bat", "--paging=always", "-l", "yaml"}, k.ViewerFor(config.ContentYAML))
+	assert.Nil(t, k.ViewerFor(config.ContentDiff))
+	assert.Nil(t, k.ViewerFor(config.ContentDescribe))
+	assert.Nil(t, k.ViewerFor(""))
+}
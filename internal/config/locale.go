@@ -0,0 +1,26 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/i18n"
+)
+
+// K9sLocalesDir represents the location of K9s message catalogs.
+var K9sLocalesDir = filepath.Join(K9sHome, "locales")
+
+// LocaleFile returns the message catalog location for a given locale.
+func LocaleFile(locale string) string {
+	return filepath.Join(K9sLocalesDir, locale+".yml")
+}
+
+// LoadLocale installs the message catalog for the given locale.
+// A blank locale reverts to the stock English messages.
+func LoadLocale(locale string) error {
+	if locale == "" {
+		i18n.Reset()
+		return nil
+	}
+
+	return i18n.Load(LocaleFile(locale))
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// LogBackendLoki queries historical logs from Loki.
+	LogBackendLoki = "loki"
+	// LogBackendElastic queries historical logs from Elasticsearch.
+	LogBackendElastic = "elasticsearch"
+
+	defaultLokiLogQuery    = `{namespace="{{.Namespace}}", pod="{{.Pod}}"{{if .Container}}, container="{{.Container}}"{{end}}}`
+	defaultElasticLogQuery = `kubernetes.namespace_name:"{{.Namespace}}" AND kubernetes.pod_name:"{{.Pod}}"{{if .Container}} AND kubernetes.container_name:"{{.Container}}"{{end}}`
+	defaultElasticIndex    = "logstash-*"
+	defaultElasticMessage  = "log"
+	defaultElasticTime     = "@timestamp"
+	defaultLogBackendLimit = 1000
+	defaultLogBackendSince = 24 * time.Hour
+)
+
+// LogBackend tracks a historical logs backend merged into the live logs.
+type LogBackend struct {
+	// Kind tracks the backend type, either loki or elasticsearch.
+	Kind string `yaml:"kind"`
+	// URL tracks the backend endpoint.
+	URL string `yaml:"url"`
+	// Query tracks the backend query template. Namespace, Pod and Container
+	// are available to the template.
+	Query string `yaml:"query,omitempty"`
+	// Index tracks the Elasticsearch index pattern.
+	Index string `yaml:"index,omitempty"`
+	// MessageField tracks the Elasticsearch document log line field.
+	MessageField string `yaml:"messageField,omitempty"`
+	// TimeField tracks the Elasticsearch document timestamp field.
+	TimeField string `yaml:"timeField,omitempty"`
+	// Limit tracks the max number of historical lines.
+	Limit int `yaml:"limit,omitempty"`
+	// Since tracks how far back to look for historical logs.
+	Since time.Duration `yaml:"since,omitempty"`
+}
+
+// Validate checks the log backend configuration and make sure we're cool. If not use defaults.
+func (b *LogBackend) Validate() {
+	switch b.Kind {
+	case LogBackendLoki:
+		if b.Query == "" {
+			b.Query = defaultLokiLogQuery
+		}
+	case LogBackendElastic:
+		if b.Query == "" {
+			b.Query = defaultElasticLogQuery
+		}
+		if b.Index == "" {
+			b.Index = defaultElasticIndex
+		}
+		if b.MessageField == "" {
+			b.MessageField = defaultElasticMessage
+		}
+		if b.TimeField == "" {
+			b.TimeField = defaultElasticTime
+		}
+	default:
+		log.Warn().Msgf("[Config] Invalid log backend %q. Expecting %s or %s", b.Kind, LogBackendLoki, LogBackendElastic)
+	}
+	if b.Limit <= 0 {
+		b.Limit = defaultLogBackendLimit
+	}
+	if b.Since <= 0 {
+		b.Since = defaultLogBackendSince
+	}
+}
+
+// IsEnabled returns true if the backend can be queried.
+func (b *LogBackend) IsEnabled() bool {
+	if b == nil || b.URL == "" {
+		return false
+	}
+
+	return b.Kind == LogBackendLoki || b.Kind == LogBackendElastic
+}
@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBackendValidate(t *testing.T) {
+	uu := map[string]struct {
+		b        config.LogBackend
+		enabled  bool
+		index    string
+		limit    int
+		since    time.Duration
+		hasQuery bool
+	}{
+		"loki": {
+			b:        config.LogBackend{Kind: config.LogBackendLoki, URL: "http://loki:3100"},
+			enabled:  true,
+			limit:    1000,
+			since:    24 * time.Hour,
+			hasQuery: true,
+		},
+		"elastic": {
+			b:        config.LogBackend{Kind: config.LogBackendElastic, URL: "http://es:9200", Limit: 50, Since: time.Hour},
+			enabled:  true,
+			index:    "logstash-*",
+			limit:    50,
+			since:    time.Hour,
+			hasQuery: true,
+		},
+		"noURL": {
+			b:        config.LogBackend{Kind: config.LogBackendLoki},
+			limit:    1000,
+			since:    24 * time.Hour,
+			hasQuery: true,
+		},
+		"toast": {
+			b:     config.LogBackend{Kind: "blee", URL: "http://blee"},
+			limit: 1000,
+			since: 24 * time.Hour,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.b.Validate()
+			assert.Equal(t, u.enabled, u.b.IsEnabled())
+			assert.Equal(t, u.index, u.b.Index)
+			assert.Equal(t, u.limit, u.b.Limit)
+			assert.Equal(t, u.since, u.b.Since)
+			assert.Equal(t, u.hasQuery, u.b.Query != "")
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+const (
+	defaultLogSinkMaxSize  = 10
+	defaultLogSinkMaxFiles = 5
+)
+
+// LogSink tracks where the tailed logs are continuously written to.
+type LogSink struct {
+	// Dir tracks the log files directory. Defaults to the k9s dump directory.
+	Dir string `yaml:"dir,omitempty"`
+	// Command tracks a shell command the log lines are piped to instead of a file.
+	Command string `yaml:"command,omitempty"`
+	// MaxSize tracks the log file size in megabytes triggering a rotation.
+	MaxSize int `yaml:"maxSize,omitempty"`
+	// MaxFiles tracks the number of rotated log files to keep.
+	MaxFiles int `yaml:"maxFiles,omitempty"`
+}
+
+// NewLogSink returns a new instance.
+func NewLogSink() *LogSink {
+	return &LogSink{
+		MaxSize:  defaultLogSinkMaxSize,
+		MaxFiles: defaultLogSinkMaxFiles,
+	}
+}
+
+// Validate checks thresholds and make sure we're cool. If not use defaults.
+func (s *LogSink) Validate() {
+	if s.MaxSize <= 0 {
+		s.MaxSize = defaultLogSinkMaxSize
+	}
+	if s.MaxFiles < 0 {
+		s.MaxFiles = defaultLogSinkMaxFiles
+	}
+}
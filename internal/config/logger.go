@@ -15,12 +15,14 @@ const (
 
 // Logger tracks logger options
 type Logger struct {
-	TailCount      int64 `yaml:"tail"`
-	BufferSize     int   `yaml:"buffer"`
-	SinceSeconds   int64 `yaml:"sinceSeconds"`
-	FullScreenLogs bool  `yaml:"fullScreenLogs"`
-	TextWrap       bool  `yaml:"textWrap"`
-	ShowTime       bool  `yaml:"showTime"`
+	TailCount      int64    `yaml:"tail"`
+	BufferSize     int      `yaml:"buffer"`
+	SinceSeconds   int64    `yaml:"sinceSeconds"`
+	FullScreenLogs bool     `yaml:"fullScreenLogs"`
+	TextWrap       bool     `yaml:"textWrap"`
+	ShowTime       bool     `yaml:"showTime"`
+	JSONFields     []string `yaml:"jsonFields,omitempty"`
+	Sink           *LogSink `yaml:"sink,omitempty"`
 }
 
 // NewLogger returns a new instance.
@@ -46,4 +48,16 @@ func (l *Logger) Validate(_ client.Connection, _ KubeSettings) {
 	if l.SinceSeconds == 0 {
 		l.SinceSeconds = DefaultSinceSeconds
 	}
+	if l.Sink != nil {
+		l.Sink.Validate()
+	}
+}
+
+// LogSink returns the logs sink settings or the defaults if none are set.
+func (l *Logger) LogSink() *LogSink {
+	if l.Sink == nil {
+		return NewLogSink()
+	}
+
+	return l.Sink
 }
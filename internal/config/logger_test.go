@@ -22,3 +22,21 @@ func TestLoggerValidate(t *testing.T) {
 	assert.Equal(t, int64(100), l.TailCount)
 	assert.Equal(t, 5000, l.BufferSize)
 }
+
+func TestLoggerSinkValidate(t *testing.T) {
+	l := config.Logger{Sink: &config.LogSink{Command: "cat", MaxSize: -1, MaxFiles: -2}}
+	l.Validate(nil, nil)
+
+	assert.Equal(t, "cat", l.Sink.Command)
+	assert.Equal(t, 10, l.Sink.MaxSize)
+	assert.Equal(t, 5, l.Sink.MaxFiles)
+}
+
+func TestLoggerSinkDefaults(t *testing.T) {
+	l := config.NewLogger()
+	l.Validate(nil, nil)
+
+	assert.Nil(t, l.Sink)
+	assert.Equal(t, 10, l.LogSink().MaxSize)
+	assert.Equal(t, 5, l.LogSink().MaxFiles)
+}
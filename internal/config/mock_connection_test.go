@@ -11,7 +11,7 @@ import (
 	pegomock "github.com/petergtz/pegomock"
 	v1 "k8s.io/api/core/v1"
 	version "k8s.io/apimachinery/pkg/version"
-	disk "k8s.io/client-go/discovery/cached/disk"
+	discovery "k8s.io/client-go/discovery"
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
 	rest "k8s.io/client-go/rest"
@@ -33,16 +33,16 @@ func NewMockConnection(options ...pegomock.Option) *MockConnection {
 func (mock *MockConnection) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
 func (mock *MockConnection) FailHandler() pegomock.FailHandler      { return mock.fail }
 
-func (mock *MockConnection) CachedDiscoveryOrDie() *disk.CachedDiscoveryClient {
+func (mock *MockConnection) CachedDiscoveryOrDie() discovery.CachedDiscoveryInterface {
 	if mock == nil {
 		panic("mock must not be nil. Use myMock := NewMockConnection().")
 	}
 	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("CachedDiscoveryOrDie", params, []reflect.Type{reflect.TypeOf((**disk.CachedDiscoveryClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *disk.CachedDiscoveryClient
+	result := pegomock.GetGenericMockFrom(mock).Invoke("CachedDiscoveryOrDie", params, []reflect.Type{reflect.TypeOf((*discovery.CachedDiscoveryInterface)(nil)).Elem()})
+	var ret0 discovery.CachedDiscoveryInterface
 	if len(result) != 0 {
 		if result[0] != nil {
-			ret0 = result[0].(*disk.CachedDiscoveryClient)
+			ret0 = result[0].(discovery.CachedDiscoveryInterface)
 		}
 	}
 	return ret0
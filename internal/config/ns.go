@@ -16,6 +16,10 @@ const (
 type Namespace struct {
 	Active    string   `yaml:"active"`
 	Favorites []string `yaml:"favorites"`
+	// Allow lists the namespaces patterns visible in this context.
+	Allow []string `yaml:"allow,omitempty"`
+	// Deny lists the namespaces patterns hidden in this context.
+	Deny []string `yaml:"deny,omitempty"`
 }
 
 // NewNamespace create a new namespace configuration.
@@ -28,6 +32,18 @@ func NewNamespace() *Namespace {
 
 // Validate a namespace is setup correctly
 func (n *Namespace) Validate(c client.Connection, ks KubeSettings) {
+	if guard := n.Guard(); guard != nil && guard.Err() == nil {
+		ff := make([]string, 0, len(n.Favorites))
+		for _, ns := range n.Favorites {
+			if ns != allNS && !guard.Allowed(ns) {
+				log.Debug().Msgf("[Config] Off limits favorite found '%s'", ns)
+				continue
+			}
+			ff = append(ff, ns)
+		}
+		n.Favorites = ff
+	}
+
 	nns, err := c.ValidNamespaces()
 	if err != nil {
 		return
@@ -55,6 +71,18 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	return nil
 }
 
+// Guard returns a guard restricting the visible namespaces or nil if all
+// namespaces are visible. Invalid patterns deny all namespaces.
+func (n *Namespace) Guard() *client.NamespaceGuard {
+	g, err := client.NewNamespaceGuard(n.Allow, n.Deny)
+	if err != nil {
+		log.Error().Err(err).Msg("[Config] Denying all namespaces")
+		return client.NewDenyAllGuard(err)
+	}
+
+	return g
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == allNS || n.Active == ""
 }
@@ -92,3 +92,33 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default"}, ns.Favorites)
 }
+
+func TestNSValidateGuard(t *testing.T) {
+	mc := NewMockConnection()
+	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+	mk := NewMockKubeSettings()
+	m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"ns1", "ns2", "default"})
+
+	ns := config.NewNamespace()
+	ns.Favorites = []string{"all", "ns1", "ns2", "default"}
+	ns.Allow = []string{"ns.*", "default"}
+	ns.Deny = []string{"ns2"}
+	ns.Validate(mc, mk)
+
+	assert.Equal(t, []string{"all", "ns1", "default"}, ns.Favorites)
+	assert.True(t, ns.Guard().Allowed("ns1"))
+	assert.False(t, ns.Guard().Allowed("ns2"))
+	assert.False(t, ns.Guard().Allowed("kube-system"))
+}
+
+func TestNSGuardInvalid(t *testing.T) {
+	ns := config.NewNamespace()
+	ns.Allow = []string{"ns.*", "bad("}
+
+	g := ns.Guard()
+	assert.NotNil(t, g.Err())
+	assert.False(t, g.Allowed("ns1"))
+	assert.False(t, g.Allowed("default"))
+	assert.True(t, g.Allowed(""))
+	assert.NotNil(t, g.Check("ns1"))
+}
@@ -1,15 +1,22 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"regexp"
 
+	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
 
 // K9sPlugins manages K9s plugins.
 var K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
 
+// PluginOutputPane captures a plugin output in a pane instead of suspending
+// to the shell.
+const PluginOutputPane = "pane"
+
 // Plugins represents a collection of plugins.
 type Plugins struct {
 	Plugin map[string]Plugin `yaml:"plugin"`
@@ -17,13 +24,77 @@ type Plugins struct {
 
 // Plugin describes a K9s plugin
 type Plugin struct {
-	Scopes      []string `yaml:"scopes"`
-	Args        []string `yaml:"args"`
-	ShortCut    string   `yaml:"shortCut"`
+	Scopes      []string      `yaml:"scopes"`
+	Args        []string      `yaml:"args"`
+	ShortCut    string        `yaml:"shortCut"`
+	Description string        `yaml:"description"`
+	Command     string        `yaml:"command"`
+	Confirm     bool          `yaml:"confirm"`
+	Background  bool          `yaml:"background"`
+	Output      string        `yaml:"output"`
+	Inputs      []PluginInput `yaml:"inputs"`
+}
+
+// PluginInput describes a plugin argument prompted for upon activation.
+type PluginInput struct {
+	Name        string   `yaml:"name"`
 	Description string   `yaml:"description"`
-	Command     string   `yaml:"command"`
-	Confirm     bool     `yaml:"confirm"`
-	Background  bool     `yaml:"background"`
+	Default     string   `yaml:"default"`
+	Options     []string `yaml:"options"`
+	Pattern     string   `yaml:"pattern"`
+	Required    bool     `yaml:"required"`
+
+	rx *regexp.Regexp
+}
+
+// Compile compiles the input pattern if any.
+func (i *PluginInput) Compile() error {
+	if i.Pattern == "" {
+		i.rx = nil
+		return nil
+	}
+	rx, err := regexp.Compile(i.Pattern)
+	if err != nil {
+		return fmt.Errorf("%s invalid pattern %q: %w", i.Name, i.Pattern, err)
+	}
+	i.rx = rx
+
+	return nil
+}
+
+// Label returns the input prompt label.
+func (i PluginInput) Label() string {
+	if i.Description != "" {
+		return i.Description + ":"
+	}
+
+	return i.Name + ":"
+}
+
+// Validate checks a value against the input options and pattern if any.
+func (i PluginInput) Validate(v string) error {
+	if v == "" {
+		if i.Required {
+			return fmt.Errorf("%s is required", i.Name)
+		}
+		return nil
+	}
+	if len(i.Options) > 0 && !InList(i.Options, v) {
+		return fmt.Errorf("%s must be one of %v", i.Name, i.Options)
+	}
+	if i.Pattern == "" {
+		return nil
+	}
+	if i.rx == nil {
+		if err := i.Compile(); err != nil {
+			return err
+		}
+	}
+	if !i.rx.MatchString(v) {
+		return fmt.Errorf("%s must match %q", i.Name, i.Pattern)
+	}
+
+	return nil
 }
 
 // NewPlugins returns a new plugin.
@@ -50,8 +121,22 @@ func (p Plugins) LoadPlugins(path string) error {
 		return err
 	}
 	for k, v := range pp.Plugin {
+		if err := v.compileInputs(); err != nil {
+			log.Warn().Err(err).Msgf("Skipping plugin %q", k)
+			continue
+		}
 		p.Plugin[k] = v
 	}
 
 	return nil
 }
+
+func (p Plugin) compileInputs() error {
+	for i := range p.Inputs {
+		if err := p.Inputs[i].Compile(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -20,5 +20,50 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, []string{"po", "dp"}, k.Scopes)
 	assert.Equal(t, "duh", k.Command)
 	assert.False(t, k.Background)
+	assert.Equal(t, config.PluginOutputPane, k.Output)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
+	assert.Equal(t, 2, len(k.Inputs))
+	i := k.Inputs[0]
+	assert.Equal(t, "replicas", i.Name)
+	assert.Equal(t, "Replicas", i.Description)
+	assert.Equal(t, "1", i.Default)
+	assert.Equal(t, "^[0-9]+$", i.Pattern)
+	assert.True(t, i.Required)
+	assert.Nil(t, i.Validate("10"))
+	assert.NotNil(t, i.Validate("1a"))
+	assert.Equal(t, "level", k.Inputs[1].Name)
+	assert.Equal(t, []string{"info", "debug"}, k.Inputs[1].Options)
+}
+
+func TestPluginLoadBadPattern(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins("testdata/plugin_bad_pattern.yml"))
+
+	assert.Equal(t, 1, len(p.Plugin))
+	_, ok := p.Plugin["good"]
+	assert.True(t, ok)
+}
+
+func TestPluginInputValidate(t *testing.T) {
+	uu := map[string]struct {
+		i  config.PluginInput
+		v  string
+		ok bool
+	}{
+		"blank":       {i: config.PluginInput{Name: "a"}, ok: true},
+		"required":    {i: config.PluginInput{Name: "a", Required: true}},
+		"option":      {i: config.PluginInput{Name: "a", Options: []string{"x", "y"}}, v: "y", ok: true},
+		"noOption":    {i: config.PluginInput{Name: "a", Options: []string{"x", "y"}}, v: "z"},
+		"match":       {i: config.PluginInput{Name: "a", Pattern: "^[0-9]+$"}, v: "10", ok: true},
+		"noMatch":     {i: config.PluginInput{Name: "a", Pattern: "^[0-9]+$"}, v: "1a"},
+		"badPattern":  {i: config.PluginInput{Name: "a", Pattern: "[0-9"}, v: "1"},
+		"blankNoRule": {i: config.PluginInput{Name: "a", Pattern: "^[0-9]+$"}, ok: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, u.i.Validate(u.v) == nil)
+		})
+	}
 }
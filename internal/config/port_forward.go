@@ -0,0 +1,72 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PortRange represents an inclusive range of local ports.
+type PortRange struct {
+	Min, Max int
+}
+
+// PortForwards tracks port-forwards options.
+type PortForwards struct {
+	// AutoPort picks a free local port when the requested one is busy.
+	AutoPort bool `yaml:"autoPort"`
+	// PortRanges lists the preferred local ports ranges, ie 8000-8100.
+	PortRanges []string `yaml:"portRanges,omitempty"`
+}
+
+// NewPortForwards returns a new port-forwards configuration.
+func NewPortForwards() *PortForwards {
+	return &PortForwards{AutoPort: true}
+}
+
+// Validate checks the port-forwards configuration and drops invalid ranges.
+func (p *PortForwards) Validate() {
+	rr := make([]string, 0, len(p.PortRanges))
+	for _, r := range p.PortRanges {
+		if _, ok := parsePortRange(r); !ok {
+			log.Warn().Msgf("[Config] Invalid port-forward range %q. Skipping", r)
+			continue
+		}
+		rr = append(rr, r)
+	}
+	p.PortRanges = rr
+}
+
+// Ranges returns the preferred local ports ranges.
+func (p *PortForwards) Ranges() []PortRange {
+	rr := make([]PortRange, 0, len(p.PortRanges))
+	for _, s := range p.PortRanges {
+		if r, ok := parsePortRange(s); ok {
+			rr = append(rr, r)
+		}
+	}
+
+	return rr
+}
+
+// parsePortRange parses a port range spec. A single port is a valid range.
+func parsePortRange(s string) (PortRange, bool) {
+	tokens := strings.SplitN(strings.TrimSpace(s), "-", 2)
+	if len(tokens) == 1 {
+		tokens = append(tokens, tokens[0])
+	}
+	lo, err := strconv.Atoi(strings.TrimSpace(tokens[0]))
+	if err != nil {
+		return PortRange{}, false
+	}
+	hi, err := strconv.Atoi(strings.TrimSpace(tokens[1]))
+	if err != nil {
+		return PortRange{}, false
+	}
+	if lo <= 0 || hi > 65535 || lo > hi {
+		return PortRange{}, false
+	}
+
+	return PortRange{Min: lo, Max: hi}, true
+}
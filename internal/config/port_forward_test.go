@@ -0,0 +1,23 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortForwardsValidate(t *testing.T) {
+	p := config.PortForwards{PortRanges: []string{"8000-8100", "blee", "9000", "90-10", "1-70000"}}
+	p.Validate()
+
+	assert.Equal(t, []string{"8000-8100", "9000"}, p.PortRanges)
+}
+
+func TestPortForwardsRanges(t *testing.T) {
+	p := config.NewPortForwards()
+	p.PortRanges = []string{"8000-8100", " 9000 "}
+
+	assert.True(t, p.AutoPort)
+	assert.Equal(t, []config.PortRange{{Min: 8000, Max: 8100}, {Min: 9000, Max: 9000}}, p.Ranges())
+}
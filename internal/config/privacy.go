@@ -0,0 +1,57 @@
+package config
+
+import (
+	"regexp"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultPrivacyRules masks IP addresses and secret names.
+var DefaultPrivacyRules = []PrivacyRule{
+	{Pattern: `\b(?:\d{1,3}\.){3}\d{1,3}\b`},
+	{Resources: []string{"v1/secrets"}, Columns: []string{"NAME"}},
+}
+
+// Privacy tracks screen sharing privacy mode options.
+type Privacy struct {
+	// Enabled starts K9s in privacy mode.
+	Enabled bool `yaml:"enabled"`
+	// Rules lists the values to mask in privacy mode.
+	Rules []PrivacyRule `yaml:"rules,omitempty"`
+}
+
+// PrivacyRule tracks values to mask in privacy mode.
+type PrivacyRule struct {
+	// Resources restricts the rule to these resources, ie v1/nodes. Blank matches all.
+	Resources []string `yaml:"resources,omitempty"`
+	// Columns restricts the rule to these columns, ie INTERNAL-IP. Blank matches all.
+	Columns []string `yaml:"columns,omitempty"`
+	// Pattern only masks the matching parts of the values. Blank masks whole values.
+	Pattern string `yaml:"pattern,omitempty"`
+}
+
+// NewPrivacy returns a new privacy mode configuration.
+func NewPrivacy() *Privacy {
+	return &Privacy{Rules: DefaultPrivacyRules}
+}
+
+// Validate checks the privacy configuration and drops invalid rules.
+func (p *Privacy) Validate() {
+	if len(p.Rules) == 0 {
+		p.Rules = DefaultPrivacyRules
+		return
+	}
+	rr := make([]PrivacyRule, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		if r.Pattern == "" && len(r.Columns) == 0 {
+			log.Warn().Msgf("[Config] Privacy rule needs either columns or a pattern. Skipping")
+			continue
+		}
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			log.Warn().Err(err).Msgf("[Config] Invalid privacy rule pattern %q. Skipping", r.Pattern)
+			continue
+		}
+		rr = append(rr, r)
+	}
+	p.Rules = rr
+}
@@ -0,0 +1,39 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivacyValidate(t *testing.T) {
+	uu := map[string]struct {
+		rr, e []config.PrivacyRule
+	}{
+		"defaults": {
+			e: config.DefaultPrivacyRules,
+		},
+		"valid": {
+			rr: []config.PrivacyRule{{Resources: []string{"v1/nodes"}, Columns: []string{"INTERNAL-IP"}}},
+			e:  []config.PrivacyRule{{Resources: []string{"v1/nodes"}, Columns: []string{"INTERNAL-IP"}}},
+		},
+		"toast": {
+			rr: []config.PrivacyRule{
+				{Resources: []string{"v1/nodes"}},
+				{Pattern: "("},
+				{Pattern: "token-.*"},
+			},
+			e: []config.PrivacyRule{{Pattern: "token-.*"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := config.Privacy{Rules: u.rr}
+			p.Validate()
+			assert.Equal(t, u.e, p.Rules)
+		})
+	}
+}
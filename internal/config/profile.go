@@ -0,0 +1,63 @@
+package config
+
+import (
+	"path/filepath"
+	"sort"
+)
+
+// Profile tracks a named set of overrides layered over the base K9s configuration.
+type Profile struct {
+	RefreshRate int    `yaml:"refreshRate,omitempty"`
+	ReadOnly    *bool  `yaml:"readOnly,omitempty"`
+	Headless    *bool  `yaml:"headless,omitempty"`
+	Skin        string `yaml:"skin,omitempty"`
+}
+
+// Profiles represents a collection of named profiles.
+type Profiles map[string]*Profile
+
+// NewProfile returns a new profile.
+func NewProfile() *Profile {
+	return &Profile{}
+}
+
+// SkinFile returns the profile skin file location or blank if none.
+func (p *Profile) SkinFile() string {
+	if p.Skin == "" {
+		return ""
+	}
+	if filepath.IsAbs(p.Skin) {
+		return p.Skin
+	}
+
+	return filepath.Join(K9sHome, p.Skin)
+}
+
+// Validate checks the profile and make sure we're cool. If not use defaults.
+func (p *Profile) Validate() {
+	if p.RefreshRate < 0 {
+		p.RefreshRate = 0
+	}
+}
+
+// Names returns all profile names.
+func (p Profiles) Names() []string {
+	nn := make([]string, 0, len(p))
+	for k := range p {
+		nn = append(nn, k)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Validate checks all profiles and drops the ones that are not set.
+func (p Profiles) Validate() {
+	for k, v := range p {
+		if v == nil {
+			delete(p, k)
+			continue
+		}
+		v.Validate()
+	}
+}
@@ -0,0 +1,95 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileLoad(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s_profiles.yml"))
+
+	assert.Equal(t, "demo", cfg.K9s.ActiveProfileName())
+	assert.Equal(t, 2, len(cfg.K9s.Profiles))
+	assert.Equal(t, []string{"demo", "work"}, cfg.K9s.Profiles.Names())
+	assert.Equal(t, 5, cfg.K9s.GetRefreshRate())
+	assert.True(t, cfg.K9s.GetReadOnly())
+	assert.True(t, cfg.K9s.GetHeadless())
+	assert.Equal(t, filepath.Join(config.K9sHome, "demo_skin.yml"), cfg.K9s.ActiveProfile().SkinFile())
+}
+
+func TestProfileOverride(t *testing.T) {
+	uu := map[string]struct {
+		profile  string
+		rate     int
+		readOnly bool
+	}{
+		"work": {
+			profile: "work",
+			rate:    2,
+		},
+		"demo": {
+			profile:  "demo",
+			rate:     5,
+			readOnly: true,
+		},
+		"unknown": {
+			profile: "fred",
+			rate:    2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewConfig(NewMockKubeSettings())
+			assert.Nil(t, cfg.Load("testdata/k9s_profiles.yml"))
+			cfg.K9s.OverrideProfile(u.profile)
+
+			assert.Equal(t, u.rate, cfg.K9s.GetRefreshRate())
+			assert.Equal(t, u.readOnly, cfg.K9s.GetReadOnly())
+		})
+	}
+}
+
+func TestProfileFlagsWin(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s_profiles.yml"))
+	cfg.K9s.OverrideRefreshRate(10)
+
+	assert.Equal(t, 10, cfg.K9s.GetRefreshRate())
+}
+
+func TestProfileSetActive(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s_profiles.yml"))
+
+	assert.NotNil(t, cfg.K9s.SetActiveProfile("fred"))
+	assert.Equal(t, "demo", cfg.K9s.ActiveProfileName())
+
+	assert.Nil(t, cfg.K9s.SetActiveProfile(""))
+	assert.Nil(t, cfg.K9s.ActiveProfile())
+	assert.Equal(t, 2, cfg.K9s.GetRefreshRate())
+	assert.False(t, cfg.K9s.GetReadOnly())
+}
+
+func TestProfileSkinFile(t *testing.T) {
+	uu := map[string]struct {
+		skin, e string
+	}{
+		"none":     {},
+		"relative": {skin: "fred_skin.yml", e: filepath.Join(config.K9sHome, "fred_skin.yml")},
+		"absolute": {skin: "/tmp/fred_skin.yml", e: "/tmp/fred_skin.yml"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := config.Profile{Skin: u.skin}
+			assert.Equal(t, u.e, p.SkinFile())
+		})
+	}
+}
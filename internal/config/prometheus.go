@@ -0,0 +1,105 @@
+package config
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// PromPodCPU tracks the pod cpu usage query, in millicores.
+	PromPodCPU = "pod.cpu"
+	// PromPodMEM tracks the pod memory usage query, in MiB.
+	PromPodMEM = "pod.mem"
+	// PromPodRX tracks the pod network received query, in KiB/s.
+	PromPodRX = "pod.rx"
+	// PromPodTX tracks the pod network transmitted query, in KiB/s.
+	PromPodTX = "pod.tx"
+	// PromNodeCPU tracks the node cpu usage query, in millicores.
+	PromNodeCPU = "node.cpu"
+	// PromNodeMEM tracks the node memory usage query, in MiB.
+	PromNodeMEM = "node.mem"
+	// PromNodeRX tracks the node network received query, in KiB/s.
+	PromNodeRX = "node.rx"
+	// PromNodeTX tracks the node network transmitted query, in KiB/s.
+	PromNodeTX = "node.tx"
+
+	defaultPromRange = time.Hour
+	defaultPromStep  = time.Minute
+	minPromStep      = 5 * time.Second
+)
+
+// DefaultPromQueries tracks the default graphs queries. Namespace, Pod, Node
+// and Window are available to the templates.
+var DefaultPromQueries = map[string]string{
+	PromPodCPU:  `sum(rate(container_cpu_usage_seconds_total{namespace="{{.Namespace}}", pod="{{.Pod}}", container!="", container!="POD"}[{{.Window}}])) * 1000`,
+	PromPodMEM:  `sum(container_memory_working_set_bytes{namespace="{{.Namespace}}", pod="{{.Pod}}", container!="", container!="POD"}) / 1048576`,
+	PromPodRX:   `sum(rate(container_network_receive_bytes_total{namespace="{{.Namespace}}", pod="{{.Pod}}"}[{{.Window}}])) / 1024`,
+	PromPodTX:   `sum(rate(container_network_transmit_bytes_total{namespace="{{.Namespace}}", pod="{{.Pod}}"}[{{.Window}}])) / 1024`,
+	PromNodeCPU: `sum(rate(container_cpu_usage_seconds_total{node="{{.Node}}", id="/"}[{{.Window}}])) * 1000`,
+	PromNodeMEM: `sum(container_memory_working_set_bytes{node="{{.Node}}", id="/"}) / 1048576`,
+	PromNodeRX:  `sum(rate(container_network_receive_bytes_total{node="{{.Node}}", id="/"}[{{.Window}}])) / 1024`,
+	PromNodeTX:  `sum(rate(container_network_transmit_bytes_total{node="{{.Node}}", id="/"}[{{.Window}}])) / 1024`,
+}
+
+// Prometheus tracks the Prometheus datasource used to graph resources usage.
+type Prometheus struct {
+	// URL tracks the Prometheus endpoint. Takes precedence over Service.
+	URL string `yaml:"url,omitempty"`
+	// Service tracks a Prometheus service reached via the api server proxy,
+	// ie monitoring/prometheus-server:80. Discovered if blank.
+	Service string `yaml:"service,omitempty"`
+	// Range tracks how far back the graphs go.
+	Range time.Duration `yaml:"range,omitempty"`
+	// Step tracks the graphs resolution.
+	Step time.Duration `yaml:"step,omitempty"`
+	// Queries overrides the default graphs queries.
+	Queries map[string]string `yaml:"queries,omitempty"`
+}
+
+// NewPrometheus returns a new Prometheus configuration.
+func NewPrometheus() *Prometheus {
+	return &Prometheus{
+		Range: defaultPromRange,
+		Step:  defaultPromStep,
+	}
+}
+
+// Validate checks the Prometheus configuration and make sure we're cool. If not use defaults.
+func (p *Prometheus) Validate() {
+	if p.Range <= 0 {
+		p.Range = defaultPromRange
+	}
+	if p.Step < minPromStep {
+		p.Step = defaultPromStep
+	}
+	if p.Step > p.Range {
+		log.Warn().Msgf("[Config] Prometheus step %s exceeds range %s. Using %s", p.Step, p.Range, defaultPromStep)
+		p.Step = defaultPromStep
+	}
+	for k := range p.Queries {
+		if _, ok := DefaultPromQueries[k]; !ok {
+			log.Warn().Msgf("[Config] Unknown Prometheus query %q", k)
+			delete(p.Queries, k)
+		}
+	}
+}
+
+// Query returns the named query template.
+func (p *Prometheus) Query(name string) string {
+	if q, ok := p.Queries[name]; ok && q != "" {
+		return q
+	}
+
+	return DefaultPromQueries[name]
+}
+
+// Window returns the rate window matching the graphs resolution.
+func (p *Prometheus) Window() time.Duration {
+	w := 2 * p.Step
+	if w < time.Minute {
+		w = time.Minute
+	}
+
+	return w
+}
@@ -0,0 +1,78 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusValidate(t *testing.T) {
+	uu := map[string]struct {
+		p           config.Prometheus
+		rg, step, w time.Duration
+		queries     int
+	}{
+		"empty": {
+			rg:   time.Hour,
+			step: time.Minute,
+			w:    2 * time.Minute,
+		},
+		"custom": {
+			p:    config.Prometheus{Range: 6 * time.Hour, Step: 5 * time.Minute},
+			rg:   6 * time.Hour,
+			step: 5 * time.Minute,
+			w:    10 * time.Minute,
+		},
+		"fineStep": {
+			p:    config.Prometheus{Step: 10 * time.Second},
+			rg:   time.Hour,
+			step: 10 * time.Second,
+			w:    time.Minute,
+		},
+		"stepTooLarge": {
+			p:    config.Prometheus{Range: time.Minute, Step: time.Hour},
+			rg:   time.Minute,
+			step: time.Minute,
+			w:    2 * time.Minute,
+		},
+		"queries": {
+			p: config.Prometheus{Queries: map[string]string{
+				config.PromNodeCPU: "blee",
+				"fred":             "duh",
+			}},
+			rg:      time.Hour,
+			step:    time.Minute,
+			w:       2 * time.Minute,
+			queries: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.p.Validate()
+			assert.Equal(t, u.rg, u.p.Range)
+			assert.Equal(t, u.step, u.p.Step)
+			assert.Equal(t, u.w, u.p.Window())
+			assert.Equal(t, u.queries, len(u.p.Queries))
+		})
+	}
+}
+
+func TestPrometheusQuery(t *testing.T) {
+	p := config.NewPrometheus()
+	p.Queries = map[string]string{config.PromPodCPU: "blee"}
+
+	assert.Equal(t, "blee", p.Query(config.PromPodCPU))
+	assert.Equal(t, config.DefaultPromQueries[config.PromPodMEM], p.Query(config.PromPodMEM))
+}
+
+func TestClusterGetPrometheus(t *testing.T) {
+	c := config.NewCluster()
+	assert.Equal(t, config.NewPrometheus(), c.GetPrometheus())
+
+	c.Prometheus = &config.Prometheus{URL: "http://prom:9090"}
+	assert.Equal(t, "http://prom:9090", c.GetPrometheus().URL)
+}
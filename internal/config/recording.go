@@ -0,0 +1,54 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// RecordingCast records shell sessions as asciinema casts.
+	RecordingCast = "asciinema"
+	// RecordingTranscript records shell sessions as plain transcripts.
+	RecordingTranscript = "transcript"
+
+	// DefaultRecordingMaxMB tracks the default max size of a recording.
+	DefaultRecordingMaxMB = 50
+)
+
+// ShellRecording tracks shell sessions recording options.
+type ShellRecording struct {
+	// Enabled records pod shell and attach sessions.
+	Enabled bool `yaml:"enabled"`
+	// Format tracks the recording format, either asciinema or transcript.
+	Format string `yaml:"format"`
+	// Dir tracks where recordings are saved.
+	Dir string `yaml:"dir,omitempty"`
+	// MaxMB caps a recording size. Output past the cap is not recorded.
+	MaxMB int `yaml:"maxMB"`
+}
+
+// NewShellRecording returns a new shell recording configuration.
+func NewShellRecording() *ShellRecording {
+	return &ShellRecording{
+		Format: RecordingCast,
+		Dir:    K9sRecordingsDir,
+		MaxMB:  DefaultRecordingMaxMB,
+	}
+}
+
+// Validate checks the recording configuration and make sure we're cool. If not use defaults.
+func (r *ShellRecording) Validate() {
+	switch r.Format {
+	case RecordingCast, RecordingTranscript:
+	case "":
+		r.Format = RecordingCast
+	default:
+		log.Warn().Msgf("[Config] Invalid shell recording format %q. Using %s", r.Format, RecordingCast)
+		r.Format = RecordingCast
+	}
+	if r.Dir == "" {
+		r.Dir = K9sRecordingsDir
+	}
+	if r.MaxMB <= 0 {
+		r.MaxMB = DefaultRecordingMaxMB
+	}
+}
@@ -0,0 +1,34 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellRecordingValidate(t *testing.T) {
+	uu := map[string]struct {
+		r, e config.ShellRecording
+	}{
+		"defaults": {
+			e: config.ShellRecording{Format: config.RecordingCast, Dir: config.K9sRecordingsDir, MaxMB: config.DefaultRecordingMaxMB},
+		},
+		"transcript": {
+			r: config.ShellRecording{Enabled: true, Format: config.RecordingTranscript, Dir: "/tmp/rec", MaxMB: 10},
+			e: config.ShellRecording{Enabled: true, Format: config.RecordingTranscript, Dir: "/tmp/rec", MaxMB: 10},
+		},
+		"toast": {
+			r: config.ShellRecording{Enabled: true, Format: "blee"},
+			e: config.ShellRecording{Enabled: true, Format: config.RecordingCast, Dir: config.K9sRecordingsDir, MaxMB: config.DefaultRecordingMaxMB},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.r.Validate()
+			assert.Equal(t, u.e, u.r)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+var (
+	lowColorMode bool
+
+	lowColorPalette = []tcell.Color{
+		tcell.ColorBlack,
+		tcell.ColorMaroon,
+		tcell.ColorGreen,
+		tcell.ColorOlive,
+		tcell.ColorNavy,
+		tcell.ColorPurple,
+		tcell.ColorTeal,
+		tcell.ColorSilver,
+		tcell.ColorGray,
+		tcell.ColorRed,
+		tcell.ColorLime,
+		tcell.ColorYellow,
+		tcell.ColorBlue,
+		tcell.ColorFuchsia,
+		tcell.ColorAqua,
+		tcell.ColorWhite,
+	}
+
+	limitedTerms = []string{"dumb", "linux", "vt100", "vt102", "vt220", "ansi", "cons25"}
+)
+
+// RenderMode tracks terminal rendering capabilities.
+type RenderMode struct {
+	LowColor bool
+	ASCII    bool
+}
+
+// SetLowColorMode forces skin colors to be mapped onto the 16 ANSI colors.
+func SetLowColorMode(b bool) {
+	lowColorMode = b
+}
+
+// DetectRenderMode infers rendering capabilities from the terminal environment.
+func DetectRenderMode() RenderMode {
+	term := strings.ToLower(os.Getenv("TERM"))
+	if InList(limitedTerms, term) {
+		return RenderMode{LowColor: true, ASCII: true}
+	}
+
+	var m RenderMode
+	if locale := activeLocale(); locale != "" {
+		l := strings.ToLower(locale)
+		m.ASCII = !strings.Contains(l, "utf-8") && !strings.Contains(l, "utf8")
+	}
+
+	return m
+}
+
+func activeLocale() string {
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func lowColor(c tcell.Color) tcell.Color {
+	if !lowColorMode || c == tcell.ColorDefault {
+		return c
+	}
+
+	return tcell.FindColor(c, lowColorPalette)
+}
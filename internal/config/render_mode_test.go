@@ -0,0 +1,109 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectRenderMode(t *testing.T) {
+	uu := map[string]struct {
+		term, lang string
+		e          config.RenderMode
+	}{
+		"full": {
+			term: "xterm-256color",
+			lang: "en_US.UTF-8",
+		},
+		"console": {
+			term: "linux",
+			lang: "en_US.UTF-8",
+			e:    config.RenderMode{LowColor: true, ASCII: true},
+		},
+		"serial": {
+			term: "vt100",
+			e:    config.RenderMode{LowColor: true, ASCII: true},
+		},
+		"noUnicode": {
+			term: "xterm-256color",
+			lang: "C",
+			e:    config.RenderMode{ASCII: true},
+		},
+		"noLocale": {
+			term: "xterm",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			defer setEnv(map[string]string{
+				"TERM":     u.term,
+				"LANG":     u.lang,
+				"LC_ALL":   "",
+				"LC_CTYPE": "",
+			})()
+			assert.Equal(t, u.e, config.DetectRenderMode())
+		})
+	}
+}
+
+func TestRenderModeOverride(t *testing.T) {
+	defer setEnv(map[string]string{
+		"TERM":     "xterm-256color",
+		"LANG":     "en_US.UTF-8",
+		"LC_ALL":   "",
+		"LC_CTYPE": "",
+	})()
+
+	k := config.NewK9s()
+	assert.False(t, k.GetNoIcons())
+	k.OverrideRenderMode(config.RenderMode{ASCII: true})
+	assert.Equal(t, config.RenderMode{ASCII: true}, k.GetRenderMode())
+	assert.True(t, k.GetNoIcons())
+}
+
+func TestScreenReaderRenderMode(t *testing.T) {
+	defer setEnv(map[string]string{
+		"TERM":     "xterm-256color",
+		"LANG":     "en_US.UTF-8",
+		"LC_ALL":   "",
+		"LC_CTYPE": "",
+	})()
+
+	k := config.NewK9s()
+	assert.False(t, k.GetScreenReader())
+	k.OverrideScreenReader(true)
+	assert.True(t, k.GetScreenReader())
+	assert.Equal(t, config.RenderMode{ASCII: true}, k.GetRenderMode())
+	assert.True(t, k.GetNoIcons())
+}
+
+func TestLowColor(t *testing.T) {
+	defer config.SetLowColorMode(false)
+
+	c := config.Color("#ff0000")
+	assert.Equal(t, tcell.GetColor("#ff0000"), c.Color())
+	config.SetLowColorMode(true)
+	assert.Equal(t, tcell.ColorRed, c.Color())
+	assert.Equal(t, tcell.ColorDefault, config.Color(config.DefaultColor).Color())
+}
+
+// Helpers...
+
+func setEnv(env map[string]string) func() {
+	old := make(map[string]string, len(env))
+	for k, v := range env {
+		old[k] = os.Getenv(k)
+		os.Setenv(k, v)
+	}
+
+	return func() {
+		for k, v := range old {
+			os.Setenv(k, v)
+		}
+	}
+}
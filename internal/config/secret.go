@@ -0,0 +1,54 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	secretEnvPrefix  = "env:"
+	secretFilePrefix = "file:"
+	secretExecPrefix = "exec:"
+
+	secretExecTimeout = 10 * time.Second
+)
+
+// ResolveSecret resolves a config value from an external source.
+// Supported sources are env:VAR, file:PATH and exec:COMMAND ARGS.
+// Values without a known source prefix are returned as is.
+func ResolveSecret(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, secretEnvPrefix):
+		k := strings.TrimPrefix(s, secretEnvPrefix)
+		v, ok := os.LookupEnv(k)
+		if !ok {
+			return "", fmt.Errorf("secret env var %q is not set", k)
+		}
+		return v, nil
+	case strings.HasPrefix(s, secretFilePrefix):
+		raw, err := ioutil.ReadFile(os.ExpandEnv(strings.TrimPrefix(s, secretFilePrefix)))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(raw), "\r\n"), nil
+	case strings.HasPrefix(s, secretExecPrefix):
+		args := strings.Fields(strings.TrimPrefix(s, secretExecPrefix))
+		if len(args) == 0 {
+			return "", fmt.Errorf("no secret command specified")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), secretExecTimeout)
+		defer cancel()
+		out, err := exec.CommandContext(ctx, args[0], args[1:]...).Output()
+		if err != nil {
+			return "", fmt.Errorf("secret command %q failed: %w", args[0], err)
+		}
+		return strings.TrimRight(string(out), "\r\n"), nil
+	default:
+		return s, nil
+	}
+}
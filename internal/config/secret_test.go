@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSecret(t *testing.T) {
+	os.Setenv("K9S_TEST_SECRET", "fred")
+	defer os.Unsetenv("K9S_TEST_SECRET")
+
+	uu := map[string]struct {
+		s, e  string
+		issue bool
+	}{
+		"plain": {
+			s: "blee",
+			e: "blee",
+		},
+		"env": {
+			s: "env:K9S_TEST_SECRET",
+			e: "fred",
+		},
+		"envMissing": {
+			s:     "env:K9S_TEST_NOT_THERE",
+			issue: true,
+		},
+		"file": {
+			s: "file:testdata/secret.txt",
+			e: "blee",
+		},
+		"fileMissing": {
+			s:     "file:testdata/not_there.txt",
+			issue: true,
+		},
+		"exec": {
+			s: "exec:echo duh",
+			e: "duh",
+		},
+		"execBlank": {
+			s:     "exec:",
+			issue: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := config.ResolveSecret(u.s)
+			if u.issue {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
@@ -149,9 +149,10 @@ type (
 
 	// Menu tracks menu styles.
 	Menu struct {
-		FgColor     Color `yaml:"fgColor"`
-		KeyColor    Color `yaml:"keyColor"`
-		NumKeyColor Color `yaml:"numKeyColor"`
+		FgColor       Color `yaml:"fgColor"`
+		KeyColor      Color `yaml:"keyColor"`
+		NumKeyColor   Color `yaml:"numKeyColor"`
+		DisabledColor Color `yaml:"disabledColor,omitempty"`
 	}
 
 	// Charts tracks charts styles.
@@ -189,9 +190,9 @@ func (c Color) Color() tcell.Color {
 		return tcell.ColorDefault
 	}
 	if color, ok := tcell.ColorNames[c.String()]; ok {
-		return color
+		return lowColor(color)
 	}
-	return tcell.GetColor(c.String())
+	return lowColor(tcell.GetColor(c.String()))
 }
 
 // Colors converts series string colors to colors.
@@ -349,9 +350,10 @@ func newBorder() Border {
 
 func newMenu() Menu {
 	return Menu{
-		FgColor:     "white",
-		KeyColor:    "dodgerblue",
-		NumKeyColor: "fuchsia",
+		FgColor:       "white",
+		KeyColor:      "dodgerblue",
+		NumKeyColor:   "fuchsia",
+		DisabledColor: "gray",
 	}
 }
 
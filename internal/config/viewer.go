@@ -0,0 +1,35 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ContentYAML tracks resource manifests.
+	ContentYAML = "yaml"
+	// ContentDescribe tracks resources descriptions.
+	ContentDescribe = "describe"
+	// ContentDiff tracks unified diffs.
+	ContentDiff = "diff"
+)
+
+// ViewerFor returns the external viewer command line for a given content
+// type or nil if none is configured.
+func (k *K9s) ViewerFor(kind string) []string {
+	if kind == "" {
+		return nil
+	}
+
+	return strings.Fields(k.Viewers[kind])
+}
+
+func validateViewers(vv map[string]string) {
+	for k, v := range vv {
+		if strings.TrimSpace(v) == "" {
+			log.Warn().Msgf("[Config] Blank viewer command for %q. Skipping", k)
+			delete(vv, k)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -16,9 +17,57 @@ type ViewConfigListener interface {
 	ViewSettingsChanged(ViewSetting)
 }
 
+const (
+	// TruncateLeft cuts a column values on the left.
+	TruncateLeft = "left"
+	// TruncateMiddle cuts a column values in the middle.
+	TruncateMiddle = "middle"
+	// TruncateRight cuts a column values on the right.
+	TruncateRight = "right"
+)
+
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns []string `yaml:"columns"`
+	Columns       []string                    `yaml:"columns"`
+	CustomColumns []CustomColumn              `yaml:"customColumns"`
+	Truncate      map[string]ColumnTruncation `yaml:"truncate"`
+}
+
+// ColumnTruncation represents a column max width along with where its values
+// are cut, either left, middle or right. Defaults to right.
+type ColumnTruncation struct {
+	Width int    `yaml:"width"`
+	Mode  string `yaml:"mode"`
+}
+
+// Truncation returns a column truncation if any.
+func (v ViewSetting) Truncation(col string) (ColumnTruncation, bool) {
+	for k, t := range v.Truncate {
+		if t.Width > 0 && strings.EqualFold(k, col) {
+			return t, true
+		}
+	}
+
+	return ColumnTruncation{}, false
+}
+
+// CustomColumn represents a column computed from a resource json path, ie
+// .spec.nodeSelector or .metadata.labels.app. When joined, the json path
+// applies to the related resource instead.
+type CustomColumn struct {
+	Name     string      `yaml:"name"`
+	JSONPath string      `yaml:"jsonPath"`
+	Wide     bool        `yaml:"wide"`
+	Join     *ColumnJoin `yaml:"join,omitempty"`
+}
+
+// ColumnJoin represents a related resource lookup, ie a pod node or a
+// deployment hpa. The related resource matches when its On json path value,
+// its name by default, equals the resource Key json path value.
+type ColumnJoin struct {
+	Resource string `yaml:"resource"`
+	Key      string `yaml:"key"`
+	On       string `yaml:"on"`
 }
 
 // ViewSettings represent a collection of view configurations.
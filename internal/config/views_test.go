@@ -13,4 +13,18 @@ func TestViewSettingsLoad(t *testing.T) {
 	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
 	assert.Equal(t, 1, len(cfg.K9s.Views))
 	assert.Equal(t, 4, len(cfg.K9s.Views["v1/pods"].Columns))
+	assert.Equal(t, []config.CustomColumn{
+		{Name: "zone", JSONPath: ".spec.nodeSelector.zone"},
+		{Name: "app", JSONPath: ".metadata.labels.app", Wide: true},
+		{Name: "pool", JSONPath: ".metadata.labels.pool", Join: &config.ColumnJoin{Resource: "v1/nodes", Key: ".spec.nodeName"}},
+	}, cfg.K9s.Views["v1/pods"].CustomColumns)
+
+	tr, ok := cfg.K9s.Views["v1/pods"].Truncation("IMAGE")
+	assert.True(t, ok)
+	assert.Equal(t, config.ColumnTruncation{Width: 20, Mode: config.TruncateMiddle}, tr)
+	tr, ok = cfg.K9s.Views["v1/pods"].Truncation("MESSAGE")
+	assert.True(t, ok)
+	assert.Equal(t, 40, tr.Width)
+	_, ok = cfg.K9s.Views["v1/pods"].Truncation("NAME")
+	assert.False(t, ok)
 }
@@ -78,6 +78,9 @@ func (a *Alias) load() error {
 	if err := a.Load(); err != nil {
 		return err
 	}
+	a.Prune(func(gvr string) bool {
+		return MetaAccess.IsExcluded(client.NewGVR(gvr))
+	})
 
 	for _, gvr := range MetaAccess.AllGVRs() {
 		meta, err := MetaAccess.MetaFor(gvr)
@@ -2,6 +2,9 @@ package dao_test
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal"
@@ -13,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/informers"
 )
 
@@ -28,6 +32,38 @@ func TestAliasList(t *testing.T) {
 	assert.Equal(t, 2, len(oo[0].(render.AliasRes).Aliases))
 }
 
+func TestAliasReloadExcludes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-excludes")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer dao.MetaAccess.Exclude(nil)
+
+	conn, err := client.NewOfflineClient(client.NewConfig(genericclioptions.NewConfigFlags(false)), "../client/testdata/dumps")
+	assert.Nil(t, err)
+	path := filepath.Join(dir, "config.yml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("k9s:\n  refreshRate: 2\n"), 0644))
+	cfg := config.NewConfig(nil)
+	assert.Nil(t, cfg.Load(path))
+
+	dao.MetaAccess.Exclude(cfg.K9s.ExcludeResources)
+	a := dao.NewAlias(connFactory{conn: conn})
+	_, err = a.Ensure()
+	assert.Nil(t, err)
+	assert.True(t, a.Check("dp"))
+	assert.True(t, a.Check("deployments"))
+
+	raw := "k9s:\n  refreshRate: 2\n  excludeResources:\n    - apps/v1/deployments\n"
+	assert.Nil(t, ioutil.WriteFile(path, []byte(raw), 0644))
+	assert.Nil(t, cfg.Reload(path))
+	assert.True(t, dao.MetaAccess.Exclude(cfg.K9s.ExcludeResources))
+	a.Clear()
+	_, err = a.Ensure()
+	assert.Nil(t, err)
+	assert.False(t, a.Check("dp"))
+	assert.False(t, a.Check("deployments"))
+	assert.True(t, a.Check("pods"))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -69,6 +105,15 @@ func (f testFactory) Forwarders() watch.Forwarders {
 }
 func (f testFactory) DeleteForwarder(string) {}
 
+type connFactory struct {
+	testFactory
+	conn client.Connection
+}
+
+func (f connFactory) Client() client.Connection {
+	return f.conn
+}
+
 func makeFactory() dao.Factory {
 	return testFactory{}
 }
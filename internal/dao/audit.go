@@ -0,0 +1,327 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// auditTailScript tails the audit log until its stdin is closed so the remote
+// tail does not outlive the session.
+const auditTailScript = `tail -n "$0" -F "$1" & pid=$!; cat >/dev/null; kill $pid`
+
+const (
+	auditTailLines     = "200"
+	auditLokiPollDelay = 2 * time.Second
+	auditLokiLimit     = 500
+)
+
+// AuditEvent represents an api server audit event.
+type AuditEvent struct {
+	ID, Stage, Verb, User string
+	Resource, Namespace   string
+	Name, SourceIP, Agent string
+	Code                  int
+	Time                  time.Time
+}
+
+// Path returns the audited resource path.
+func (e AuditEvent) Path() string {
+	return client.FQN(e.Namespace, e.Name)
+}
+
+// auditEvent mirrors the audit.k8s.io/v1 event fields of interest.
+type auditEvent struct {
+	AuditID   string   `json:"auditID"`
+	Stage     string   `json:"stage"`
+	Verb      string   `json:"verb"`
+	SourceIPs []string `json:"sourceIPs"`
+	UserAgent string   `json:"userAgent"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ImpersonatedUser *struct {
+		Username string `json:"username"`
+	} `json:"impersonatedUser"`
+	ObjectRef *struct {
+		Resource    string `json:"resource"`
+		Subresource string `json:"subresource"`
+		Namespace   string `json:"namespace"`
+		Name        string `json:"name"`
+		APIGroup    string `json:"apiGroup"`
+	} `json:"objectRef"`
+	ResponseStatus *struct {
+		Code int `json:"code"`
+	} `json:"responseStatus"`
+	StageTimestamp           time.Time `json:"stageTimestamp"`
+	RequestReceivedTimestamp time.Time `json:"requestReceivedTimestamp"`
+}
+
+// ParseAuditEvent parses an audit log json line.
+func ParseAuditEvent(raw []byte) (AuditEvent, error) {
+	var (
+		a AuditEvent
+		e auditEvent
+	)
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return a, err
+	}
+	if e.AuditID == "" || e.Verb == "" {
+		return a, errors.New("not an audit event")
+	}
+
+	a = AuditEvent{
+		ID:    e.AuditID,
+		Stage: e.Stage,
+		Verb:  e.Verb,
+		User:  e.User.Username,
+		Agent: e.UserAgent,
+		Time:  e.StageTimestamp,
+	}
+	if a.Time.IsZero() {
+		a.Time = e.RequestReceivedTimestamp
+	}
+	if e.ImpersonatedUser != nil {
+		a.User += " as " + e.ImpersonatedUser.Username
+	}
+	if len(e.SourceIPs) > 0 {
+		a.SourceIP = e.SourceIPs[0]
+	}
+	if r := e.ObjectRef; r != nil {
+		a.Resource, a.Namespace, a.Name = r.Resource, r.Namespace, r.Name
+		if r.Subresource != "" {
+			a.Resource += "/" + r.Subresource
+		}
+		if r.APIGroup != "" {
+			a.Resource = r.APIGroup + "/" + a.Resource
+		}
+	}
+	if e.ResponseStatus != nil {
+		a.Code = e.ResponseStatus.Code
+	}
+
+	return a, nil
+}
+
+// AuditFilter filters audit events by user, verb or resource.
+type AuditFilter struct {
+	User, Verb, Resource string
+}
+
+// ParseAuditFilter parses user=, verb= and resource= filter terms.
+func ParseAuditFilter(tokens []string) (AuditFilter, error) {
+	var f AuditFilter
+	for _, t := range tokens {
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return f, fmt.Errorf("invalid audit filter %q. Expecting user=, verb= or resource=", t)
+		}
+		switch kv[0] {
+		case "user", "u":
+			f.User = kv[1]
+		case "verb", "v":
+			f.Verb = kv[1]
+		case "resource", "res", "r":
+			f.Resource = kv[1]
+		default:
+			return f, fmt.Errorf("unknown audit filter %q", kv[0])
+		}
+	}
+
+	return f, nil
+}
+
+// String returns the filter terms.
+func (f AuditFilter) String() string {
+	var ss []string
+	if f.User != "" {
+		ss = append(ss, "user="+f.User)
+	}
+	if f.Verb != "" {
+		ss = append(ss, "verb="+f.Verb)
+	}
+	if f.Resource != "" {
+		ss = append(ss, "resource="+f.Resource)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// Matches returns true if an event matches the filter. Users and resources
+// match on substrings, verbs must match exactly.
+func (f AuditFilter) Matches(e AuditEvent) bool {
+	if f.Verb != "" && f.Verb != e.Verb {
+		return false
+	}
+	if f.User != "" && !strings.Contains(e.User, f.User) {
+		return false
+	}
+	if f.Resource != "" && !strings.Contains(e.Resource, f.Resource) {
+		return false
+	}
+
+	return true
+}
+
+// TailAudit streams the audit events from the configured source until the
+// context is canceled. Only completed requests are reported.
+func TailAudit(ctx context.Context, f Factory, cfg *config.Audit, out chan<- AuditEvent) error {
+	switch cfg.Source {
+	case config.AuditSourceLoki:
+		return tailAuditLoki(ctx, cfg, out)
+	default:
+		return tailAuditPod(ctx, f, cfg, out)
+	}
+}
+
+func tailAuditPod(ctx context.Context, f Factory, cfg *config.Audit, out chan<- AuditEvent) error {
+	if cfg.Pod == "" {
+		return errors.New("no audit pod configured. Set audit.pod or audit.lokiURL in the k9s config")
+	}
+	r, w := io.Pipe()
+	sr, sw := io.Pipe()
+	go func() {
+		<-ctx.Done()
+		_ = sw.Close()
+		_ = r.Close()
+	}()
+
+	errs := make(chan error, 1)
+	go func() {
+		err := Exec(f.Client(), cfg.Pod, ExecOptions{
+			Container: cfg.Container,
+			Command:   []string{"sh", "-c", auditTailScript, auditTailLines, cfg.File},
+			Stdin:     sr,
+			Stdout:    w,
+			Batch:     true,
+		})
+		_ = w.CloseWithError(err)
+		errs <- err
+	}()
+
+	scanAudit(ctx, r, out)
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return <-errs
+}
+
+func scanAudit(ctx context.Context, r io.Reader, out chan<- AuditEvent) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		emitAudit(ctx, scanner.Bytes(), out)
+	}
+}
+
+func emitAudit(ctx context.Context, raw []byte, out chan<- AuditEvent) {
+	e, err := ParseAuditEvent(raw)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Skipping audit line")
+		return
+	}
+	if e.Stage != "" && e.Stage != "ResponseComplete" && e.Stage != "Panic" {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case out <- e:
+	}
+}
+
+// lokiResponse mirrors a Loki query_range streams response.
+type lokiResponse struct {
+	Data struct {
+		Result []struct {
+			Values [][]string `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func tailAuditLoki(ctx context.Context, cfg *config.Audit, out chan<- AuditEvent) error {
+	if cfg.LokiURL == "" {
+		return errors.New("no audit Loki endpoint configured. Set audit.lokiURL in the k9s config")
+	}
+	c := http.Client{Timeout: client.CallTimeout}
+	start := time.Now().Add(-time.Hour).UnixNano()
+	for {
+		last, err := pollLoki(ctx, &c, cfg, start, out)
+		if err != nil {
+			return err
+		}
+		if last >= start {
+			start = last + 1
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(auditLokiPollDelay):
+		}
+	}
+}
+
+func pollLoki(ctx context.Context, c *http.Client, cfg *config.Audit, start int64, out chan<- AuditEvent) (int64, error) {
+	q := url.Values{}
+	q.Set("query", cfg.Query)
+	q.Set("start", strconv.FormatInt(start, 10))
+	q.Set("direction", "forward")
+	q.Set("limit", strconv.Itoa(auditLokiLimit))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(cfg.LokiURL, "/")+"/loki/api/v1/query_range?"+q.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing Loki response")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("loki query failed: %s", resp.Status)
+	}
+
+	var lr lokiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
+		return 0, err
+	}
+
+	return emitLoki(ctx, lr, out), nil
+}
+
+// emitLoki emits the Loki entries as audit events and returns the latest
+// entry timestamp.
+func emitLoki(ctx context.Context, lr lokiResponse, out chan<- AuditEvent) int64 {
+	var last int64
+	for _, r := range lr.Data.Result {
+		for _, v := range r.Values {
+			if len(v) != 2 {
+				continue
+			}
+			if ts, err := strconv.ParseInt(v[0], 10, 64); err == nil && ts > last {
+				last = ts
+			}
+			emitAudit(ctx, []byte(v[1]), out)
+		}
+	}
+
+	return last
+}
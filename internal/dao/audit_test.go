@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuditEvent(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		err bool
+		e   dao.AuditEvent
+	}{
+		"full": {
+			raw: `{"kind":"Event","apiVersion":"audit.k8s.io/v1","level":"Metadata","auditID":"a1","stage":"ResponseComplete","verb":"delete","user":{"username":"fernand"},"sourceIPs":["10.0.0.1"],"userAgent":"kubectl/v1.18.2","objectRef":{"resource":"deployments","namespace":"default","name":"nginx","apiGroup":"apps"},"responseStatus":{"code":200},"stageTimestamp":"2020-05-02T10:00:00Z"}`,
+			e: dao.AuditEvent{
+				ID:        "a1",
+				Stage:     "ResponseComplete",
+				Verb:      "delete",
+				User:      "fernand",
+				Resource:  "apps/deployments",
+				Namespace: "default",
+				Name:      "nginx",
+				SourceIP:  "10.0.0.1",
+				Agent:     "kubectl/v1.18.2",
+				Code:      200,
+				Time:      time.Date(2020, 5, 2, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		"subresource": {
+			raw: `{"auditID":"a2","verb":"create","user":{"username":"admin"},"impersonatedUser":{"username":"bozo"},"objectRef":{"resource":"pods","subresource":"exec","namespace":"ns1","name":"p1"}}`,
+			e: dao.AuditEvent{
+				ID:        "a2",
+				Verb:      "create",
+				User:      "admin as bozo",
+				Resource:  "pods/exec",
+				Namespace: "ns1",
+				Name:      "p1",
+			},
+		},
+		"notAudit": {
+			raw: `{"level":"info","msg":"blee"}`,
+			err: true,
+		},
+		"toast": {
+			raw: `blee`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := dao.ParseAuditEvent([]byte(u.raw))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
+
+func TestAuditFilter(t *testing.T) {
+	e := dao.AuditEvent{User: "system:serviceaccount:kube-system:ctrl", Verb: "update", Resource: "apps/deployments"}
+
+	uu := map[string]struct {
+		tokens []string
+		err    bool
+		match  bool
+	}{
+		"blank": {
+			match: true,
+		},
+		"user": {
+			tokens: []string{"user=kube-system"},
+			match:  true,
+		},
+		"verb": {
+			tokens: []string{"verb=delete"},
+		},
+		"all": {
+			tokens: []string{"u=ctrl", "v=update", "r=deployments"},
+			match:  true,
+		},
+		"unknown": {
+			tokens: []string{"blee=duh"},
+			err:    true,
+		},
+		"toast": {
+			tokens: []string{"user"},
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := dao.ParseAuditFilter(u.tokens)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.match, f.Matches(e))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	autoscalerNS           = "kube-system"
+	autoscalerStatusCM     = "cluster-autoscaler-status"
+	autoscalerSource       = "cluster-autoscaler"
+	autoscalerSafeToEvict  = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+	autoscalerMaxEvents    = 20
+	autoscalerClusterGroup = "Cluster-wide"
+)
+
+var nodeGroupSizeRX = regexp.MustCompile(`minSize=(\d+), maxSize=(\d+)`)
+
+// AutoscalerCondition represents a cluster-autoscaler status entry, ie ScaleUp.
+type AutoscalerCondition struct {
+	Group, Name, Status, Details string
+}
+
+// NodeGroupLimits represents a node group size limits.
+type NodeGroupLimits struct {
+	Name, Min, Max string
+}
+
+// AutoscalerStatus represents the parsed cluster-autoscaler status configmap.
+type AutoscalerStatus struct {
+	Time       string
+	Conditions []AutoscalerCondition
+	Limits     []NodeGroupLimits
+}
+
+// AutoscalerPod represents a pod relevant to autoscaling decisions.
+type AutoscalerPod struct {
+	Path, Reason string
+}
+
+// AutoscalerReport represents the cluster-autoscaler activity.
+type AutoscalerReport struct {
+	Status        AutoscalerStatus
+	Events        []v1.Event
+	Unschedulable []AutoscalerPod
+	Blockers      []AutoscalerPod
+}
+
+// FetchAutoscaler collects the cluster-autoscaler status, its latest events and
+// the pods preventing scale ups or downs.
+func FetchAutoscaler(f Factory) (*AutoscalerReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+
+	dial := f.Client().DialOrDie().CoreV1()
+	cm, err := dial.ConfigMaps(autoscalerNS).Get(ctx, autoscalerStatusCM, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("no %s/%s configmap found. Is cluster-autoscaler installed?", autoscalerNS, autoscalerStatusCM)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r := AutoscalerReport{Status: ParseAutoscalerStatus(cm.Data["status"])}
+
+	ee, err := dial.Events(client.AllNamespaces).List(ctx, metav1.ListOptions{FieldSelector: "source=" + autoscalerSource})
+	if err != nil {
+		return nil, err
+	}
+	r.Events = latestEvents(ee.Items, autoscalerMaxEvents)
+
+	oo, err := f.List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		path := client.FQN(po.Namespace, po.Name)
+		if reason, ok := Unschedulable(&po); ok {
+			r.Unschedulable = append(r.Unschedulable, AutoscalerPod{Path: path, Reason: reason})
+		}
+		if reason, ok := ScaleDownBlocker(&po); ok {
+			r.Blockers = append(r.Blockers, AutoscalerPod{Path: path, Reason: reason})
+		}
+	}
+
+	return &r, nil
+}
+
+// ParseAutoscalerStatus parses the cluster-autoscaler status report.
+func ParseAutoscalerStatus(s string) AutoscalerStatus {
+	var (
+		st    AutoscalerStatus
+		group = autoscalerClusterGroup
+	)
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "Cluster-autoscaler status at ") {
+			st.Time = strings.TrimSuffix(strings.TrimPrefix(line, "Cluster-autoscaler status at "), ":")
+			continue
+		}
+		k, v, ok := statusField(line)
+		if !ok {
+			continue
+		}
+		switch k {
+		case "Name":
+			group = v
+		case "Health", "ScaleUp", "ScaleDown":
+			status, details := v, ""
+			if i := strings.Index(v, " ("); i > 0 {
+				status, details = v[:i], strings.TrimSuffix(v[i+2:], ")")
+			}
+			st.Conditions = append(st.Conditions, AutoscalerCondition{Group: group, Name: k, Status: status, Details: details})
+			if mm := nodeGroupSizeRX.FindStringSubmatch(v); k == "Health" && len(mm) == 3 {
+				st.Limits = append(st.Limits, NodeGroupLimits{Name: group, Min: mm[1], Max: mm[2]})
+			}
+		}
+	}
+
+	return st
+}
+
+func statusField(line string) (string, string, bool) {
+	tokens := strings.SplitN(strings.TrimSpace(line), ":", 2)
+	if len(tokens) != 2 || strings.Contains(tokens[0], " ") {
+		return "", "", false
+	}
+
+	return tokens[0], strings.TrimSpace(tokens[1]), true
+}
+
+// Unschedulable returns true if the scheduler could not place a pod.
+func Unschedulable(po *v1.Pod) (string, bool) {
+	if po.Status.Phase != v1.PodPending {
+		return "", false
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodScheduled && c.Status == v1.ConditionFalse && c.Reason == v1.PodReasonUnschedulable {
+			return c.Message, true
+		}
+	}
+
+	return "", false
+}
+
+// ScaleDownBlocker returns true if a pod prevents its node from being scaled down.
+func ScaleDownBlocker(po *v1.Pod) (string, bool) {
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed || po.Spec.NodeName == "" {
+		return "", false
+	}
+	switch po.Annotations[autoscalerSafeToEvict] {
+	case "false":
+		return autoscalerSafeToEvict + "=false", true
+	case "true":
+		return "", false
+	}
+	if _, ok := po.Annotations[v1.MirrorPodAnnotationKey]; ok {
+		return "", false
+	}
+	if len(po.OwnerReferences) == 0 {
+		return "not backed by a controller", true
+	}
+	for _, o := range po.OwnerReferences {
+		if o.Kind == "DaemonSet" {
+			return "", false
+		}
+	}
+	for _, v := range po.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return "uses local storage (emptyDir " + v.Name + ")", true
+		}
+	}
+
+	return "", false
+}
+
+func latestEvents(ee []v1.Event, max int) []v1.Event {
+	sort.SliceStable(ee, func(i, j int) bool {
+		return eventTime(ee[i]).After(eventTime(ee[j]).Time)
+	})
+	if len(ee) > max {
+		ee = ee[:max]
+	}
+
+	return ee
+}
+
+func eventTime(e v1.Event) metav1.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp
+	}
+	if !e.EventTime.IsZero() {
+		return metav1.Time{Time: e.EventTime.Time}
+	}
+
+	return e.FirstTimestamp
+}
@@ -0,0 +1,106 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const caStatus = `Cluster-autoscaler status at 2020-05-12 10:00:00.123 +0000 UTC:
+Cluster-wide:
+  Health:      Healthy (ready=3 unready=0 notStarted=0 longNotStarted=0 registered=3 longUnregistered=0)
+               LastProbeTime:      2020-05-12 10:00:00.1 +0000 UTC
+  ScaleUp:     InProgress (ready=3 registered=3)
+  ScaleDown:   NoCandidates (candidates=0)
+
+NodeGroups:
+  Name:        ng-1
+  Health:      Healthy (ready=3 unready=0 notStarted=0 longNotStarted=0 registered=3 longUnregistered=0 cloudProviderTarget=3 (minSize=1, maxSize=10))
+  ScaleUp:     NoActivity (ready=3 cloudProviderTarget=3)
+  ScaleDown:   NoCandidates (candidates=0)
+`
+
+func TestParseAutoscalerStatus(t *testing.T) {
+	st := dao.ParseAutoscalerStatus(caStatus)
+
+	assert.Equal(t, "2020-05-12 10:00:00.123 +0000 UTC", st.Time)
+	assert.Equal(t, 6, len(st.Conditions))
+	assert.Equal(t, dao.AutoscalerCondition{Group: "Cluster-wide", Name: "ScaleUp", Status: "InProgress", Details: "ready=3 registered=3"}, st.Conditions[1])
+	assert.Equal(t, "ng-1", st.Conditions[3].Group)
+	assert.Equal(t, "ready=3 unready=0 notStarted=0 longNotStarted=0 registered=3 longUnregistered=0 cloudProviderTarget=3 (minSize=1, maxSize=10)", st.Conditions[3].Details)
+	assert.Equal(t, []dao.NodeGroupLimits{{Name: "ng-1", Min: "1", Max: "10"}}, st.Limits)
+}
+
+func TestUnschedulable(t *testing.T) {
+	po := v1.Pod{Status: v1.PodStatus{
+		Phase: v1.PodPending,
+		Conditions: []v1.PodCondition{
+			{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: v1.PodReasonUnschedulable, Message: "0/3 nodes are available"},
+		},
+	}}
+	reason, ok := dao.Unschedulable(&po)
+	assert.True(t, ok)
+	assert.Equal(t, "0/3 nodes are available", reason)
+
+	po.Status.Phase = v1.PodRunning
+	_, ok = dao.Unschedulable(&po)
+	assert.False(t, ok)
+}
+
+func TestScaleDownBlocker(t *testing.T) {
+	rs := []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "fred"}}
+	uu := map[string]struct {
+		po     v1.Pod
+		reason string
+		ok     bool
+	}{
+		"evictable": {
+			po: v1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: rs}, Spec: v1.PodSpec{NodeName: "n1"}},
+		},
+		"annotated": {
+			po: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: rs, Annotations: map[string]string{"cluster-autoscaler.kubernetes.io/safe-to-evict": "false"}},
+				Spec:       v1.PodSpec{NodeName: "n1"},
+			},
+			reason: "cluster-autoscaler.kubernetes.io/safe-to-evict=false", ok: true,
+		},
+		"bare": {
+			po:     v1.Pod{Spec: v1.PodSpec{NodeName: "n1"}},
+			reason: "not backed by a controller", ok: true,
+		},
+		"local-storage": {
+			po: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: rs},
+				Spec:       v1.PodSpec{NodeName: "n1", Volumes: []v1.Volume{{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}},
+			},
+			reason: "uses local storage (emptyDir tmp)", ok: true,
+		},
+		"bare-safe": {
+			po: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"cluster-autoscaler.kubernetes.io/safe-to-evict": "true"}},
+				Spec:       v1.PodSpec{NodeName: "n1"},
+			},
+		},
+		"daemonset": {
+			po: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet"}}},
+				Spec:       v1.PodSpec{NodeName: "n1", Volumes: []v1.Volume{{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}},
+			},
+		},
+		"unscheduled": {
+			po: v1.Pod{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			reason, ok := dao.ScaleDownBlocker(&u.po)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.reason, reason)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package dao
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	bundleLogFile    = "k9s.log"
+	bundleErrorsFile = "errors.txt"
+	lastAppliedKey   = "kubectl.kubernetes.io/last-applied-configuration"
+	redactedText     = "<redacted>"
+
+	// bundleSyncTimeout tracks how long to wait for a resource cache to load.
+	bundleSyncTimeout = 30 * time.Second
+)
+
+// RedactedValue replaces secret values in support bundles.
+var RedactedValue = base64.StdEncoding.EncodeToString([]byte(redactedText))
+
+// podSpecPaths tracks where pod specs live in workloads manifests.
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// SaveBundle writes a support bundle archive to path.
+func SaveBundle(f Factory, path, ns string, cfg *config.Bundle, logPath string) error {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing bundle %q", path)
+		}
+	}()
+
+	return WriteBundle(f, out, ns, cfg, logPath)
+}
+
+// WriteBundle writes a tar.gz of the configured resources manifests, listing errors and
+// the tail of the K9s logs. Resources are stored as yaml so bundles can be browsed offline.
+func WriteBundle(f Factory, w io.Writer, ns string, cfg *config.Bundle, logPath string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	var errs []string
+	for _, gvr := range cfg.Resources {
+		raw, err := bundleResources(f, client.NewGVR(gvr), ns, cfg.Redact)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", gvr, err))
+			continue
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		name := "resources/" + strings.ReplaceAll(gvr, "/", "_") + ".yaml"
+		if err := addBundleFile(tw, name, raw); err != nil {
+			return err
+		}
+	}
+
+	if cfg.LogLines > 0 {
+		raw, err := tailFile(logPath, cfg.LogLines)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("logs: %s", err))
+		} else if err := addBundleFile(tw, bundleLogFile, raw); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		if err := addBundleFile(tw, bundleErrorsFile, []byte(strings.Join(errs, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func bundleResources(f Factory, gvr client.GVR, ns string, redact bool) ([]byte, error) {
+	if m, err := MetaAccess.MetaFor(gvr); err == nil && !m.Namespaced {
+		ns = client.ClusterScope
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), bundleSyncTimeout)
+	defer cancel()
+	if err := WaitSynced(ctx, f, ns, gvr.String()); err != nil {
+		return nil, err
+	}
+	oo, err := f.List(gvr.String(), ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var buff bytes.Buffer
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if redact {
+			u = RedactObject(u)
+		}
+		raw, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		buff.WriteString("---\n")
+		buff.Write(raw)
+	}
+
+	return buff.Bytes(), nil
+}
+
+// RedactSecret returns a copy of a secret with all its values redacted.
+func RedactSecret(u *unstructured.Unstructured) *unstructured.Unstructured {
+	s := u.DeepCopy()
+	for _, field := range []string{"data", "stringData"} {
+		m, ok := s.Object[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k := range m {
+			m[k] = RedactedValue
+		}
+	}
+	if aa := s.GetAnnotations(); aa != nil {
+		delete(aa, lastAppliedKey)
+		s.SetAnnotations(aa)
+	}
+
+	return s
+}
+
+// RedactObject returns a copy of a resource with its sensitive values redacted,
+// ie secrets and configmaps data, containers env values and annotations.
+func RedactObject(u *unstructured.Unstructured) *unstructured.Unstructured {
+	if u.GetKind() == "Secret" {
+		u = RedactSecret(u)
+	} else {
+		u = u.DeepCopy()
+	}
+	if u.GetKind() == "ConfigMap" {
+		redactFields(u.Object, "data", redactedText)
+		redactFields(u.Object, "binaryData", RedactedValue)
+	}
+	for _, p := range podSpecPaths {
+		spec, ok, _ := unstructured.NestedMap(u.Object, p...)
+		if !ok {
+			continue
+		}
+		redactEnv(spec)
+		_ = unstructured.SetNestedMap(u.Object, spec, p...)
+	}
+	if aa := u.GetAnnotations(); len(aa) > 0 {
+		delete(aa, lastAppliedKey)
+		for k := range aa {
+			aa[k] = redactedText
+		}
+		u.SetAnnotations(aa)
+	}
+
+	return u
+}
+
+func redactFields(o map[string]interface{}, field, v string) {
+	m, ok := o[field].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k := range m {
+		m[k] = v
+	}
+}
+
+// redactEnv redacts a pod spec containers literal env values.
+func redactEnv(spec map[string]interface{}) {
+	for _, f := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		cc, ok := spec[f].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, c := range cc {
+			co, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ee, ok := co["env"].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, e := range ee {
+				if eo, ok := e.(map[string]interface{}); ok {
+					if _, ok := eo["value"]; ok {
+						eo["value"] = redactedText
+					}
+				}
+			}
+		}
+	}
+}
+
+func addBundleFile(tw *tar.Writer, name string, raw []byte) error {
+	h := tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(raw)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err := tw.Write(raw)
+
+	return err
+}
+
+func tailFile(path string, lines int) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing log %q", path)
+		}
+	}()
+
+	ll := make([]string, 0, lines)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if len(ll) == lines {
+			ll = ll[1:]
+		}
+		ll = append(ll, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ll) == 0 {
+		return nil, nil
+	}
+
+	return []byte(strings.Join(ll, "\n") + "\n"), nil
+}
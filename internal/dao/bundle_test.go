@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWriteBundle(t *testing.T) {
+	f := newDepFactory()
+	f.add("v1/pods", makeDep("default", "p1", "u1", ""))
+	f.add("v1/secrets", makeSecret("default", "s1"))
+
+	logs, err := ioutil.TempFile("", "k9s-log")
+	assert.Nil(t, err)
+	defer os.Remove(logs.Name())
+	_, err = logs.WriteString("l1\nl2\nl3\n")
+	assert.Nil(t, err)
+	assert.Nil(t, logs.Close())
+
+	uu := map[string]struct {
+		redact bool
+		secret string
+	}{
+		"redact": {redact: true, secret: RedactedValue},
+		"raw":    {secret: "YmxlZQ=="},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			cfg := config.Bundle{
+				Resources: []string{"v1/pods", "v1/secrets", "v1/configmaps"},
+				Redact:    u.redact,
+				LogLines:  2,
+			}
+			assert.Nil(t, WriteBundle(f, &buff, client.AllNamespaces, &cfg, logs.Name()))
+
+			ff := untar(t, &buff)
+			assert.Equal(t, 3, len(ff))
+			assert.Contains(t, ff["resources/v1_pods.yaml"], "name: p1")
+			assert.Contains(t, ff["resources/v1_secrets.yaml"], "pwd: "+u.secret)
+			assert.Equal(t, "l2\nl3\n", ff["k9s.log"])
+		})
+	}
+}
+
+func TestRedactSecret(t *testing.T) {
+	s := makeSecret("default", "s1")
+	s.SetAnnotations(map[string]string{lastAppliedKey: "blee", "fred": "zorg"})
+
+	r := RedactSecret(s)
+	assert.Equal(t, RedactedValue, r.Object["data"].(map[string]interface{})["pwd"])
+	assert.Equal(t, map[string]string{"fred": "zorg"}, r.GetAnnotations())
+	assert.Equal(t, "YmxlZQ==", s.Object["data"].(map[string]interface{})["pwd"])
+}
+
+func TestRedactObject(t *testing.T) {
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":        "cm1",
+			"annotations": map[string]interface{}{"fred": "zorg"},
+		},
+		"data": map[string]interface{}{"url": "postgres://u:p@db"},
+	}}
+	r := RedactObject(cm)
+	assert.Equal(t, redactedText, r.Object["data"].(map[string]interface{})["url"])
+	assert.Equal(t, map[string]string{"fred": redactedText}, r.GetAnnotations())
+	assert.Equal(t, "postgres://u:p@db", cm.Object["data"].(map[string]interface{})["url"])
+
+	dp := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "dp1"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name": "c1",
+							"env": []interface{}{
+								map[string]interface{}{"name": "TOKEN", "value": "s3cr3t"},
+								map[string]interface{}{"name": "REF", "valueFrom": map[string]interface{}{}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+	r = RedactObject(dp)
+	cc, _, _ := unstructured.NestedSlice(r.Object, "spec", "template", "spec", "containers")
+	ee := cc[0].(map[string]interface{})["env"].([]interface{})
+	assert.Equal(t, redactedText, ee[0].(map[string]interface{})["value"])
+	_, ok := ee[1].(map[string]interface{})["value"]
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func makeSecret(ns, n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"namespace": ns,
+			"name":      n,
+		},
+		"data": map[string]interface{}{
+			"pwd": "YmxlZQ==",
+		},
+	}}
+}
+
+func untar(t *testing.T, r io.Reader) map[string]string {
+	gz, err := gzip.NewReader(r)
+	assert.Nil(t, err)
+	tr := tar.NewReader(gz)
+	ff := make(map[string]string)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		raw, err := ioutil.ReadAll(tr)
+		assert.Nil(t, err)
+		ff[h.Name] = string(raw)
+	}
+
+	return ff
+}
@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// WatchEvent represents a recorded resource watch event.
+type WatchEvent struct {
+	Time   time.Time              `json:"time"`
+	Type   watch.EventType        `json:"type"`
+	Object map[string]interface{} `json:"object"`
+}
+
+// CaptureWatch records all resource events as json lines until the context is done.
+// The initial events describe the existing resources and secrets values are
+// redacted. Returns the number of recorded events.
+func CaptureWatch(ctx context.Context, res dynamic.ResourceInterface, out io.Writer) (int, error) {
+	var (
+		count int
+		rv    string
+		e     = json.NewEncoder(out)
+	)
+	for {
+		w, err := res.Watch(ctx, metav1.ListOptions{ResourceVersion: rv})
+		if err != nil {
+			return count, err
+		}
+		var expired bool
+		rv, expired, err = captureEvents(ctx, w, e, rv, &count)
+		w.Stop()
+		if err != nil || ctx.Err() != nil {
+			return count, err
+		}
+		if expired {
+			log.Debug().Msg("Capture watch expired. Restarting")
+			rv = ""
+		}
+	}
+}
+
+func captureEvents(ctx context.Context, w watch.Interface, e *json.Encoder, rv string, count *int) (string, bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return rv, false, nil
+		case evt, ok := <-w.ResultChan():
+			if !ok {
+				return rv, false, nil
+			}
+			if evt.Type == watch.Error {
+				if s, ok := evt.Object.(*metav1.Status); ok && s.Code == http.StatusGone {
+					return rv, true, nil
+				}
+				return rv, false, fmt.Errorf("capture watch failed: %v", evt.Object)
+			}
+			o := evt.Object
+			if u, ok := o.(*unstructured.Unstructured); ok && u.GetKind() == "Secret" {
+				o = RedactSecret(u)
+			}
+			m, err := toMap(o)
+			if err != nil {
+				return rv, false, err
+			}
+			if err := e.Encode(WatchEvent{Time: time.Now(), Type: evt.Type, Object: m}); err != nil {
+				return rv, false, err
+			}
+			*count++
+			if u, ok := evt.Object.(*unstructured.Unstructured); ok {
+				rv = u.GetResourceVersion()
+			}
+		}
+	}
+}
+
+func toMap(o runtime.Object) (map[string]interface{}, error) {
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		return u.Object, nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+}
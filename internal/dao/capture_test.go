@@ -0,0 +1,93 @@
+package dao_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestCaptureWatch(t *testing.T) {
+	f := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	w := watch.NewFake()
+	f.PrependWatchReactor("*", ktesting.DefaultWatchReactor(w, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		o := capturePod("fred")
+		w.Add(o)
+		w.Modify(o)
+		w.Delete(o)
+		cancel()
+	}()
+
+	var buff bytes.Buffer
+	res := f.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace("default")
+	count, err := dao.CaptureWatch(ctx, res, &buff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	ll := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	assert.Equal(t, 3, len(ll))
+	for i, e := range []watch.EventType{watch.Added, watch.Modified, watch.Deleted} {
+		var evt dao.WatchEvent
+		assert.Nil(t, json.Unmarshal([]byte(ll[i]), &evt))
+		assert.Equal(t, e, evt.Type)
+		assert.Equal(t, "fred", evt.Object["metadata"].(map[string]interface{})["name"])
+	}
+}
+
+func TestCaptureWatchSecrets(t *testing.T) {
+	f := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	w := watch.NewFake()
+	f.PrependWatchReactor("*", ktesting.DefaultWatchReactor(w, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		w.Add(captureSecret("fred"))
+		cancel()
+	}()
+
+	var buff bytes.Buffer
+	res := f.Resource(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}).Namespace("default")
+	count, err := dao.CaptureWatch(ctx, res, &buff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.NotContains(t, buff.String(), "czNjcjN0")
+	var evt dao.WatchEvent
+	assert.Nil(t, json.Unmarshal(buff.Bytes(), &evt))
+	assert.Equal(t, dao.RedactedValue, evt.Object["data"].(map[string]interface{})["password"])
+}
+
+// Helpers...
+
+func captureSecret(n string) *unstructured.Unstructured {
+	o := capturePod(n)
+	o.SetKind("Secret")
+	o.Object["data"] = map[string]interface{}{"password": "czNjcjN0"}
+
+	return o
+}
+
+func capturePod(n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"namespace":       "default",
+			"name":            n,
+			"resourceVersion": "1",
+		},
+	}}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Victims returns up to count random pods matching a selector. Terminating pods are skipped.
+func Victims(f Factory, ns string, sel map[string]string, count int) ([]string, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]string, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if u.GetDeletionTimestamp() != nil {
+			continue
+		}
+		pp = append(pp, client.FQN(u.GetNamespace(), u.GetName()))
+	}
+	if len(pp) == 0 {
+		return nil, fmt.Errorf("no matching pods for %v", sel)
+	}
+	rand.Shuffle(len(pp), func(i, j int) { pp[i], pp[j] = pp[j], pp[i] })
+	if count < len(pp) {
+		pp = pp[:count]
+	}
+	sort.Strings(pp)
+
+	return pp, nil
+}
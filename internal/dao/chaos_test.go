@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVictims(t *testing.T) {
+	f := newDepFactory()
+	f.add("v1/pods", makeDep("default", "p1", "u1", ""))
+	f.add("v1/pods", makeDep("default", "p2", "u2", ""))
+	f.add("v1/pods", makeDep("default", "p3", "u3", ""))
+	dead := makeDep("default", "p4", "u4", "")
+	now := metav1.Now()
+	dead.SetDeletionTimestamp(&now)
+	f.add("v1/pods", dead)
+	all := []string{"default/p1", "default/p2", "default/p3"}
+
+	uu := map[string]struct {
+		ns    string
+		count int
+		e     int
+		err   bool
+	}{
+		"one":      {ns: "default", count: 1, e: 1},
+		"some":     {ns: "default", count: 2, e: 2},
+		"overflow": {ns: "default", count: 10, e: 3},
+		"none":     {ns: "blee", count: 1, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := Victims(f, u.ns, map[string]string{"app": "fred"}, u.count)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, len(pp))
+			assert.Subset(t, all, pp)
+		})
+	}
+}
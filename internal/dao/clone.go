@@ -0,0 +1,190 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	// maskedSecret hides a secret value in diffs.
+	maskedSecret = "<masked>"
+
+	// maskedSecretChanged hides a secret value that differs from the target one.
+	maskedSecretChanged = "<masked:changed>"
+)
+
+// Clone copies a resource into a target namespace and/or name.
+func Clone(ctx context.Context, f Factory, gvr client.GVR, path, ns, name string) error {
+	srcNS, srcName := client.Namespaced(path)
+	if ns == srcNS && name == srcName {
+		return fmt.Errorf("clone of %s must target another namespace or name", path)
+	}
+	u, err := loadUnstructured(f, gvr, path)
+	if err != nil {
+		return err
+	}
+
+	auth, err := f.Client().CanI(ns, gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to create %s in namespace %q", gvr, ns)
+	}
+
+	c := CloneManifest(gvr, u, ns, name)
+	dial := f.Client().DynDialOrDie().Resource(gvr.GVR())
+	if c.GetNamespace() == "" {
+		_, err = dial.Create(ctx, c, metav1.CreateOptions{})
+	} else {
+		_, err = dial.Namespace(c.GetNamespace()).Create(ctx, c, metav1.CreateOptions{})
+	}
+
+	return err
+}
+
+// CloneManifest returns a copy of a resource stripped from its status, identity
+// and cluster assigned fields, targeting the given namespace and name.
+func CloneManifest(gvr client.GVR, u *unstructured.Unstructured, ns, name string) *unstructured.Unstructured {
+	c := u.DeepCopy()
+	stripServerFields(c)
+	c.SetOwnerReferences(nil)
+	c.SetFinalizers(nil)
+	aa := c.GetAnnotations()
+	delete(aa, lastAppliedAnnotation)
+	if len(aa) == 0 {
+		aa = nil
+	}
+	c.SetAnnotations(aa)
+	if c.GetNamespace() != "" {
+		c.SetNamespace(ns)
+	}
+	c.SetName(name)
+
+	switch gvr.String() {
+	case "v1/services":
+		unstructured.RemoveNestedField(c.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(c.Object, "spec", "clusterIPs")
+		unstructured.RemoveNestedField(c.Object, "spec", "healthCheckNodePort")
+	case "v1/pods":
+		unstructured.RemoveNestedField(c.Object, "spec", "nodeName")
+	case "v1/persistentvolumeclaims":
+		unstructured.RemoveNestedField(c.Object, "spec", "volumeName")
+	case "batch/v1/jobs":
+		unstructured.RemoveNestedField(c.Object, "spec", "selector")
+		for _, l := range []string{"controller-uid", "job-name"} {
+			unstructured.RemoveNestedField(c.Object, "spec", "template", "metadata", "labels", l)
+		}
+	}
+
+	return c
+}
+
+// ClonePlan tracks a pending clone of a resource into another cluster.
+type ClonePlan struct {
+	GVR      client.GVR
+	Manifest *unstructured.Unstructured
+	Existing *unstructured.Unstructured
+	Diff     []string
+}
+
+// PlanClone prepares a clone of a resource into a target connection, checking
+// the target access independently and diffing against an existing target resource.
+func PlanClone(ctx context.Context, f Factory, dst client.Connection, gvr client.GVR, path, ns, name string) (*ClonePlan, error) {
+	u, err := loadUnstructured(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	p := ClonePlan{GVR: gvr, Manifest: CloneManifest(gvr, u, ns, name)}
+
+	existing, err := dst.DynDialOrDie().Resource(gvr.GVR()).Namespace(p.Manifest.GetNamespace()).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+	case err != nil:
+		return nil, err
+	default:
+		p.Existing = existing
+	}
+
+	verb := client.CreateVerb
+	if p.Existing != nil {
+		verb = client.UpdateVerb
+	}
+	auth, err := dst.CanI(ns, gvr.String(), []string{verb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to %s %s in target namespace %q", verb, gvr, ns)
+	}
+
+	var (
+		from string
+		prev *unstructured.Unstructured
+	)
+	if p.Existing != nil {
+		prev = CloneManifest(gvr, p.Existing, ns, name)
+		if from, err = diffYAML(gvr, prev, nil); err != nil {
+			return nil, err
+		}
+	}
+	to, err := diffYAML(gvr, p.Manifest, prev)
+	if err != nil {
+		return nil, err
+	}
+	p.Diff = Diff(from, to)
+
+	return &p, nil
+}
+
+// Apply creates the target resource or replaces it if it already exists.
+func (p *ClonePlan) Apply(ctx context.Context, dst client.Connection) error {
+	dial := dst.DynDialOrDie().Resource(p.GVR.GVR()).Namespace(p.Manifest.GetNamespace())
+	if p.Existing == nil {
+		_, err := dial.Create(ctx, p.Manifest, metav1.CreateOptions{})
+		return err
+	}
+	p.Manifest.SetResourceVersion(p.Existing.GetResourceVersion())
+	_, err := dial.Update(ctx, p.Manifest, metav1.UpdateOptions{})
+
+	return err
+}
+
+// diffYAML returns a manifest as yaml with secret values masked. Values
+// differing from the reference manifest if any are flagged as changed.
+func diffYAML(gvr client.GVR, u, ref *unstructured.Unstructured) (string, error) {
+	if gvr.String() == "v1/secrets" {
+		u = u.DeepCopy()
+		for _, k := range []string{"data", "stringData"} {
+			m, ok, _ := unstructured.NestedStringMap(u.Object, k)
+			if !ok {
+				continue
+			}
+			var prev map[string]string
+			if ref != nil {
+				prev, _, _ = unstructured.NestedStringMap(ref.Object, k)
+			}
+			for key, v := range m {
+				m[key] = maskedSecret
+				if ref != nil && prev[key] != v {
+					m[key] = maskedSecretChanged
+				}
+			}
+			_ = unstructured.SetNestedStringMap(u.Object, m, k)
+		}
+	}
+	raw, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffYAMLSecret(t *testing.T) {
+	u := makeSecretManifest("c2VjcmV0", "dXNlcg==")
+	ref := makeSecretManifest("b2xk", "dXNlcg==")
+
+	uu := map[string]struct {
+		ref *unstructured.Unstructured
+		ee  []string
+	}{
+		"noRef": {
+			ee: []string{"password: <masked>", "user: <masked>"},
+		},
+		"ref": {
+			ref: ref,
+			ee:  []string{"password: <masked:changed>", "user: <masked>"},
+		},
+	}
+
+	for k := range uu {
+		u1 := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := diffYAML(client.NewGVR("v1/secrets"), u, u1.ref)
+			assert.Nil(t, err)
+			assert.NotContains(t, s, "c2VjcmV0")
+			assert.NotContains(t, s, "dXNlcg==")
+			for _, e := range u1.ee {
+				assert.Contains(t, s, e)
+			}
+			assert.Equal(t, "c2VjcmV0", u.Object["data"].(map[string]interface{})["password"])
+		})
+	}
+}
+
+// Helpers...
+
+func makeSecretManifest(pwd, user string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"namespace": "dev", "name": "s1"},
+		"data":       map[string]interface{}{"password": pwd, "user": user},
+	}}
+}
@@ -0,0 +1,73 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCloneManifest(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace":         "dev",
+			"name":              "cm1",
+			"uid":               "u1",
+			"resourceVersion":   "10",
+			"creationTimestamp": "2020-05-01T10:00:00Z",
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+			"ownerReferences": []interface{}{
+				map[string]interface{}{"kind": "Deployment", "name": "dp1", "uid": "u2"},
+			},
+		},
+		"data":   map[string]interface{}{"k": "v"},
+		"status": map[string]interface{}{"blee": "fred"},
+	}}
+
+	c := dao.CloneManifest(client.NewGVR("v1/configmaps"), &u, "prod", "cm2")
+
+	assert.Equal(t, "prod", c.GetNamespace())
+	assert.Equal(t, "cm2", c.GetName())
+	assert.Empty(t, c.GetUID())
+	assert.Empty(t, c.GetResourceVersion())
+	assert.Empty(t, c.GetAnnotations())
+	assert.Empty(t, c.GetOwnerReferences())
+	assert.NotContains(t, c.Object, "status")
+	assert.Equal(t, map[string]interface{}{"k": "v"}, c.Object["data"])
+	assert.Equal(t, "cm1", u.GetName())
+	assert.Equal(t, "u1", string(u.GetUID()))
+}
+
+func TestCloneManifestJob(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"namespace": "dev",
+			"name":      "j1",
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"controller-uid": "u1"},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"controller-uid": "u1", "job-name": "j1", "app": "fred"},
+				},
+			},
+		},
+	}}
+
+	c := dao.CloneManifest(client.NewGVR("batch/v1/jobs"), &u, "dev", "j2")
+
+	_, ok, _ := unstructured.NestedMap(c.Object, "spec", "selector")
+	assert.False(t, ok)
+	ll, _, _ := unstructured.NestedStringMap(c.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "fred"}, ll)
+}
@@ -15,7 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/version"
-	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -42,18 +42,18 @@ func makeConn() *conn {
 	return &conn{}
 }
 
-func (c *conn) Config() *client.Config                            { return nil }
-func (c *conn) DialOrDie() kubernetes.Interface                   { return nil }
-func (c *conn) SwitchContext(ctx string) error                    { return nil }
-func (c *conn) CachedDiscoveryOrDie() *disk.CachedDiscoveryClient { return nil }
-func (c *conn) RestConfigOrDie() *restclient.Config               { return nil }
-func (c *conn) MXDial() (*versioned.Clientset, error)             { return nil, nil }
-func (c *conn) DynDialOrDie() dynamic.Interface                   { return nil }
-func (c *conn) HasMetrics() bool                                  { return false }
-func (c *conn) CheckConnectivity() bool                           { return false }
-func (c *conn) IsNamespaced(n string) bool                        { return false }
-func (c *conn) SupportsResource(group string) bool                { return false }
-func (c *conn) ValidNamespaces() ([]v1.Namespace, error)          { return nil, nil }
+func (c *conn) Config() *client.Config                                   { return nil }
+func (c *conn) DialOrDie() kubernetes.Interface                          { return nil }
+func (c *conn) SwitchContext(ctx string) error                           { return nil }
+func (c *conn) CachedDiscoveryOrDie() discovery.CachedDiscoveryInterface { return nil }
+func (c *conn) RestConfigOrDie() *restclient.Config                      { return nil }
+func (c *conn) MXDial() (*versioned.Clientset, error)                    { return nil, nil }
+func (c *conn) DynDialOrDie() dynamic.Interface                          { return nil }
+func (c *conn) HasMetrics() bool                                         { return false }
+func (c *conn) CheckConnectivity() bool                                  { return false }
+func (c *conn) IsNamespaced(n string) bool                               { return false }
+func (c *conn) SupportsResource(group string) bool                       { return false }
+func (c *conn) ValidNamespaces() ([]v1.Namespace, error)                 { return nil, nil }
 func (c *conn) SupportsRes(grp string, versions []string) (string, bool, error) {
 	return "", false, nil
 }
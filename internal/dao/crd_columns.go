@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	crdMaxSchemaCols = 5
+	crdAgeJSONPath   = ".metadata.creationTimestamp"
+	crdReadyJSONPath = `.status.conditions[?(@.type=="Ready")].status`
+)
+
+// CRDColumns returns a custom resource version columns from its definition
+// printer columns. Columns are derived from the version schema status and
+// spec scalar fields when no printer columns are defined.
+func CRDColumns(crd *unstructured.Unstructured, version string) []render.CRDColumn {
+	spec, ok := crd.Object["spec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	pcols, schema := crdVersion(spec, version)
+	if len(pcols) > 0 {
+		return printerColumns(pcols)
+	}
+
+	return schemaColumns(schema)
+}
+
+// crdVersion returns a CRD version printer columns and schema, falling back
+// to the v1beta1 top level definitions.
+func crdVersion(spec map[string]interface{}, version string) ([]interface{}, map[string]interface{}) {
+	pcols, _, _ := unstructured.NestedSlice(spec, "additionalPrinterColumns")
+	schema, _, _ := unstructured.NestedMap(spec, "validation", "openAPIV3Schema")
+
+	vv, _, _ := unstructured.NestedSlice(spec, "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != version {
+			continue
+		}
+		if cc, ok, _ := unstructured.NestedSlice(m, "additionalPrinterColumns"); ok {
+			pcols = cc
+		}
+		if s, ok, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema"); ok {
+			schema = s
+		}
+	}
+
+	return pcols, schema
+}
+
+func printerColumns(pcols []interface{}) []render.CRDColumn {
+	cc := make([]render.CRDColumn, 0, len(pcols))
+	for _, p := range pcols {
+		m, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		col := render.CRDColumn{
+			Name: stringField(m, "name"),
+			Type: stringField(m, "type"),
+		}
+		// v1 CRDs use jsonPath while v1beta1 ones use JSONPath.
+		col.JSONPath = stringField(m, "jsonPath")
+		if col.JSONPath == "" {
+			col.JSONPath = stringField(m, "JSONPath")
+		}
+		if col.Name == "" || col.JSONPath == "" || col.JSONPath == crdAgeJSONPath {
+			continue
+		}
+		if p, ok := m["priority"].(int64); ok {
+			col.Priority = int(p)
+		}
+		cc = append(cc, col)
+	}
+
+	return cc
+}
+
+// schemaColumns derives columns from the status then spec schema scalar
+// properties, calling out the Ready condition if the status has conditions.
+func schemaColumns(schema map[string]interface{}) []render.CRDColumn {
+	var cc []render.CRDColumn
+	for _, section := range []string{"status", "spec"} {
+		props, _, _ := unstructured.NestedMap(schema, "properties", section, "properties")
+		if section == "status" {
+			if _, ok := props["conditions"]; ok {
+				cc = append(cc, render.CRDColumn{Name: "Ready", Type: "string", JSONPath: crdReadyJSONPath})
+			}
+		}
+		kk := make([]string, 0, len(props))
+		for k := range props {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		for _, k := range kk {
+			p, ok := props[k].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			t := stringField(p, "type")
+			if stringField(p, "format") == "date-time" {
+				t = render.CRDColumnDate
+			}
+			switch t {
+			case "string", "boolean", render.CRDColumnInteger, render.CRDColumnNumber, render.CRDColumnDate:
+			default:
+				continue
+			}
+			cc = append(cc, render.CRDColumn{Name: k, Type: t, JSONPath: "." + section + "." + k})
+			if len(cc) == crdMaxSchemaCols {
+				return cc
+			}
+		}
+	}
+
+	return cc
+}
+
+func stringField(m map[string]interface{}, k string) string {
+	s, _ := m[k].(string)
+
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,107 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCRDColumns(t *testing.T) {
+	schema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"replicas": map[string]interface{}{"type": "integer"},
+					"template": map[string]interface{}{"type": "object"},
+				},
+			},
+			"status": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"phase":       map[string]interface{}{"type": "string"},
+					"lastUpdated": map[string]interface{}{"type": "string", "format": "date-time"},
+					"conditions":  map[string]interface{}{"type": "array"},
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		spec    map[string]interface{}
+		version string
+		e       []render.CRDColumn
+	}{
+		"v1beta1Printer": {
+			spec: map[string]interface{}{
+				"version": "v1",
+				"additionalPrinterColumns": []interface{}{
+					map[string]interface{}{"name": "Phase", "type": "string", "JSONPath": ".status.phase"},
+					map[string]interface{}{"name": "Image", "type": "string", "JSONPath": ".spec.image", "priority": int64(1)},
+					map[string]interface{}{"name": "Age", "type": "date", "JSONPath": ".metadata.creationTimestamp"},
+				},
+			},
+			version: "v1",
+			e: []render.CRDColumn{
+				{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+				{Name: "Image", Type: "string", JSONPath: ".spec.image", Priority: 1},
+			},
+		},
+		"v1Printer": {
+			spec: map[string]interface{}{
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha1",
+						"additionalPrinterColumns": []interface{}{
+							map[string]interface{}{"name": "Old", "type": "string", "jsonPath": ".status.old"},
+						},
+					},
+					map[string]interface{}{
+						"name": "v1",
+						"additionalPrinterColumns": []interface{}{
+							map[string]interface{}{"name": "Ready", "type": "boolean", "jsonPath": ".status.ready"},
+						},
+					},
+				},
+			},
+			version: "v1",
+			e: []render.CRDColumn{
+				{Name: "Ready", Type: "boolean", JSONPath: ".status.ready"},
+			},
+		},
+		"schema": {
+			spec: map[string]interface{}{
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name":   "v1",
+						"schema": map[string]interface{}{"openAPIV3Schema": schema},
+					},
+				},
+			},
+			version: "v1",
+			e: []render.CRDColumn{
+				{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+				{Name: "lastUpdated", Type: render.CRDColumnDate, JSONPath: ".status.lastUpdated"},
+				{Name: "phase", Type: "string", JSONPath: ".status.phase"},
+				{Name: "replicas", Type: render.CRDColumnInteger, JSONPath: ".spec.replicas"},
+			},
+		},
+		"none": {
+			spec:    map[string]interface{}{"version": "v1"},
+			version: "v1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			crd := unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}}
+			cc := dao.CRDColumns(&crd, u.version)
+			assert.Equal(t, len(u.e), len(cc))
+			if len(u.e) > 0 {
+				assert.Equal(t, u.e, cc)
+			}
+		})
+	}
+}
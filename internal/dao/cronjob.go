@@ -6,15 +6,23 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-const maxJobNameSize = 42
+const (
+	maxJobNameSize = 42
+
+	cronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+)
 
 var (
-	_ Accessor = (*CronJob)(nil)
-	_ Runnable = (*CronJob)(nil)
+	_ Accessor    = (*CronJob)(nil)
+	_ Runnable    = (*CronJob)(nil)
+	_ Suspendable = (*CronJob)(nil)
 )
 
 // CronJob represents a cronjob K8s resource.
@@ -24,37 +32,111 @@ type CronJob struct {
 
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	_, err := c.Trigger(ctx, path)
+
+	return err
+}
+
+// Trigger creates a Job from the CronJob template right away and returns
+// the job name.
+func (c *CronJob) Trigger(ctx context.Context, path string) (string, error) {
 	ns, n := client.Namespaced(path)
-	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{client.GetVerb, client.CreateVerb})
-	if err != nil {
-		return err
+	if err := c.canI(ns, "batch/v1beta1/cronjobs", client.GetVerb); err != nil {
+		return "", err
 	}
-	if !auth {
-		return fmt.Errorf("user is not authorize to run cronjobs")
+	if err := c.canI(ns, "batch/v1/jobs", client.CreateVerb); err != nil {
+		return "", err
 	}
 
-	// BOZO!! Factory resource??
-	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
-	defer cancel()
 	cj, err := c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	job, err := c.Client().DialOrDie().BatchV1().Jobs(ns).Create(ctx, jobFromCronJob(cj), metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return job.Name, nil
+}
+
+// IsSuspended checks if a CronJob schedule is suspended.
+func (c *CronJob) IsSuspended(path string) (bool, error) {
+	o, err := c.Factory.Get(c.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	var cj batchv1beta1.CronJob
+	if err := fromUnstructured(o, &cj); err != nil {
+		return false, err
+	}
+
+	return cj.Spec.Suspend != nil && *cj.Spec.Suspend, nil
+}
+
+// Suspend suspends or resumes a CronJob schedule.
+func (c *CronJob) Suspend(ctx context.Context, path string, suspend bool) error {
+	ns, n := client.Namespaced(path)
+	if err := c.canI(ns, "batch/v1beta1/cronjobs", client.PatchVerb); err != nil {
+		return err
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+	_, err := c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+func (c *CronJob) canI(ns, gvr, verb string) error {
+	auth, err := c.Client().CanI(ns, gvr, []string{verb})
 	if err != nil {
 		return err
 	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to %s %s", verb, client.NewGVR(gvr).R())
+	}
+
+	return nil
+}
 
+// jobFromCronJob returns a manual run of a CronJob, owned by the CronJob so
+// it is listed with its scheduled runs.
+func jobFromCronJob(cj *batchv1beta1.CronJob) *batchv1.Job {
 	var jobName = cj.Name
 	if len(cj.Name) >= maxJobNameSize {
 		jobName = cj.Name[0:maxJobNameSize]
 	}
 
-	job := &batchv1.Job{
+	annotations := map[string]string{cronJobInstantiateAnnotation: "manual"}
+	for k, v := range cj.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+	controller := true
+
+	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName + "-manual-" + rand.String(3),
-			Namespace: ns,
-			Labels:    cj.Spec.JobTemplate.Labels,
+			Name:        jobName + "-manual-" + rand.String(3),
+			Namespace:   cj.Namespace,
+			Labels:      cj.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "batch/v1beta1",
+					Kind:       "CronJob",
+					Name:       cj.Name,
+					UID:        cj.UID,
+					Controller: &controller,
+				},
+			},
 		},
 		Spec: cj.Spec.JobTemplate.Spec,
 	}
-	_, err = c.Client().DialOrDie().BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
-
-	return err
 }
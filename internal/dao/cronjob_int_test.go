@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestJobFromCronJob(t *testing.T) {
+	cj := batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "blee", UID: "cj-1"},
+		Spec: batchv1beta1.CronJobSpec{
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "fred"},
+					Annotations: map[string]string{"team": "duh"},
+				},
+				Spec: batchv1.JobSpec{BackoffLimit: new(int32)},
+			},
+		},
+	}
+
+	job := jobFromCronJob(&cj)
+	assert.True(t, strings.HasPrefix(job.Name, "fred-manual-"))
+	assert.Equal(t, "blee", job.Namespace)
+	assert.Equal(t, map[string]string{"app": "fred"}, job.Labels)
+	assert.Equal(t, map[string]string{"team": "duh", cronJobInstantiateAnnotation: "manual"}, job.Annotations)
+	assert.Equal(t, 1, len(job.OwnerReferences))
+	assert.Equal(t, "CronJob", job.OwnerReferences[0].Kind)
+	assert.Equal(t, "cj-1", string(job.OwnerReferences[0].UID))
+	assert.True(t, *job.OwnerReferences[0].Controller)
+	assert.Equal(t, cj.Spec.JobTemplate.Spec, job.Spec)
+}
@@ -0,0 +1,235 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// dependentsSyncTimeout tracks how long to wait for the dependents caches to load.
+const dependentsSyncTimeout = 3 * time.Second
+
+// DependencyGVRs represents the resources inspected when computing deletion dependents.
+var DependencyGVRs = []string{
+	"v1/pods",
+	"v1/services",
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/persistentvolumeclaims",
+	"v1/serviceaccounts",
+	"apps/v1/deployments",
+	"apps/v1/replicasets",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"apps/v1/controllerrevisions",
+	"batch/v1/jobs",
+	"batch/v1beta1/cronjobs",
+}
+
+// Dependents tracks resources impacted by a deletion.
+type Dependents struct {
+	// Cascade lists resources deleted along with the resource when cascading.
+	Cascade []string
+
+	// Orphans lists resources left without an owner when not cascading.
+	Orphans []string
+
+	// Unknown lists the resources whose caches were still loading.
+	Unknown []string
+}
+
+// IsEmpty returns true if no resources are impacted.
+func (d Dependents) IsEmpty() bool {
+	return len(d.Cascade) == 0 && len(d.Orphans) == 0
+}
+
+type dependent struct {
+	gvr, path string
+	uid       types.UID
+}
+
+func (d dependent) String() string {
+	return d.gvr + " " + d.path
+}
+
+// FindDependents computes the resources impacted by deleting the given resource
+// using the informer caches. This call blocks until the caches are loaded or
+// reports the resources still loading as unknown.
+func FindDependents(f Factory, gvr client.GVR, path string) (Dependents, error) {
+	switch gvr.String() {
+	case "v1/namespaces":
+		_, n := client.Namespaced(path)
+		return namespaceDependents(f, n), nil
+	case "apiextensions.k8s.io/v1/customresourcedefinitions", "apiextensions.k8s.io/v1beta1/customresourcedefinitions":
+		return crdDependents(f, gvr, path)
+	}
+
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return Dependents{}, err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return Dependents{}, err
+	}
+
+	index, unknown := ownerIndex(f, m.GetNamespace())
+	d := Dependents{Unknown: unknown}
+	for _, c := range index[m.GetUID()] {
+		d.Orphans = append(d.Orphans, c.String())
+	}
+	seen := map[types.UID]struct{}{m.GetUID(): {}}
+	queue := []types.UID{m.GetUID()}
+	for len(queue) > 0 {
+		uid := queue[0]
+		queue = queue[1:]
+		for _, c := range index[uid] {
+			if _, ok := seen[c.uid]; ok {
+				continue
+			}
+			seen[c.uid] = struct{}{}
+			d.Cascade = append(d.Cascade, c.String())
+			queue = append(queue, c.uid)
+		}
+	}
+	sort.Strings(d.Cascade)
+	sort.Strings(d.Orphans)
+
+	return d, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// listSynced lists the dependency resources in a namespace, skipping the
+// resources whose caches are not loaded yet.
+func listSynced(f Factory, ns string, fn func(gvr string, oo []runtime.Object)) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), dependentsSyncTimeout)
+	defer cancel()
+
+	var unknown []string
+	for _, gvr := range DependencyGVRs {
+		if err := WaitSynced(ctx, f, ns, gvr); errors.Is(err, ErrCacheNotSynced) {
+			unknown = append(unknown, gvr)
+			continue
+		}
+		oo, err := f.List(gvr, ns, false, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Dependents skipping %s", gvr)
+			continue
+		}
+		fn(gvr, oo)
+	}
+
+	return unknown
+}
+
+// ownerIndex maps owner uids to their dependents in a given namespace.
+func ownerIndex(f Factory, ns string) (map[types.UID][]dependent, []string) {
+	index := make(map[types.UID][]dependent)
+	unknown := listSynced(f, ns, func(gvr string, oo []runtime.Object) {
+		for _, o := range oo {
+			m, err := meta.Accessor(o)
+			if err != nil {
+				continue
+			}
+			for _, ref := range m.GetOwnerReferences() {
+				index[ref.UID] = append(index[ref.UID], dependent{
+					gvr:  gvr,
+					path: client.FQN(m.GetNamespace(), m.GetName()),
+					uid:  m.GetUID(),
+				})
+			}
+		}
+	})
+
+	return index, unknown
+}
+
+func namespaceDependents(f Factory, ns string) Dependents {
+	var d Dependents
+	d.Unknown = listSynced(f, ns, func(gvr string, oo []runtime.Object) {
+		for _, o := range oo {
+			m, err := meta.Accessor(o)
+			if err != nil {
+				continue
+			}
+			d.Cascade = append(d.Cascade, dependent{gvr: gvr, path: client.FQN(m.GetNamespace(), m.GetName())}.String())
+		}
+	})
+	sort.Strings(d.Cascade)
+
+	return d
+}
+
+func crdDependents(f Factory, gvr client.GVR, path string) (Dependents, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return Dependents{}, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return Dependents{}, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	crGVR, ok := crdInstanceGVR(u)
+	if !ok {
+		return Dependents{}, fmt.Errorf("unable to resolve resource type for crd %s", path)
+	}
+
+	var d Dependents
+	oo, err := f.List(crGVR, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return d, err
+	}
+	for _, o := range oo {
+		m, err := meta.Accessor(o)
+		if err != nil {
+			continue
+		}
+		d.Cascade = append(d.Cascade, dependent{gvr: crGVR, path: client.FQN(m.GetNamespace(), m.GetName())}.String())
+	}
+	sort.Strings(d.Cascade)
+
+	return d, nil
+}
+
+// crdInstanceGVR returns the storage gvr of a crd instances.
+func crdInstanceGVR(u *unstructured.Unstructured) (string, bool) {
+	group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(u.Object, "spec", "names", "plural")
+	if group == "" || plural == "" {
+		return "", false
+	}
+
+	version, _, _ := unstructured.NestedString(u.Object, "spec", "version")
+	vv, _, _ := unstructured.NestedSlice(u.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := m["storage"].(bool); storage || version == "" {
+			if n, ok := m["name"].(string); ok {
+				version = n
+			}
+			if storage {
+				break
+			}
+		}
+	}
+	if version == "" {
+		return "", false
+	}
+
+	return group + "/" + version + "/" + plural, true
+}
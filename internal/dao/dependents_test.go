@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+)
+
+func TestFindDependents(t *testing.T) {
+	f := newDepFactory()
+	f.add("apps/v1/deployments", makeDep("default", "dp1", "u1", ""))
+	f.add("apps/v1/replicasets", makeDep("default", "rs1", "u2", "u1"))
+	f.add("v1/pods", makeDep("default", "p1", "u3", "u2"))
+	f.add("v1/pods", makeDep("default", "p2", "u4", "u2"))
+	f.add("v1/configmaps", makeDep("default", "cm1", "u5", ""))
+
+	d, err := FindDependents(f, client.NewGVR("apps/v1/deployments"), "default/dp1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"apps/v1/replicasets default/rs1",
+		"v1/pods default/p1",
+		"v1/pods default/p2",
+	}, d.Cascade)
+	assert.Equal(t, []string{"apps/v1/replicasets default/rs1"}, d.Orphans)
+}
+
+func TestFindDependentsNone(t *testing.T) {
+	f := newDepFactory()
+	f.add("v1/configmaps", makeDep("default", "cm1", "u5", ""))
+
+	d, err := FindDependents(f, client.NewGVR("v1/configmaps"), "default/cm1")
+
+	assert.Nil(t, err)
+	assert.True(t, d.IsEmpty())
+}
+
+func TestFindDependentsNamespace(t *testing.T) {
+	f := newDepFactory()
+	f.add("v1/pods", makeDep("fred", "p1", "u3", ""))
+	f.add("v1/configmaps", makeDep("fred", "cm1", "u5", ""))
+	f.add("v1/configmaps", makeDep("blee", "cm2", "u6", ""))
+
+	d, err := FindDependents(f, client.NewGVR("v1/namespaces"), "fred")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"v1/configmaps fred/cm1", "v1/pods fred/p1"}, d.Cascade)
+	assert.Empty(t, d.Orphans)
+}
+
+func TestCrdInstanceGVR(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    string
+		ok   bool
+	}{
+		"version": {
+			spec: map[string]interface{}{
+				"group":   "fred.io",
+				"version": "v1",
+				"names":   map[string]interface{}{"plural": "blees"},
+			},
+			e:  "fred.io/v1/blees",
+			ok: true,
+		},
+		"storage": {
+			spec: map[string]interface{}{
+				"group": "fred.io",
+				"names": map[string]interface{}{"plural": "blees"},
+				"versions": []interface{}{
+					map[string]interface{}{"name": "v1alpha1", "storage": false},
+					map[string]interface{}{"name": "v1", "storage": true},
+				},
+			},
+			e:  "fred.io/v1/blees",
+			ok: true,
+		},
+		"missing": {
+			spec: map[string]interface{}{"group": "fred.io"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}}
+			gvr, ok := crdInstanceGVR(&o)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, gvr)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeDep(ns, n, uid, owner string) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetNamespace(ns)
+	u.SetName(n)
+	u.SetUID(types.UID(uid))
+	if owner != "" {
+		u.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID(owner), Name: "owner"}})
+	}
+
+	return &u
+}
+
+type depFactory struct {
+	oo map[string][]*unstructured.Unstructured
+}
+
+var _ Factory = (*depFactory)(nil)
+
+func newDepFactory() *depFactory {
+	return &depFactory{oo: make(map[string][]*unstructured.Unstructured)}
+}
+
+func (f *depFactory) add(gvr string, u *unstructured.Unstructured) {
+	f.oo[gvr] = append(f.oo[gvr], u)
+}
+
+func (f *depFactory) Client() client.Connection {
+	return nil
+}
+func (f *depFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	for _, u := range f.oo[gvr] {
+		if client.FQN(u.GetNamespace(), u.GetName()) == path {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+func (f *depFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(f.oo[gvr]))
+	for _, u := range f.oo[gvr] {
+		if ns == client.AllNamespaces || u.GetNamespace() == ns {
+			oo = append(oo, u)
+		}
+	}
+	return oo, nil
+}
+func (f *depFactory) ForResource(ns, gvr string) informers.GenericInformer {
+	return nil
+}
+func (f *depFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	return nil, nil
+}
+func (f *depFactory) WaitForCacheSync() {}
+func (f *depFactory) Forwarders() watch.Forwarders {
+	return nil
+}
+func (f *depFactory) DeleteForwarder(string) {}
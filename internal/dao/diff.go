@@ -0,0 +1,164 @@
+package dao
+
+import "strings"
+
+// maxDiffCells caps the size of the longest common subsequence table. Larger
+// texts fall back to a coarser diff of their differing middle section.
+const maxDiffCells = 1000000
+
+// DiffOp represents a side by side diff line operation.
+type DiffOp int
+
+const (
+	// DiffSame tracks a line present on both sides.
+	DiffSame DiffOp = iota
+	// DiffRemoved tracks a line only present on the left side.
+	DiffRemoved
+	// DiffAdded tracks a line only present on the right side.
+	DiffAdded
+	// DiffChanged tracks a line that changed between both sides.
+	DiffChanged
+)
+
+// DiffLine represents a side by side diff line.
+type DiffLine struct {
+	Op          DiffOp
+	Left, Right string
+}
+
+// Diff returns the lines removed from and added to a text, prefixed with - or +.
+func Diff(from, to string) []string {
+	var dd []string
+	for _, l := range diffLines(splitLines(from), splitLines(to)) {
+		switch l.Op {
+		case DiffRemoved:
+			dd = append(dd, "- "+l.Left)
+		case DiffAdded:
+			dd = append(dd, "+ "+l.Right)
+		}
+	}
+
+	return dd
+}
+
+// SideBySide returns a side by side diff of two texts. Removed lines directly
+// followed by added ones are paired up as changed lines.
+func SideBySide(from, to string) []DiffLine {
+	ll := diffLines(splitLines(from), splitLines(to))
+	dd := make([]DiffLine, 0, len(ll))
+	for i := 0; i < len(ll); {
+		if ll[i].Op != DiffRemoved {
+			dd = append(dd, ll[i])
+			i++
+			continue
+		}
+		var rm, add []string
+		for ; i < len(ll) && ll[i].Op == DiffRemoved; i++ {
+			rm = append(rm, ll[i].Left)
+		}
+		for ; i < len(ll) && ll[i].Op == DiffAdded; i++ {
+			add = append(add, ll[i].Right)
+		}
+		for j := 0; j < len(rm) || j < len(add); j++ {
+			switch {
+			case j >= len(add):
+				dd = append(dd, DiffLine{Op: DiffRemoved, Left: rm[j]})
+			case j >= len(rm):
+				dd = append(dd, DiffLine{Op: DiffAdded, Right: add[j]})
+			default:
+				dd = append(dd, DiffLine{Op: DiffChanged, Left: rm[j], Right: add[j]})
+			}
+		}
+	}
+
+	return dd
+}
+
+// diffLines returns the lines edit script turning a into b. Common leading and
+// trailing lines are matched up front to keep the lcs table small.
+func diffLines(a, b []string) []DiffLine {
+	var pre, suf int
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+
+	dd := make([]DiffLine, 0, len(a)+len(b))
+	for _, l := range a[:pre] {
+		dd = append(dd, DiffLine{Op: DiffSame, Left: l, Right: l})
+	}
+	dd = append(dd, diffMiddle(a[pre:len(a)-suf], b[pre:len(b)-suf])...)
+	for _, l := range a[len(a)-suf:] {
+		dd = append(dd, DiffLine{Op: DiffSame, Left: l, Right: l})
+	}
+
+	return dd
+}
+
+// diffMiddle returns the edit script of differing lines. Texts too large for
+// the lcs table are diffed as all removed then all added.
+func diffMiddle(a, b []string) []DiffLine {
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		dd := make([]DiffLine, 0, len(a)+len(b))
+		for _, l := range a {
+			dd = append(dd, DiffLine{Op: DiffRemoved, Left: l})
+		}
+		for _, l := range b {
+			dd = append(dd, DiffLine{Op: DiffAdded, Right: l})
+		}
+		return dd
+	}
+
+	// lcs[i][j] tracks the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	dd := make([]DiffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			dd = append(dd, DiffLine{Op: DiffSame, Left: a[i], Right: b[j]})
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			dd = append(dd, DiffLine{Op: DiffRemoved, Left: a[i]})
+			i++
+		default:
+			dd = append(dd, DiffLine{Op: DiffAdded, Right: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		dd = append(dd, DiffLine{Op: DiffRemoved, Left: a[i]})
+	}
+	for ; j < len(b); j++ {
+		dd = append(dd, DiffLine{Op: DiffAdded, Right: b[j]})
+	}
+
+	return dd
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
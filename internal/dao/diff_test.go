@@ -0,0 +1,111 @@
+package dao_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	uu := map[string]struct {
+		from, to string
+		e        []string
+	}{
+		"same": {
+			from: "a\nb\n",
+			to:   "a\nb\n",
+		},
+		"added": {
+			from: "a\n",
+			to:   "a\nb\n",
+			e:    []string{"+ b"},
+		},
+		"removed": {
+			to:   "a\n",
+			from: "a\nb\n",
+			e:    []string{"- b"},
+		},
+		"changed": {
+			from: "a\nb\nc\n",
+			to:   "a\nB\nc\n",
+			e:    []string{"- b", "+ B"},
+		},
+		"empty": {
+			to: "a\n",
+			e:  []string{"+ a"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.Diff(u.from, u.to))
+		})
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	uu := map[string]struct {
+		from, to string
+		e        []dao.DiffLine
+	}{
+		"same": {
+			from: "a\n",
+			to:   "a\n",
+			e:    []dao.DiffLine{{Op: dao.DiffSame, Left: "a", Right: "a"}},
+		},
+		"changed": {
+			from: "a\nb\nc\n",
+			to:   "a\nB\nc\n",
+			e: []dao.DiffLine{
+				{Op: dao.DiffSame, Left: "a", Right: "a"},
+				{Op: dao.DiffChanged, Left: "b", Right: "B"},
+				{Op: dao.DiffSame, Left: "c", Right: "c"},
+			},
+		},
+		"uneven": {
+			from: "a\nb\nc\n",
+			to:   "B\n",
+			e: []dao.DiffLine{
+				{Op: dao.DiffChanged, Left: "a", Right: "B"},
+				{Op: dao.DiffRemoved, Left: "b"},
+				{Op: dao.DiffRemoved, Left: "c"},
+			},
+		},
+		"added": {
+			from: "a\n",
+			to:   "a\nb\n",
+			e: []dao.DiffLine{
+				{Op: dao.DiffSame, Left: "a", Right: "a"},
+				{Op: dao.DiffAdded, Right: "b"},
+			},
+		},
+		"empty": {
+			e: []dao.DiffLine{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.SideBySide(u.from, u.to))
+		})
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	var from, to strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&from, "a%d\n", i)
+		fmt.Fprintf(&to, "b%d\n", i)
+	}
+	to.WriteString("c\n")
+
+	dd := dao.Diff("h\n"+from.String()+"t\n", "h\n"+to.String()+"t\n")
+	assert.Equal(t, 4001, len(dd))
+	assert.Equal(t, "- a0", dd[0])
+	assert.Equal(t, "+ c", dd[len(dd)-1])
+}
@@ -22,6 +22,8 @@ var (
 	_ Restartable = (*Deployment)(nil)
 	_ Scalable    = (*Deployment)(nil)
 	_ Controller  = (*Deployment)(nil)
+	_ ChaosMonkey = (*Deployment)(nil)
+	_ PodSelector = (*Deployment)(nil)
 )
 
 // Deployment represents a deployment K8s resource.
@@ -29,6 +31,27 @@ type Deployment struct {
 	Resource
 }
 
+// List returns a collection of deployments and records their ready pods.
+func (d *Deployment) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := d.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	recordReady(readyContext(d.Factory), d.gvr, oo)
+
+	return oo, nil
+}
+
+// Describe describes a deployment along with its ready pods trend.
+func (d *Deployment) Describe(path string) (string, error) {
+	desc, err := d.Resource.Describe(path)
+	if err != nil {
+		return "", err
+	}
+
+	return withReadyTrend(readyContext(d.Factory), d.gvr, path, desc), nil
+}
+
 // IsHappy check for happy deployments.
 func (d *Deployment) IsHappy(dp appsv1.Deployment) bool {
 	return dp.Status.Replicas == dp.Status.AvailableReplicas
@@ -108,6 +131,33 @@ func (d *Deployment) Pod(fqn string) (string, error) {
 	return podFromSelector(d.Factory, dp.Namespace, dp.Spec.Selector.MatchLabels)
 }
 
+// PodSelector returns the label selector matching the deployment pods.
+func (d *Deployment) PodSelector(fqn string) (*metav1.LabelSelector, error) {
+	dp, err := d.Load(d.Factory, fqn)
+	if err != nil {
+		return nil, err
+	}
+	sel := dp.Spec.Selector
+	if sel == nil || (len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0) {
+		return nil, fmt.Errorf("No valid selector found on Deployment %s", fqn)
+	}
+
+	return sel, nil
+}
+
+// Victims returns random pods of the deployment.
+func (d *Deployment) Victims(fqn string, count int) ([]string, error) {
+	dp, err := d.Load(d.Factory, fqn)
+	if err != nil {
+		return nil, err
+	}
+	if dp.Spec.Selector == nil || len(dp.Spec.Selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("No valid selector found on Deployment %s", fqn)
+	}
+
+	return Victims(d.Factory, dp.Namespace, dp.Spec.Selector.MatchLabels, count)
+}
+
 // Load returns a deployment instance.
 func (*Deployment) Load(f Factory, fqn string) (*appsv1.Deployment, error) {
 	o, err := f.Get("apps/v1/deployments", fqn, true, labels.Everything())
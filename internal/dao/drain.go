@@ -0,0 +1,210 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
+)
+
+const (
+	// DrainPending tracks a pod waiting to be evicted.
+	DrainPending = "Pending"
+	// DrainBlocked tracks a pod eviction denied by a disruption budget.
+	DrainBlocked = "Blocked"
+	// DrainEvicting tracks an evicted pod still terminating.
+	DrainEvicting = "Evicting"
+	// DrainEvicted tracks a pod gone from the node.
+	DrainEvicted = "Evicted"
+	// DrainFailed tracks a pod that could not be evicted.
+	DrainFailed = "Failed"
+
+	drainRetryDelay = 5 * time.Second
+	drainPollDelay  = time.Second
+)
+
+// DrainEvent reports a pod eviction progress.
+type DrainEvent struct {
+	Path    string
+	Status  string
+	Message string
+}
+
+// Drain cordons a node and evicts its pods using the eviction api so pod
+// disruption budgets are honored. Evictions denied by a budget are retried
+// until the drain times out.
+func (n *Node) Drain(ctx context.Context, path string, opts DrainOptions, report DrainReporter) error {
+	if err := n.ToggleCordon(path, true); err != nil && !strings.Contains(err.Error(), "already cordoned") {
+		return err
+	}
+
+	k := n.Factory.Client().DialOrDie()
+	h := drain.Helper{
+		Client:              k,
+		Force:               opts.Force,
+		GracePeriodSeconds:  opts.GracePeriodSeconds,
+		DeleteLocalData:     opts.DeleteLocalData,
+		IgnoreAllDaemonSets: opts.IgnoreAllDaemonSets,
+	}
+	_, node := client.Namespaced(path)
+	dd, errs := h.GetPodsForDeletion(node)
+	if len(errs) != 0 {
+		return errs[0]
+	}
+	if w := dd.Warnings(); w != "" {
+		log.Warn().Msgf("Drain %s: %s", path, w)
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	pods := dd.Pods()
+	for _, po := range pods {
+		report(DrainEvent{Path: client.FQN(po.Namespace, po.Name), Status: DrainPending})
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mx     sync.Mutex
+		failed int
+	)
+	wg.Add(len(pods))
+	for i := range pods {
+		go func(po v1.Pod) {
+			defer wg.Done()
+			if err := evictPod(ctx, k, po, opts, report); err != nil {
+				mx.Lock()
+				failed++
+				mx.Unlock()
+			}
+		}(pods[i])
+	}
+	wg.Wait()
+	if failed > 0 {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("drain %s interrupted with %d/%d pods left: %w", path, failed, len(pods), err)
+		}
+		return fmt.Errorf("drain %s failed to evict %d/%d pods", path, failed, len(pods))
+	}
+
+	return nil
+}
+
+func evictPod(ctx context.Context, k kubernetes.Interface, po v1.Pod, opts DrainOptions, report DrainReporter) error {
+	fqn := client.FQN(po.Namespace, po.Name)
+	ev := policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Namespace: po.Namespace, Name: po.Name},
+	}
+	if opts.GracePeriodSeconds >= 0 {
+		grace := int64(opts.GracePeriodSeconds)
+		ev.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &grace}
+	}
+
+	for {
+		err := k.PolicyV1beta1().Evictions(po.Namespace).Evict(ctx, &ev)
+		switch {
+		case err == nil:
+		case kerrors.IsNotFound(err):
+			report(DrainEvent{Path: fqn, Status: DrainEvicted})
+			return nil
+		case kerrors.IsTooManyRequests(err):
+			report(DrainEvent{Path: fqn, Status: DrainBlocked, Message: err.Error()})
+			if err := sleepCtx(ctx, drainRetryDelay); err != nil {
+				report(DrainEvent{Path: fqn, Status: DrainFailed, Message: "timed out while blocked"})
+				return err
+			}
+			continue
+		default:
+			report(DrainEvent{Path: fqn, Status: DrainFailed, Message: err.Error()})
+			return err
+		}
+		break
+	}
+
+	report(DrainEvent{Path: fqn, Status: DrainEvicting})
+	for {
+		o, err := k.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) || (err == nil && o.UID != po.UID) {
+			report(DrainEvent{Path: fqn, Status: DrainEvicted})
+			return nil
+		}
+		if err := sleepCtx(ctx, drainPollDelay); err != nil {
+			report(DrainEvent{Path: fqn, Status: DrainFailed, Message: "timed out while terminating"})
+			return err
+		}
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// DrainTracker tracks a node drain pods progress.
+type DrainTracker struct {
+	mx     sync.RWMutex
+	paths  []string
+	events map[string]DrainEvent
+}
+
+// NewDrainTracker returns a new drain tracker.
+func NewDrainTracker() *DrainTracker {
+	return &DrainTracker{events: make(map[string]DrainEvent)}
+}
+
+// Report records a drain event.
+func (t *DrainTracker) Report(e DrainEvent) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if _, ok := t.events[e.Path]; !ok {
+		t.paths = append(t.paths, e.Path)
+	}
+	t.events[e.Path] = e
+}
+
+// Events returns the pods latest events in reporting order.
+func (t *DrainTracker) Events() []DrainEvent {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	ee := make([]DrainEvent, 0, len(t.paths))
+	for _, p := range t.paths {
+		ee = append(ee, t.events[p])
+	}
+
+	return ee
+}
+
+// Count returns the number of pods in a given state.
+func (t *DrainTracker) Count(status string) int {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	var c int
+	for _, e := range t.events {
+		if e.Status == status {
+			c++
+		}
+	}
+
+	return c
+}
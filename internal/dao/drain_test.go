@@ -0,0 +1,59 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainTracker(t *testing.T) {
+	uu := map[string]struct {
+		ee      []dao.DrainEvent
+		paths   []string
+		evicted int
+		blocked int
+	}{
+		"empty": {},
+		"pending": {
+			ee: []dao.DrainEvent{
+				{Path: "default/p1", Status: dao.DrainPending},
+				{Path: "default/p2", Status: dao.DrainPending},
+			},
+			paths: []string{"default/p1", "default/p2"},
+		},
+		"progress": {
+			ee: []dao.DrainEvent{
+				{Path: "default/p1", Status: dao.DrainPending},
+				{Path: "default/p2", Status: dao.DrainPending},
+				{Path: "default/p2", Status: dao.DrainBlocked, Message: "pdb"},
+				{Path: "default/p1", Status: dao.DrainEvicting},
+				{Path: "default/p1", Status: dao.DrainEvicted},
+			},
+			paths:   []string{"default/p1", "default/p2"},
+			evicted: 1,
+			blocked: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tr := dao.NewDrainTracker()
+			for _, e := range u.ee {
+				tr.Report(e)
+			}
+			ee := tr.Events()
+			paths := make([]string, 0, len(ee))
+			for _, e := range ee {
+				paths = append(paths, e.Path)
+			}
+			assert.Equal(t, len(u.paths), len(paths))
+			if len(u.paths) > 0 {
+				assert.Equal(t, u.paths, paths)
+			}
+			assert.Equal(t, u.evicted, tr.Count(dao.DrainEvicted))
+			assert.Equal(t, u.blocked, tr.Count(dao.DrainBlocked))
+		})
+	}
+}
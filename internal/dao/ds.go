@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,12 +21,21 @@ import (
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
+const (
+	// MaxLogPods tracks the max number of pods tailed at once by a multi pods tail.
+	MaxLogPods = 50
+
+	// logPodsRescan tracks how often multi pods tails look for new pods.
+	logPodsRescan = 5 * time.Second
+)
+
 var (
 	_ Accessor    = (*DaemonSet)(nil)
 	_ Nuker       = (*DaemonSet)(nil)
 	_ Loggable    = (*DaemonSet)(nil)
 	_ Restartable = (*DaemonSet)(nil)
 	_ Controller  = (*DaemonSet)(nil)
+	_ ChaosMonkey = (*DaemonSet)(nil)
 )
 
 // DaemonSet represents a K8s daemonset.
@@ -81,10 +92,6 @@ func (d *DaemonSet) TailLogs(ctx context.Context, c LogChan, opts LogOptions) er
 }
 
 func podLogs(ctx context.Context, c LogChan, sel map[string]string, opts LogOptions) error {
-	f, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
-	if !ok {
-		return errors.New("expecting a context factory")
-	}
 	ls, err := metav1.ParseToLabelSelector(toSelector(sel))
 	if err != nil {
 		return err
@@ -93,30 +100,135 @@ func podLogs(ctx context.Context, c LogChan, sel map[string]string, opts LogOpti
 	if err != nil {
 		return err
 	}
-
 	ns, _ := client.Namespaced(opts.Path)
-	oo, err := f.List("v1/pods", ns, true, lsel)
+
+	return selectorLogs(ctx, c, ns, lsel, opts)
+}
+
+// selectorLogs tails the logs of all pods in a namespace matching a selector
+// and the options pod filter. Pods with no logs to stream yet are skipped.
+// At most MaxLogPods pods are tailed. Pods coming up later on are picked up
+// as long as the tail runs.
+func selectorLogs(ctx context.Context, c LogChan, ns string, sel labels.Selector, opts LogOptions) error {
+	f, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
+	if !ok {
+		return errors.New("expecting a context factory")
+	}
+	oo, err := f.List("v1/pods", ns, true, sel)
 	if err != nil {
 		return err
 	}
 	opts.MultiPods = true
 
+	pp, err := logPods(oo, opts)
+	if err != nil {
+		return err
+	}
+	if len(pp) == 0 && opts.PodFilter != nil {
+		return fmt.Errorf("no running pods matching %q", opts.PodFilter)
+	}
+	if len(pp) > MaxLogPods {
+		msg := fmt.Sprintf("Tailing %d of %d pods. Narrow the query with a pod regex or a selector", MaxLogPods, len(pp))
+		log.Warn().Msg(msg)
+		select {
+		case <-ctx.Done():
+			return nil
+		case c <- NewLogItemFromString(msg + "\n"):
+		}
+		pp = pp[:MaxLogPods]
+	}
+
 	po := Pod{}
 	po.Init(f, client.NewGVR("v1/pods"))
-	for _, o := range oo {
-		var pod v1.Pod
-		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
-		if err != nil {
-			return err
-		}
-		opts.Path = client.FQN(pod.Namespace, pod.Name)
+	tailed := make(map[string]struct{}, len(pp))
+	for _, path := range pp {
+		opts.Path = path
 		if err := po.TailLogs(ctx, c, opts); err != nil {
 			return err
 		}
+		tailed[path] = struct{}{}
 	}
+	go followPods(ctx, f, &po, c, ns, sel, opts, tailed)
+
 	return nil
 }
 
+// followPods tails the logs of new pods matching a multi pods tail until the
+// context is canceled.
+func followPods(ctx context.Context, f *watch.Factory, po *Pod, c LogChan, ns string, sel labels.Selector, opts LogOptions, tailed map[string]struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(logPodsRescan):
+		}
+		oo, err := f.List("v1/pods", ns, false, sel)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Listing pods to tail failed")
+			continue
+		}
+		pp, err := logPods(oo, opts)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Listing pods to tail failed")
+			continue
+		}
+		for _, path := range newLogPods(tailed, pp, MaxLogPods) {
+			opts.Path = path
+			if err := po.TailLogs(ctx, c, opts); err != nil {
+				log.Warn().Err(err).Msgf("Tailing new pod %s failed", path)
+				continue
+			}
+			tailed[path] = struct{}{}
+		}
+	}
+}
+
+// newLogPods forgets the tailed pods that are gone and returns the pods to
+// tail, keeping the tailed pods count under the given max.
+func newLogPods(tailed map[string]struct{}, pp []string, max int) []string {
+	live := make(map[string]struct{}, len(pp))
+	for _, p := range pp {
+		live[p] = struct{}{}
+	}
+	for p := range tailed {
+		if _, ok := live[p]; !ok {
+			delete(tailed, p)
+		}
+	}
+	nn := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if len(tailed)+len(nn) >= max {
+			break
+		}
+		if _, ok := tailed[p]; !ok {
+			nn = append(nn, p)
+		}
+	}
+
+	return nn
+}
+
+// logPods returns the running pods selected by the options pod filter.
+func logPods(oo []runtime.Object, opts LogOptions) ([]string, error) {
+	pp := make([]string, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return nil, err
+		}
+		if pod.Status.Phase == v1.PodPending || !opts.MatchPod(pod.Name) {
+			continue
+		}
+		pp = append(pp, client.FQN(pod.Namespace, pod.Name))
+	}
+
+	return pp, nil
+}
+
 // Pod returns a pod victim by name.
 func (d *DaemonSet) Pod(fqn string) (string, error) {
 	ds, err := d.GetInstance(fqn)
@@ -127,6 +239,19 @@ func (d *DaemonSet) Pod(fqn string) (string, error) {
 	return podFromSelector(d.Factory, ds.Namespace, ds.Spec.Selector.MatchLabels)
 }
 
+// Victims returns random pods of the daemonset.
+func (d *DaemonSet) Victims(fqn string, count int) ([]string, error) {
+	ds, err := d.GetInstance(fqn)
+	if err != nil {
+		return nil, err
+	}
+	if ds.Spec.Selector == nil || len(ds.Spec.Selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("No valid selector found on DaemonSet %s", fqn)
+	}
+
+	return Victims(d.Factory, ds.Namespace, ds.Spec.Selector.MatchLabels, count)
+}
+
 // GetInstance returns a daemonset instance.
 func (d *DaemonSet) GetInstance(fqn string) (*appsv1.DaemonSet, error) {
 	o, err := d.Factory.Get(d.gvr.String(), fqn, true, labels.Everything())
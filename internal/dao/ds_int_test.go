@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLogPods(t *testing.T) {
+	oo := []runtime.Object{
+		logPod("fred", "Running"),
+		logPod("blee", "Pending"),
+		logPod("zorg", "Succeeded"),
+	}
+	uu := map[string]struct {
+		filter *regexp.Regexp
+		e      []string
+	}{
+		"all":    {e: []string{"ns1/fred", "ns1/zorg"}},
+		"filter": {filter: regexp.MustCompile(`\Af`), e: []string{"ns1/fred"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := logPods(oo, LogOptions{PodFilter: u.filter})
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, pp)
+		})
+	}
+}
+
+func TestNewLogPods(t *testing.T) {
+	tailed := map[string]struct{}{"ns1/fred": {}, "ns1/gone": {}}
+	pp := newLogPods(tailed, []string{"ns1/fred", "ns1/blee", "ns1/zorg"}, 2)
+
+	assert.Equal(t, []string{"ns1/blee"}, pp)
+	_, ok := tailed["ns1/gone"]
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func logPod(n, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"namespace": "ns1", "name": n},
+		"status":     map[string]interface{}{"phase": phase},
+	}}
+}
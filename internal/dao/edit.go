@@ -0,0 +1,243 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const editHeader = `# Please edit the object below. Leading lines beginning with a '#' will be
+# ignored, and an empty file will abort the edit. The changes are validated with
+# a server side dry-run and this file will be reopened with the failures if any.
+#
+`
+
+// ErrEditCanceled indicates an edit was aborted or did not change the resource.
+var ErrEditCanceled = errors.New("edit canceled, no changes made")
+
+// EditSession tracks a resource edit validated server side before it is applied.
+type EditSession struct {
+	gvr     client.GVR
+	factory Factory
+	orig    *unstructured.Unstructured
+	raw     []byte
+}
+
+// NewEditSession loads the latest resource revision for edition.
+func NewEditSession(ctx context.Context, f Factory, gvr client.GVR, path string) (*EditSession, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), []string{client.UpdateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to update %s", gvr)
+	}
+
+	u, err := f.Client().DynDialOrDie().Resource(gvr.GVR()).Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	u.SetManagedFields(nil)
+	raw, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditSession{gvr: gvr, factory: f, orig: u, raw: raw}, nil
+}
+
+// Buffer returns the editor buffer annotated with the given validation error if any.
+func (e *EditSession) Buffer(err error) []byte {
+	var b bytes.Buffer
+	b.WriteString(editHeader)
+	if err != nil {
+		for _, l := range EditErrors(err) {
+			b.WriteString("# " + l + "\n")
+		}
+		b.WriteString("#\n")
+	}
+	b.Write(e.raw)
+
+	return b.Bytes()
+}
+
+// Validate parses an edited buffer and dry-runs the update server side. The
+// buffer is kept so it can be reopened with the validation failures.
+func (e *EditSession) Validate(ctx context.Context, buff []byte) (*unstructured.Unstructured, error) {
+	raw := StripEditComments(buff)
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, ErrEditCanceled
+	}
+	e.raw = raw
+
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	u := unstructured.Unstructured{Object: m}
+	if err := e.checkIdentity(&u); err != nil {
+		return nil, err
+	}
+	if e.unchanged(&u) {
+		return nil, ErrEditCanceled
+	}
+
+	_, err := e.patch(ctx, &u, []string{metav1.DryRunAll})
+
+	return &u, err
+}
+
+// Apply patches the resource with the changes of a validated manifest.
+func (e *EditSession) Apply(ctx context.Context, u *unstructured.Unstructured) error {
+	_, err := e.patch(ctx, u, nil)
+
+	return err
+}
+
+// Revert reverts the changes an applied manifest made to the original revision.
+func (e *EditSession) Revert(ctx context.Context, u *unstructured.Unstructured) error {
+	_, err := e.mergePatch(ctx, u, editPatch(u.Object, e.orig.Object), nil)
+
+	return err
+}
+
+// patch merge patches the resource with the edits made against the original
+// revision, as kubectl edit does, so concurrent changes to untouched fields
+// don't fail the edit on a stale resource version.
+func (e *EditSession) patch(ctx context.Context, u *unstructured.Unstructured, dry []string) (*unstructured.Unstructured, error) {
+	return e.mergePatch(ctx, u, editPatch(e.orig.Object, u.Object), dry)
+}
+
+func (e *EditSession) mergePatch(ctx context.Context, u *unstructured.Unstructured, p map[string]interface{}, dry []string) (*unstructured.Unstructured, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.factory.Client().DynDialOrDie().Resource(e.gvr.GVR()).
+		Namespace(u.GetNamespace()).
+		Patch(ctx, u.GetName(), types.MergePatchType, raw, metav1.PatchOptions{DryRun: dry})
+}
+
+// editPatch returns the merge patch turning an original manifest into an
+// edited one, leaving the resource version out.
+func editPatch(orig, edited map[string]interface{}) map[string]interface{} {
+	p := mergePatch(orig, edited)
+	if md, ok := p["metadata"].(map[string]interface{}); ok {
+		delete(md, "resourceVersion")
+		if len(md) == 0 {
+			delete(p, "metadata")
+		}
+	}
+
+	return p
+}
+
+// mergePatch returns the RFC 7386 merge patch turning a into b.
+func mergePatch(a, b map[string]interface{}) map[string]interface{} {
+	p := make(map[string]interface{})
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			p[k] = nil
+		}
+	}
+	for k, v := range b {
+		av, ok := a[k]
+		if !ok {
+			p[k] = v
+			continue
+		}
+		am, aok := av.(map[string]interface{})
+		bm, bok := v.(map[string]interface{})
+		if aok && bok {
+			if sub := mergePatch(am, bm); len(sub) > 0 {
+				p[k] = sub
+			}
+			continue
+		}
+		if !reflect.DeepEqual(av, v) {
+			p[k] = v
+		}
+	}
+
+	return p
+}
+
+func (e *EditSession) checkIdentity(u *unstructured.Unstructured) error {
+	switch {
+	case u.GetKind() != e.orig.GetKind():
+		return fmt.Errorf("kind can't be changed from %q to %q", e.orig.GetKind(), u.GetKind())
+	case u.GetName() != e.orig.GetName():
+		return fmt.Errorf("name can't be changed from %q to %q", e.orig.GetName(), u.GetName())
+	case u.GetNamespace() != e.orig.GetNamespace():
+		return fmt.Errorf("namespace can't be changed from %q to %q", e.orig.GetNamespace(), u.GetNamespace())
+	}
+
+	return nil
+}
+
+func (e *EditSession) unchanged(u *unstructured.Unstructured) bool {
+	raw, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return false
+	}
+	orig, err := yaml.Marshal(e.orig.Object)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(raw, orig)
+}
+
+// EditErrors returns the validation failures of an edit, one per line.
+func EditErrors(err error) []string {
+	var status kerrors.APIStatus
+	if !errors.As(err, &status) {
+		return strings.Split(err.Error(), "\n")
+	}
+
+	s := status.Status()
+	ee := []string{s.Message}
+	if s.Details == nil {
+		return ee
+	}
+	for _, c := range s.Details.Causes {
+		if c.Field == "" {
+			ee = append(ee, "* "+c.Message)
+			continue
+		}
+		ee = append(ee, fmt.Sprintf("* %s: %s", c.Field, c.Message))
+	}
+
+	return ee
+}
+
+// StripEditComments removes the leading comment lines from an edited buffer.
+// Comments past the manifest start are kept as they may be part of a value.
+func StripEditComments(buff []byte) []byte {
+	for len(buff) > 0 {
+		l := buff
+		i := bytes.IndexByte(buff, '\n')
+		if i != -1 {
+			l = buff[:i+1]
+		}
+		if t := bytes.TrimSpace(l); len(t) > 0 && t[0] != '#' {
+			break
+		}
+		buff = buff[len(l):]
+	}
+
+	return buff
+}
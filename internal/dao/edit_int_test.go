@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+func TestStripEditComments(t *testing.T) {
+	uu := map[string]struct {
+		buff, e string
+	}{
+		"empty": {},
+		"comments": {
+			buff: "# blee\n#\n",
+		},
+		"header": {
+			buff: "# blee\n\n  # duh\napiVersion: v1\n",
+			e:    "apiVersion: v1\n",
+		},
+		"values": {
+			buff: "# blee\ndata:\n  run.sh: |\n    # fred\n    echo\n",
+			e:    "data:\n  run.sh: |\n    # fred\n    echo\n",
+		},
+		"noEOL": {
+			buff: "# blee\nkind: Pod",
+			e:    "kind: Pod",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(StripEditComments([]byte(u.buff))))
+		})
+	}
+}
+
+func TestEditErrors(t *testing.T) {
+	invalid := kerrors.NewInvalid(
+		schema.GroupKind{Kind: "Pod"},
+		"fred",
+		field.ErrorList{field.Required(field.NewPath("spec", "containers"), "")},
+	)
+
+	uu := map[string]struct {
+		err error
+		e   []string
+	}{
+		"plain": {
+			err: errors.New("blee\nduh"),
+			e:   []string{"blee", "duh"},
+		},
+		"invalid": {
+			err: invalid,
+			e: []string{
+				invalid.Error(),
+				"* spec.containers: Required value",
+			},
+		},
+		"status": {
+			err: kerrors.NewConflict(schema.GroupResource{Resource: "pods"}, "fred", errors.New("boom")),
+			e:   []string{`Operation cannot be fulfilled on pods "fred": boom`},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, EditErrors(u.err))
+		})
+	}
+}
+
+func TestEditSessionBuffer(t *testing.T) {
+	s := EditSession{raw: []byte("kind: Pod\n")}
+
+	assert.Equal(t, editHeader+"kind: Pod\n", string(s.Buffer(nil)))
+	assert.Equal(t, editHeader+"# boom\n#\nkind: Pod\n", string(s.Buffer(errors.New("boom"))))
+}
+
+func TestEditSessionValidate(t *testing.T) {
+	orig := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "fred",
+			"namespace": "blee",
+		},
+	}}
+	raw, err := yaml.Marshal(orig.Object)
+	assert.Nil(t, err)
+
+	uu := map[string]struct {
+		buff string
+		err  string
+	}{
+		"empty": {
+			buff: editHeader,
+			err:  ErrEditCanceled.Error(),
+		},
+		"unchanged": {
+			buff: editHeader + string(raw),
+			err:  ErrEditCanceled.Error(),
+		},
+		"invalid": {
+			buff: "kind: [Pod",
+			err:  "invalid manifest",
+		},
+		"kind": {
+			buff: "kind: Job\nmetadata:\n  name: fred\n  namespace: blee\n",
+			err:  `kind can't be changed from "Pod" to "Job"`,
+		},
+		"name": {
+			buff: "kind: Pod\nmetadata:\n  name: zorg\n  namespace: blee\n",
+			err:  `name can't be changed from "fred" to "zorg"`,
+		},
+		"namespace": {
+			buff: "kind: Pod\nmetadata:\n  name: fred\n  namespace: zorg\n",
+			err:  `namespace can't be changed from "blee" to "zorg"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := EditSession{orig: &orig, raw: raw}
+			_, err := s.Validate(context.Background(), []byte(u.buff))
+			assert.Contains(t, err.Error(), u.err)
+		})
+	}
+}
+
+func TestEditPatch(t *testing.T) {
+	uu := map[string]struct {
+		orig, edited, e map[string]interface{}
+	}{
+		"unchanged": {
+			orig:   map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "resourceVersion": "1"}},
+			edited: map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "resourceVersion": "1"}},
+			e:      map[string]interface{}{},
+		},
+		"staleVersion": {
+			orig:   map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "resourceVersion": "1"}},
+			edited: map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "resourceVersion": "2"}},
+			e:      map[string]interface{}{},
+		},
+		"labels": {
+			orig: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "fred",
+					"labels": map[string]interface{}{"app": "blee", "env": "dev"},
+				},
+			},
+			edited: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "fred",
+					"labels": map[string]interface{}{"app": "zorg"},
+				},
+			},
+			e: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "zorg", "env": nil},
+				},
+			},
+		},
+		"lists": {
+			orig:   map[string]interface{}{"spec": map[string]interface{}{"args": []interface{}{"a"}}},
+			edited: map[string]interface{}{"spec": map[string]interface{}{"args": []interface{}{"a", "b"}}},
+			e:      map[string]interface{}{"spec": map[string]interface{}{"args": []interface{}{"a", "b"}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, editPatch(u.orig, u.edited))
+		})
+	}
+}
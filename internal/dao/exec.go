@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecOptions tracks an interactive pod session options.
+type ExecOptions struct {
+	// Container tracks the target container.
+	Container string
+	// Command tracks the command to run, attaches to the container if blank.
+	Command []string
+	// Stdin, Stdout tracks the session streams.
+	Stdin  io.Reader
+	Stdout io.Writer
+	// SizeQueue tracks the terminal resizes.
+	SizeQueue remotecommand.TerminalSizeQueue
+	// Batch runs the command without a terminal, only streaming its output.
+	Batch bool
+}
+
+// Exec runs an interactive tty session in a pod container, either executing
+// a command or attaching to the container main process. Batch sessions run
+// the command without a tty.
+func Exec(c client.Connection, path string, opts ExecOptions) error {
+	sub := "exec"
+	if len(opts.Command) == 0 {
+		sub = "attach"
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := c.CanI(ns, "v1/pods:"+sub, []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to %s pods", sub)
+	}
+
+	tty := !opts.Batch
+	req := c.DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource(sub)
+	if sub == "exec" {
+		req.VersionedParams(&v1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    true,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+	} else {
+		req.VersionedParams(&v1.PodAttachOptions{
+			Container: opts.Container,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    true,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+	}
+
+	exec, err := remotecommand.NewSPDYExecutor(c.RestConfigOrDie(), "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return exec.Stream(remotecommand.StreamOptions{
+		Stdin:             opts.Stdin,
+		Stdout:            opts.Stdout,
+		Tty:               tty,
+		TerminalSizeQueue: opts.SizeQueue,
+	})
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -108,6 +112,84 @@ func (c *Helm) Delete(path string, cascade, force bool) error {
 	return nil
 }
 
+// HelmRevision represents a helm release revision.
+type HelmRevision struct {
+	Revision    int
+	Status      string
+	Chart       string
+	AppVersion  string
+	Description string
+	Updated     time.Time
+}
+
+// History returns a release revisions, latest first.
+func (c *Helm) History(path string) ([]HelmRevision, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := action.NewHistory(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return helmRevisions(rr), nil
+}
+
+// Rollback rolls a release back to the given revision.
+func (c *Helm) Rollback(path string, rev int) error {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+	rb := action.NewRollback(cfg)
+	rb.Version = rev
+
+	return rb.Run(n)
+}
+
+// Values returns a release revision values as yaml, either the user
+// supplied ones or the computed ones. Revision 0 designates the latest.
+func (c *Helm) Values(path string, rev int, all bool) (string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return "", err
+	}
+	get := action.NewGetValues(cfg)
+	get.Version, get.AllValues = rev, all
+	vals, err := get.Run(n)
+	if err != nil {
+		return "", err
+	}
+	raw, err := yaml.Marshal(vals)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// Manifest returns a release revision rendered manifest. Revision 0
+// designates the latest.
+func (c *Helm) Manifest(path string, rev int) (string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return "", err
+	}
+	get := action.NewGet(cfg)
+	get.Version = rev
+	resp, err := get.Run(n)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Manifest, nil
+}
+
 // EnsureHelmConfig return a new configuration.
 func (c *Helm) EnsureHelmConfig(ns string) (*action.Configuration, error) {
 	cfg := new(action.Configuration)
@@ -121,3 +203,24 @@ func (c *Helm) EnsureHelmConfig(ns string) (*action.Configuration, error) {
 func helmLogger(s string, args ...interface{}) {
 	log.Debug().Msgf("%s %v", s, args)
 }
+
+func helmRevisions(rr []*release.Release) []HelmRevision {
+	hh := make([]HelmRevision, 0, len(rr))
+	for _, r := range rr {
+		h := HelmRevision{Revision: r.Version}
+		if r.Info != nil {
+			h.Status, h.Description = r.Info.Status.String(), r.Info.Description
+			h.Updated = r.Info.LastDeployed.Time
+		}
+		if r.Chart != nil && r.Chart.Metadata != nil {
+			h.Chart = r.Chart.Metadata.Name + "-" + r.Chart.Metadata.Version
+			h.AppVersion = r.Chart.Metadata.AppVersion
+		}
+		hh = append(hh, h)
+	}
+	sort.Slice(hh, func(i, j int) bool {
+		return hh[i].Revision > hh[j].Revision
+	})
+
+	return hh
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+)
+
+func TestHelmRevisions(t *testing.T) {
+	at := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	rel := func(v int, s release.Status, desc string) *release.Release {
+		return &release.Release{
+			Version: v,
+			Info:    &release.Info{Status: s, Description: desc, LastDeployed: helmtime.Time{Time: at.Add(time.Duration(v) * time.Hour)}},
+			Chart:   &chart.Chart{Metadata: &chart.Metadata{Name: "nginx", Version: fmt.Sprintf("1.0.%d", v), AppVersion: "1.19"}},
+		}
+	}
+	rr := []*release.Release{
+		rel(1, release.StatusSuperseded, "Install complete"),
+		rel(3, release.StatusDeployed, "Rollback to 1"),
+		rel(2, release.StatusSuperseded, "Upgrade complete"),
+		{Version: 4},
+	}
+
+	hh := helmRevisions(rr)
+
+	assert.Equal(t, 4, len(hh))
+	assert.Equal(t, HelmRevision{Revision: 4}, hh[0])
+	assert.Equal(t, HelmRevision{
+		Revision:    3,
+		Status:      "deployed",
+		Chart:       "nginx-1.0.3",
+		AppVersion:  "1.19",
+		Description: "Rollback to 1",
+		Updated:     at.Add(3 * time.Hour),
+	}, hh[1])
+	assert.Equal(t, 1, hh[3].Revision)
+}
@@ -2,16 +2,40 @@ package dao
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"math"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/tools/cache"
 )
 
+// ErrCacheNotSynced indicates a resource cache is still loading.
+var ErrCacheNotSynced = errors.New("cache not synced yet")
+
+// WaitSynced waits for a resource cache to be fully loaded, up to the context
+// deadline, so listings don't silently miss resources.
+func WaitSynced(ctx context.Context, f Factory, ns, gvr string) error {
+	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+	if inf == nil {
+		return nil
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), inf.Informer().HasSynced) {
+		return fmt.Errorf("%s %w", gvr, ErrCacheNotSynced)
+	}
+
+	return nil
+}
+
 // IsFuzzySelector checks if filter is fuzzy or not.
 func IsFuzzySelector(s string) bool {
 	if s == "" {
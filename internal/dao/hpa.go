@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -37,6 +39,7 @@ func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtim
 	for _, gvr := range gvrs {
 		oo, err := h.list(gvr, ns, lsel)
 		if err == nil && len(oo) > 0 {
+			recordReplicas(oo)
 			return oo, nil
 		}
 	}
@@ -45,6 +48,21 @@ func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtim
 	return []runtime.Object{}, nil
 }
 
+// recordReplicas tracks the autoscalers replica counts over the session.
+func recordReplicas(oo []runtime.Object) {
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		n, ok, err := unstructured.NestedInt64(u.Object, "status", "currentReplicas")
+		if err != nil || !ok {
+			continue
+		}
+		RecordHPAReplicas(client.FQN(u.GetNamespace(), u.GetName()), int32(n))
+	}
+}
+
 func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
 	oo, err := h.Factory.List(gvr, ns, true, sel)
 	if err != nil {
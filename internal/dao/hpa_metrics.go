@@ -0,0 +1,304 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	hpaMaxEvents  = 20
+	hpaMaxSamples = 60
+
+	hpaSampleInterval = 10 * time.Second
+)
+
+// hpaHistory tracks the autoscalers replica counts over the session.
+var hpaHistory = newReplicaHistory(hpaMaxSamples, hpaSampleInterval)
+
+// HPAMetric represents an autoscaler metric current value versus its target.
+// Live tracks the value computed from metrics-server for resource metrics.
+type HPAMetric struct {
+	Type, Name, Current, Target, Live string
+}
+
+// HPAReport represents an autoscaler live status.
+type HPAReport struct {
+	Path, Reference            string
+	Min, Max, Current, Desired int32
+	Metrics                    []HPAMetric
+	Events                     []v1.Event
+	Replicas                   []int32
+}
+
+// FetchHPAReport joins an autoscaler status with its target pods live metrics
+// and scaling events.
+func FetchHPAReport(f Factory, path string) (*HPAReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+
+	ns, n := client.Namespaced(path)
+	dial := f.Client().DialOrDie()
+	hpa, err := dial.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ref := hpa.Spec.ScaleTargetRef
+	r := HPAReport{
+		Path:      path,
+		Reference: ref.Kind + "/" + ref.Name,
+		Min:       1,
+		Max:       hpa.Spec.MaxReplicas,
+		Current:   hpa.Status.CurrentReplicas,
+		Desired:   hpa.Status.DesiredReplicas,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		r.Min = *hpa.Spec.MinReplicas
+	}
+	hpaHistory.Add(path, r.Current, time.Now())
+	r.Replicas = hpaHistory.Get(path)
+
+	usage, err := targetUsage(ctx, f, ns, ref)
+	if err != nil {
+		return nil, err
+	}
+	for i, spec := range hpa.Spec.Metrics {
+		var status *autoscalingv2beta2.MetricStatus
+		if i < len(hpa.Status.CurrentMetrics) {
+			status = &hpa.Status.CurrentMetrics[i]
+		}
+		r.Metrics = append(r.Metrics, newHPAMetric(spec, status, usage))
+	}
+
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=HorizontalPodAutoscaler,involvedObject.name=" + n,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.Events = latestEvents(ee.Items, hpaMaxEvents)
+
+	return &r, nil
+}
+
+// RecordHPAReplicas records an autoscaler current replica count.
+func RecordHPAReplicas(path string, replicas int32) {
+	hpaHistory.Add(path, replicas, time.Now())
+}
+
+// podsUsage tracks the target pods resources usage and requests.
+type podsUsage struct {
+	pods             int
+	usage, requests  v1.ResourceList
+	metricsAvailable bool
+}
+
+// targetUsage sums up the autoscaler target pods usage and requests. Usage is
+// left blank when the target has no known scale selector or metrics-server
+// is not available.
+func targetUsage(ctx context.Context, f Factory, ns string, ref autoscalingv2beta2.CrossVersionObjectReference) (*podsUsage, error) {
+	apps := f.Client().DialOrDie().AppsV1()
+	var (
+		scale *autoscalingv1.Scale
+		err   error
+	)
+	switch ref.Kind {
+	case "Deployment":
+		scale, err = apps.Deployments(ns).GetScale(ctx, ref.Name, metav1.GetOptions{})
+	case "StatefulSet":
+		scale, err = apps.StatefulSets(ns).GetScale(ctx, ref.Name, metav1.GetOptions{})
+	case "ReplicaSet":
+		scale, err = apps.ReplicaSets(ns).GetScale(ctx, ref.Name, metav1.GetOptions{})
+	default:
+		return &podsUsage{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sel, err := labels.Parse(scale.Status.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := f.Client().DialOrDie().CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+	u := podsUsage{usage: v1.ResourceList{}, requests: v1.ResourceList{}}
+	names := make(map[string]struct{}, len(pp.Items))
+	for _, po := range pp.Items {
+		if po.Status.Phase != v1.PodRunning {
+			continue
+		}
+		names[po.Name] = struct{}{}
+		u.pods++
+		for _, co := range po.Spec.Containers {
+			addResources(u.requests, co.Resources.Requests)
+		}
+	}
+
+	mx, err := client.DialMetrics(f.Client()).FetchPodsMetrics(ctx, ns)
+	if err != nil {
+		return &u, nil
+	}
+	u.metricsAvailable = true
+	for _, m := range mx.Items {
+		if _, ok := names[m.Name]; !ok {
+			continue
+		}
+		addContainersUsage(u.usage, m.Containers)
+	}
+
+	return &u, nil
+}
+
+func addResources(acc, rr v1.ResourceList) {
+	for k, q := range rr {
+		sum := acc[k]
+		sum.Add(q)
+		acc[k] = sum
+	}
+}
+
+func addContainersUsage(acc v1.ResourceList, cc []mv1beta1.ContainerMetrics) {
+	for _, c := range cc {
+		addResources(acc, c.Usage)
+	}
+}
+
+func newHPAMetric(spec autoscalingv2beta2.MetricSpec, status *autoscalingv2beta2.MetricStatus, u *podsUsage) HPAMetric {
+	m := HPAMetric{Type: string(spec.Type), Current: "<unknown>", Target: "<unknown>"}
+	switch spec.Type {
+	case autoscalingv2beta2.ResourceMetricSourceType:
+		m.Name = string(spec.Resource.Name)
+		m.Target = metricTarget(spec.Resource.Target)
+		if status != nil && status.Resource != nil {
+			m.Current = metricValue(status.Resource.Current)
+		}
+		m.Live = liveUsage(spec.Resource.Name, spec.Resource.Target, u)
+	case autoscalingv2beta2.PodsMetricSourceType:
+		m.Name = spec.Pods.Metric.Name
+		m.Target = metricTarget(spec.Pods.Target)
+		if status != nil && status.Pods != nil {
+			m.Current = metricValue(status.Pods.Current)
+		}
+	case autoscalingv2beta2.ObjectMetricSourceType:
+		m.Name = spec.Object.DescribedObject.Kind + "/" + spec.Object.DescribedObject.Name + " " + spec.Object.Metric.Name
+		m.Target = metricTarget(spec.Object.Target)
+		if status != nil && status.Object != nil {
+			m.Current = metricValue(status.Object.Current)
+		}
+	case autoscalingv2beta2.ExternalMetricSourceType:
+		m.Name = spec.External.Metric.Name
+		m.Target = metricTarget(spec.External.Target)
+		if status != nil && status.External != nil {
+			m.Current = metricValue(status.External.Current)
+		}
+	}
+
+	return m
+}
+
+func metricTarget(t autoscalingv2beta2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return strconv.Itoa(int(*t.AverageUtilization)) + "%"
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	}
+
+	return "<unknown>"
+}
+
+func metricValue(v autoscalingv2beta2.MetricValueStatus) string {
+	switch {
+	case v.AverageUtilization != nil:
+		return strconv.Itoa(int(*v.AverageUtilization)) + "%"
+	case v.AverageValue != nil:
+		return v.AverageValue.String()
+	case v.Value != nil:
+		return v.Value.String()
+	}
+
+	return "<unknown>"
+}
+
+// liveUsage computes a resource metric from the target pods live usage.
+func liveUsage(n v1.ResourceName, t autoscalingv2beta2.MetricTarget, u *podsUsage) string {
+	if u == nil || !u.metricsAvailable || u.pods == 0 {
+		return ""
+	}
+	usage, ok := u.usage[n]
+	if !ok {
+		return ""
+	}
+	if t.AverageUtilization != nil {
+		req, ok := u.requests[n]
+		if !ok || req.IsZero() {
+			return "<no requests>"
+		}
+		return strconv.Itoa(int(usage.MilliValue()*100/req.MilliValue())) + "%"
+	}
+	avg := resource.NewMilliQuantity(usage.MilliValue()/int64(u.pods), usage.Format)
+
+	return avg.String()
+}
+
+// ----------------------------------------------------------------------------
+
+// replicaHistory tracks replica counts samples per resource, keeping at most
+// one sample per interval.
+type replicaHistory struct {
+	mx       sync.RWMutex
+	size     int
+	interval time.Duration
+	samples  map[string][]int32
+	last     map[string]time.Time
+}
+
+func newReplicaHistory(size int, interval time.Duration) *replicaHistory {
+	return &replicaHistory{
+		size:     size,
+		interval: interval,
+		samples:  make(map[string][]int32),
+		last:     make(map[string]time.Time),
+	}
+}
+
+// Add records a replica count sample, replacing the latest one if it was
+// taken within the sampling interval.
+func (h *replicaHistory) Add(path string, n int32, now time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss := h.samples[path]
+	if len(ss) > 0 && now.Sub(h.last[path]) < h.interval {
+		ss[len(ss)-1] = n
+		return
+	}
+	ss = append(ss, n)
+	if len(ss) > h.size {
+		ss = ss[len(ss)-h.size:]
+	}
+	h.samples[path], h.last[path] = ss, now
+}
+
+// Get returns a copy of the recorded samples.
+func (h *replicaHistory) Get(path string) []int32 {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return append([]int32(nil), h.samples[path]...)
+}
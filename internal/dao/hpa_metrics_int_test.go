@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestReplicaHistory(t *testing.T) {
+	h := newReplicaHistory(3, 10*time.Second)
+	now := time.Now()
+	h.Add("default/fred", 1, now)
+	h.Add("default/fred", 2, now.Add(time.Second))
+	h.Add("default/fred", 3, now.Add(20*time.Second))
+	h.Add("default/fred", 4, now.Add(40*time.Second))
+	h.Add("default/fred", 5, now.Add(60*time.Second))
+
+	assert.Equal(t, []int32{3, 4, 5}, h.Get("default/fred"))
+	assert.Nil(t, h.Get("default/blee"))
+}
+
+func TestLiveUsage(t *testing.T) {
+	util := int32(50)
+	avg := resource.MustParse("100m")
+	u := podsUsage{
+		pods:             2,
+		metricsAvailable: true,
+		usage:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("300m")},
+		requests:         v1.ResourceList{v1.ResourceCPU: resource.MustParse("400m")},
+	}
+
+	uu := map[string]struct {
+		n v1.ResourceName
+		t autoscalingv2beta2.MetricTarget
+		u *podsUsage
+		e string
+	}{
+		"utilization": {
+			n: v1.ResourceCPU,
+			t: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			u: &u,
+			e: "75%",
+		},
+		"average": {
+			n: v1.ResourceCPU,
+			t: autoscalingv2beta2.MetricTarget{AverageValue: &avg},
+			u: &u,
+			e: "150m",
+		},
+		"noRequests": {
+			n: v1.ResourceCPU,
+			t: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			u: &podsUsage{
+				pods:             1,
+				metricsAvailable: true,
+				usage:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("300m")},
+			},
+			e: "<no requests>",
+		},
+		"noMetrics": {
+			n: v1.ResourceMemory,
+			t: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			u: &u,
+		},
+		"noServer": {
+			n: v1.ResourceCPU,
+			t: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			u: &podsUsage{pods: 1},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, liveUsage(u.n, u.t, u.u))
+		})
+	}
+}
@@ -1,22 +1,45 @@
 package dao
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
 )
 
 var (
-	_ Accessor = (*Job)(nil)
-	_ Nuker    = (*Job)(nil)
-	_ Loggable = (*Job)(nil)
+	_ Accessor   = (*Job)(nil)
+	_ Nuker      = (*Job)(nil)
+	_ Loggable   = (*Job)(nil)
+	_ Rerunnable = (*Job)(nil)
 )
 
+var rerunSuffixRx = regexp.MustCompile(`-rerun-[a-z0-9]{3}\z`)
+
+// jobGeneratedKeys tracks the job labels and annotations set by the api server
+// or kubectl that must not be carried over to a new run.
+var jobGeneratedKeys = map[string]struct{}{
+	"controller-uid":                                   {},
+	"job-name":                                         {},
+	"batch.kubernetes.io/controller-uid":               {},
+	"batch.kubernetes.io/job-name":                     {},
+	"kubectl.kubernetes.io/last-applied-configuration": {},
+}
+
 // Job represents a K8s job resource.
 type Job struct {
 	Resource
@@ -41,3 +64,178 @@ func (j *Job) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 
 	return podLogs(ctx, c, job.Spec.Selector.MatchLabels, opts)
 }
+
+// Rerun creates a new Job off a Job spec and returns the new job name.
+func (j *Job) Rerun(ctx context.Context, path string) (string, error) {
+	ns, _ := client.Namespaced(path)
+	auth, err := j.Client().CanI(ns, "batch/v1/jobs", []string{client.GetVerb, client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to rerun jobs")
+	}
+
+	o, err := j.Factory.Get(j.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var job batchv1.Job
+	if err := fromUnstructured(o, &job); err != nil {
+		return "", errors.New("expecting a job resource")
+	}
+	nj, err := j.Client().DialOrDie().BatchV1().Jobs(ns).Create(ctx, rerunJob(&job), metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return nj.Name, nil
+}
+
+// rerunJob returns a copy of a job without its generated selector so the
+// api server assigns new ones.
+func rerunJob(job *batchv1.Job) *batchv1.Job {
+	name := rerunSuffixRx.ReplaceAllString(job.Name, "")
+	if len(name) >= maxJobNameSize {
+		name = name[0:maxJobNameSize]
+	}
+
+	spec := job.Spec.DeepCopy()
+	spec.Selector, spec.ManualSelector = nil, nil
+	spec.Template.Labels = withoutGeneratedKeys(spec.Template.Labels)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name + "-rerun-" + rand.String(3),
+			Namespace:       job.Namespace,
+			Labels:          withoutGeneratedKeys(job.Labels),
+			Annotations:     withoutGeneratedKeys(job.Annotations),
+			OwnerReferences: job.OwnerReferences,
+		},
+		Spec: *spec,
+	}
+}
+
+func withoutGeneratedKeys(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	mm := make(map[string]string, len(m))
+	for k, v := range m {
+		if _, ok := jobGeneratedKeys[k]; !ok {
+			mm[k] = v
+		}
+	}
+
+	return mm
+}
+
+// AggregateLogs gathers logs from all pods owned by this Job, including completed
+// and failed ones, interleaved by time. Previous logs are included for containers
+// that restarted. This call blocks until all logs are fetched or the context is done.
+func (j *Job) AggregateLogs(ctx context.Context, path string, lines int64) (LogItems, error) {
+	o, err := j.Factory.Get(j.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var job batchv1.Job
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
+	if err != nil {
+		return nil, errors.New("expecting a job resource")
+	}
+	if job.Spec.Selector == nil || len(job.Spec.Selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("No valid selector found on Job %s", path)
+	}
+
+	ns, _ := client.Namespaced(path)
+	oo, err := j.Factory.List("v1/pods", ns, true, labels.SelectorFromSet(job.Spec.Selector.MatchLabels))
+	if err != nil {
+		return nil, err
+	}
+
+	po := Pod{}
+	po.Init(j.Factory, client.NewGVR("v1/pods"))
+	var ll LogItems
+	for _, o := range oo {
+		var pod v1.Pod
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
+		if err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(pod.Namespace, pod.Name)
+		css := append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		for _, cs := range append(css, pod.Status.ContainerStatuses...) {
+			if err := ctx.Err(); err != nil {
+				return nil, fmt.Errorf("aggregating logs for job %s: %w", path, err)
+			}
+			if cs.RestartCount > 0 {
+				ll = append(ll, fetchLogs(ctx, &po, fqn, cs.Name, lines, true)...)
+			}
+			ll = append(ll, fetchLogs(ctx, &po, fqn, cs.Name, lines, false)...)
+		}
+	}
+	SortLogs(ll)
+
+	return ll, nil
+}
+
+// SortLogs sorts log items by timestamp, preserving the order of lines
+// sharing the same timestamp.
+func SortLogs(ll LogItems) {
+	sort.SliceStable(ll, func(i, j int) bool {
+		t1, err1 := time.Parse(time.RFC3339Nano, ll[i].Timestamp)
+		t2, err2 := time.Parse(time.RFC3339Nano, ll[j].Timestamp)
+		if err1 != nil || err2 != nil {
+			return ll[i].Timestamp < ll[j].Timestamp
+		}
+		return t1.Before(t2)
+	})
+}
+
+func fetchLogs(ctx context.Context, po *Pod, path, co string, lines int64, prev bool) LogItems {
+	opts := v1.PodLogOptions{
+		Container:  co,
+		Previous:   prev,
+		Timestamps: true,
+	}
+	if lines > 0 {
+		opts.TailLines = &lines
+	}
+	req, err := po.Logs(path, &opts)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to fetch logs for %s:%s", path, co)
+		return nil
+	}
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to obtain log stream for %s:%s", path, co)
+		return nil
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.Error().Err(err).Msgf("Fail to close stream %s:%s", path, co)
+		}
+	}()
+
+	_, n := client.Namespaced(path)
+	var ll LogItems
+	r := bufio.NewReader(stream)
+	for {
+		bb, err := r.ReadBytes('\n')
+		if len(bb) > 0 {
+			if bb[len(bb)-1] != '\n' {
+				bb = append(bb, '\n')
+			}
+			item := NewLogItem(bb)
+			item.Pod, item.Container = n, co
+			ll = append(ll, item)
+		}
+		if err != nil {
+			if err != io.EOF {
+				log.Warn().Err(err).Msgf("Stream READ error %s:%s", path, co)
+			}
+			return ll
+		}
+	}
+}
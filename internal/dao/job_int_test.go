@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRerunJob(t *testing.T) {
+	manual := true
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fred-rerun-x2z",
+			Namespace: "blee",
+			Labels:    map[string]string{"app": "fred", "controller-uid": "1", "job-name": "fred"},
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			ManualSelector: &manual,
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "1"}},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "fred", "controller-uid": "1", "batch.kubernetes.io/job-name": "fred"},
+				},
+				Spec: v1.PodSpec{RestartPolicy: v1.RestartPolicyNever},
+			},
+		},
+		Status: batchv1.JobStatus{Failed: 1},
+	}
+
+	nj := rerunJob(&job)
+	assert.True(t, strings.HasPrefix(nj.Name, "fred-rerun-"))
+	assert.NotEqual(t, job.Name, nj.Name)
+	assert.Equal(t, len(job.Name), len(nj.Name))
+	assert.Equal(t, "blee", nj.Namespace)
+	assert.Equal(t, map[string]string{"app": "fred"}, nj.Labels)
+	assert.Equal(t, 0, len(nj.Annotations))
+	assert.Nil(t, nj.Spec.Selector)
+	assert.Nil(t, nj.Spec.ManualSelector)
+	assert.Equal(t, map[string]string{"app": "fred"}, nj.Spec.Template.Labels)
+	assert.Equal(t, v1.RestartPolicyNever, nj.Spec.Template.Spec.RestartPolicy)
+	assert.Equal(t, batchv1.JobStatus{}, nj.Status)
+	assert.NotNil(t, job.Spec.Selector)
+}
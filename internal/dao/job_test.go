@@ -0,0 +1,51 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortLogs(t *testing.T) {
+	uu := map[string]struct {
+		ll dao.LogItems
+		e  []string
+	}{
+		"empty": {},
+		"interleaved": {
+			ll: dao.LogItems{
+				{Pod: "p1", Timestamp: "2020-05-01T10:00:02Z"},
+				{Pod: "p2", Timestamp: "2020-05-01T10:00:01.5Z"},
+				{Pod: "p1", Timestamp: "2020-05-01T10:00:01.25Z"},
+			},
+			e: []string{"p1", "p2", "p1"},
+		},
+		"nanos": {
+			ll: dao.LogItems{
+				{Pod: "p1", Timestamp: "2020-05-01T10:00:05.1Z"},
+				{Pod: "p2", Timestamp: "2020-05-01T10:00:05.12Z"},
+			},
+			e: []string{"p1", "p2"},
+		},
+		"stable": {
+			ll: dao.LogItems{
+				{Pod: "p2", Timestamp: "2020-05-01T10:00:01Z"},
+				{Pod: "p1", Timestamp: "2020-05-01T10:00:01Z"},
+			},
+			e: []string{"p2", "p1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dao.SortLogs(u.ll)
+			var pp []string
+			for _, l := range u.ll {
+				pp = append(pp, l.Pod)
+			}
+			assert.Equal(t, u.e, pp)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	karpenterGroup     = "karpenter.sh"
+	karpenterSource    = "karpenter"
+	karpenterMaxEvents = 30
+)
+
+// karpenterVersions lists supported Karpenter api versions by preference.
+var karpenterVersions = []string{"v1", "v1beta1"}
+
+// EventSummary represents a condensed event.
+type EventSummary struct {
+	Object  string `json:"object"`
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Count   int32  `json:"count,omitempty"`
+	Last    string `json:"last"`
+	Message string `json:"message"`
+}
+
+// KarpenterGVR returns the served Karpenter gvr for a resource ie nodeclaims.
+func KarpenterGVR(res string) (client.GVR, error) {
+	for _, v := range karpenterVersions {
+		gvr := client.NewGVR(path.Join(karpenterGroup, v, res))
+		if _, err := MetaAccess.MetaFor(gvr); err == nil {
+			return gvr, nil
+		}
+	}
+
+	return client.GVR{}, fmt.Errorf("no %s.%s resource found. Is Karpenter installed?", res, karpenterGroup)
+}
+
+// FetchNodeClaimFor returns the name of the NodeClaim owning a given node.
+func FetchNodeClaimFor(f Factory, node string) (client.GVR, string, error) {
+	gvr, err := KarpenterGVR("nodeclaims")
+	if err != nil {
+		return gvr, "", err
+	}
+	oo, err := f.List(gvr.String(), client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return gvr, "", err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return gvr, "", fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if n, _, _ := unstructured.NestedString(u.Object, "status", "nodeName"); n == node {
+			return gvr, u.GetName(), nil
+		}
+	}
+
+	return gvr, "", fmt.Errorf("no nodeclaim found for node %q", node)
+}
+
+// FetchKarpenterEvents returns the latest Karpenter events for a claim and its node.
+func FetchKarpenterEvents(f Factory, claim, node string) ([]EventSummary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+
+	dial := f.Client().DialOrDie().CoreV1()
+	var ee []v1.Event
+	for _, n := range []string{claim, node} {
+		if n == "" {
+			continue
+		}
+		sel := "involvedObject.name=" + n + ",source=" + karpenterSource
+		ll, err := dial.Events(client.AllNamespaces).List(ctx, metav1.ListOptions{FieldSelector: sel})
+		if err != nil {
+			return nil, err
+		}
+		ee = append(ee, ll.Items...)
+	}
+
+	return summarizeEvents(latestEvents(ee, karpenterMaxEvents)), nil
+}
+
+func summarizeEvents(ee []v1.Event) []EventSummary {
+	ss := make([]EventSummary, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, EventSummary{
+			Object:  e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Count:   e.Count,
+			Last:    eventTime(e).String(),
+			Message: e.Message,
+		})
+	}
+
+	return ss
+}
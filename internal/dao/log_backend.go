@@ -0,0 +1,262 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// LogQuery represents a historical logs query.
+type LogQuery struct {
+	Namespace, Pod, Container string
+	Start, End                time.Time
+	Limit                     int
+}
+
+// LogLine represents a historical log line.
+type LogLine struct {
+	Time time.Time
+	Line string
+}
+
+// LogBackend represents a historical logs store.
+type LogBackend interface {
+	// Query returns the log lines matching the query, oldest first.
+	Query(ctx context.Context, q LogQuery) ([]LogLine, error)
+}
+
+// NewLogBackend returns a log backend for the given configuration.
+func NewLogBackend(cfg *config.LogBackend) (LogBackend, error) {
+	if !cfg.IsEnabled() {
+		return nil, fmt.Errorf("log backend %q is not configured", cfg.Kind)
+	}
+	tpl, err := template.New(cfg.Kind).Parse(cfg.Query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log backend query: %w", err)
+	}
+	c := &http.Client{Timeout: client.CallTimeout}
+	switch cfg.Kind {
+	case config.LogBackendLoki:
+		return &lokiLogs{cfg: cfg, query: tpl, client: c}, nil
+	default:
+		return &elasticLogs{cfg: cfg, query: tpl, client: c}, nil
+	}
+}
+
+// HistoricalLogs fetches a pod logs from the backend ahead of the live tail,
+// converted to log items.
+func HistoricalLogs(ctx context.Context, b LogBackend, opts LogOptions, since time.Duration, limit int) (LogItems, error) {
+	ns, po := client.Namespaced(opts.Path)
+	now := time.Now()
+	ll, err := b.Query(ctx, LogQuery{
+		Namespace: ns,
+		Pod:       po,
+		Container: opts.Container,
+		Start:     now.Add(-since),
+		End:       now,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ii := make(LogItems, 0, len(ll))
+	for _, l := range ll {
+		raw := []byte(l.Time.UTC().Format(time.RFC3339Nano) + " " + strings.TrimRight(l.Line, "\n") + "\n")
+		ii = append(ii, opts.DecorateLog(raw))
+	}
+
+	return ii, nil
+}
+
+// MergeLogs prepends historical lines to the live ones, dropping the ones
+// the live tail already covers.
+func MergeLogs(history, live LogItems) LogItems {
+	if len(live) == 0 {
+		return history
+	}
+	first, err := time.Parse(time.RFC3339Nano, live[0].Timestamp)
+	if err != nil {
+		return append(history, live...)
+	}
+	ii := make(LogItems, 0, len(history)+len(live))
+	for _, h := range history {
+		t, err := time.Parse(time.RFC3339Nano, h.Timestamp)
+		if err != nil || !t.Before(first) {
+			continue
+		}
+		ii = append(ii, h)
+	}
+
+	return append(ii, live...)
+}
+
+func renderQuery(tpl *template.Template, q LogQuery) (string, error) {
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, q); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func getJSON(ctx context.Context, c *http.Client, method, u string, body []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing log backend response")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("log backend query failed: %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ----------------------------------------------------------------------------
+
+// lokiLogs queries logs from Loki.
+type lokiLogs struct {
+	cfg    *config.LogBackend
+	query  *template.Template
+	client *http.Client
+}
+
+// Query returns the log lines matching the query.
+func (l *lokiLogs) Query(ctx context.Context, q LogQuery) ([]LogLine, error) {
+	expr, err := renderQuery(l.query, q)
+	if err != nil {
+		return nil, err
+	}
+	v := url.Values{}
+	v.Set("query", expr)
+	v.Set("start", strconv.FormatInt(q.Start.UnixNano(), 10))
+	v.Set("end", strconv.FormatInt(q.End.UnixNano(), 10))
+	v.Set("limit", strconv.Itoa(q.Limit))
+	// Loki returns the latest lines first when limited.
+	v.Set("direction", "backward")
+
+	var lr lokiResponse
+	u := strings.TrimRight(l.cfg.URL, "/") + "/loki/api/v1/query_range?" + v.Encode()
+	if err := getJSON(ctx, l.client, http.MethodGet, u, nil, &lr); err != nil {
+		return nil, err
+	}
+
+	return lokiLines(lr), nil
+}
+
+func lokiLines(lr lokiResponse) []LogLine {
+	var ll []LogLine
+	for _, r := range lr.Data.Result {
+		for _, v := range r.Values {
+			if len(v) != 2 {
+				continue
+			}
+			ns, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			ll = append(ll, LogLine{Time: time.Unix(0, ns), Line: v[1]})
+		}
+	}
+	sortLines(ll)
+
+	return ll
+}
+
+// ----------------------------------------------------------------------------
+
+// elasticLogs queries logs from Elasticsearch.
+type elasticLogs struct {
+	cfg    *config.LogBackend
+	query  *template.Template
+	client *http.Client
+}
+
+type elasticResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// Query returns the log lines matching the query.
+func (e *elasticLogs) Query(ctx context.Context, q LogQuery) ([]LogLine, error) {
+	expr, err := renderQuery(e.query, q)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"size": q.Limit,
+		"sort": []interface{}{map[string]string{e.cfg.TimeField: "desc"}},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": []interface{}{
+					map[string]interface{}{"query_string": map[string]string{"query": expr}},
+					map[string]interface{}{"range": map[string]interface{}{
+						e.cfg.TimeField: map[string]string{
+							"gte": q.Start.UTC().Format(time.RFC3339Nano),
+							"lte": q.End.UTC().Format(time.RFC3339Nano),
+						},
+					}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var er elasticResponse
+	u := strings.TrimRight(e.cfg.URL, "/") + "/" + url.PathEscape(e.cfg.Index) + "/_search"
+	if err := getJSON(ctx, e.client, http.MethodPost, u, body, &er); err != nil {
+		return nil, err
+	}
+
+	return elasticLines(er, e.cfg.TimeField, e.cfg.MessageField), nil
+}
+
+func elasticLines(er elasticResponse, timeField, msgField string) []LogLine {
+	ll := make([]LogLine, 0, len(er.Hits.Hits))
+	for _, h := range er.Hits.Hits {
+		ts, _ := h.Source[timeField].(string)
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			continue
+		}
+		msg, _ := h.Source[msgField].(string)
+		ll = append(ll, LogLine{Time: t, Line: msg})
+	}
+	sortLines(ll)
+
+	return ll
+}
+
+func sortLines(ll []LogLine) {
+	sort.SliceStable(ll, func(i, j int) bool {
+		return ll[i].Time.Before(ll[j].Time)
+	})
+}
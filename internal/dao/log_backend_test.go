@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeLogs(t *testing.T) {
+	item := func(ts, msg string) *dao.LogItem {
+		return dao.NewLogItem([]byte(ts + " " + msg + "\n"))
+	}
+	history := dao.LogItems{
+		item("2020-05-02T10:00:00Z", "h1"),
+		item("2020-05-02T10:00:01Z", "h2"),
+		item("2020-05-02T10:00:02Z", "h3"),
+	}
+
+	uu := map[string]struct {
+		live dao.LogItems
+		e    []string
+	}{
+		"noLive": {
+			e: []string{"h1", "h2", "h3"},
+		},
+		"overlap": {
+			live: dao.LogItems{
+				item("2020-05-02T10:00:02Z", "h3"),
+				item("2020-05-02T10:00:03Z", "l1"),
+			},
+			e: []string{"h1", "h2", "h3", "l1"},
+		},
+		"disjoint": {
+			live: dao.LogItems{item("2020-05-02T10:00:05Z", "l1")},
+			e:    []string{"h1", "h2", "h3", "l1"},
+		},
+		"covered": {
+			live: dao.LogItems{item("2020-05-02T09:00:00Z", "l1")},
+			e:    []string{"l1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii := dao.MergeLogs(history, u.live)
+			ll := make([]string, 0, len(ii))
+			for _, i := range ii {
+				ll = append(ll, string(i.Bytes))
+			}
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestLokiLogBackend(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/loki/api/v1/query_range", r.URL.Path)
+		query = r.URL.Query().Get("query")
+		_, _ = w.Write([]byte(`{"data":{"result":[{"values":[["1588413602000000000","l2"],["1588413601000000000","l1"]]}]}}`))
+	}))
+	defer srv.Close()
+
+	cfg := config.LogBackend{Kind: config.LogBackendLoki, URL: srv.URL}
+	cfg.Validate()
+	b, err := dao.NewLogBackend(&cfg)
+	assert.Nil(t, err)
+
+	ll, err := b.Query(context.Background(), dao.LogQuery{Namespace: "ns1", Pod: "p1", Container: "c1", Start: time.Now().Add(-time.Hour), End: time.Now(), Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, `{namespace="ns1", pod="p1", container="c1"}`, query)
+	assert.Equal(t, 2, len(ll))
+	assert.Equal(t, "l1", ll[0].Line)
+	assert.Equal(t, "l2", ll[1].Line)
+}
+
+func TestElasticLogBackend(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/logstash-*/_search", r.URL.Path)
+		raw, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_source":{"@timestamp":"2020-05-02T10:00:02Z","log":"e2"}},{"_source":{"@timestamp":"2020-05-02T10:00:01Z","log":"e1"}},{"_source":{"log":"toast"}}]}}`))
+	}))
+	defer srv.Close()
+
+	cfg := config.LogBackend{Kind: config.LogBackendElastic, URL: srv.URL}
+	cfg.Validate()
+	b, err := dao.NewLogBackend(&cfg)
+	assert.Nil(t, err)
+
+	ll, err := b.Query(context.Background(), dao.LogQuery{Namespace: "ns1", Pod: "p1", Start: time.Now().Add(-time.Hour), End: time.Now(), Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), body["size"])
+	assert.Equal(t, 2, len(ll))
+	assert.Equal(t, "e1", ll[0].Line)
+	assert.Equal(t, "e2", ll[1].Line)
+}
+
+func TestNewLogBackendDisabled(t *testing.T) {
+	_, err := dao.NewLogBackend(&config.LogBackend{Kind: config.LogBackendLoki})
+	assert.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DownloadPending tracks a log waiting to be downloaded.
+	DownloadPending = "pending"
+	// DownloadRunning tracks a log being downloaded.
+	DownloadRunning = "running"
+	// DownloadDone tracks a downloaded log.
+	DownloadDone = "done"
+	// DownloadFailed tracks a log that failed to download.
+	DownloadFailed = "failed"
+
+	downloadReportRate = 250 * time.Millisecond
+)
+
+// LogDownloadOptions tracks a logs download options.
+type LogDownloadOptions struct {
+	// Dir tracks the download directory.
+	Dir string
+	// LimitBytes caps each container log size. Unlimited if zero.
+	LimitBytes int64
+	// Since tracks how far back to fetch logs. All logs if zero.
+	Since time.Duration
+	// Previous fetches the previous containers instances logs.
+	Previous bool
+}
+
+// LogDownload represents a container log download progress.
+type LogDownload struct {
+	Path, Container string
+	File            string
+	Status          string
+	Bytes           int64
+	Message         string
+}
+
+// ID returns the download pod container id.
+func (d LogDownload) ID() string {
+	return d.Path + ":" + d.Container
+}
+
+// LogDownloadReporter reports logs downloads progress.
+type LogDownloadReporter func(LogDownload)
+
+// DownloadLogs downloads the complete logs of the given pods containers, one
+// file per container named after the pod fqn and container.
+func DownloadLogs(ctx context.Context, f Factory, paths []string, opts LogDownloadOptions, report LogDownloadReporter) error {
+	var po Pod
+	po.Init(f, client.NewGVR("v1/pods"))
+
+	var dd []LogDownload
+	for _, path := range paths {
+		pod, err := po.GetInstance(path)
+		if err != nil {
+			return err
+		}
+		for _, co := range podContainers(pod) {
+			d := LogDownload{
+				Path:      path,
+				Container: co,
+				File:      logDownloadFile(opts, path, co),
+				Status:    DownloadPending,
+			}
+			dd = append(dd, d)
+			report(d)
+		}
+	}
+
+	var failed int
+	for _, d := range dd {
+		if ctx.Err() != nil {
+			return fmt.Errorf("logs download interrupted: %w", ctx.Err())
+		}
+		d.Status = DownloadRunning
+		report(d)
+		if err := downloadLog(ctx, &po, &d, opts, report); err != nil {
+			log.Error().Err(err).Msgf("Log download failed for %s", d.ID())
+			failed++
+			d.Status, d.Message = DownloadFailed, err.Error()
+		} else {
+			d.Status = DownloadDone
+		}
+		report(d)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d/%d logs download failed", failed, len(dd))
+	}
+
+	return nil
+}
+
+func downloadLog(ctx context.Context, l Logger, d *LogDownload, opts LogDownloadOptions, report LogDownloadReporter) error {
+	req, err := l.Logs(d.Path, downloadLogOptions(d.Container, opts))
+	if err != nil {
+		return err
+	}
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing log stream %s", d.ID())
+		}
+	}()
+
+	if err := os.MkdirAll(filepath.Dir(d.File), 0744); err != nil {
+		return err
+	}
+	file, err := os.Create(d.File)
+	if err != nil {
+		return err
+	}
+	w := progressWriter{download: d, report: report}
+	_, err = io.Copy(io.MultiWriter(file, &w), stream)
+	if e := file.Close(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+func downloadLogOptions(co string, opts LogDownloadOptions) *v1.PodLogOptions {
+	o := v1.PodLogOptions{
+		Container:  co,
+		Previous:   opts.Previous,
+		Timestamps: true,
+	}
+	if opts.LimitBytes > 0 {
+		o.LimitBytes = &opts.LimitBytes
+	}
+	if opts.Since > 0 {
+		secs := int64(opts.Since.Seconds())
+		o.SinceSeconds = &secs
+	}
+
+	return &o
+}
+
+// logDownloadFile returns a container log file, ie dir/ns/pod/container.log.
+func logDownloadFile(opts LogDownloadOptions, path, co string) string {
+	ns, n := client.Namespaced(path)
+	name := co + ".log"
+	if opts.Previous {
+		name = co + "-previous.log"
+	}
+
+	return filepath.Join(opts.Dir, ns, n, name)
+}
+
+func podContainers(po *v1.Pod) []string {
+	cc := make([]string, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	for _, co := range po.Spec.InitContainers {
+		cc = append(cc, co.Name)
+	}
+	for _, co := range po.Spec.Containers {
+		cc = append(cc, co.Name)
+	}
+
+	return cc
+}
+
+// progressWriter reports a download progress as bytes come in.
+type progressWriter struct {
+	download *LogDownload
+	report   LogDownloadReporter
+	last     time.Time
+}
+
+// Write tallies the downloaded bytes.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.download.Bytes += int64(len(p))
+	if time.Since(w.last) > downloadReportRate {
+		w.last = time.Now()
+		w.report(*w.download)
+	}
+
+	return len(p), nil
+}
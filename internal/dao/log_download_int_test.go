@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestLogDownloadFile(t *testing.T) {
+	uu := map[string]struct {
+		opts LogDownloadOptions
+		e    string
+	}{
+		"current": {
+			opts: LogDownloadOptions{Dir: "/tmp/logs"},
+			e:    "/tmp/logs/fred/p1/main.log",
+		},
+		"previous": {
+			opts: LogDownloadOptions{Dir: "/tmp/logs", Previous: true},
+			e:    "/tmp/logs/fred/p1/main-previous.log",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, logDownloadFile(u.opts, "fred/p1", "main"))
+		})
+	}
+}
+
+func TestDownloadLogOptions(t *testing.T) {
+	o := downloadLogOptions("main", LogDownloadOptions{LimitBytes: 1024, Since: time.Hour})
+
+	assert.Equal(t, "main", o.Container)
+	assert.False(t, o.Follow)
+	assert.True(t, o.Timestamps)
+	assert.Equal(t, int64(1024), *o.LimitBytes)
+	assert.Equal(t, int64(3600), *o.SinceSeconds)
+
+	o = downloadLogOptions("main", LogDownloadOptions{})
+	assert.Nil(t, o.LimitBytes)
+	assert.Nil(t, o.SinceSeconds)
+}
+
+func TestPodContainers(t *testing.T) {
+	po := v1.Pod{Spec: v1.PodSpec{
+		InitContainers: []v1.Container{{Name: "init"}},
+		Containers:     []v1.Container{{Name: "main"}, {Name: "sidecar"}},
+	}}
+
+	assert.Equal(t, []string{"init", "main", "sidecar"}, podContainers(&po))
+}
+
+func TestProgressWriter(t *testing.T) {
+	var rr []LogDownload
+	d := LogDownload{Path: "fred/p1", Container: "main"}
+	w := progressWriter{download: &d, report: func(d LogDownload) { rr = append(rr, d) }}
+
+	n, err := w.Write([]byte("blee\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, n)
+	_, _ = w.Write([]byte("duh\n"))
+
+	assert.Equal(t, int64(9), d.Bytes)
+	assert.Equal(t, 1, len(rr))
+	assert.Equal(t, int64(5), rr[0].Bytes)
+}
@@ -21,6 +21,7 @@ type LogItem struct {
 	Pod, Container, Timestamp string
 	SingleContainer           bool
 	Bytes                     []byte
+	Fields                    LogFields
 }
 
 // NewLogItem returns a new item.
@@ -56,6 +57,13 @@ func (l *LogItem) Info() string {
 	return fmt.Sprintf("%q::%q", l.Pod, l.Container)
 }
 
+// Parse extracts the fields of JSON log lines.
+func (l *LogItem) Parse() {
+	if l.Fields == nil {
+		l.Fields = ParseLogFields(l.Bytes)
+	}
+}
+
 // IsEmpty checks if the entry is empty.
 func (l *LogItem) IsEmpty() bool {
 	return len(l.Bytes) == 0
@@ -70,7 +78,27 @@ func colorize(s string, c int) string {
 
 // Render returns a log line as string.
 func (l *LogItem) Render(c int, showTime bool) []byte {
-	bb := make([]byte, 0, 30+len(l.Bytes)+len(l.Info()))
+	return l.render(c, showTime, tview.Escape(string(l.Bytes)))
+}
+
+// RenderAs returns a log line in the given format. Lines that are not JSON
+// objects render as is.
+func (l *LogItem) RenderAs(c int, showTime bool, f LogFormat, cols []string) []byte {
+	if l.Fields == nil {
+		return l.Render(c, showTime)
+	}
+	switch f {
+	case LogColumns:
+		return l.render(c, showTime, l.Fields.Columns(cols))
+	case LogPretty:
+		return l.render(c, showTime, l.Fields.Pretty())
+	default:
+		return l.Render(c, showTime)
+	}
+}
+
+func (l *LogItem) render(c int, showTime bool, body string) []byte {
+	bb := make([]byte, 0, 30+len(body)+len(l.Info()))
 	if showTime {
 		bb = append(bb, colorize(fmt.Sprintf("%-30s ", l.Timestamp), 106)...)
 	}
@@ -83,11 +111,28 @@ func (l *LogItem) Render(c int, showTime bool) []byte {
 		bb = append(bb, []byte(colorize(l.Container, c))...)
 		bb = append(bb, ' ')
 	}
-	bb = append(bb, []byte(tview.Escape(string(l.Bytes)))...)
+	bb = append(bb, body...)
 
 	return bb
 }
 
+// Plain returns a log line without colors.
+func (l *LogItem) Plain(showTime bool) string {
+	var b strings.Builder
+	if showTime {
+		b.WriteString(fmt.Sprintf("%-30s ", l.Timestamp))
+	}
+	if l.Pod != "" {
+		b.WriteString(l.Pod + ":")
+	}
+	if !l.SingleContainer && l.Container != "" {
+		b.WriteString(l.Container + " ")
+	}
+	b.Write(l.Bytes)
+
+	return b.String()
+}
+
 func colorFor(n string) int {
 	var sum int
 	for _, r := range n {
@@ -118,6 +163,11 @@ func (l LogItems) Lines() []string {
 
 // Render returns logs as a collection of strings.
 func (l LogItems) Render(showTime bool, ll [][]byte) {
+	l.RenderAs(showTime, LogRaw, nil, ll)
+}
+
+// RenderAs returns logs in the given format as a collection of strings.
+func (l LogItems) RenderAs(showTime bool, f LogFormat, cols []string, ll [][]byte) {
 	colors := map[string]int{}
 	for i, item := range l {
 		info := item.ID()
@@ -126,7 +176,7 @@ func (l LogItems) Render(showTime bool, ll [][]byte) {
 			c = colorFor(info)
 			colors[info] = c
 		}
-		ll[i] = item.Render(c, showTime)
+		ll[i] = item.RenderAs(c, showTime, f, cols)
 	}
 }
 
@@ -146,6 +196,9 @@ func (l LogItems) Filter(q string) ([]int, error) {
 	if IsFuzzySelector(q) {
 		return l.fuzzyFilter(strings.TrimSpace(q[2:])), nil
 	}
+	if IsJSONSelector(q) {
+		return l.fieldFilter(strings.TrimSpace(q[2:]))
+	}
 	indexes, err := l.filterLogs(q)
 	if err != nil {
 		log.Error().Err(err).Msgf("Logs filter failed")
@@ -167,6 +220,31 @@ func (l LogItems) fuzzyFilter(q string) []int {
 	return matches
 }
 
+func (l LogItems) fieldFilter(q string) ([]int, error) {
+	ff, err := parseFieldFilters(q)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]int, 0, len(l))
+	for i, item := range l {
+		if item.Fields == nil {
+			continue
+		}
+		ok := true
+		for _, f := range ff {
+			if !f.matches(item.Fields) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, i)
+		}
+	}
+
+	return matches, nil
+}
+
 func (l LogItems) filterLogs(q string) ([]int, error) {
 	rx, err := regexp.Compile(`(?i)` + q)
 	if err != nil {
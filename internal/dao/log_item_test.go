@@ -188,6 +188,29 @@ func TestLogItemRender(t *testing.T) {
 	}
 }
 
+func TestLogItemPlain(t *testing.T) {
+	uu := map[string]struct {
+		pod, co  string
+		showTime bool
+		e        string
+	}{
+		"empty":     {e: "Testing 1,2,3..."},
+		"container": {co: "blee", e: "blee Testing 1,2,3..."},
+		"full":      {pod: "fred", co: "blee", showTime: true, e: "2018-12-14T10:36:43.326972-07:00 fred:blee Testing 1,2,3..."},
+	}
+
+	s := []byte(fmt.Sprintf("%s %s\n", "2018-12-14T10:36:43.326972-07:00", "Testing 1,2,3..."))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := dao.NewLogItem(s)
+			i.Pod, i.Container = u.pod, u.co
+
+			assert.Equal(t, u.e, i.Plain(u.showTime))
+		})
+	}
+}
+
 func BenchmarkLogItemRender(b *testing.B) {
 	s := []byte(fmt.Sprintf("%s %s\n", "2018-12-14T10:36:43.326972-07:00", "Testing 1,2,3..."))
 	i := dao.NewLogItem(s)
@@ -0,0 +1,214 @@
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+)
+
+// LogFormat represents a log lines rendering format.
+type LogFormat int
+
+const (
+	// LogRaw renders log lines as is.
+	LogRaw LogFormat = iota
+
+	// LogColumns renders selected JSON log fields as columns.
+	LogColumns
+
+	// LogPretty pretty prints JSON log lines.
+	LogPretty
+)
+
+// String returns the format name.
+func (f LogFormat) String() string {
+	switch f {
+	case LogColumns:
+		return "columns"
+	case LogPretty:
+		return "pretty"
+	default:
+		return "raw"
+	}
+}
+
+// Next returns the next format in rotation.
+func (f LogFormat) Next() LogFormat {
+	return (f + 1) % (LogPretty + 1)
+}
+
+// DefaultLogColumns tracks the JSON log fields columnized by default.
+var DefaultLogColumns = []string{"ts", "level", "msg"}
+
+// logFieldAliases maps well known fields to the keys used by common loggers.
+var logFieldAliases = map[string][]string{
+	"level": {"level", "lvl", "severity", "log.level"},
+	"msg":   {"msg", "message"},
+	"ts":    {"ts", "time", "timestamp", "@timestamp"},
+}
+
+var (
+	jsonRx        = regexp.MustCompile(`\A\-j`)
+	fieldFilterRx = regexp.MustCompile(`\A([^=!\s]+)(!?=)(.*)\z`)
+)
+
+// IsJSONSelector checks if a log filter matches JSON fields ie -j level=error.
+func IsJSONSelector(s string) bool {
+	return jsonRx.MatchString(s)
+}
+
+// LogFields represents a JSON log line fields.
+type LogFields map[string]interface{}
+
+// ParseLogFields returns a JSON log line fields or nil if the line is not a
+// JSON object.
+func ParseLogFields(b []byte) LogFields {
+	b = bytes.TrimSpace(b)
+	if len(b) < 2 || b[0] != '{' || b[len(b)-1] != '}' {
+		return nil
+	}
+	var ff LogFields
+	if err := json.Unmarshal(b, &ff); err != nil {
+		return nil
+	}
+
+	return ff
+}
+
+// Value returns a field value. The level, msg and ts fields match the keys
+// used by common loggers. Nested fields are accessed via a dotted path.
+func (f LogFields) Value(k string) (string, bool) {
+	keys, ok := logFieldAliases[k]
+	if !ok {
+		keys = []string{k}
+	}
+	for _, key := range keys {
+		if v, ok := f.lookup(key); ok {
+			return fieldString(v), true
+		}
+	}
+
+	return "", false
+}
+
+// Columns renders the given fields values, coloring the log level.
+func (f LogFields) Columns(cols []string) string {
+	ss := make([]string, 0, len(cols))
+	for _, c := range cols {
+		v, ok := f.Value(c)
+		if !ok {
+			v = "-"
+		}
+		if c == "level" {
+			ss = append(ss, colorize(fmt.Sprintf("%-5s", tview.Escape(strings.ToUpper(v))), levelColor(v)))
+			continue
+		}
+		ss = append(ss, tview.Escape(v))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// Pretty returns the fields as indented JSON.
+func (f LogFields) Pretty() string {
+	raw, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err.Error()
+	}
+
+	return tview.Escape(string(raw))
+}
+
+func (f LogFields) lookup(k string) (interface{}, bool) {
+	if v, ok := f[k]; ok {
+		return v, true
+	}
+	m := map[string]interface{}(f)
+	pp := strings.Split(k, ".")
+	for i, p := range pp {
+		v, ok := m[p]
+		if !ok {
+			return nil, false
+		}
+		if i == len(pp)-1 {
+			return v, true
+		}
+		if m, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+func fieldString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		raw, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(raw)
+	}
+}
+
+func levelColor(l string) int {
+	switch strings.ToLower(l) {
+	case "error", "err", "fatal", "panic", "critical":
+		return 196
+	case "warn", "warning":
+		return 220
+	case "info":
+		return 34
+	case "debug", "trace":
+		return 244
+	default:
+		return 250
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// logFieldFilter matches a JSON log field value ie level=error or level!=debug.
+type logFieldFilter struct {
+	key, value string
+	negate     bool
+}
+
+// parseFieldFilters parses space or comma separated field filters.
+func parseFieldFilters(q string) ([]logFieldFilter, error) {
+	ss := strings.FieldsFunc(q, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(ss) == 0 {
+		return nil, fmt.Errorf("invalid JSON filter %q. Expecting field=value", q)
+	}
+	ff := make([]logFieldFilter, 0, len(ss))
+	for _, s := range ss {
+		mm := fieldFilterRx.FindStringSubmatch(s)
+		if mm == nil {
+			return nil, fmt.Errorf("invalid JSON filter %q. Expecting field=value", s)
+		}
+		ff = append(ff, logFieldFilter{key: mm[1], value: mm[3], negate: mm[2] == "!="})
+	}
+
+	return ff, nil
+}
+
+func (f logFieldFilter) matches(ff LogFields) bool {
+	v, ok := ff.Value(f.key)
+	eq := ok && strings.EqualFold(v, f.value)
+
+	return eq != f.negate
+}
@@ -0,0 +1,124 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogFields(t *testing.T) {
+	uu := map[string]struct {
+		l     string
+		k, e  string
+		found bool
+	}{
+		"plain": {
+			l: "Bumble bee tuna",
+			k: "msg",
+		},
+		"brokenJSON": {
+			l: `{"level":"info"`,
+			k: "level",
+		},
+		"alias": {
+			l:     `{"severity":"WARNING","message":"disk full"}`,
+			k:     "level",
+			e:     "WARNING",
+			found: true,
+		},
+		"nested": {
+			l:     `{"level":"info","http":{"status":503}}`,
+			k:     "http.status",
+			e:     "503",
+			found: true,
+		},
+		"dotted": {
+			l:     `{"log.level":"debug"}`,
+			k:     "level",
+			e:     "debug",
+			found: true,
+		},
+		"missing": {
+			l: `{"level":"info"}`,
+			k: "msg",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, ok := dao.ParseLogFields([]byte(u.l)).Value(u.k)
+			assert.Equal(t, u.found, ok)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestLogFieldsColumns(t *testing.T) {
+	ff := dao.ParseLogFields([]byte(`{"ts":"2020-05-06T10:00:00Z","msg":"[boom]","caller":"main.go"}`))
+
+	assert.Equal(t, "2020-05-06T10:00:00Z [boom[] - main.go", ff.Columns([]string{"ts", "msg", "span", "caller"}))
+}
+
+func TestLogFormatNext(t *testing.T) {
+	f := dao.LogRaw
+	assert.Equal(t, dao.LogColumns, f.Next())
+	assert.Equal(t, dao.LogPretty, f.Next().Next())
+	assert.Equal(t, dao.LogRaw, f.Next().Next().Next())
+}
+
+func TestLogItemsJSONFilter(t *testing.T) {
+	uu := map[string]struct {
+		q   string
+		e   []int
+		err bool
+	}{
+		"level": {
+			q: "-j level=error",
+			e: []int{1},
+		},
+		"caseInsensitive": {
+			q: "-j level=ERROR",
+			e: []int{1},
+		},
+		"negate": {
+			q: "-j level!=error",
+			e: []int{0, 3},
+		},
+		"multi": {
+			q: "-j level=info,app=fred",
+			e: []int{0},
+		},
+		"none": {
+			q: "-j level=fatal",
+			e: []int{},
+		},
+		"invalid": {
+			q:   "-j level",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		ii := dao.LogItems{
+			dao.NewLogItemFromString(`{"level":"info","app":"fred","msg":"hello"}`),
+			dao.NewLogItemFromString(`{"level":"error","app":"blee","msg":"boom"}`),
+			dao.NewLogItemFromString("Jean Batiste Emmanuel Zorg"),
+			dao.NewLogItemFromString(`{"lvl":"debug","app":"blee"}`),
+		}
+		for _, i := range ii {
+			i.Parse()
+		}
+		t.Run(k, func(t *testing.T) {
+			res, err := ii.Filter(u.q)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, res)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 type LogOptions struct {
 	Path            string
 	Container       string
+	Selector        string
 	Lines           int64
 	Previous        bool
 	SingleContainer bool
@@ -22,6 +24,8 @@ type LogOptions struct {
 	SinceTime       string
 	SinceSeconds    int64
 	In, Out         string
+	// PodFilter and ContainerFilter narrow multi pods tails by name.
+	PodFilter, ContainerFilter *regexp.Regexp
 }
 
 // Info returns the option pod and container info.
@@ -29,6 +33,16 @@ func (o LogOptions) Info() string {
 	return fmt.Sprintf("%q::%q", o.Path, o.Container)
 }
 
+// MatchPod checks if a pod is selected by the pod filter.
+func (o LogOptions) MatchPod(name string) bool {
+	return o.PodFilter == nil || o.PodFilter.MatchString(name)
+}
+
+// MatchContainer checks if a container is selected by the container filter.
+func (o LogOptions) MatchContainer(name string) bool {
+	return o.ContainerFilter == nil || o.ContainerFilter.MatchString(name)
+}
+
 // HasContainer checks if a container is present.
 func (o LogOptions) HasContainer() bool {
 	return o.Container != ""
@@ -0,0 +1,45 @@
+package dao_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogOptionsMatch(t *testing.T) {
+	uu := map[string]struct {
+		opts   dao.LogOptions
+		po, co string
+		pok    bool
+		cok    bool
+	}{
+		"noFilters": {
+			po:  "nginx-1",
+			co:  "sidecar",
+			pok: true,
+			cok: true,
+		},
+		"match": {
+			opts: dao.LogOptions{PodFilter: regexp.MustCompile(`^nginx-`), ContainerFilter: regexp.MustCompile(`^main$`)},
+			po:   "nginx-1",
+			co:   "main",
+			pok:  true,
+			cok:  true,
+		},
+		"noMatch": {
+			opts: dao.LogOptions{PodFilter: regexp.MustCompile(`^api-`), ContainerFilter: regexp.MustCompile(`^main$`)},
+			po:   "nginx-1",
+			co:   "sidecar",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.pok, u.opts.MatchPod(u.po))
+			assert.Equal(t, u.cok, u.opts.MatchContainer(u.co))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+)
+
+var (
+	// restartPollInterval tracks how often a closed container stream checks
+	// on the container status.
+	restartPollInterval = time.Second
+	// restartGrace tracks how long a still running container has to report
+	// its termination once its stream closed.
+	restartGrace = 5 * time.Second
+	// restartTimeout tracks how long to wait on a crashing container to restart.
+	restartTimeout = 10 * time.Minute
+)
+
+const logStreamClosed = "log stream closed\n"
+
+// containerTracker represents a logger tracking its containers instances.
+type containerTracker interface {
+	Logger
+
+	// containerStatus returns a pod container status and the pod phase.
+	containerStatus(path, co string) (*v1.ContainerStatus, v1.PodPhase, error)
+}
+
+// containerStatus returns a pod container status and the pod phase.
+func (p *Pod) containerStatus(path, co string) (*v1.ContainerStatus, v1.PodPhase, error) {
+	po, err := p.GetInstance(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return getContainerStatus(co, po.Status), po.Status.Phase, nil
+}
+
+// followLogs streams a container logs. When the container restarts, marker
+// lines annotate the termination and the new instance start and the stream
+// resumes on the new instance.
+func followLogs(ctx context.Context, t containerTracker, stream io.ReadCloser, c LogChan, opts LogOptions) {
+	var id string
+	if cs, _, err := t.containerStatus(opts.Path, opts.Container); err == nil && cs != nil {
+		id = cs.ContainerID
+	}
+	for {
+		if !readLogs(stream, c, opts) {
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		cs, ok := awaitRestart(ctx, t, c, opts, id)
+		if !ok {
+			c <- opts.DecorateLog([]byte(logStreamClosed))
+			return
+		}
+		id = cs.ContainerID
+
+		req, err := t.Logs(opts.Path, restartLogOptions(opts))
+		if err != nil {
+			c <- opts.DecorateLog([]byte(err.Error() + "\n"))
+			return
+		}
+		if stream, err = req.Stream(ctx); err != nil {
+			log.Error().Err(err).Msgf("Unable to resume log stream for %s", opts.Info())
+			c <- opts.DecorateLog([]byte(err.Error() + "\n"))
+			return
+		}
+	}
+}
+
+// awaitRestart waits for the container instance id to be replaced, reporting
+// its termination along the way. Returns false if the container is done.
+func awaitRestart(ctx context.Context, t containerTracker, c LogChan, opts LogOptions, id string) (*v1.ContainerStatus, bool) {
+	start := time.Now()
+	var reported bool
+	for {
+		cs, phase, err := t.containerStatus(opts.Path, opts.Container)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Container status failed for %s", opts.Info())
+			return nil, false
+		}
+		if cs != nil {
+			if ts := terminatedState(cs, id); ts != nil && !reported {
+				c <- opts.DecorateLog(terminatedMarker(opts.Container, ts))
+				reported = true
+			}
+			if cs.State.Running != nil && cs.ContainerID != id {
+				c <- opts.DecorateLog(restartedMarker(opts.Container, cs))
+				return cs, true
+			}
+			if cs.State.Running != nil && !reported && time.Since(start) > restartGrace {
+				return nil, false
+			}
+		}
+		if phase == v1.PodSucceeded || phase == v1.PodFailed || time.Since(start) > restartTimeout {
+			return nil, false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-time.After(restartPollInterval):
+		}
+	}
+}
+
+// terminatedState returns the termination of the given container instance if any.
+func terminatedState(cs *v1.ContainerStatus, id string) *v1.ContainerStateTerminated {
+	if t := cs.State.Terminated; t != nil && (id == "" || t.ContainerID == id) {
+		return t
+	}
+	if t := cs.LastTerminationState.Terminated; t != nil && id != "" && t.ContainerID == id {
+		return t
+	}
+
+	return nil
+}
+
+func terminatedMarker(co string, t *v1.ContainerStateTerminated) []byte {
+	reason := t.Reason
+	if reason == "" {
+		reason = "Unknown"
+	}
+	if t.Signal != 0 {
+		reason += fmt.Sprintf(", signal %d", t.Signal)
+	}
+	at := t.FinishedAt.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return markerLine(at, fmt.Sprintf("container %s terminated with exit code %d (%s)", co, t.ExitCode, reason))
+}
+
+func restartedMarker(co string, cs *v1.ContainerStatus) []byte {
+	at := cs.State.Running.StartedAt.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return markerLine(at, fmt.Sprintf("container %s restarted (restarts: %d)", co, cs.RestartCount))
+}
+
+func markerLine(at time.Time, msg string) []byte {
+	return []byte(at.UTC().Format(time.RFC3339Nano) + " ---- " + msg + " ----\n")
+}
+
+// restartLogOptions returns options streaming a new container instance whole log.
+func restartLogOptions(opts LogOptions) *v1.PodLogOptions {
+	o := opts.ToPodLogOptions()
+	o.Previous, o.TailLines, o.SinceSeconds, o.SinceTime = false, nil, nil, nil
+
+	return o
+}
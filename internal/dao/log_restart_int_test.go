@@ -0,0 +1,135 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestAwaitRestart(t *testing.T) {
+	defer func(d time.Duration) { restartPollInterval = d }(restartPollInterval)
+	restartPollInterval = time.Millisecond
+
+	at := metav1.NewTime(time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC))
+	crashed := v1.ContainerStatus{
+		ContainerID: "c1",
+		State: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{ContainerID: "c1", ExitCode: 137, Reason: "OOMKilled", FinishedAt: at},
+		},
+	}
+	backoff := v1.ContainerStatus{
+		ContainerID:  "c1",
+		RestartCount: 1,
+		State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		LastTerminationState: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{ContainerID: "c1", ExitCode: 137, Reason: "OOMKilled", FinishedAt: at},
+		},
+	}
+	restarted := backoff
+	restarted.ContainerID = "c2"
+	restarted.State = v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(at.Add(10 * time.Second))}}
+	completed := v1.ContainerStatus{
+		ContainerID: "c1",
+		State: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{ContainerID: "c1", Reason: "Completed", FinishedAt: at},
+		},
+	}
+
+	uu := map[string]struct {
+		ss     []v1.ContainerStatus
+		phase  v1.PodPhase
+		err    error
+		ok     bool
+		id     string
+		events []string
+	}{
+		"restart": {
+			ss: []v1.ContainerStatus{crashed, backoff, backoff, restarted},
+			ok: true,
+			id: "c2",
+			events: []string{
+				"---- container main terminated with exit code 137 (OOMKilled) ----",
+				"---- container main restarted (restarts: 1) ----",
+			},
+		},
+		"completed": {
+			ss:     []v1.ContainerStatus{completed},
+			phase:  v1.PodSucceeded,
+			events: []string{"---- container main terminated with exit code 0 (Completed) ----"},
+		},
+		"gone": {
+			err: errors.New("not found"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tr := &fakeTracker{ss: u.ss, phase: u.phase, err: u.err}
+			c := make(LogChan, 10)
+			cs, ok := awaitRestart(context.Background(), tr, c, LogOptions{Path: "fred/p1", Container: "main"}, "c1")
+			close(c)
+
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.id, cs.ContainerID)
+			}
+			var ee []string
+			for i := range c {
+				ee = append(ee, string(i.Bytes))
+			}
+			assert.Equal(t, u.events, ee)
+		})
+	}
+}
+
+func TestTerminatedMarker(t *testing.T) {
+	ts := v1.ContainerStateTerminated{
+		ExitCode:   143,
+		Signal:     15,
+		Reason:     "Error",
+		FinishedAt: metav1.NewTime(time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)),
+	}
+
+	assert.Equal(t, "2020-05-01T10:00:00Z ---- container main terminated with exit code 143 (Error, signal 15) ----\n", string(terminatedMarker("main", &ts)))
+}
+
+func TestRestartLogOptions(t *testing.T) {
+	o := restartLogOptions(LogOptions{Container: "main", Lines: 100, SinceSeconds: 60})
+
+	assert.Equal(t, "main", o.Container)
+	assert.True(t, o.Follow)
+	assert.Nil(t, o.TailLines)
+	assert.Nil(t, o.SinceSeconds)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type fakeTracker struct {
+	ss    []v1.ContainerStatus
+	phase v1.PodPhase
+	err   error
+}
+
+func (f *fakeTracker) Logs(string, *v1.PodLogOptions) (*restclient.Request, error) {
+	return nil, errors.New("no logs")
+}
+
+func (f *fakeTracker) containerStatus(string, string) (*v1.ContainerStatus, v1.PodPhase, error) {
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	cs := f.ss[0]
+	if len(f.ss) > 1 {
+		f.ss = f.ss[1:]
+	}
+
+	return &cs, f.phase, nil
+}
@@ -0,0 +1,250 @@
+package dao
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// logSinkBacklog tracks the max number of pending log batches before the
+// sink is deemed stuck.
+const logSinkBacklog = 100
+
+// LogSinkCloseTimeout tracks how long to wait for a sink command to exit
+// before killing it.
+var LogSinkCloseTimeout = 5 * time.Second
+
+// LogSinkOptions represents a log sink options.
+type LogSinkOptions struct {
+	// Path tracks the log file path.
+	Path string
+	// Command tracks a shell command piped the log lines instead of a file.
+	Command string
+	// MaxSize tracks the log file size in bytes triggering a rotation. Zero
+	// disables the rotation.
+	MaxSize int64
+	// MaxFiles tracks the number of rotated files to keep.
+	MaxFiles int
+}
+
+// Target returns the sink destination.
+func (o LogSinkOptions) Target() string {
+	if o.Command != "" {
+		return o.Command
+	}
+	return o.Path
+}
+
+// LogSink continuously writes log lines to a file rotated by size or to an
+// external command standard input. Lines are written in the background so a
+// slow sink never blocks the logs listeners.
+type LogSink struct {
+	opts  LogSinkOptions
+	w     io.WriteCloser
+	cmd   *exec.Cmd
+	size  int64
+	lines chan []string
+	done  chan struct{}
+	err   error
+	mx    sync.Mutex
+}
+
+// NewLogSink opens a new log sink.
+func NewLogSink(opts LogSinkOptions) (*LogSink, error) {
+	s := LogSink{opts: opts}
+	var err error
+	if opts.Command != "" {
+		err = s.startCommand()
+	} else {
+		err = s.openFile()
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.lines, s.done = make(chan []string, logSinkBacklog), make(chan struct{})
+	go s.drain()
+
+	return &s, nil
+}
+
+// Target returns the sink destination.
+func (s *LogSink) Target() string {
+	return s.opts.Target()
+}
+
+// Write queues the given lines to be appended to the sink. It fails if a
+// previous write failed or if the sink is not keeping up.
+func (s *LogSink) Write(lines []string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.lines == nil {
+		return fmt.Errorf("log sink %s is closed", s.Target())
+	}
+	if s.err != nil {
+		return s.err
+	}
+	select {
+	case s.lines <- lines:
+		return nil
+	default:
+		return fmt.Errorf("log sink %s is not keeping up", s.Target())
+	}
+}
+
+func (s *LogSink) drain() {
+	defer close(s.done)
+	for ll := range s.lines {
+		if err := s.write(ll); err != nil {
+			s.mx.Lock()
+			if s.err == nil {
+				s.err = err
+			}
+			s.mx.Unlock()
+		}
+	}
+}
+
+func (s *LogSink) write(lines []string) error {
+	if s.w == nil {
+		return fmt.Errorf("log sink %s is closed", s.Target())
+	}
+	for _, l := range lines {
+		if s.needsRotation(int64(len(l) + 1)) {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := io.WriteString(s.w, l+"\n")
+		s.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close flushes and closes the sink. A sink command still running after
+// the close timeout gets killed.
+func (s *LogSink) Close() error {
+	s.mx.Lock()
+	if s.lines == nil {
+		s.mx.Unlock()
+		return nil
+	}
+	close(s.lines)
+	s.lines = nil
+	s.mx.Unlock()
+
+	if s.cmd == nil {
+		<-s.done
+		return s.closeWriter()
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		<-s.done
+		err := s.closeWriter()
+		if e := s.cmd.Wait(); err == nil {
+			err = e
+		}
+		exited <- err
+	}()
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(LogSinkCloseTimeout):
+		if err := s.cmd.Process.Kill(); err != nil {
+			return err
+		}
+		<-exited
+		return fmt.Errorf("log sink command %q killed after %v", s.opts.Command, LogSinkCloseTimeout)
+	}
+}
+
+func (s *LogSink) closeWriter() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	var err error
+	if s.w != nil {
+		err = s.w.Close()
+		s.w = nil
+	}
+	if err == nil {
+		err = s.err
+	}
+
+	return err
+}
+
+func (s *LogSink) startCommand() error {
+	s.cmd = exec.Command("sh", "-c", s.opts.Command)
+	w, err := s.cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := s.cmd.Start(); err != nil {
+		return fmt.Errorf("log sink command %q failed: %w", s.opts.Command, err)
+	}
+	s.w = w
+
+	return nil
+}
+
+func (s *LogSink) openFile() error {
+	if err := os.MkdirAll(filepath.Dir(s.opts.Path), 0744); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	s.w, s.size = f, fi.Size()
+
+	return nil
+}
+
+func (s *LogSink) needsRotation(n int64) bool {
+	return s.cmd == nil && s.opts.MaxSize > 0 && s.size > 0 && s.size+n > s.opts.MaxSize
+}
+
+// rotate shifts the log file to path.1, path.1 to path.2 and so on, dropping
+// the oldest file past the max files count.
+func (s *LogSink) rotate() error {
+	if err := s.w.Close(); err != nil {
+		return err
+	}
+	s.w = nil
+	if s.opts.MaxFiles <= 0 {
+		if err := os.Remove(s.opts.Path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return s.openFile()
+	}
+
+	rotated := func(i int) string { return fmt.Sprintf("%s.%d", s.opts.Path, i) }
+	if err := os.Remove(rotated(s.opts.MaxFiles)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := s.opts.MaxFiles - 1; i > 0; i-- {
+		if err := os.Rename(rotated(i), rotated(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.opts.Path, rotated(1)); err != nil {
+		return err
+	}
+
+	return s.openFile()
+}
@@ -0,0 +1,85 @@
+package dao_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSinkRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-sink")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "fred.log")
+
+	s, err := dao.NewLogSink(dao.LogSinkOptions{Path: path, MaxSize: 10, MaxFiles: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, s.Write([]string{"line-1", "line-2", "line-3", "line-4"}))
+	assert.Nil(t, s.Close())
+
+	uu := map[string]string{
+		path:        "line-4\n",
+		path + ".1": "line-3\n",
+		path + ".2": "line-2\n",
+	}
+	for p, e := range uu {
+		bb, err := ioutil.ReadFile(p)
+		assert.Nil(t, err)
+		assert.Equal(t, e, string(bb))
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLogSinkAppend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-sink")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fred.log")
+
+	for _, l := range []string{"line-1", "line-2"} {
+		s, err := dao.NewLogSink(dao.LogSinkOptions{Path: path})
+		assert.Nil(t, err)
+		assert.Nil(t, s.Write([]string{l}))
+		assert.Nil(t, s.Close())
+	}
+	bb, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "line-1\nline-2\n", string(bb))
+}
+
+func TestLogSinkCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-sink")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fred.log")
+
+	s, err := dao.NewLogSink(dao.LogSinkOptions{Command: "cat > " + path, MaxSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "cat > "+path, s.Target())
+	assert.Nil(t, s.Write([]string{"line-1", "line-2"}))
+	assert.Nil(t, s.Close())
+	assert.NotNil(t, s.Write([]string{"line-3"}))
+
+	bb, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "line-1\nline-2\n", string(bb))
+}
+
+func TestLogSinkCommandStuck(t *testing.T) {
+	defer func(d time.Duration) { dao.LogSinkCloseTimeout = d }(dao.LogSinkCloseTimeout)
+	dao.LogSinkCloseTimeout = 100 * time.Millisecond
+
+	s, err := dao.NewLogSink(dao.LogSinkOptions{Command: "exec sleep 60", MaxSize: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, s.Write([]string{"line-1"}))
+
+	start := time.Now()
+	assert.NotNil(t, s.Close())
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
@@ -0,0 +1,317 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// promServices tracks well known Prometheus service names, by preference.
+var promServices = []string{
+	"prometheus-operated",
+	"prometheus-k8s",
+	"prometheus-kube-prometheus-prometheus",
+	"prometheus-server",
+	"prometheus",
+}
+
+// promNamespaces tracks well known Prometheus namespaces, searched after the
+// current namespace.
+var promNamespaces = []string{"monitoring", "prometheus", "observability"}
+
+// ResourceSeries tracks a resource usage history, one sample per step, oldest
+// first. CPU is in millicores, MEM in MiB and network rates in KiB/s.
+type ResourceSeries struct {
+	CPU, MEM, RX, TX []int64
+}
+
+// MetricsProvider represents a resources usage history source.
+type MetricsProvider interface {
+	// PodSeries returns a pod usage history.
+	PodSeries(ctx context.Context, path string) (*ResourceSeries, error)
+
+	// NodeSeries returns a node usage history.
+	NodeSeries(ctx context.Context, node string) (*ResourceSeries, error)
+}
+
+// NewMetricsProvider returns a Prometheus backed metrics provider. Prometheus
+// is either reached directly or via the api server service proxy. Services
+// are discovered in the given namespace then in well known namespaces.
+func NewMetricsProvider(f Factory, cfg *config.Prometheus, ns string) MetricsProvider {
+	return &promProvider{
+		factory: f,
+		cfg:     cfg,
+		ns:      ns,
+		client:  &http.Client{Timeout: client.CallTimeout},
+	}
+}
+
+// promQuery tracks the graphs queries templates arguments.
+type promQuery struct {
+	Namespace, Pod, Node, Window string
+}
+
+type promRangeResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			Values [][]interface{} `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+type promProvider struct {
+	factory Factory
+	cfg     *config.Prometheus
+	ns      string
+	client  *http.Client
+
+	mx            sync.Mutex
+	service, port string
+}
+
+// PodSeries returns a pod usage history.
+func (p *promProvider) PodSeries(ctx context.Context, path string) (*ResourceSeries, error) {
+	ns, n := client.Namespaced(path)
+
+	return p.series(ctx, promQuery{Namespace: ns, Pod: n}, config.PromPodCPU, config.PromPodMEM, config.PromPodRX, config.PromPodTX)
+}
+
+// NodeSeries returns a node usage history.
+func (p *promProvider) NodeSeries(ctx context.Context, node string) (*ResourceSeries, error) {
+	return p.series(ctx, promQuery{Node: node}, config.PromNodeCPU, config.PromNodeMEM, config.PromNodeRX, config.PromNodeTX)
+}
+
+func (p *promProvider) series(ctx context.Context, q promQuery, names ...string) (*ResourceSeries, error) {
+	q.Window = promDuration(p.cfg.Window())
+	end := time.Now().Truncate(p.cfg.Step)
+	start := end.Add(-p.cfg.Range)
+	count := int(p.cfg.Range/p.cfg.Step) + 1
+
+	vv := make([][]int64, len(names))
+	for i, n := range names {
+		expr, err := promExpr(p.cfg.Query(n), q)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s query: %w", n, err)
+		}
+		raw, err := p.queryRange(ctx, expr, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("%s query failed: %w", n, err)
+		}
+		if vv[i], err = promSeries(raw, start, p.cfg.Step, count); err != nil {
+			return nil, fmt.Errorf("%s query failed: %w", n, err)
+		}
+	}
+
+	return &ResourceSeries{CPU: vv[0], MEM: vv[1], RX: vv[2], TX: vv[3]}, nil
+}
+
+func (p *promProvider) queryRange(ctx context.Context, expr string, start, end time.Time) ([]byte, error) {
+	params := url.Values{
+		"query": []string{expr},
+		"start": []string{strconv.FormatInt(start.Unix(), 10)},
+		"end":   []string{strconv.FormatInt(end.Unix(), 10)},
+		"step":  []string{promDuration(p.cfg.Step)},
+	}
+	target := "api/v1/query_range?" + params.Encode()
+	if p.cfg.URL != "" {
+		return p.get(ctx, strings.TrimRight(p.cfg.URL, "/")+"/"+target)
+	}
+
+	svc, port, err := p.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ProxyGet(p.factory, client.NewGVR("v1/services"), svc, port, target)
+}
+
+func (p *promProvider) get(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing prometheus response")
+		}
+	}()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Prometheus reports query errors in the response body.
+	if resp.StatusCode != http.StatusOK && !bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+		return nil, fmt.Errorf("prometheus query failed: %s", resp.Status)
+	}
+
+	return raw, nil
+}
+
+// resolve returns the Prometheus service path and port, either configured
+// or discovered from the well known service names.
+func (p *promProvider) resolve(ctx context.Context) (string, string, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.service != "" {
+		return p.service, p.port, nil
+	}
+	if p.cfg.Service != "" {
+		svc, port := splitServicePort(p.cfg.Service)
+		if ns, n := client.Namespaced(svc); ns == "" || n == "" {
+			return "", "", fmt.Errorf("invalid prometheus service %q. Expecting ns/name:port", p.cfg.Service)
+		}
+		p.service, p.port = svc, port
+		return p.service, p.port, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, client.CallTimeout)
+	defer cancel()
+	svc, ok := p.discover(ctx)
+	if !ok {
+		return "", "", fmt.Errorf("no prometheus service found. Configure the prometheus url or service")
+	}
+	p.service, p.port = client.FQN(svc.Namespace, svc.Name), promServicePort(svc)
+	log.Debug().Msgf("Discovered prometheus service %s:%s", p.service, p.port)
+
+	return p.service, p.port, nil
+}
+
+// discover looks for a well known Prometheus service in the current
+// namespace then in the well known Prometheus namespaces.
+func (p *promProvider) discover(ctx context.Context) (v1.Service, bool) {
+	nss := make([]string, 0, len(promNamespaces)+1)
+	if client.IsNamespaced(p.ns) && !client.IsClusterScoped(p.ns) {
+		nss = append(nss, p.ns)
+	}
+	for _, ns := range promNamespaces {
+		if ns != p.ns {
+			nss = append(nss, ns)
+		}
+	}
+	for _, ns := range nss {
+		ll, err := p.factory.Client().DialOrDie().CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Debug().Err(err).Msgf("Prometheus discovery skipped namespace %q", ns)
+			continue
+		}
+		if svc, ok := findPromService(ll.Items); ok {
+			return svc, true
+		}
+	}
+
+	return v1.Service{}, false
+}
+
+func findPromService(ss []v1.Service) (v1.Service, bool) {
+	for _, n := range promServices {
+		for _, s := range ss {
+			if s.Name == n {
+				return s, true
+			}
+		}
+	}
+
+	return v1.Service{}, false
+}
+
+func promServicePort(s v1.Service) string {
+	for _, p := range s.Spec.Ports {
+		if p.Name == "web" || p.Name == "http" || p.Port == 9090 {
+			return strconv.Itoa(int(p.Port))
+		}
+	}
+	if len(s.Spec.Ports) > 0 {
+		return strconv.Itoa(int(s.Spec.Ports[0].Port))
+	}
+
+	return ""
+}
+
+func splitServicePort(s string) (string, string) {
+	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
+		return s[:i], s[i+1:]
+	}
+
+	return s, ""
+}
+
+func promExpr(q string, args promQuery) (string, error) {
+	tpl, err := template.New("prom").Parse(q)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, args); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// promSeries aligns a range query first result on the given steps. Missing
+// samples are zeroed.
+func promSeries(raw []byte, start time.Time, step time.Duration, count int) ([]int64, error) {
+	var resp promRangeResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("prometheus error: %s", resp.Error)
+	}
+
+	vv := make([]int64, count)
+	if len(resp.Data.Result) == 0 {
+		return vv, nil
+	}
+	for _, v := range resp.Data.Result[0].Values {
+		if len(v) != 2 {
+			continue
+		}
+		ts, ok := v[0].(float64)
+		if !ok {
+			continue
+		}
+		s, ok := v[1].(string)
+		if !ok {
+			continue
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			continue
+		}
+		i := int(math.Round((ts - float64(start.Unix())) / step.Seconds()))
+		if i < 0 || i >= count {
+			continue
+		}
+		vv[i] = int64(math.Round(f))
+	}
+
+	return vv, nil
+}
+
+func promDuration(d time.Duration) string {
+	return strconv.Itoa(int(d.Seconds())) + "s"
+}
@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPromSeries(t *testing.T) {
+	start := time.Unix(1000, 0)
+	uu := map[string]struct {
+		raw string
+		e   []int64
+		err string
+	}{
+		"plain": {
+			raw: `{"status":"success","data":{"result":[{"values":[[1000,"1.4"],[1060,"2.6"],[1120,"3"]]}]}}`,
+			e:   []int64{1, 3, 3},
+		},
+		"gaps": {
+			raw: `{"status":"success","data":{"result":[{"values":[[1060,"2"],[1300,"9"],[1120,"NaN"]]}]}}`,
+			e:   []int64{0, 2, 0},
+		},
+		"empty": {
+			raw: `{"status":"success","data":{"result":[]}}`,
+			e:   []int64{0, 0, 0},
+		},
+		"error": {
+			raw: `{"status":"error","error":"parse error"}`,
+			err: "prometheus error: parse error",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv, err := promSeries([]byte(u.raw), start, time.Minute, 3)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, vv)
+		})
+	}
+}
+
+func TestPromExpr(t *testing.T) {
+	q, err := promExpr(config.DefaultPromQueries[config.PromPodCPU], promQuery{Namespace: "ns1", Pod: "p1", Window: "120s"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `sum(rate(container_cpu_usage_seconds_total{namespace="ns1", pod="p1", container!="", container!="POD"}[120s])) * 1000`, q)
+}
+
+func TestFindPromService(t *testing.T) {
+	svc := func(ns, n string, pp ...v1.ServicePort) v1.Service {
+		return v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+			Spec:       v1.ServiceSpec{Ports: pp},
+		}
+	}
+	uu := map[string]struct {
+		ss         []v1.Service
+		ok         bool
+		name, port string
+	}{
+		"none": {
+			ss: []v1.Service{svc("default", "kubernetes", v1.ServicePort{Port: 443})},
+		},
+		"preferred": {
+			ss: []v1.Service{
+				svc("monitoring", "prometheus-server", v1.ServicePort{Port: 80}),
+				svc("monitoring", "prometheus-operated", v1.ServicePort{Name: "web", Port: 9090}),
+			},
+			ok:   true,
+			name: "prometheus-operated",
+			port: "9090",
+		},
+		"firstPort": {
+			ss:   []v1.Service{svc("monitoring", "prometheus-server", v1.ServicePort{Port: 80})},
+			ok:   true,
+			name: "prometheus-server",
+			port: "80",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, ok := findPromService(u.ss)
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, u.name, s.Name)
+			assert.Equal(t, u.port, promServicePort(s))
+		})
+	}
+}
+
+func TestSplitServicePort(t *testing.T) {
+	uu := map[string]struct {
+		s, svc, port string
+	}{
+		"port":   {s: "monitoring/prometheus-server:80", svc: "monitoring/prometheus-server", port: "80"},
+		"noPort": {s: "monitoring/prometheus-server", svc: "monitoring/prometheus-server"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc, port := splitServicePort(u.s)
+			assert.Equal(t, u.svc, svc)
+			assert.Equal(t, u.port, port)
+		})
+	}
+}
+
+func TestPromProviderPodSeries(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query_range", r.URL.Path)
+		assert.Equal(t, "60s", r.URL.Query().Get("step"))
+		queries = append(queries, r.URL.Query().Get("query"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"result":[]}}`))
+	}))
+	defer srv.Close()
+
+	cfg := config.NewPrometheus()
+	cfg.URL = srv.URL + "/"
+	ss, err := NewMetricsProvider(nil, cfg, "ns1").PodSeries(context.Background(), "ns1/p1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 61, len(ss.CPU))
+	assert.Equal(t, 61, len(ss.TX))
+	assert.Equal(t, 4, len(queries))
+	for _, q := range queries {
+		assert.True(t, strings.Contains(q, `pod="p1"`), q)
+	}
+}
@@ -0,0 +1,245 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const redactedHeader = "<redacted>"
+
+// sensitiveHeaders tracks the headers masked in recordings.
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+	"Cookie":              {},
+	"Set-Cookie":          {},
+}
+
+// MirrorTransport tees http exchanges to a writer. Bodies are captured up to
+// BodyCap bytes. A blank cap only records headers.
+type MirrorTransport struct {
+	Transport http.RoundTripper
+	Out       io.Writer
+	BodyCap   int64
+
+	count uint64
+	mx    sync.Mutex
+}
+
+// RoundTrip executes and records a single exchange.
+func (m *MirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := atomic.AddUint64(&m.count, 1)
+	head, err := httputil.DumpRequestOut(redactRequest(req), false)
+	if err != nil {
+		return nil, err
+	}
+	var reqBody *capture
+	if m.BodyCap > 0 && req.Body != nil {
+		reqBody = newCapture(req.Body, m.BodyCap)
+		req.Body = reqBody
+	}
+
+	t := time.Now()
+	tr := m.Transport
+	if tr == nil {
+		tr = http.DefaultTransport
+	}
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		m.write(fmt.Sprintf(">>> #%d %s\n%s%s<<< #%d failed: %s\n\n", id, t.Format(time.RFC3339), head, reqBody.String(), id, err))
+		return nil, err
+	}
+	m.write(fmt.Sprintf(">>> #%d %s\n%s%s", id, t.Format(time.RFC3339), head, reqBody.String()))
+
+	respHead, err := httputil.DumpResponse(redactResponse(resp), false)
+	if err != nil {
+		return nil, err
+	}
+	if m.BodyCap <= 0 {
+		m.write(fmt.Sprintf("<<< #%d %s\n%s\n", id, time.Since(t), respHead))
+		return resp, nil
+	}
+	body := newCapture(resp.Body, m.BodyCap)
+	body.done = func() {
+		m.write(fmt.Sprintf("<<< #%d %s\n%s%s\n", id, time.Since(t), respHead, body.String()))
+	}
+	resp.Body = body
+
+	return resp, nil
+}
+
+func (m *MirrorTransport) write(s string) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	_, _ = io.WriteString(m.Out, s)
+}
+
+// redactRequest returns a shallow copy of a request with its credentials
+// headers masked.
+func redactRequest(req *http.Request) *http.Request {
+	r := *req
+	r.Header = redactHeaders(req.Header)
+
+	return &r
+}
+
+// redactResponse returns a shallow copy of a response with its cookies masked.
+func redactResponse(resp *http.Response) *http.Response {
+	r := *resp
+	r.Header = redactHeaders(resp.Header)
+
+	return &r
+}
+
+func redactHeaders(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, vv := range h {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]; ok {
+			vv = []string{redactedHeader}
+		}
+		c[k] = vv
+	}
+
+	return c
+}
+
+// NewMirrorProxy returns a reverse proxy to target recording all exchanges.
+func NewMirrorProxy(target string, out io.Writer, bodyCap int64) http.Handler {
+	p := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: target})
+	p.Transport = &MirrorTransport{Out: out, BodyCap: bodyCap}
+
+	return p
+}
+
+// capture records the first bytes read from a body.
+type capture struct {
+	io.ReadCloser
+
+	buff      bytes.Buffer
+	max, seen int64
+	done      func()
+	once      sync.Once
+}
+
+func newCapture(rc io.ReadCloser, max int64) *capture {
+	return &capture{ReadCloser: rc, max: max}
+}
+
+// Read reads from the body recording up to max bytes.
+func (c *capture) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if n > 0 {
+		if left := c.max - int64(c.buff.Len()); left > 0 {
+			if int64(n) < left {
+				left = int64(n)
+			}
+			c.buff.Write(p[:left])
+		}
+		c.seen += int64(n)
+	}
+	if err == io.EOF {
+		c.finish()
+	}
+
+	return n, err
+}
+
+// Close closes the body.
+func (c *capture) Close() error {
+	c.finish()
+	return c.ReadCloser.Close()
+}
+
+func (c *capture) finish() {
+	c.once.Do(func() {
+		if c.done != nil {
+			c.done()
+		}
+	})
+}
+
+// String returns the captured bytes, masked per the privacy rules.
+func (c *capture) String() string {
+	if c == nil || c.seen == 0 {
+		return ""
+	}
+	s := render.RedactText(c.buff.String())
+	if c.seen > int64(c.buff.Len()) {
+		s += fmt.Sprintf("\n... %d bytes truncated", c.seen-int64(c.buff.Len()))
+	}
+
+	return s + "\n"
+}
+
+// mirror tracks a recording proxy fronting a port forward.
+type mirror struct {
+	addr string
+	srv  *http.Server
+	out  io.Closer
+	once sync.Once
+}
+
+// startMirror serves a recording proxy on a free local port. Traffic is
+// forwarded to the given local port the port forward listens on.
+func startMirror(t client.PortTunnel, port string) (*mirror, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(localhost, "0"))
+	if err != nil {
+		return nil, err
+	}
+	out, err := os.OpenFile(t.Mirror, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+
+	if t.MirrorBodyCap > 0 {
+		hdr := fmt.Sprintf("# Mirror of %s:%s started %s. Credentials headers are redacted. Bodies are recorded as is unless masked by the privacy mode rules.\n\n",
+			t.Address, t.PortMap(), time.Now().Format(time.RFC3339))
+		if _, err := io.WriteString(out, hdr); err != nil {
+			_ = l.Close()
+			_ = out.Close()
+			return nil, err
+		}
+	}
+
+	m := mirror{
+		addr: l.Addr().String(),
+		srv:  &http.Server{Handler: NewMirrorProxy(net.JoinHostPort(localhost, port), out, t.MirrorBodyCap)},
+		out:  out,
+	}
+	go func() {
+		if err := m.srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msgf("Mirror on %s failed", l.Addr())
+		}
+	}()
+
+	return &m, nil
+}
+
+func (m *mirror) close() {
+	if m == nil {
+		return
+	}
+	m.once.Do(func() {
+		if err := m.srv.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing mirror")
+		}
+		if err := m.out.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing mirror file")
+		}
+	})
+}
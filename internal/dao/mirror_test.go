@@ -0,0 +1,81 @@
+package dao_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+type echoTransport struct{}
+
+func (echoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = ioutil.ReadAll(req.Body)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"X-Echo": []string{"true"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(append([]byte("echo "), body...))),
+		Request:    req,
+	}, nil
+}
+
+func TestMirrorTransport(t *testing.T) {
+	uu := map[string]struct {
+		cap    int64
+		e, not []string
+	}{
+		"headers": {
+			e:   []string{">>> #1", "POST /fred HTTP/1.1", "<<< #1", "X-Echo: true"},
+			not: []string{"hello world", "echo hello"},
+		},
+		"bodies": {
+			cap: 100,
+			e:   []string{">>> #1", "hello world", "<<< #1", "echo hello world"},
+		},
+		"capped": {
+			cap: 5,
+			e:   []string{"hello\n... 6 bytes truncated", "echo \n... 11 bytes truncated"},
+		},
+		"redacted": {
+			e:   []string{"Authorization: <redacted>", "Cookie: <redacted>"},
+			not: []string{"Bearer fred", "session=blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			m := dao.MirrorTransport{Transport: echoTransport{}, Out: &out, BodyCap: u.cap}
+			req, err := http.NewRequest(http.MethodPost, "http://localhost/fred", strings.NewReader("hello world"))
+			assert.Nil(t, err)
+			req.Header.Set("Authorization", "Bearer fred")
+			req.Header.Set("Cookie", "session=blee")
+
+			resp, err := m.RoundTrip(req)
+			assert.Nil(t, err)
+			body, err := ioutil.ReadAll(resp.Body)
+			assert.Nil(t, err)
+			assert.Nil(t, resp.Body.Close())
+			assert.Equal(t, "echo hello world", string(body))
+
+			for _, e := range u.e {
+				assert.Contains(t, out.String(), e)
+			}
+			for _, e := range u.not {
+				assert.NotContains(t, out.String(), e)
+			}
+		})
+	}
+}
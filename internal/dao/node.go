@@ -3,7 +3,6 @@ package dao
 import (
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -13,7 +12,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -22,6 +20,7 @@ import (
 var (
 	_ Accessor       = (*Node)(nil)
 	_ NodeMaintainer = (*Node)(nil)
+	_ Drainable      = (*Node)(nil)
 )
 
 // NodeMetricsFunc retrieves node metrics.
@@ -63,41 +62,6 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 	return nil
 }
 
-func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
-	return drain.Helper{
-		Client:              k,
-		GracePeriodSeconds:  o.GracePeriodSeconds,
-		Timeout:             o.Timeout,
-		DeleteLocalData:     o.DeleteLocalData,
-		IgnoreAllDaemonSets: o.IgnoreAllDaemonSets,
-		Out:                 w,
-		ErrOut:              w,
-	}
-}
-
-// Drain drains a node.
-func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
-	_ = n.ToggleCordon(path, true)
-
-	h := opts.toDrainHelper(n.Factory.Client().DialOrDie(), w)
-	dd, errs := h.GetPodsForDeletion(path)
-	if len(errs) != 0 {
-		for _, e := range errs {
-			if _, err := h.ErrOut.Write([]byte(e.Error() + "\n")); err != nil {
-				return err
-			}
-		}
-		return errs[0]
-	}
-
-	if err := h.DeleteOrEvictPods(dd.Pods()); err != nil {
-		return err
-	}
-	fmt.Fprintf(h.Out, "Node %s drained!", path)
-
-	return nil
-}
-
 // Get returns a node resource.
 func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 	var (
@@ -0,0 +1,254 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+)
+
+// maxDiskPods caps the number of pods listed by ephemeral storage usage.
+const maxDiskPods = 10
+
+// NodeDisk represents a node disk pressure report.
+type NodeDisk struct {
+	Node        string            `json:"node"`
+	Pressure    DiskCondition     `json:"diskPressure"`
+	Capacity    string            `json:"ephemeralCapacity,omitempty"`
+	Allocatable string            `json:"ephemeralAllocatable,omitempty"`
+	NodeFs      *DiskUsage        `json:"nodeFs,omitempty"`
+	ImageFs     *DiskUsage        `json:"imageFs,omitempty"`
+	Eviction    map[string]string `json:"evictionHard,omitempty"`
+	ImagesSize  string            `json:"imagesSize"`
+	Images      []DiskImage       `json:"images,omitempty"`
+	Pods        []DiskPod         `json:"topEphemeralPods,omitempty"`
+	Errors      []string          `json:"errors,omitempty"`
+}
+
+// DiskCondition represents the node DiskPressure condition.
+type DiskCondition struct {
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// DiskUsage represents a filesystem usage.
+type DiskUsage struct {
+	Capacity  string `json:"capacity"`
+	Used      string `json:"used"`
+	Available string `json:"available"`
+	Usage     string `json:"usage"`
+}
+
+// DiskImage represents a container image stored on a node.
+type DiskImage struct {
+	Name string `json:"name"`
+	Size string `json:"size"`
+}
+
+// DiskPod represents a pod ephemeral storage usage.
+type DiskPod struct {
+	Pod  string `json:"pod"`
+	Used string `json:"used"`
+}
+
+// kubeletFs tracks the kubelet stats summary filesystem fields we care about.
+type kubeletFs struct {
+	AvailableBytes *uint64 `json:"availableBytes"`
+	CapacityBytes  *uint64 `json:"capacityBytes"`
+	UsedBytes      *uint64 `json:"usedBytes"`
+}
+
+// kubeletSummary tracks the kubelet /stats/summary fields we care about.
+type kubeletSummary struct {
+	Node struct {
+		Fs      *kubeletFs `json:"fs"`
+		Runtime *struct {
+			ImageFs *kubeletFs `json:"imageFs"`
+		} `json:"runtime"`
+	} `json:"node"`
+	Pods []struct {
+		PodRef struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"podRef"`
+		EphemeralStorage *kubeletFs `json:"ephemeral-storage"`
+	} `json:"pods"`
+}
+
+// kubeletConfigz tracks the kubelet /configz fields we care about.
+type kubeletConfigz struct {
+	KubeletConfig struct {
+		EvictionHard map[string]string `json:"evictionHard"`
+	} `json:"kubeletconfig"`
+}
+
+// FetchNodeDisk builds a node disk report from the node status and the kubelet
+// stats and config, proxied through the api server.
+func FetchNodeDisk(f Factory, path string) (*NodeDisk, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	no, err := FetchNode(ctx, f, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errs    []string
+		summary *kubeletSummary
+		configz *kubeletConfigz
+	)
+	if err := nodeProxyGet(f, no.Name, "stats/summary", &summary); err != nil {
+		errs = append(errs, fmt.Sprintf("stats: %s", err))
+	}
+	if err := nodeProxyGet(f, no.Name, "configz", &configz); err != nil {
+		errs = append(errs, fmt.Sprintf("configz: %s", err))
+	}
+	d := newNodeDisk(no, summary, configz)
+	d.Errors = errs
+
+	return d, nil
+}
+
+func nodeProxyGet(f Factory, node, target string, o interface{}) error {
+	auth, err := f.Client().CanI(client.ClusterScope, "v1/nodes:proxy", []string{client.GetVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to proxy nodes")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	raw, err := f.Client().DialOrDie().CoreV1().RESTClient().Get().
+		AbsPath(path.Join("/api/v1/nodes", node, "proxy", target)).
+		DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, o)
+}
+
+// newNodeDisk returns a disk report. Kubelet stats and config are optional.
+func newNodeDisk(no *v1.Node, s *kubeletSummary, c *kubeletConfigz) *NodeDisk {
+	d := NodeDisk{
+		Node:     no.Name,
+		Pressure: DiskCondition{Status: string(v1.ConditionUnknown)},
+	}
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeDiskPressure {
+			d.Pressure = DiskCondition{Status: string(c.Status), Reason: c.Reason, Message: c.Message}
+		}
+	}
+	if q, ok := no.Status.Capacity[v1.ResourceEphemeralStorage]; ok {
+		d.Capacity = toBytes(uint64(q.Value()))
+	}
+	if q, ok := no.Status.Allocatable[v1.ResourceEphemeralStorage]; ok {
+		d.Allocatable = toBytes(uint64(q.Value()))
+	}
+
+	ii := make([]v1.ContainerImage, len(no.Status.Images))
+	copy(ii, no.Status.Images)
+	sort.SliceStable(ii, func(i, j int) bool { return ii[i].SizeBytes > ii[j].SizeBytes })
+	var total uint64
+	for _, i := range ii {
+		total += uint64(i.SizeBytes)
+		d.Images = append(d.Images, DiskImage{Name: imageName(i.Names), Size: toBytes(uint64(i.SizeBytes))})
+	}
+	d.ImagesSize = toBytes(total)
+
+	if s != nil {
+		d.NodeFs = s.Node.Fs.usage()
+		if s.Node.Runtime != nil {
+			d.ImageFs = s.Node.Runtime.ImageFs.usage()
+		}
+		d.Pods = topEphemeralPods(s)
+	}
+	if c != nil {
+		d.Eviction = c.KubeletConfig.EvictionHard
+	}
+
+	return &d
+}
+
+func topEphemeralPods(s *kubeletSummary) []DiskPod {
+	type usage struct {
+		fqn  string
+		used uint64
+	}
+	uu := make([]usage, 0, len(s.Pods))
+	for _, p := range s.Pods {
+		if p.EphemeralStorage == nil || p.EphemeralStorage.UsedBytes == nil {
+			continue
+		}
+		uu = append(uu, usage{fqn: client.FQN(p.PodRef.Namespace, p.PodRef.Name), used: *p.EphemeralStorage.UsedBytes})
+	}
+	sort.SliceStable(uu, func(i, j int) bool { return uu[i].used > uu[j].used })
+	if len(uu) > maxDiskPods {
+		uu = uu[:maxDiskPods]
+	}
+	pp := make([]DiskPod, 0, len(uu))
+	for _, u := range uu {
+		pp = append(pp, DiskPod{Pod: u.fqn, Used: toBytes(u.used)})
+	}
+
+	return pp
+}
+
+func (f *kubeletFs) usage() *DiskUsage {
+	if f == nil || f.CapacityBytes == nil {
+		return nil
+	}
+	var used, avail uint64
+	if f.UsedBytes != nil {
+		used = *f.UsedBytes
+	}
+	if f.AvailableBytes != nil {
+		avail = *f.AvailableBytes
+	}
+	u := DiskUsage{
+		Capacity:  toBytes(*f.CapacityBytes),
+		Used:      toBytes(used),
+		Available: toBytes(avail),
+	}
+	if *f.CapacityBytes > 0 {
+		u.Usage = fmt.Sprintf("%d%%", used*100 / *f.CapacityBytes)
+	}
+
+	return &u
+}
+
+// imageName returns the most readable image name, skipping digests.
+func imageName(nn []string) string {
+	for _, n := range nn {
+		if !strings.Contains(n, "@sha256:") {
+			return n
+		}
+	}
+	if len(nn) > 0 {
+		return nn[0]
+	}
+
+	return "n/a"
+}
+
+// toBytes returns a human readable binary size.
+func toBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewNodeDisk(t *testing.T) {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Capacity:    v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("100Gi")},
+			Allocatable: v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("90Gi")},
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, Reason: "KubeletHasDiskPressure", Message: "low disk"},
+			},
+			Images: []v1.ContainerImage{
+				{Names: []string{"nginx@sha256:abc", "nginx:1.19"}, SizeBytes: 1024},
+				{Names: []string{"postgres:12"}, SizeBytes: 3 * 1024 * 1024},
+			},
+		},
+	}
+	raw := `{
+  "node": {"fs": {"availableBytes": 25, "capacityBytes": 100, "usedBytes": 75}, "runtime": {"imageFs": {"capacityBytes": 2048, "usedBytes": 1024, "availableBytes": 1024}}},
+  "pods": [
+    {"podRef": {"name": "p1", "namespace": "default"}, "ephemeral-storage": {"usedBytes": 10}},
+    {"podRef": {"name": "p2", "namespace": "default"}, "ephemeral-storage": {"usedBytes": 2048}},
+    {"podRef": {"name": "p3", "namespace": "default"}}
+  ]
+}`
+	var s kubeletSummary
+	assert.Nil(t, json.Unmarshal([]byte(raw), &s))
+	var c kubeletConfigz
+	assert.Nil(t, json.Unmarshal([]byte(`{"kubeletconfig": {"evictionHard": {"nodefs.available": "10%"}}}`), &c))
+
+	d := newNodeDisk(&no, &s, &c)
+
+	assert.Equal(t, "n1", d.Node)
+	assert.Equal(t, DiskCondition{Status: "True", Reason: "KubeletHasDiskPressure", Message: "low disk"}, d.Pressure)
+	assert.Equal(t, "100.0GiB", d.Capacity)
+	assert.Equal(t, "90.0GiB", d.Allocatable)
+	assert.Equal(t, []DiskImage{{Name: "postgres:12", Size: "3.0MiB"}, {Name: "nginx:1.19", Size: "1.0KiB"}}, d.Images)
+	assert.Equal(t, "3.0MiB", d.ImagesSize)
+	assert.Equal(t, &DiskUsage{Capacity: "100B", Used: "75B", Available: "25B", Usage: "75%"}, d.NodeFs)
+	assert.Equal(t, &DiskUsage{Capacity: "2.0KiB", Used: "1.0KiB", Available: "1.0KiB", Usage: "50%"}, d.ImageFs)
+	assert.Equal(t, []DiskPod{{Pod: "default/p2", Used: "2.0KiB"}, {Pod: "default/p1", Used: "10B"}}, d.Pods)
+	assert.Equal(t, map[string]string{"nodefs.available": "10%"}, d.Eviction)
+}
+
+func TestNewNodeDiskNoStats(t *testing.T) {
+	d := newNodeDisk(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}}, nil, nil)
+
+	assert.Equal(t, "Unknown", d.Pressure.Status)
+	assert.Nil(t, d.NodeFs)
+	assert.Equal(t, "0B", d.ImagesSize)
+}
@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	_ Accessor = (*Namespace)(nil)
+	_ Loggable = (*Namespace)(nil)
+)
+
+// Namespace represents a namespace resource.
+type Namespace struct {
+	Resource
+}
+
+// TailLogs tails the logs of all pods in a namespace, optionally matching the
+// options label selector.
+func (n *Namespace) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
+	_, ns := client.Namespaced(opts.Path)
+	ns = client.CleanseNamespace(ns)
+	sel, err := labels.Parse(opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	return selectorLogs(ctx, c, ns, sel, opts)
+}
@@ -0,0 +1,29 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceTailLogs(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		err string
+	}{
+		"badSelector": {sel: "app in ("},
+		"noFactory":   {sel: "app=fred", err: "expecting a context factory"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var n dao.Namespace
+			err := n.TailLogs(context.Background(), make(dao.LogChan), dao.LogOptions{Path: "default", Selector: u.sel})
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), u.err)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Pause records the current replica count of a workload and scales it to zero.
+func Pause(ctx context.Context, f Factory, gvr client.GVR, s Scalable, path string) error {
+	u, err := loadUnstructured(f, gvr, path)
+	if err != nil {
+		return err
+	}
+	if _, ok := render.PausedReplicas(u.GetAnnotations()); ok {
+		return fmt.Errorf("%s is already paused", path)
+	}
+	replicas, found, err := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if err != nil {
+		return err
+	}
+	if !found {
+		replicas = 1
+	}
+	if replicas == 0 {
+		return fmt.Errorf("%s is already scaled to zero", path)
+	}
+
+	v := strconv.Itoa(int(replicas))
+	if err := patchPaused(ctx, f, gvr, path, &v); err != nil {
+		return err
+	}
+	if err := s.Scale(ctx, path, 0); err != nil {
+		_ = patchPaused(ctx, f, gvr, path, nil)
+		return err
+	}
+
+	return nil
+}
+
+// Resume restores the replica count recorded when a workload was paused.
+func Resume(ctx context.Context, f Factory, gvr client.GVR, s Scalable, path string) error {
+	u, err := loadUnstructured(f, gvr, path)
+	if err != nil {
+		return err
+	}
+	replicas, ok := render.PausedReplicas(u.GetAnnotations())
+	if !ok {
+		return fmt.Errorf("%s is not paused", path)
+	}
+	if err := s.Scale(ctx, path, replicas); err != nil {
+		return err
+	}
+
+	return patchPaused(ctx, f, gvr, path, nil)
+}
+
+// IsPaused checks if a workload was paused.
+func IsPaused(f Factory, gvr client.GVR, path string) (bool, error) {
+	u, err := loadUnstructured(f, gvr, path)
+	if err != nil {
+		return false, err
+	}
+	_, ok := render.PausedReplicas(u.GetAnnotations())
+
+	return ok, nil
+}
+
+func loadUnstructured(f Factory, gvr client.GVR, path string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// patchPaused sets the paused annotation or clears it when v is nil.
+func patchPaused(ctx context.Context, f Factory, gvr client.GVR, path string, v *string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", gvr)
+	}
+
+	var val interface{}
+	if v != nil {
+		val = *v
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{render.PausedAnnotation: val},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Client().DynDialOrDie().Resource(gvr.GVR()).Namespace(ns).Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
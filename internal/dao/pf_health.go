@@ -0,0 +1,159 @@
+package dao
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ForwardActive tracks a healthy port forward.
+	ForwardActive = "Active"
+
+	// ForwardUnhealthy tracks a port forward failing its liveness probe.
+	ForwardUnhealthy = "Unhealthy"
+
+	// ForwardRestarting tracks a port forward being re-established.
+	ForwardRestarting = "Restarting"
+
+	// ForwardStopped tracks a terminated port forward.
+	ForwardStopped = "Stopped"
+)
+
+const (
+	// ForwardProbeInterval represents the time between liveness probes.
+	ForwardProbeInterval = 5 * time.Second
+
+	// ForwardProbeTimeout represents the liveness probe connect timeout.
+	ForwardProbeTimeout = 2 * time.Second
+
+	// ForwardMaxRestarts represents the number of consecutive restarts before giving up.
+	ForwardMaxRestarts = 5
+
+	forwardBackoffMin = time.Second
+	forwardBackoffMax = 30 * time.Second
+)
+
+// ProbeForward checks a port forward is accepting tcp connections.
+func ProbeForward(address string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// NextBackoff returns the delay before the next restart attempt.
+func NextBackoff(d time.Duration) time.Duration {
+	if d < forwardBackoffMin {
+		return forwardBackoffMin
+	}
+	if d *= 2; d > forwardBackoffMax {
+		return forwardBackoffMax
+	}
+
+	return d
+}
+
+// relay fronts a port forward on the user facing port and counts the bytes
+// flowing through. The relay outlives the tunnel so forwards can be restarted
+// without dropping the local listener.
+type relay struct {
+	ll     []net.Listener
+	target string
+	bytes  int64
+	once   sync.Once
+}
+
+// startRelay listens on the given comma separated addresses and port.
+func startRelay(address, port, target string) (*relay, error) {
+	if address == "" {
+		address = localhost
+	}
+	r := relay{target: target}
+	for _, a := range strings.Split(address, ",") {
+		l, err := net.Listen("tcp", net.JoinHostPort(strings.TrimSpace(a), port))
+		if err != nil {
+			r.close()
+			return nil, err
+		}
+		r.ll = append(r.ll, l)
+	}
+	for _, l := range r.ll {
+		go r.serve(l)
+	}
+
+	return &r, nil
+}
+
+func (r *relay) serve(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go r.pipe(conn)
+	}
+}
+
+func (r *relay) pipe(src net.Conn) {
+	defer func() { _ = src.Close() }()
+	dst, err := net.Dial("tcp", r.target)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Relay dial %s failed", r.target)
+		return
+	}
+	defer func() { _ = dst.Close() }()
+
+	done := make(chan struct{}, 2)
+	cp := func(w, rd net.Conn) {
+		if _, err := io.Copy(counter{Writer: w, n: &r.bytes}, rd); err != nil {
+			log.Debug().Err(err).Msgf("Relay to %s closed", r.target)
+		}
+		done <- struct{}{}
+	}
+	go cp(dst, src)
+	go cp(src, dst)
+	<-done
+}
+
+// Bytes returns the number of bytes transferred so far.
+func (r *relay) Bytes() int64 {
+	if r == nil {
+		return 0
+	}
+
+	return atomic.LoadInt64(&r.bytes)
+}
+
+func (r *relay) close() {
+	if r == nil {
+		return
+	}
+	r.once.Do(func() {
+		for _, l := range r.ll {
+			if err := l.Close(); err != nil {
+				log.Error().Err(err).Msg("Closing relay")
+			}
+		}
+	})
+}
+
+// counter tracks the bytes written through a writer.
+type counter struct {
+	io.Writer
+	n *int64
+}
+
+func (c counter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	atomic.AddInt64(c.n, int64(n))
+
+	return n, err
+}
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextBackoff(t *testing.T) {
+	uu := map[string]struct {
+		d, e time.Duration
+	}{
+		"first":  {e: time.Second},
+		"double": {d: 2 * time.Second, e: 4 * time.Second},
+		"capped": {d: 20 * time.Second, e: 30 * time.Second},
+		"max":    {d: 30 * time.Second, e: 30 * time.Second},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NextBackoff(u.d))
+		})
+	}
+}
+
+func TestProbeForward(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := l.Addr().String()
+
+	assert.Nil(t, ProbeForward(addr, time.Second))
+	assert.Nil(t, l.Close())
+	assert.NotNil(t, ProbeForward(addr, time.Second))
+}
+
+func TestRelayBytes(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer echo.Close()
+	go func() {
+		conn, err := echo.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		_, _ = conn.Write([]byte(line))
+	}()
+
+	port, err := netutil.FreePort("127.0.0.1")
+	assert.Nil(t, err)
+	r, err := startRelay("127.0.0.1", port, echo.Addr().String())
+	assert.Nil(t, err)
+	defer r.close()
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", port))
+	assert.Nil(t, err)
+	_, err = conn.Write([]byte("hello\n"))
+	assert.Nil(t, err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	assert.Nil(t, err)
+	assert.Equal(t, "hello\n", line)
+	assert.Nil(t, conn.Close())
+
+	assert.Eventually(t, func() bool { return r.Bytes() == 12 }, time.Second, 10*time.Millisecond)
+}
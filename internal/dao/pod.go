@@ -89,29 +89,65 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		}
 	}
 
+	health := p.nodesHealth()
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
-		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
-			continue
-		}
-
 		spec, ok := u.Object["spec"].(map[string]interface{})
 		if !ok {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
-		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+		if nodeName != "" && spec["nodeName"] != nodeName {
+			continue
 		}
+		node, _ := spec["nodeName"].(string)
+		res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), NodeHealth: health[node]})
 	}
 
 	return res, nil
 }
 
+// nodesHealth returns the nodes health badges by node name. Nodes are skipped
+// when the user can't list them.
+func (p *Pod) nodesHealth() map[string]string {
+	oo, err := p.Factory.List("v1/nodes", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("No nodes health")
+		return nil
+	}
+
+	hh := make(map[string]string, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		hh[u.GetName()] = render.NodeHealth(nodeConditions(u))
+	}
+
+	return hh
+}
+
+// nodeConditions returns a node conditions types and statuses.
+func nodeConditions(u *unstructured.Unstructured) []v1.NodeCondition {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	conds := make([]v1.NodeCondition, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t, _ := m["type"].(string)
+		s, _ := m["status"].(string)
+		conds = append(conds, v1.NodeCondition{Type: v1.NodeConditionType(t), Status: v1.ConditionStatus(s)})
+	}
+
+	return conds
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
@@ -195,6 +231,9 @@ func (p *Pod) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 
 	var tailed bool
 	for _, co := range po.Spec.InitContainers {
+		if !opts.MatchContainer(co.Name) {
+			continue
+		}
 		log.Debug().Msgf("Tailing INIT-CO %q", co.Name)
 		opts.Container = co.Name
 		if err := p.TailLogs(ctx, c, opts); err != nil {
@@ -203,6 +242,9 @@ func (p *Pod) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 		tailed = true
 	}
 	for _, co := range po.Spec.Containers {
+		if !opts.MatchContainer(co.Name) {
+			continue
+		}
 		log.Debug().Msgf("Tailing CO %q", co.Name)
 		opts.Container = co.Name
 		if err := tailLogs(ctx, p, c, opts); err != nil {
@@ -211,6 +253,9 @@ func (p *Pod) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 		tailed = true
 	}
 	for _, co := range po.Spec.EphemeralContainers {
+		if !opts.MatchContainer(co.Name) {
+			continue
+		}
 		log.Debug().Msgf("Tailing EPH-CO %q", co.Name)
 		opts.Container = co.Name
 		if err := tailLogs(ctx, p, c, opts); err != nil {
@@ -219,7 +264,7 @@ func (p *Pod) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 		tailed = true
 	}
 
-	if !tailed {
+	if !tailed && opts.ContainerFilter == nil {
 		return fmt.Errorf("no loggable containers found for pod %s", opts.Path)
 	}
 
@@ -240,12 +285,22 @@ func tailLogs(ctx context.Context, logger Logger, c LogChan, opts LogOptions) er
 		log.Error().Err(err).Msgf("Unable to obtain log stream failed for `%s", opts.Path)
 		return err
 	}
-	go readLogs(stream, c, opts)
+	if t, ok := logger.(containerTracker); ok && !opts.Previous {
+		go followLogs(ctx, t, stream, c, opts)
+		return nil
+	}
+	go func() {
+		if readLogs(stream, c, opts) {
+			c <- opts.DecorateLog([]byte(logStreamClosed))
+		}
+	}()
 
 	return nil
 }
 
-func readLogs(stream io.ReadCloser, c LogChan, opts LogOptions) {
+// readLogs reads a log stream until it ends. Returns true if the stream closed
+// or false if it failed.
+func readLogs(stream io.ReadCloser, c LogChan, opts LogOptions) bool {
 	defer func() {
 		log.Debug().Msgf(">>> Closing stream %s", opts.Info())
 		if err := stream.Close(); err != nil {
@@ -259,12 +314,11 @@ func readLogs(stream io.ReadCloser, c LogChan, opts LogOptions) {
 		if err != nil {
 			if err == io.EOF {
 				log.Warn().Err(err).Msgf("Stream closed for %s", opts.Info())
-				c <- opts.DecorateLog([]byte("log stream closed\n"))
-				return
+				return true
 			}
 			log.Warn().Err(err).Msgf("Stream READ error %s", opts.Info())
 			c <- opts.DecorateLog([]byte("log stream failed\n"))
-			return
+			return false
 		}
 		c <- opts.DecorateLog(bytes)
 	}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const evictedReason = "Evicted"
+
+// IsStalePod checks if a pod succeeded or was evicted more than age ago.
+func IsStalePod(po *v1.Pod, age time.Duration, now time.Time) bool {
+	switch {
+	case po.Status.Phase == v1.PodSucceeded:
+	case po.Status.Phase == v1.PodFailed && po.Status.Reason == evictedReason:
+	default:
+		return false
+	}
+
+	return now.Sub(podDoneTime(po)) > age
+}
+
+// StalePods returns the paths of all succeeded or evicted pods older than age in a given namespace.
+func StalePods(f Factory, ns string, age time.Duration) ([]string, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pp := make([]string, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if IsStalePod(&po, age, now) {
+			pp = append(pp, client.FQN(po.Namespace, po.Name))
+		}
+	}
+
+	return pp, nil
+}
+
+// podDoneTime returns the last time a container terminated or the pod creation time if unknown.
+func podDoneTime(po *v1.Pod) time.Time {
+	t := po.CreationTimestamp.Time
+	if po.Status.StartTime != nil && po.Status.StartTime.After(t) {
+		t = po.Status.StartTime.Time
+	}
+	for _, cs := range po.Status.ContainerStatuses {
+		if cs.State.Terminated != nil && cs.State.Terminated.FinishedAt.After(t) {
+			t = cs.State.Terminated.FinishedAt.Time
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsStalePod(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		po *v1.Pod
+		e  bool
+	}{
+		"running": {
+			po: makeCleanupPod(v1.PodRunning, "", now.Add(-2*time.Hour), nil),
+		},
+		"succeeded-old": {
+			po: makeCleanupPod(v1.PodSucceeded, "", now.Add(-2*time.Hour), nil),
+			e:  true,
+		},
+		"succeeded-recent": {
+			po: makeCleanupPod(v1.PodSucceeded, "", now.Add(-2*time.Hour), timePtr(now.Add(-10*time.Minute))),
+		},
+		"evicted-old": {
+			po: makeCleanupPod(v1.PodFailed, "Evicted", now.Add(-3*time.Hour), nil),
+			e:  true,
+		},
+		"failed-not-evicted": {
+			po: makeCleanupPod(v1.PodFailed, "Error", now.Add(-3*time.Hour), nil),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.IsStalePod(u.po, time.Hour, now))
+		})
+	}
+}
+
+// Helpers...
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func makeCleanupPod(phase v1.PodPhase, reason string, created time.Time, finished *time.Time) *v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "p1",
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Status: v1.PodStatus{
+			Phase:  phase,
+			Reason: reason,
+		},
+	}
+	if finished != nil {
+		po.Status.ContainerStatuses = []v1.ContainerStatus{
+			{
+				Name: "c1",
+				State: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{FinishedAt: metav1.Time{Time: *finished}},
+				},
+			},
+		}
+	}
+
+	return &po
+}
@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	debugGVR          = "v1/pods:ephemeralcontainers"
+	debugPrefix       = "debugger-"
+	debugPollInterval = 500 * time.Millisecond
+)
+
+var _ Debuggable = (*Pod)(nil)
+
+// DebugOptions tracks an ephemeral debug container options.
+type DebugOptions struct {
+	// Image tracks the debug container image.
+	Image string
+	// Target tracks the container whose process namespace is shared if any.
+	Target string
+	// Command overrides the image entrypoint if set.
+	Command []string
+}
+
+// Debug adds an ephemeral debug container to a pod, ie kubectl debug.
+func (p *Pod) Debug(ctx context.Context, path string, opts DebugOptions) (string, error) {
+	if opts.Image == "" {
+		return "", errors.New("a debug container image is required")
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, debugGVR, []string{client.PatchVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to debug pod %s", path)
+	}
+
+	po, err := p.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+	if opts.Target != "" && !hasContainer(po, opts.Target) {
+		return "", fmt.Errorf("no container named %q in pod %s", opts.Target, path)
+	}
+	ec := newDebugContainer(po, opts)
+
+	pods := p.Client().DialOrDie().CoreV1().Pods(ns)
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"ephemeralContainers": []v1.EphemeralContainer{ec},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	_, err = pods.Patch(ctx, n, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "ephemeralcontainers")
+	switch {
+	case err == nil:
+		return ec.Name, nil
+	case apierrors.IsNotFound(err):
+		return "", errors.New("ephemeral containers are not enabled on this cluster")
+	case !apierrors.IsBadRequest(err):
+		return "", err
+	}
+
+	// Clusters prior to 1.22 update the legacy EphemeralContainers kind.
+	ecs, err := pods.GetEphemeralContainers(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	ecs.EphemeralContainers = append(ecs.EphemeralContainers, ec)
+	if _, err = pods.UpdateEphemeralContainers(ctx, n, ecs, metav1.UpdateOptions{}); err != nil {
+		return "", err
+	}
+
+	return ec.Name, nil
+}
+
+// WaitForDebug waits for an ephemeral container to start running.
+func (p *Pod) WaitForDebug(ctx context.Context, path, co string) error {
+	ns, n := client.Namespaced(path)
+	pods := p.Client().DialOrDie().CoreV1().Pods(ns)
+	for {
+		po, err := pods.Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, s := range po.Status.EphemeralContainerStatuses {
+			if s.Name != co {
+				continue
+			}
+			if s.State.Running != nil {
+				return nil
+			}
+			if t := s.State.Terminated; t != nil {
+				return fmt.Errorf("debug container %s terminated: %s", co, t.Reason)
+			}
+			if w := s.State.Waiting; w != nil && w.Reason != "" && w.Reason != "ContainerCreating" && w.Reason != "PodInitializing" {
+				return fmt.Errorf("debug container %s failed to start: %s %s", co, w.Reason, w.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for debug container %s", co)
+		case <-time.After(debugPollInterval):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newDebugContainer(po *v1.Pod, opts DebugOptions) v1.EphemeralContainer {
+	name := debugPrefix + rand.String(5)
+	for hasContainer(po, name) {
+		name = debugPrefix + rand.String(5)
+	}
+
+	return v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    opts.Image,
+			Command:                  opts.Command,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+		},
+		TargetContainerName: opts.Target,
+	}
+}
+
+func hasContainer(po *v1.Pod, co string) bool {
+	for _, c := range po.Spec.Containers {
+		if c.Name == co {
+			return true
+		}
+	}
+	for _, c := range po.Spec.InitContainers {
+		if c.Name == co {
+			return true
+		}
+	}
+	for _, c := range po.Spec.EphemeralContainers {
+		if c.Name == co {
+			return true
+		}
+	}
+
+	return false
+}
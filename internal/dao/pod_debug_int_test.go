@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNewDebugContainer(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "c1"}},
+		},
+	}
+	ec := newDebugContainer(&po, DebugOptions{Image: "busybox", Target: "c1", Command: []string{"sh"}})
+
+	assert.True(t, strings.HasPrefix(ec.Name, debugPrefix))
+	assert.Equal(t, "busybox", ec.Image)
+	assert.Equal(t, "c1", ec.TargetContainerName)
+	assert.Equal(t, []string{"sh"}, ec.Command)
+	assert.True(t, ec.Stdin)
+	assert.True(t, ec.TTY)
+}
+
+func TestHasContainer(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "i1"}},
+			Containers:     []v1.Container{{Name: "c1"}},
+			EphemeralContainers: []v1.EphemeralContainer{
+				{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debugger-fred"}},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		co string
+		e  bool
+	}{
+		"container": {co: "c1", e: true},
+		"init":      {co: "i1", e: true},
+		"ephemeral": {co: "debugger-fred", e: true},
+		"missing":   {co: "blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hasContainer(&po, u.co))
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestBenchForConfig(t *testing.T) {
@@ -31,3 +33,66 @@ func TestBenchForConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckForwardProtocol(t *testing.T) {
+	uu := map[string]struct {
+		p  v1.Protocol
+		ok bool
+	}{
+		"blank": {ok: true},
+		"tcp":   {p: v1.ProtocolTCP, ok: true},
+		"udp":   {p: v1.ProtocolUDP},
+		"sctp":  {p: v1.ProtocolSCTP},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, dao.CheckForwardProtocol(u.p) == nil)
+		})
+	}
+}
+
+func TestForwardSelector(t *testing.T) {
+	ctrl := true
+	owner := []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "fred-5d8f7", Controller: &ctrl}}
+	uu := map[string]struct {
+		owners []metav1.OwnerReference
+		ll     map[string]string
+		e      map[string]string
+	}{
+		"bare": {
+			ll: map[string]string{"app": "fred"},
+		},
+		"deployment": {
+			owners: owner,
+			ll:     map[string]string{"app": "fred", "pod-template-hash": "5d8f7"},
+			e:      map[string]string{"app": "fred"},
+		},
+		"statefulset": {
+			owners: owner,
+			ll: map[string]string{
+				"app":                                "fred",
+				"controller-revision-hash":           "fred-6b4c",
+				"statefulset.kubernetes.io/pod-name": "fred-0",
+			},
+			e: map[string]string{"app": "fred"},
+		},
+		"instanceOnly": {
+			owners: owner,
+			ll:     map[string]string{"pod-template-hash": "5d8f7"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod := v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: u.ll, OwnerReferences: u.owners}}
+			var e *metav1.LabelSelector
+			if u.e != nil {
+				e = &metav1.LabelSelector{MatchLabels: u.e}
+			}
+			assert.Equal(t, e, dao.ForwardSelector(&pod))
+		})
+	}
+}
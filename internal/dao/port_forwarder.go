@@ -2,12 +2,15 @@ package dao
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/netutil"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +26,28 @@ import (
 
 const localhost = "localhost"
 
+// podInstanceLabels tracks labels unique to a pod instance that can't be used
+// to match its replacement.
+var podInstanceLabels = []string{
+	podTemplateHashLabel,
+	RevisionHashLabel,
+	"statefulset.kubernetes.io/pod-name",
+}
+
+// forwardProtocols tracks the protocols the port-forward api can tunnel.
+var forwardProtocols = map[v1.Protocol]bool{
+	v1.ProtocolTCP: true,
+}
+
+// CheckForwardProtocol returns an error if ports using the given protocol cannot be forwarded.
+func CheckForwardProtocol(p v1.Protocol) error {
+	if p == "" || forwardProtocols[p] {
+		return nil
+	}
+
+	return fmt.Errorf("%s ports cannot be forwarded: the Kubernetes port-forward api only tunnels TCP", p)
+}
+
 // PortForwarder tracks a port forward stream.
 type PortForwarder struct {
 	Factory
@@ -34,6 +59,16 @@ type PortForwarder struct {
 	container           string
 	ports               []string
 	age                 time.Time
+	mirror              *mirror
+	relay               *relay
+	tunnel              client.PortTunnel
+	tunnelPort          string
+	selector            *metav1.LabelSelector
+	state               string
+	restarts            int
+	uptime              time.Time
+	stopped             bool
+	mx                  sync.RWMutex
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -50,14 +85,82 @@ func (p *PortForwarder) Age() string {
 	return time.Since(p.age).String()
 }
 
+// Uptime returns the time since the tunnel was last (re)established.
+func (p *PortForwarder) Uptime() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	if p.uptime.IsZero() {
+		return ""
+	}
+
+	return time.Since(p.uptime).Truncate(time.Second).String()
+}
+
+// State returns the port forward health state.
+func (p *PortForwarder) State() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.state
+}
+
+// SetState updates the port forward health state.
+func (p *PortForwarder) SetState(s string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.state = s
+}
+
+// Restarts returns the number of times the tunnel was re-established.
+func (p *PortForwarder) Restarts() int {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.restarts
+}
+
+// Bytes returns the number of bytes transferred through the forward.
+func (p *PortForwarder) Bytes() int64 {
+	return p.relay.Bytes()
+}
+
+// Stopped returns true if the forward was terminated by the user.
+func (p *PortForwarder) Stopped() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.stopped
+}
+
+// TunnelAddress returns the local address the tunnel listens on.
+func (p *PortForwarder) TunnelAddress() string {
+	return net.JoinHostPort(localhost, p.tunnelPort)
+}
+
 // Active returns the forward status.
 func (p *PortForwarder) Active() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.active
 }
 
-// SetActive mark a portforward as active.
+// SetActive mark a portforward as active. Inactive forwards release their
+// relay and mirror.
 func (p *PortForwarder) SetActive(b bool) {
+	p.mx.Lock()
 	p.active = b
+	if b {
+		p.state, p.uptime = ForwardActive, time.Now()
+	} else {
+		p.state = ForwardStopped
+	}
+	p.mx.Unlock()
+	if !b {
+		p.release()
+	}
 }
 
 // Ports returns the forwarded ports mappings.
@@ -67,9 +170,34 @@ func (p *PortForwarder) Ports() []string {
 
 // Path returns the pod resource path.
 func (p *PortForwarder) Path() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return PortForwardID(p.path, p.container)
 }
 
+// Tunnel returns the tunnel specification.
+func (p *PortForwarder) Tunnel() client.PortTunnel {
+	return p.tunnel
+}
+
+// Selector returns the label selector matching a replacement pod.
+func (p *PortForwarder) Selector() *metav1.LabelSelector {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.selector
+}
+
+// SetSelector sets the label selector matching a replacement pod once the
+// target pod is gone.
+func (p *PortForwarder) SetSelector(sel *metav1.LabelSelector) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.selector = sel
+}
+
 // PortForwardID computes port-forward identifier.
 func PortForwardID(path, co string) string {
 	return path + ":" + co
@@ -83,12 +211,39 @@ func (p *PortForwarder) Container() string {
 // Stop terminates a port forard
 func (p *PortForwarder) Stop() {
 	log.Debug().Msgf("<<< Stopping PortForward %q %v", p.path, p.ports)
-	p.active = false
-	close(p.stopChan)
+	p.mx.Lock()
+	p.active, p.stopped, p.state = false, true, ForwardStopped
+	p.mx.Unlock()
+	p.release()
+	p.closeTunnel()
+}
+
+// Break terminates the current tunnel so it can be restarted.
+func (p *PortForwarder) Break() {
+	p.SetState(ForwardUnhealthy)
+	p.closeTunnel()
+}
+
+func (p *PortForwarder) closeTunnel() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.stopChan != nil {
+		close(p.stopChan)
+		p.stopChan = nil
+	}
+}
+
+func (p *PortForwarder) release() {
+	p.relay.close()
+	p.mirror.close()
 }
 
 // FQN returns the portforward unique id.
 func (p *PortForwarder) FQN() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.path + ":" + p.container
 }
 
@@ -102,12 +257,52 @@ func (p *PortForwarder) HasPortMapping(m string) bool {
 	return false
 }
 
-// Start initiates a port forward session for a given pod and ports.
+// Start initiates a port forward session for a given pod and ports. The tunnel
+// listens on a free local port fronted by a relay on the requested port.
 func (p *PortForwarder) Start(path, co string, t client.PortTunnel) (*portforward.PortForwarder, error) {
-	fwds := []string{t.PortMap()}
-	p.path, p.container, p.ports, p.age = path, co, fwds, time.Now()
+	p.path, p.container, p.ports, p.age = path, co, []string{t.PortMap()}, time.Now()
+	p.tunnel = t
 
-	ns, n := client.Namespaced(path)
+	var err error
+	if p.tunnelPort, err = netutil.FreePort(localhost); err != nil {
+		return nil, err
+	}
+	target := p.TunnelAddress()
+	if t.Mirror != "" {
+		if p.mirror, err = startMirror(t, p.tunnelPort); err != nil {
+			return nil, err
+		}
+		target = p.mirror.addr
+	}
+	if p.relay, err = startRelay(t.Address, t.LocalPort, target); err != nil {
+		p.release()
+		return nil, err
+	}
+	fwd, err := p.dial()
+	if err != nil {
+		p.release()
+	}
+
+	return fwd, err
+}
+
+// Restart re-establishes a broken tunnel on the same local ports. Once the
+// target pod is gone, the tunnel moves to a running pod matching the selector.
+func (p *PortForwarder) Restart() (*portforward.PortForwarder, error) {
+	p.mx.Lock()
+	if p.stopped {
+		p.mx.Unlock()
+		return nil, fmt.Errorf("port-forward %s was stopped", p.path+":"+p.container)
+	}
+	p.state, p.stopChan, p.readyChan = ForwardRestarting, make(chan struct{}), make(chan struct{})
+	p.restarts++
+	p.mx.Unlock()
+
+	return p.dial()
+}
+
+func (p *PortForwarder) dial() (*portforward.PortForwarder, error) {
+	ns, _ := client.Namespaced(p.path)
 	auth, err := p.Client().CanI(ns, "v1/pods", []string{client.GetVerb})
 	if err != nil {
 		return nil, err
@@ -116,15 +311,11 @@ func (p *PortForwarder) Start(path, co string, t client.PortTunnel) (*portforwar
 		return nil, fmt.Errorf("user is not authorized to get pods")
 	}
 
-	var res Pod
-	res.Init(p, client.NewGVR("v1/pods"))
-	pod, err := res.GetInstance(path)
+	pod, err := p.targetPod()
 	if err != nil {
 		return nil, err
 	}
-	if pod.Status.Phase != v1.PodRunning {
-		return nil, fmt.Errorf("unable to forward port because pod is not running. Current status=%v", pod.Status.Phase)
-	}
+	n := pod.Name
 
 	auth, err = p.Client().CanI(ns, "v1/pods:portforward", []string{client.CreateVerb})
 	if err != nil {
@@ -150,7 +341,52 @@ func (p *PortForwarder) Start(path, co string, t client.PortTunnel) (*portforwar
 		Name(n).
 		SubResource("portforward")
 
-	return p.forwardPorts("POST", req.URL(), t.Address, fwds)
+	return p.forwardPorts("POST", req.URL(), localhost, []string{p.tunnelPort + ":" + p.tunnel.ContainerPort})
+}
+
+// targetPod returns the running pod to forward to. Unless set, the selector
+// is derived from the pod labels so the forward can fail over to another ready
+// pod once the current one terminates or turns unready.
+func (p *PortForwarder) targetPod() (*v1.Pod, error) {
+	var res Pod
+	res.Init(p, client.NewGVR("v1/pods"))
+	pod, err := res.GetInstance(p.path)
+	if err == nil && p.Selector() == nil {
+		p.SetSelector(ForwardSelector(pod))
+	}
+	sel := p.Selector()
+	running := err == nil && pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil
+	if err == nil && (isPodServing(pod) || (running && sel == nil)) {
+		return pod, nil
+	}
+	if sel == nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unable to forward port because pod is not running. Current status=%v", pod.Status.Phase)
+	}
+
+	lsel, e := metav1.LabelSelectorAsSelector(sel)
+	if e != nil {
+		return nil, e
+	}
+	ns, _ := client.Namespaced(p.path)
+	next, e := selectPod(p, ns, lsel, true)
+	if e != nil {
+		// Stick with a running pod that is not ready yet rather than bailing out.
+		if running {
+			return pod, nil
+		}
+		return nil, e
+	}
+	pod = next
+	path := client.FQN(pod.Namespace, pod.Name)
+	log.Debug().Msgf("PortForward %s moving to replacement pod %s", p.FQN(), path)
+	p.mx.Lock()
+	p.path = path
+	p.mx.Unlock()
+
+	return pod, nil
 }
 
 func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string, ports []string) (*portforward.PortForwarder, error) {
@@ -168,12 +404,36 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 		address = localhost
 	}
 	addrs := strings.Split(address, ",")
-	return portforward.NewOnAddresses(dialer, addrs, ports, p.stopChan, p.readyChan, p.Out, p.ErrOut)
+	p.mx.RLock()
+	stop, ready := p.stopChan, p.readyChan
+	p.mx.RUnlock()
+
+	return portforward.NewOnAddresses(dialer, addrs, ports, stop, ready, p.Out, p.ErrOut)
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// ForwardSelector returns the label selector matching a replacement for a
+// controlled pod or nil if the pod has no controller.
+func ForwardSelector(pod *v1.Pod) *metav1.LabelSelector {
+	if metav1.GetControllerOf(pod) == nil || len(pod.Labels) == 0 {
+		return nil
+	}
+	sel := make(map[string]string, len(pod.Labels))
+	for k, v := range pod.Labels {
+		sel[k] = v
+	}
+	for _, l := range podInstanceLabels {
+		delete(sel, l)
+	}
+	if len(sel) == 0 {
+		return nil
+	}
+
+	return &metav1.LabelSelector{MatchLabels: sel}
+}
+
 func codec() (serializer.CodecFactory, runtime.ParameterCodec) {
 	scheme := runtime.NewScheme()
 	gv := schema.GroupVersion{Group: "", Version: "v1"}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// histogram and summaries series suffixes.
+var promSuffixes = []string{"_bucket", "_sum", "_count"}
+
+// PeekMetrics fetches and parses a pod prometheus metrics endpoint via the api server proxy.
+func PeekMetrics(f Factory, fqn, port, target string) ([]render.PromSample, error) {
+	raw, err := ProxyGet(f, client.NewGVR("v1/pods"), fqn, port, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePromMetrics(bytes.NewReader(raw))
+}
+
+// ParsePromMetrics parses metrics in the prometheus text exposition format.
+// Malformed lines, ie a partial last line of a truncated body, are skipped.
+// Errors out only if no samples could be parsed.
+func ParsePromMetrics(r io.Reader) ([]render.PromSample, error) {
+	types, helps := make(map[string]string), make(map[string]string)
+	var (
+		ss     []render.PromSample
+		errLoc error
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxProxyBody)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			parsePromComment(line, types, helps)
+			continue
+		}
+		s, err := parsePromSample(line)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Skipping metrics line %d", n)
+			if errLoc == nil {
+				errLoc = fmt.Errorf("line %d: %w", n, err)
+			}
+			continue
+		}
+		base := promBase(s.Name, types)
+		s.Type, s.Help = types[base], helps[base]
+		ss = append(ss, s)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ss) == 0 && errLoc != nil {
+		return nil, errLoc
+	}
+
+	return ss, nil
+}
+
+func parsePromComment(line string, types, helps map[string]string) {
+	tokens := strings.SplitN(line, " ", 4)
+	if len(tokens) < 3 {
+		return
+	}
+	switch tokens[1] {
+	case "TYPE":
+		if len(tokens) == 4 {
+			types[tokens[2]] = tokens[3]
+		}
+	case "HELP":
+		if len(tokens) == 4 {
+			helps[tokens[2]] = tokens[3]
+		}
+	}
+}
+
+// promBase returns the metric family of a histogram or summary series.
+func promBase(name string, types map[string]string) string {
+	if _, ok := types[name]; ok {
+		return name
+	}
+	for _, s := range promSuffixes {
+		if b := strings.TrimSuffix(name, s); b != name {
+			if _, ok := types[b]; ok {
+				return b
+			}
+		}
+	}
+
+	return name
+}
+
+func parsePromSample(line string) (render.PromSample, error) {
+	var s render.PromSample
+	i := strings.IndexAny(line, "{ \t")
+	if i <= 0 {
+		return s, fmt.Errorf("invalid sample %q", line)
+	}
+	s.Name, line = line[:i], line[i:]
+	if line[0] == '{' {
+		var err error
+		if s.Labels, line, err = parsePromLabels(line[1:]); err != nil {
+			return s, err
+		}
+	}
+	ff := strings.Fields(line)
+	if len(ff) == 0 {
+		return s, fmt.Errorf("missing value for %q", s.Name)
+	}
+	s.Value = ff[0]
+
+	return s, nil
+}
+
+// parsePromLabels parses a labels set up to the closing brace. Returns the
+// remainder of the line.
+func parsePromLabels(line string) (map[string]string, string, error) {
+	ll := make(map[string]string)
+	for {
+		line = strings.TrimLeft(line, " ,")
+		if line == "" {
+			return nil, "", fmt.Errorf("unterminated labels")
+		}
+		if line[0] == '}' {
+			return ll, line[1:], nil
+		}
+		eq := strings.Index(line, "=")
+		if eq <= 0 || len(line) < eq+2 || line[eq+1] != '"' {
+			return nil, "", fmt.Errorf("invalid label in %q", line)
+		}
+		k := strings.TrimSpace(line[:eq])
+		v, rest, err := promQuoted(line[eq+2:])
+		if err != nil {
+			return nil, "", err
+		}
+		ll[k], line = v, rest
+	}
+}
+
+// promQuoted reads an escaped label value up to its closing quote.
+func promQuoted(s string) (string, string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), s[i+1:], nil
+		case '\\':
+			if i+1 == len(s) {
+				return "", "", fmt.Errorf("invalid escape in %q", s)
+			}
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", "", fmt.Errorf("unterminated label value %q", s)
+}
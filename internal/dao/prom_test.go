@@ -0,0 +1,64 @@
+package dao_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+const promSample = `# HELP http_requests_total Total requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="get",path="/a \"b\"\\c"} 3
+
+# HELP req_seconds Latency.
+# TYPE req_seconds histogram
+req_seconds_bucket{le="0.5"} 24054
+req_seconds_sum 53423
+up 1
+`
+
+func TestParsePromMetrics(t *testing.T) {
+	ss, err := dao.ParsePromMetrics(strings.NewReader(promSample))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []render.PromSample{
+		{Name: "http_requests_total", Type: "counter", Help: "Total requests.", Value: "1027", Labels: map[string]string{"method": "post", "code": "200"}},
+		{Name: "http_requests_total", Type: "counter", Help: "Total requests.", Value: "3", Labels: map[string]string{"method": "get", "path": `/a "b"\c`}},
+		{Name: "req_seconds_bucket", Type: "histogram", Help: "Latency.", Value: "24054", Labels: map[string]string{"le": "0.5"}},
+		{Name: "req_seconds_sum", Type: "histogram", Help: "Latency.", Value: "53423"},
+		{Name: "up", Value: "1"},
+	}, ss)
+}
+
+func TestParsePromMetricsTruncated(t *testing.T) {
+	ss, err := dao.ParsePromMetrics(strings.NewReader("up 1\nbad\nreq_seconds_sum 5\nhttp_requests_total{method=\"po"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []render.PromSample{
+		{Name: "up", Value: "1"},
+		{Name: "req_seconds_sum", Value: "5"},
+	}, ss)
+}
+
+func TestParsePromMetricsFail(t *testing.T) {
+	uu := map[string]struct {
+		raw, err string
+	}{
+		"no-value":     {raw: "up\n", err: `line 1: invalid sample "up"`},
+		"unterminated": {raw: `up{a="b 1`, err: `line 1: unterminated label value "b 1"`},
+		"no-brace":     {raw: `up{a="b" 1`, err: `line 1: invalid label in "1"`},
+		"no-quote":     {raw: "up{a=b} 1", err: `line 1: invalid label in "a=b} 1"`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := dao.ParsePromMetrics(strings.NewReader(u.raw))
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// MaxProxyBody caps the size of proxied responses.
+const MaxProxyBody = 1 << 20
+
+// ProxyPath returns the api server proxy path and query for a pod or service
+// resource, ie /api/v1/namespaces/ns/pods/fred:8080/proxy/metrics.
+func ProxyPath(gvr client.GVR, fqn, port, target string) (string, url.Values, error) {
+	if r := gvr.R(); r != "pods" && r != "services" {
+		return "", nil, fmt.Errorf("proxy is not supported on %s", gvr)
+	}
+	ns, n := client.Namespaced(fqn)
+	if ns == "" || n == "" {
+		return "", nil, fmt.Errorf("invalid resource path %q", fqn)
+	}
+	if strings.Contains(port, "/") {
+		return "", nil, fmt.Errorf("invalid proxy port %q", port)
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", nil, err
+	}
+	if port != "" {
+		n += ":" + port
+	}
+	base := path.Join("/api", gvr.V(), "namespaces", ns, gvr.R(), n, "proxy")
+	p := path.Join(base, u.Path)
+	if p != base && !strings.HasPrefix(p, base+"/") {
+		return "", nil, fmt.Errorf("proxy target %q escapes the proxy path", target)
+	}
+	if strings.HasSuffix(u.Path, "/") && !strings.HasSuffix(p, "/") {
+		p += "/"
+	}
+
+	return p, u.Query(), nil
+}
+
+// ProxyGet issues a GET request through the api server proxy subresource of a
+// pod or service. Responses are capped to MaxProxyBody.
+func ProxyGet(f Factory, gvr client.GVR, fqn, port, target string) ([]byte, error) {
+	p, q, err := ProxyPath(gvr, fqn, port, target)
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(fqn)
+	auth, err := f.Client().CanI(ns, gvr.String()+":proxy", []string{client.GetVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to proxy %s", gvr.R())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	req := f.Client().DialOrDie().CoreV1().RESTClient().Get().AbsPath(p)
+	for k, vv := range q {
+		for _, v := range vv {
+			req = req.Param(k, v)
+		}
+	}
+	rc, err := req.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rc.Close(); err != nil {
+			log.Warn().Err(err).Msgf("Closing proxy stream %s", p)
+		}
+	}()
+	raw, err := ioutil.ReadAll(io.LimitReader(rc, MaxProxyBody+1))
+	if len(raw) > MaxProxyBody {
+		raw = append(raw[:MaxProxyBody], []byte("\n... truncated")...)
+	}
+
+	return raw, err
+}
@@ -0,0 +1,66 @@
+package dao_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyPath(t *testing.T) {
+	uu := map[string]struct {
+		gvr, path, port, target string
+		e                       string
+		q                       url.Values
+		err                     string
+	}{
+		"pod": {
+			gvr: "v1/pods", path: "default/fred", port: "8080", target: "/metrics",
+			e: "/api/v1/namespaces/default/pods/fred:8080/proxy/metrics", q: url.Values{},
+		},
+		"svc": {
+			gvr: "v1/services", path: "default/blee", target: "healthz?verbose=1",
+			e: "/api/v1/namespaces/default/services/blee/proxy/healthz", q: url.Values{"verbose": []string{"1"}},
+		},
+		"slash": {
+			gvr: "v1/services", path: "default/blee", port: "http", target: "/",
+			e: "/api/v1/namespaces/default/services/blee:http/proxy/", q: url.Values{},
+		},
+		"unsupported": {
+			gvr: "apps/v1/deployments", path: "default/fred", target: "/",
+			err: "proxy is not supported on apps/v1/deployments",
+		},
+		"escape": {
+			gvr: "v1/pods", path: "default/fred", port: "8080", target: "/../../secrets/blee",
+			err: `proxy target "/../../secrets/blee" escapes the proxy path`,
+		},
+		"relative-escape": {
+			gvr: "v1/services", path: "default/blee", target: "metrics/../../..",
+			err: `proxy target "metrics/../../.." escapes the proxy path`,
+		},
+		"port-escape": {
+			gvr: "v1/pods", path: "default/fred", port: "../../secrets", target: "/",
+			err: `invalid proxy port "../../secrets"`,
+		},
+		"no-ns": {
+			gvr: "v1/pods", path: "fred", target: "/",
+			err: `invalid resource path "fred"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, q, err := dao.ProxyPath(client.NewGVR(u.gvr), u.path, u.port, u.target)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, p)
+			assert.Equal(t, u.q, q)
+		})
+	}
+}
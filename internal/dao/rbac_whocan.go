@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*WhoCan)(nil)
+
+// WhoCanQuery represents a reverse rbac lookup of the subjects granted a verb
+// on a resource. A blank namespace matches bindings in all namespaces.
+type WhoCanQuery struct {
+	Verb, Group, Resource, SubResource, Namespace string
+}
+
+// String returns the query as a kubectl auth can-i style statement.
+func (q WhoCanQuery) String() string {
+	res := q.Resource
+	if q.Group != "" {
+		res += "." + q.Group
+	}
+	if q.SubResource != "" {
+		res += "/" + q.SubResource
+	}
+	if q.Namespace == "" {
+		return q.Verb + " " + res
+	}
+
+	return q.Verb + " " + res + " -n " + q.Namespace
+}
+
+// WhoCan represents the subjects granted a given verb on a resource.
+type WhoCan struct {
+	Resource
+}
+
+// List returns the subjects granted the context query.
+func (w *WhoCan) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyWhoCan).(WhoCanQuery)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context who-can query")
+	}
+
+	p := Policy{Resource: w.Resource}
+	crs, err := p.fetchClusterRoles()
+	if err != nil {
+		return nil, err
+	}
+	ros, err := p.fetchRoles()
+	if err != nil {
+		return nil, err
+	}
+	crbs, err := fetchClusterRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Match(crs, ros, crbs, rbs), nil
+}
+
+// Match returns the bindings subjects granted the query.
+func (q WhoCanQuery) Match(crs []rbacv1.ClusterRole, ros []rbacv1.Role, crbs []rbacv1.ClusterRoleBinding, rbs []rbacv1.RoleBinding) []runtime.Object {
+	crRules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for _, cr := range crs {
+		crRules[cr.Name] = cr.Rules
+	}
+	roRules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for _, ro := range ros {
+		roRules[client.FQN(ro.Namespace, ro.Name)] = ro.Rules
+	}
+
+	var oo []runtime.Object
+	for _, crb := range crbs {
+		names, ok := q.grants(crRules[crb.RoleRef.Name])
+		if !ok {
+			continue
+		}
+		oo = append(oo, whoCanRows(crb.Subjects, "", "*", "CRB:"+crb.Name, "CR:"+crb.RoleRef.Name, names)...)
+	}
+	for _, rb := range rbs {
+		if q.Namespace != "" && rb.Namespace != q.Namespace {
+			continue
+		}
+		rules, role := crRules[rb.RoleRef.Name], "CR:"+rb.RoleRef.Name
+		if rb.RoleRef.Kind == "Role" {
+			rules, role = roRules[client.FQN(rb.Namespace, rb.RoleRef.Name)], "RO:"+rb.RoleRef.Name
+		}
+		names, ok := q.grants(rules)
+		if !ok {
+			continue
+		}
+		oo = append(oo, whoCanRows(rb.Subjects, rb.Namespace, rb.Namespace, "RB:"+rb.Name, role, names)...)
+	}
+
+	return oo
+}
+
+// grants checks if the rules grant the query and returns the resource names
+// the grant is restricted to if any.
+func (q WhoCanQuery) grants(rules []rbacv1.PolicyRule) ([]string, bool) {
+	var (
+		names   []string
+		granted bool
+	)
+	for _, r := range rules {
+		if !ruleMatches(r.Verbs, q.Verb) || !ruleMatches(r.APIGroups, q.Group) || !q.matchesResource(r.Resources) {
+			continue
+		}
+		if len(r.ResourceNames) == 0 {
+			return nil, true
+		}
+		granted, names = true, append(names, r.ResourceNames...)
+	}
+
+	return names, granted
+}
+
+func (q WhoCanQuery) matchesResource(rr []string) bool {
+	res := q.Resource
+	if q.SubResource != "" {
+		res += "/" + q.SubResource
+	}
+	for _, r := range rr {
+		switch {
+		case r == rbacv1.ResourceAll, r == res:
+			return true
+		case q.SubResource != "" && r == rbacv1.ResourceAll+"/"+q.SubResource:
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleMatches(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == "*" || v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func whoCanRows(ss []rbacv1.Subject, ns, scope, binding, role string, names []string) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		sns := s.Namespace
+		if s.Kind == rbacv1.ServiceAccountKind && sns == "" {
+			sns = ns
+		}
+		oo = append(oo, render.WhoCanRes{
+			Kind:          s.Kind,
+			Name:          s.Name,
+			Namespace:     sns,
+			Scope:         scope,
+			Binding:       binding,
+			Role:          role,
+			ResourceNames: strings.Join(names, ","),
+		})
+	}
+
+	return oo
+}
@@ -0,0 +1,158 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWhoCanQueryMatch(t *testing.T) {
+	crs := []rbacv1.ClusterRole{
+		makeClusterRole("admin", rbacv1.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}),
+		makeClusterRole("view", rbacv1.PolicyRule{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}}),
+		makeClusterRole("deployer", rbacv1.PolicyRule{Verbs: []string{"delete", "patch"}, APIGroups: []string{"apps"}, Resources: []string{"deployments"}}),
+	}
+	ros := []rbacv1.Role{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "janitor"},
+			Rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"p1"}},
+			},
+		},
+	}
+	crbs := []rbacv1.ClusterRoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "admin"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "ops"}},
+		},
+	}
+	rbs := []rbacv1.RoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "viewers"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "bob"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "janitors"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "janitor"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "cleaner"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "blee", Name: "deployers"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "deployer"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "ci", Namespace: "cicd"}},
+		},
+	}
+
+	uu := map[string]struct {
+		q dao.WhoCanQuery
+		e []string
+	}{
+		"deletePods": {
+			q: dao.WhoCanQuery{Verb: "delete", Resource: "pods", Namespace: "fred"},
+			e: []string{
+				"Group:ops@*/CRB:admins",
+				"ServiceAccount:fred/cleaner@fred/RB:janitors",
+			},
+		},
+		"logs": {
+			q: dao.WhoCanQuery{Verb: "get", Resource: "pods", SubResource: "log", Namespace: "fred"},
+			e: []string{
+				"Group:ops@*/CRB:admins",
+				"User:bob@fred/RB:viewers",
+			},
+		},
+		"otherNamespace": {
+			q: dao.WhoCanQuery{Verb: "list", Resource: "pods", Namespace: "blee"},
+			e: []string{"Group:ops@*/CRB:admins"},
+		},
+		"allNamespaces": {
+			q: dao.WhoCanQuery{Verb: "patch", Group: "apps", Resource: "deployments"},
+			e: []string{
+				"Group:ops@*/CRB:admins",
+				"ServiceAccount:cicd/ci@blee/RB:deployers",
+			},
+		},
+		"wrongGroup": {
+			q: dao.WhoCanQuery{Verb: "delete", Resource: "deployments", Namespace: "blee"},
+			e: []string{"Group:ops@*/CRB:admins"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, whoCanIDs(u.q.Match(crs, ros, crbs, rbs)))
+		})
+	}
+}
+
+func TestWhoCanQueryResourceNames(t *testing.T) {
+	ros := []rbacv1.Role{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "janitor"},
+			Rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"p1", "p2"}},
+			},
+		},
+	}
+	rbs := []rbacv1.RoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: "janitors"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "janitor"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "bob"}},
+		},
+	}
+
+	oo := dao.WhoCanQuery{Verb: "delete", Resource: "pods", Namespace: "fred"}.Match(nil, ros, nil, rbs)
+	assert.Equal(t, 1, len(oo))
+	assert.Equal(t, "p1,p2", oo[0].(render.WhoCanRes).ResourceNames)
+	assert.Equal(t, "RO:janitor", oo[0].(render.WhoCanRes).Role)
+}
+
+func TestWhoCanQueryString(t *testing.T) {
+	uu := map[string]struct {
+		q dao.WhoCanQuery
+		e string
+	}{
+		"core": {
+			q: dao.WhoCanQuery{Verb: "get", Resource: "pods", SubResource: "log", Namespace: "fred"},
+			e: "get pods/log -n fred",
+		},
+		"group": {
+			q: dao.WhoCanQuery{Verb: "delete", Group: "apps", Resource: "deployments"},
+			e: "delete deployments.apps",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.q.String())
+		})
+	}
+}
+
+// Helpers...
+
+func makeClusterRole(name string, rr ...rbacv1.PolicyRule) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Rules:      rr,
+	}
+}
+
+func whoCanIDs(oo []runtime.Object) []string {
+	ss := make([]string, 0, len(oo))
+	for _, o := range oo {
+		ss = append(ss, o.(render.WhoCanRes).ID())
+	}
+
+	return ss
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	readyMaxSamples = 60
+
+	// ReadySampleInterval tracks how often workloads ready pods are sampled.
+	ReadySampleInterval = 10 * time.Second
+)
+
+// readyHistory tracks the workloads ready pods counts over the session.
+var readyHistory = newReplicaHistory(readyMaxSamples, ReadySampleInterval)
+
+// readyGVRs tracks the workloads sampled for ready pods.
+var readyGVRs = []client.GVR{
+	client.NewGVR("apps/v1/deployments"),
+	client.NewGVR("apps/v1/replicasets"),
+}
+
+// SampleReady records the workloads ready pods in a given namespace from the
+// informers cache, regardless of the resource being viewed.
+func SampleReady(f Factory, ns string) {
+	ctx := readyContext(f)
+	for _, gvr := range readyGVRs {
+		oo, err := f.List(gvr.String(), ns, false, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Sampling ready pods for %s", gvr)
+			continue
+		}
+		recordReady(ctx, gvr, oo)
+	}
+}
+
+// recordReady tracks the workloads ready replicas over the session.
+func recordReady(ctx string, gvr client.GVR, oo []runtime.Object) {
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		n, _, err := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+		if err != nil {
+			continue
+		}
+		readyHistory.Add(readyKey(ctx, gvr, client.FQN(u.GetNamespace(), u.GetName())), int32(n), time.Now())
+	}
+}
+
+// ReadyHistory returns a workload ready pods counts recorded over the session
+// on a given context.
+func ReadyHistory(ctx string, gvr client.GVR, path string) []int32 {
+	return readyHistory.Get(readyKey(ctx, gvr, path))
+}
+
+// withReadyTrend prepends a workload ready pods trend to its description if
+// any samples were recorded.
+func withReadyTrend(ctx string, gvr client.GVR, path, desc string) string {
+	trend := readyTrend(ReadyHistory(ctx, gvr, path))
+	if trend == "" {
+		return desc
+	}
+
+	return trend + "\n" + desc
+}
+
+// readyTrend returns a ready pods counts sparkline along with its range.
+func readyTrend(ss []int32) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	lo, hi := ss[0], ss[0]
+	for _, s := range ss {
+		if s < lo {
+			lo = s
+		}
+		if s > hi {
+			hi = s
+		}
+	}
+
+	return fmt.Sprintf("%-16s%s ready min=%d max=%d (%d samples)", "Ready Trend:", render.Sparkline(ss), lo, hi, len(ss))
+}
+
+// readyContext returns the context the factory is connected to.
+func readyContext(f Factory) string {
+	if f == nil || f.Client() == nil {
+		return ""
+	}
+	ctx, err := f.Client().Config().CurrentContextName()
+	if err != nil {
+		return ""
+	}
+
+	return ctx
+}
+
+func readyKey(ctx string, gvr client.GVR, path string) string {
+	return ctx + ":" + gvr.String() + ":" + path
+}
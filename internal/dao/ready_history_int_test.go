@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRecordReady(t *testing.T) {
+	gvr := client.NewGVR("apps/v1/replicasets")
+	recordReady("c1", gvr, []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"namespace": "ns1", "name": "rs-ready"},
+			"status":   map[string]interface{}{"readyReplicas": int64(2)},
+		}},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"namespace": "ns1", "name": "rs-none"},
+			"status":   map[string]interface{}{},
+		}},
+	})
+
+	assert.Equal(t, []int32{2}, ReadyHistory("c1", gvr, "ns1/rs-ready"))
+	assert.Equal(t, []int32{0}, ReadyHistory("c1", gvr, "ns1/rs-none"))
+	assert.Nil(t, ReadyHistory("c2", gvr, "ns1/rs-ready"))
+	assert.Nil(t, ReadyHistory("c1", client.NewGVR("apps/v1/deployments"), "ns1/rs-ready"))
+}
+
+func TestReadyTrend(t *testing.T) {
+	uu := map[string]struct {
+		ss []int32
+		e  string
+	}{
+		"none":   {},
+		"steady": {ss: []int32{3, 3}, e: "Ready Trend:    ██ ready min=3 max=3 (2 samples)"},
+		"flappy": {ss: []int32{0, 3, 1, 3}, e: "Ready Trend:    ▁█▃█ ready min=0 max=3 (4 samples)"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, readyTrend(u.ss))
+		})
+	}
+}
+
+func TestWithReadyTrend(t *testing.T) {
+	gvr := client.NewGVR("apps/v1/deployments")
+	assert.Equal(t, "Name: fred", withReadyTrend("c1", gvr, "ns1/trend-none", "Name: fred"))
+
+	readyHistory.Add(readyKey("c1", gvr, "ns1/trend"), 1, time.Now())
+	assert.Equal(t, "Ready Trend:    █ ready min=1 max=1 (1 samples)\nName: fred", withReadyTrend("c1", gvr, "ns1/trend", "Name: fred"))
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -20,6 +21,8 @@ var MetaAccess = NewMeta()
 // Meta represents available resource metas.
 type Meta struct {
 	resMetas ResourceMetas
+	crdCols  map[client.GVR][]render.CRDColumn
+	excludes map[string]struct{}
 	mx       sync.RWMutex
 }
 
@@ -36,10 +39,12 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("contexts"):                      &Context{},
 		client.NewGVR("containers"):                    &Container{},
 		client.NewGVR("screendumps"):                   &ScreenDump{},
+		client.NewGVR("trash"):                         &Trash{},
 		client.NewGVR("benchmarks"):                    &Benchmark{},
 		client.NewGVR("portforwards"):                  &PortForward{},
 		client.NewGVR("v1/services"):                   &Service{},
 		client.NewGVR("v1/pods"):                       &Pod{},
+		client.NewGVR("v1/namespaces"):                 &Namespace{},
 		client.NewGVR("v1/nodes"):                      &Node{},
 		client.NewGVR("apps/v1/deployments"):           &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
@@ -51,6 +56,8 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("popeye"):                        &Popeye{},
 		client.NewGVR("sanitizer"):                     &Popeye{},
 		client.NewGVR("helm"):                          &Helm{},
+		client.NewGVR("argoproj.io/v1alpha1/rollouts"): &Rollout{},
+		client.NewGVR("flagger.app/v1beta1/canaries"):  &Canary{},
 	}
 
 	r, ok := m[gvr]
@@ -71,6 +78,49 @@ func (m *Meta) RegisterMeta(gvr string, res metav1.APIResource) {
 	m.resMetas[client.NewGVR(gvr)] = res
 }
 
+// Exclude skips the given resources while loading resources metadata.
+// Resources are specified by gvr ie v1/events or by kubectl style resource
+// names ie leases.coordination.k8s.io. Returns true if the exclusions changed.
+func (m *Meta) Exclude(ss []string) bool {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	excludes := make(map[string]struct{}, len(ss))
+	for _, s := range ss {
+		excludes[s] = struct{}{}
+	}
+	changed := len(excludes) != len(m.excludes)
+	for k := range excludes {
+		if _, ok := m.excludes[k]; !ok {
+			changed = true
+		}
+	}
+	m.excludes = excludes
+
+	return changed
+}
+
+// IsExcluded returns true if the given resource was excluded.
+func (m *Meta) IsExcluded(gvr client.GVR) bool {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.isExcluded(gvr)
+}
+
+func (m *Meta) isExcluded(gvr client.GVR) bool {
+	if len(m.excludes) == 0 {
+		return false
+	}
+	for _, k := range []string{gvr.String(), gvr.GR().String()} {
+		if _, ok := m.excludes[k]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AllGVRs returns all cluster resources.
 func (m *Meta) AllGVRs() client.GVRs {
 	m.mx.RLock()
@@ -97,6 +147,16 @@ func (m *Meta) MetaFor(gvr client.GVR) (metav1.APIResource, error) {
 	return meta, nil
 }
 
+// CRDColumnsFor returns a custom resource columns if defined by its CRD.
+func (m *Meta) CRDColumnsFor(gvr client.GVR) ([]render.CRDColumn, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	cc, ok := m.crdCols[gvr]
+
+	return cc, ok && len(cc) > 0
+}
+
 // IsK8sMeta checks for non resource meta.
 func IsK8sMeta(m metav1.APIResource) bool {
 	for _, c := range m.Categories {
@@ -129,7 +189,14 @@ func (m *Meta) LoadResources(f Factory) error {
 		return err
 	}
 	loadNonResource(m.resMetas)
-	loadCRDs(f, m.resMetas)
+	m.crdCols = make(map[client.GVR][]render.CRDColumn)
+	loadCRDs(f, m.resMetas, m.crdCols)
+	for gvr := range m.resMetas {
+		if m.isExcluded(gvr) {
+			log.Debug().Msgf("Excluding resource %q", gvr)
+			delete(m.resMetas, gvr)
+		}
+	}
 
 	return nil
 }
@@ -195,6 +262,14 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("trash")] = metav1.APIResource{
+		Name:         "trash",
+		Kind:         "Trash",
+		SingularName: "trash",
+		ShortNames:   []string{"tr"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("benchmarks")] = metav1.APIResource{
 		Name:         "benchmarks",
 		Kind:         "Benchmarks",
@@ -254,6 +329,11 @@ func loadRBAC(m ResourceMetas) {
 		Namespaced: true,
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("whocan")] = metav1.APIResource{
+		Name:       "whocan",
+		Kind:       "Subject",
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("users")] = metav1.APIResource{
 		Name:       "users",
 		Kind:       "User",
@@ -285,7 +365,7 @@ func loadPreferred(f Factory, m ResourceMetas) error {
 	return nil
 }
 
-func loadCRDs(f Factory, m ResourceMetas) {
+func loadCRDs(f Factory, m ResourceMetas, cols map[client.GVR][]render.CRDColumn) {
 	const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
 	oo, err := f.List(crdGVR, client.ClusterScope, true, labels.Everything())
 	if err != nil {
@@ -301,6 +381,9 @@ func loadCRDs(f Factory, m ResourceMetas) {
 		}
 		gvr := client.NewGVRFromMeta(meta)
 		m[gvr] = meta
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			cols[gvr] = CRDColumns(u, meta.Version)
+		}
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -98,3 +99,28 @@ func load(t *testing.T, n string) *unstructured.Unstructured {
 
 	return &o
 }
+
+func TestMetaExclude(t *testing.T) {
+	m := NewMeta()
+	ee := []string{"v1/events", "leases.coordination.k8s.io", "orders.acme.cert-manager.io"}
+	assert.True(t, m.Exclude(ee))
+	assert.False(t, m.Exclude(ee))
+
+	uu := map[string]struct {
+		gvr string
+		e   bool
+	}{
+		"gvr":      {gvr: "v1/events", e: true},
+		"resource": {gvr: "coordination.k8s.io/v1/leases", e: true},
+		"crd":      {gvr: "acme.cert-manager.io/v1alpha2/orders", e: true},
+		"kept":     {gvr: "v1/pods"},
+		"group":    {gvr: "events.k8s.io/v1beta1/events"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, m.IsExcluded(client.NewGVR(u.gvr)))
+		})
+	}
+}
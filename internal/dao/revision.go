@@ -0,0 +1,277 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// RevisionCurrent tracks the replicaset of the latest rollout.
+	RevisionCurrent = "Current"
+	// RevisionActive tracks an old replicaset still running pods.
+	RevisionActive = "Active"
+	// RevisionRetained tracks an idle replicaset within the revision history limit.
+	RevisionRetained = "Retained"
+	// RevisionGarbage tracks an idle replicaset beyond the revision history limit.
+	RevisionGarbage = "Garbage"
+	// RevisionHashLabel tracks the label matching pods to their controller revision.
+	RevisionHashLabel = "controller-revision-hash"
+
+	revisionAnnotation          = "deployment.kubernetes.io/revision"
+	podTemplateHashLabel        = "pod-template-hash"
+	defaultRevisionHistoryLimit = 10
+)
+
+// Revision represents a workload replicaset or controller revision.
+type Revision struct {
+	Path                    string
+	Hash                    string
+	Revision                int64
+	Desired, Current, Ready int32
+	Created                 time.Time
+	Status                  string
+}
+
+// FetchRevisions returns a deployment replicasets, latest revision first.
+func FetchRevisions(f Factory, path string) ([]Revision, error) {
+	var ddp Deployment
+	dp, err := ddp.Load(f, path)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("apps/v1/replicasets", dp.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rss := make([]appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+			return nil, err
+		}
+		rss = append(rss, rs)
+	}
+
+	return newRevisions(dp, rss), nil
+}
+
+// FetchControllerRevisions returns a statefulset or daemonset controller
+// revisions, latest revision first.
+func FetchControllerRevisions(f Factory, gvr client.GVR, path string) ([]Revision, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	owner, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	oo, err := f.List("apps/v1/controllerrevisions", owner.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	crs := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		crs = append(crs, u)
+	}
+	oo, err = f.List("v1/pods", owner.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return nil, err
+		}
+		pods = append(pods, pod)
+	}
+
+	return newControllerRevisions(owner.GetUID(), crs, pods), nil
+}
+
+// RevisionTemplate returns a revision pod template as yaml. Revision specific
+// labels are dropped so templates can be compared.
+func RevisionTemplate(f Factory, gvr client.GVR, path string) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return revisionTemplate(u)
+}
+
+func revisionTemplate(u *unstructured.Unstructured) (string, error) {
+	fields := []string{"spec", "template"}
+	// Controller revisions track the workload template patch under data.
+	if _, ok := u.Object["data"]; ok {
+		fields = append([]string{"data"}, fields...)
+	}
+	tpl, ok, err := unstructured.NestedMap(u.Object, fields...)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("no pod template found on %s", u.GetName())
+	}
+	delete(tpl, "$patch")
+	unstructured.RemoveNestedField(tpl, "metadata", "labels", podTemplateHashLabel)
+	unstructured.RemoveNestedField(tpl, "metadata", "labels", RevisionHashLabel)
+	raw, err := yaml.Marshal(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// GarbageRevisions returns the paths of the replicasets beyond the revision history limit.
+func GarbageRevisions(rr []Revision) []string {
+	var pp []string
+	for _, r := range rr {
+		if r.Status == RevisionGarbage {
+			pp = append(pp, r.Path)
+		}
+	}
+
+	return pp
+}
+
+// newRevisions classifies the replicasets controlled by a deployment. Idle
+// old replicasets are garbage once past the revision history limit, the same
+// way the deployment controller prunes them.
+func newRevisions(dp *appsv1.Deployment, rss []appsv1.ReplicaSet) []Revision {
+	rr := make([]Revision, 0, len(rss))
+	for _, rs := range rss {
+		if !controlledBy(&rs, dp) {
+			continue
+		}
+		rev, _ := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+		r := Revision{
+			Path:     client.FQN(rs.Namespace, rs.Name),
+			Hash:     rs.Labels[podTemplateHashLabel],
+			Revision: rev,
+			Current:  rs.Status.Replicas,
+			Ready:    rs.Status.ReadyReplicas,
+			Created:  rs.CreationTimestamp.Time,
+		}
+		if rs.Spec.Replicas != nil {
+			r.Desired = *rs.Spec.Replicas
+		}
+		rr = append(rr, r)
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		return rr[i].Revision > rr[j].Revision
+	})
+
+	limit := defaultRevisionHistoryLimit
+	if dp.Spec.RevisionHistoryLimit != nil {
+		limit = int(*dp.Spec.RevisionHistoryLimit)
+	}
+	var idle int
+	for i := range rr {
+		switch {
+		case i == 0:
+			rr[i].Status = RevisionCurrent
+		case rr[i].Desired != 0 || rr[i].Current != 0:
+			rr[i].Status = RevisionActive
+		case idle < limit:
+			rr[i].Status = RevisionRetained
+			idle++
+		default:
+			rr[i].Status = RevisionGarbage
+		}
+	}
+
+	return rr
+}
+
+// newControllerRevisions lists the controller revisions owned by a workload.
+// Old revisions still running pods are active, pods track a revision via
+// either its name or hash.
+func newControllerRevisions(uid types.UID, crs []*unstructured.Unstructured, pods []v1.Pod) []Revision {
+	rr := make([]Revision, 0, len(crs))
+	for _, cr := range crs {
+		if !controlledByUID(cr.GetOwnerReferences(), uid) {
+			continue
+		}
+		rev, _, _ := unstructured.NestedInt64(cr.Object, "revision")
+		r := Revision{
+			Path:     client.FQN(cr.GetNamespace(), cr.GetName()),
+			Hash:     cr.GetLabels()[RevisionHashLabel],
+			Revision: rev,
+			Created:  cr.GetCreationTimestamp().Time,
+		}
+		for i := range pods {
+			if !controlledByUID(pods[i].OwnerReferences, uid) {
+				continue
+			}
+			if h := pods[i].Labels[RevisionHashLabel]; h == "" || (h != cr.GetName() && h != r.Hash) {
+				continue
+			}
+			r.Current++
+			if isPodServing(&pods[i]) {
+				r.Ready++
+			}
+		}
+		r.Desired = r.Current
+		rr = append(rr, r)
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		return rr[i].Revision > rr[j].Revision
+	})
+	for i := range rr {
+		switch {
+		case i == 0:
+			rr[i].Status = RevisionCurrent
+		case rr[i].Current != 0:
+			rr[i].Status = RevisionActive
+		default:
+			rr[i].Status = RevisionRetained
+		}
+	}
+
+	return rr
+}
+
+func controlledByUID(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller && ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func controlledBy(rs *appsv1.ReplicaSet, dp *appsv1.Deployment) bool {
+	return controlledByUID(rs.OwnerReferences, dp.UID)
+}
@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewRevisions(t *testing.T) {
+	dp := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred", UID: types.UID("fred")},
+	}
+	limit := int32(1)
+	dp.Spec.RevisionHistoryLimit = &limit
+	rss := []appsv1.ReplicaSet{
+		makeRevisionRS("fred-1", "fred", 1, 0),
+		makeRevisionRS("fred-4", "fred", 4, 3),
+		makeRevisionRS("fred-2", "fred", 2, 0),
+		makeRevisionRS("fred-3", "fred", 3, 1),
+		makeRevisionRS("blee-1", "blee", 5, 0),
+	}
+
+	rr := newRevisions(&dp, rss)
+	assert.Equal(t, 4, len(rr))
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		ss = append(ss, r.Path+":"+r.Status)
+	}
+	assert.Equal(t, []string{
+		"default/fred-4:Current",
+		"default/fred-3:Active",
+		"default/fred-2:Retained",
+		"default/fred-1:Garbage",
+	}, ss)
+	assert.Equal(t, []string{"default/fred-1"}, GarbageRevisions(rr))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeRevisionRS(n, owner string, rev int, replicas int32) appsv1.ReplicaSet {
+	yes := true
+	return appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			Annotations:     map[string]string{revisionAnnotation: strconv.Itoa(rev)},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: owner, UID: types.UID(owner), Controller: &yes}},
+		},
+		Spec:   appsv1.ReplicaSetSpec{Replicas: &replicas},
+		Status: appsv1.ReplicaSetStatus{Replicas: replicas, ReadyReplicas: replicas},
+	}
+}
+
+func TestNewControllerRevisions(t *testing.T) {
+	crs := []*unstructured.Unstructured{
+		makeControllerRevision("web-aaa", "web", 1),
+		makeControllerRevision("web-ccc", "web", 3),
+		makeControllerRevision("web-bbb", "web", 2),
+		makeControllerRevision("blee-ddd", "blee", 4),
+	}
+	pods := []v1.Pod{
+		makeRevisionPod("web-0", "web", "web-ccc", true),
+		makeRevisionPod("web-1", "web", "web-bbb", false),
+		makeRevisionPod("blee-0", "blee", "web-aaa", true),
+	}
+
+	rr := newControllerRevisions(types.UID("web"), crs, pods)
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		ss = append(ss, r.Path+":"+r.Status+":"+strconv.Itoa(int(r.Current))+"/"+strconv.Itoa(int(r.Ready)))
+	}
+	assert.Equal(t, []string{
+		"default/web-ccc:Current:1/1",
+		"default/web-bbb:Active:1/0",
+		"default/web-aaa:Retained:0/0",
+	}, ss)
+}
+
+func TestRevisionTemplate(t *testing.T) {
+	tpl := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app": "web", RevisionHashLabel: "aaa"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "nginx", "image": "nginx:1.19"}},
+		},
+	}
+	e := "metadata:\n  labels:\n    app: web\nspec:\n  containers:\n  - image: nginx:1.19\n    name: nginx\n"
+
+	cr := makeControllerRevision("web-aaa", "web", 1)
+	cr.Object["data"] = map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": runtime.DeepCopyJSONValue(tpl),
+		},
+	}
+	unstructured.SetNestedField(cr.Object, "replace", "data", "spec", "template", "$patch")
+	s, err := revisionTemplate(cr)
+	assert.Nil(t, err)
+	assert.Equal(t, e, s)
+
+	rs := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "ReplicaSet",
+		"spec": map[string]interface{}{"template": runtime.DeepCopyJSONValue(tpl)},
+	}}
+	s, err = revisionTemplate(&rs)
+	assert.Nil(t, err)
+	assert.Equal(t, e, s)
+
+	_, err = revisionTemplate(&unstructured.Unstructured{Object: map[string]interface{}{"kind": "ReplicaSet"}})
+	assert.NotNil(t, err)
+}
+
+func makeControllerRevision(n, owner string, rev int64) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetKind("ControllerRevision")
+	u.SetNamespace("default")
+	u.SetName(n)
+	u.SetLabels(map[string]string{RevisionHashLabel: n[len(owner)+1:]})
+	yes := true
+	u.SetOwnerReferences([]metav1.OwnerReference{{Kind: "StatefulSet", Name: owner, UID: types.UID(owner), Controller: &yes}})
+	u.Object["revision"] = rev
+
+	return &u
+}
+
+func makeRevisionPod(n, owner, hash string, ready bool) v1.Pod {
+	yes, status := true, v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			Labels:          map[string]string{RevisionHashLabel: hash},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: owner, UID: types.UID(owner), Controller: &yes}},
+		},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+}
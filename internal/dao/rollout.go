@@ -0,0 +1,282 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	canaryPollInterval   = 5 * time.Second
+	canaryPromoteTimeout = 30 * time.Minute
+)
+
+var (
+	_ Accessor    = (*Rollout)(nil)
+	_ Nuker       = (*Rollout)(nil)
+	_ Loggable    = (*Rollout)(nil)
+	_ Restartable = (*Rollout)(nil)
+	_ Scalable    = (*Rollout)(nil)
+	_ Promotable  = (*Rollout)(nil)
+
+	_ Accessor    = (*Canary)(nil)
+	_ Nuker       = (*Canary)(nil)
+	_ Restartable = (*Canary)(nil)
+	_ Promotable  = (*Canary)(nil)
+)
+
+// Rollout represents an Argo Rollout.
+type Rollout struct {
+	Resource
+}
+
+// Scale a Rollout.
+func (r *Rollout) Scale(ctx context.Context, path string, replicas int32) error {
+	return patchResource(ctx, r.Factory, r.gvr, path, "", map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": replicas},
+	})
+}
+
+// Restart a Rollout pods the same way the argo rollouts kubectl plugin does.
+func (r *Rollout) Restart(ctx context.Context, path string) error {
+	return patchResource(ctx, r.Factory, r.gvr, path, "", map[string]interface{}{
+		"spec": map[string]interface{}{"restartAt": time.Now().UTC().Format(time.RFC3339)},
+	})
+}
+
+// TailLogs tail logs for all pods represented by this Rollout.
+func (r *Rollout) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
+	u, err := loadUnstructured(r.Factory, r.gvr, opts.Path)
+	if err != nil {
+		return err
+	}
+	sel, _, err := unstructured.NestedStringMap(u.Object, "spec", "selector", "matchLabels")
+	if err != nil {
+		return err
+	}
+	if len(sel) == 0 {
+		return fmt.Errorf("No valid selector found on Rollout %s", opts.Path)
+	}
+
+	return podLogs(ctx, c, sel, opts)
+}
+
+// Promote unpauses a Rollout and skips its current pause step. Full promotes
+// skip all remaining steps and analysis.
+func (r *Rollout) Promote(ctx context.Context, path string, full bool) error {
+	u, err := loadUnstructured(r.Factory, r.gvr, path)
+	if err != nil {
+		return err
+	}
+	if paused, _, _ := unstructured.NestedBool(u.Object, "spec", "paused"); paused {
+		err := patchResource(ctx, r.Factory, r.gvr, path, "", map[string]interface{}{
+			"spec": map[string]interface{}{"paused": false},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if full {
+		return patchResource(ctx, r.Factory, r.gvr, path, "status", map[string]interface{}{
+			"status": map[string]interface{}{"promoteFull": true},
+		})
+	}
+
+	return patchResource(ctx, r.Factory, r.gvr, path, "status", map[string]interface{}{
+		"status": rolloutPromoteStatus(u),
+	})
+}
+
+// Abort aborts a Rollout update and reverts traffic to the stable pods.
+func (r *Rollout) Abort(ctx context.Context, path string) error {
+	return patchResource(ctx, r.Factory, r.gvr, path, "status", map[string]interface{}{
+		"status": map[string]interface{}{"abort": true},
+	})
+}
+
+// Retry resumes an aborted Rollout update from the first step.
+func (r *Rollout) Retry(ctx context.Context, path string) error {
+	return patchResource(ctx, r.Factory, r.gvr, path, "status", map[string]interface{}{
+		"status": map[string]interface{}{"abort": false},
+	})
+}
+
+// rolloutPromoteStatus clears a rollout pause conditions and moves a canary
+// past its current step.
+func rolloutPromoteStatus(u *unstructured.Unstructured) map[string]interface{} {
+	st := map[string]interface{}{
+		"pauseConditions": nil,
+		"controllerPause": false,
+	}
+	steps, ok, _ := unstructured.NestedSlice(u.Object, "spec", "strategy", "canary", "steps")
+	if !ok {
+		return st
+	}
+	idx, ok, _ := unstructured.NestedInt64(u.Object, "status", "currentStepIndex")
+	if ok && idx < int64(len(steps)) {
+		st["currentStepIndex"] = idx + 1
+	}
+
+	return st
+}
+
+// ----------------------------------------------------------------------------
+
+// Canary represents a Flagger Canary.
+type Canary struct {
+	Resource
+}
+
+// Restart a Canary target, which triggers a new canary analysis.
+func (c *Canary) Restart(ctx context.Context, path string) error {
+	u, err := loadUnstructured(c.Factory, c.gvr, path)
+	if err != nil {
+		return err
+	}
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "targetRef", "kind")
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "targetRef", "name")
+	var gvr client.GVR
+	switch kind {
+	case "Deployment":
+		gvr = client.NewGVR("apps/v1/deployments")
+	case "DaemonSet":
+		gvr = client.NewGVR("apps/v1/daemonsets")
+	default:
+		return fmt.Errorf("unsupported canary target kind %q", kind)
+	}
+	res, err := AccessorFor(c.Factory, gvr)
+	if err != nil {
+		return err
+	}
+	r, ok := res.(Restartable)
+	if !ok {
+		return fmt.Errorf("%s is not restartable", gvr)
+	}
+
+	return r.Restart(ctx, client.FQN(u.GetNamespace(), name))
+}
+
+// Promote skips the Canary analysis so Flagger promotes the canary right away.
+// The analysis is re-enabled in the background once the promotion completes so
+// later releases are analyzed again. Flagger has no partial promotions, full
+// is ignored.
+func (c *Canary) Promote(ctx context.Context, path string, _ bool) error {
+	u, err := loadUnstructured(c.Factory, c.gvr, path)
+	if err != nil {
+		return err
+	}
+	skipped, _, _ := unstructured.NestedBool(u.Object, "spec", "skipAnalysis")
+	err = patchResource(ctx, c.Factory, c.gvr, path, "", map[string]interface{}{
+		"spec": map[string]interface{}{"skipAnalysis": true},
+	})
+	if err != nil || skipped {
+		return err
+	}
+	go c.restoreAnalysis(path)
+
+	return nil
+}
+
+// restoreAnalysis re-enables a promoted canary analysis once Flagger is done
+// rolling it out or the promotion times out.
+func (c *Canary) restoreAnalysis(path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), canaryPromoteTimeout)
+	defer cancel()
+	ticker := time.NewTicker(canaryPollInterval)
+	defer ticker.Stop()
+
+	var rolling bool
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			log.Warn().Msgf("Canary %s promotion timed out. Re-enabling its analysis", path)
+			done = true
+		case <-ticker.C:
+			u, err := loadUnstructured(c.Factory, c.gvr, path)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to check canary %s promotion", path)
+				continue
+			}
+			phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+			rolling, done = canaryPromoted(phase, rolling)
+		}
+	}
+
+	pctx, pcancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer pcancel()
+	err := patchResource(pctx, c.Factory, c.gvr, path, "", map[string]interface{}{
+		"spec": map[string]interface{}{"skipAnalysis": false},
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to re-enable canary %s analysis. Its spec.skipAnalysis is still set", path)
+	}
+}
+
+// canaryPromoted tracks whether a canary rollout started and returns true once
+// it settled after starting.
+func canaryPromoted(phase string, rolling bool) (bool, bool) {
+	switch phase {
+	case "Succeeded", "Failed":
+		return rolling, rolling
+	case "", "Initializing", "Initialized":
+		return rolling, false
+	default:
+		return true, false
+	}
+}
+
+// Abort is not supported by Flagger outside of its rollback webhooks.
+func (c *Canary) Abort(ctx context.Context, path string) error {
+	return errors.New("flagger canaries can only be aborted via a rollback webhook")
+}
+
+// Retry re-enables the Canary analysis and restarts its target.
+func (c *Canary) Retry(ctx context.Context, path string) error {
+	err := patchResource(ctx, c.Factory, c.gvr, path, "", map[string]interface{}{
+		"spec": map[string]interface{}{"skipAnalysis": false},
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Restart(ctx, path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// patchResource merge patches a resource or one of its subresources.
+func patchResource(ctx context.Context, f Factory, gvr client.GVR, path, sub string, patch map[string]interface{}) error {
+	ns, n := client.Namespaced(path)
+	res := gvr.String()
+	if sub != "" {
+		res += ":" + sub
+	}
+	auth, err := f.Client().CanI(ns, res, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", res)
+	}
+
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	var ss []string
+	if sub != "" {
+		ss = append(ss, sub)
+	}
+	_, err = f.Client().DynDialOrDie().Resource(gvr.GVR()).Namespace(ns).Patch(ctx, n, types.MergePatchType, raw, metav1.PatchOptions{}, ss...)
+
+	return err
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRolloutPromoteStatus(t *testing.T) {
+	steps := []interface{}{
+		map[string]interface{}{"setWeight": int64(20)},
+		map[string]interface{}{"pause": map[string]interface{}{}},
+		map[string]interface{}{"setWeight": int64(50)},
+	}
+	uu := map[string]struct {
+		spec, status map[string]interface{}
+		e            map[string]interface{}
+	}{
+		"blueGreen": {
+			spec: map[string]interface{}{
+				"strategy": map[string]interface{}{"blueGreen": map[string]interface{}{"activeService": "fred"}},
+			},
+			e: map[string]interface{}{"pauseConditions": nil, "controllerPause": false},
+		},
+		"canaryPaused": {
+			spec: map[string]interface{}{
+				"strategy": map[string]interface{}{"canary": map[string]interface{}{"steps": steps}},
+			},
+			status: map[string]interface{}{"currentStepIndex": int64(1)},
+			e:      map[string]interface{}{"pauseConditions": nil, "controllerPause": false, "currentStepIndex": int64(2)},
+		},
+		"canaryDone": {
+			spec: map[string]interface{}{
+				"strategy": map[string]interface{}{"canary": map[string]interface{}{"steps": steps}},
+			},
+			status: map[string]interface{}{"currentStepIndex": int64(3)},
+			e:      map[string]interface{}{"pauseConditions": nil, "controllerPause": false},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec, "status": u.status}}
+			assert.Equal(t, u.e, rolloutPromoteStatus(&o))
+		})
+	}
+}
+
+func TestCanaryPromoted(t *testing.T) {
+	uu := map[string]struct {
+		phases  []string
+		e       bool
+		rolling bool
+	}{
+		"idle":      {phases: []string{"Succeeded", "Succeeded"}},
+		"promoted":  {phases: []string{"Succeeded", "Promoting", "Finalising", "Succeeded"}, e: true, rolling: true},
+		"failed":    {phases: []string{"Progressing", "Failed"}, e: true, rolling: true},
+		"rolling":   {phases: []string{"Initialized", "Progressing"}, rolling: true},
+		"noPhase":   {phases: []string{""}},
+		"initiated": {phases: []string{"Initializing", "Initialized"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var rolling, done bool
+			for _, p := range u.phases {
+				rolling, done = canaryPromoted(p, rolling)
+			}
+			assert.Equal(t, u.e, done)
+			assert.Equal(t, u.rolling, rolling)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -22,6 +23,27 @@ type ReplicaSet struct {
 	Resource
 }
 
+// List returns a collection of replicasets and records their ready pods.
+func (r *ReplicaSet) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := r.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	recordReady(readyContext(r.Factory), r.gvr, oo)
+
+	return oo, nil
+}
+
+// Describe describes a replicaset along with its ready pods trend.
+func (r *ReplicaSet) Describe(path string) (string, error) {
+	desc, err := r.Resource.Describe(path)
+	if err != nil {
+		return "", err
+	}
+
+	return withReadyTrend(readyContext(r.Factory), r.gvr, path, desc), nil
+}
+
 // Load returns a given instance.
 func (r *ReplicaSet) Load(f Factory, path string) (*v1.ReplicaSet, error) {
 	o, err := f.Get("apps/v1/replicasets", path, true, labels.Everything())
@@ -0,0 +1,442 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// taintNodeUnschedulable tracks the taint set on cordoned nodes.
+const taintNodeUnschedulable = "node.kubernetes.io/unschedulable"
+
+// schedulingResources tracks the resources requests checked against allocatable.
+var schedulingResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, v1.ResourceEphemeralStorage}
+
+// NodeFit represents a node scheduling verdict for a pod spec.
+type NodeFit struct {
+	Node    string
+	Fits    bool
+	FreeCPU resource.Quantity
+	FreeMEM resource.Quantity
+	Reasons []string
+}
+
+// FetchNodeFits evaluates a pod or a workload pod template against all nodes.
+// Checks node readiness, cordons, taints, node selectors, required node
+// affinity, host ports and resource requests. Inter-pod affinities are skipped.
+func FetchNodeFits(f Factory, gvr client.GVR, path string) ([]NodeFit, error) {
+	spec, self, err := fetchPodSpec(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	nodes, pods, err := fetchPlacements(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNodeFits(spec, self, nodes, pods), nil
+}
+
+// fetchPodSpec returns a pod spec or a workload pod template spec.
+func fetchPodSpec(f Factory, gvr client.GVR, path string) (*v1.PodSpec, string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	if gvr.String() == "v1/pods" {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, "", err
+		}
+		return &po.Spec, path, nil
+	}
+	var w workloadSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &w); err != nil {
+		return nil, "", err
+	}
+
+	return &w.Spec.Template.Spec, "", nil
+}
+
+func fetchPlacements(f Factory) ([]v1.Node, []v1.Pod, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	nn, err := FetchNodes(ctx, f, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := WaitSynced(ctx, f, client.AllNamespaces, "v1/pods"); err != nil {
+		return nil, nil, err
+	}
+	pods, err := fetchPods(f, client.AllNamespaces)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nn.Items, pods, nil
+}
+
+// newNodeFits returns the nodes verdicts, schedulable nodes first.
+func newNodeFits(spec *v1.PodSpec, self string, nodes []v1.Node, pods []v1.Pod) []NodeFit {
+	byNode := podsByNode(self, pods)
+	req := podRequests(spec)
+	ff := make([]NodeFit, 0, len(nodes))
+	for i := range nodes {
+		ff = append(ff, nodeFit(spec, req, &nodes[i], byNode[nodes[i].Name]))
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		if ff[i].Fits != ff[j].Fits {
+			return ff[i].Fits
+		}
+		return ff[i].Node < ff[j].Node
+	})
+
+	return ff
+}
+
+// podsByNode groups active pods by node, skipping the given pod.
+func podsByNode(self string, pods []v1.Pod) map[string][]v1.Pod {
+	byNode := make(map[string][]v1.Pod)
+	for _, po := range pods {
+		if po.Spec.NodeName == "" || client.FQN(po.Namespace, po.Name) == self {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		byNode[po.Spec.NodeName] = append(byNode[po.Spec.NodeName], po)
+	}
+
+	return byNode
+}
+
+func nodeFit(spec *v1.PodSpec, req v1.ResourceList, no *v1.Node, pods []v1.Pod) NodeFit {
+	fit := NodeFit{Node: no.Name, Reasons: nodeConstraints(spec, no, pods)}
+	reject := func(f string, args ...interface{}) {
+		fit.Reasons = append(fit.Reasons, fmt.Sprintf(f, args...))
+	}
+
+	used := usedResources(pods)
+	fit.FreeCPU, fit.FreeMEM = freeResource(no, used, v1.ResourceCPU), freeResource(no, used, v1.ResourceMemory)
+	for _, r := range schedulingResources {
+		want, ok := req[r]
+		if !ok || want.IsZero() {
+			continue
+		}
+		if free := freeResource(no, used, r); want.Cmp(free) > 0 {
+			reject("insufficient %s (requests %s, free %s)", r, want.String(), free.String())
+		}
+	}
+	if max, ok := no.Status.Allocatable[v1.ResourcePods]; ok && int64(len(pods)) >= max.Value() {
+		reject("too many pods (%d/%d)", len(pods), max.Value())
+	}
+	fit.Fits = len(fit.Reasons) == 0
+
+	return fit
+}
+
+// nodeConstraints returns the reasons a node rejects a pod, resources aside.
+func nodeConstraints(spec *v1.PodSpec, no *v1.Node, pods []v1.Pod) []string {
+	var rr []string
+	reject := func(f string, args ...interface{}) {
+		rr = append(rr, fmt.Sprintf(f, args...))
+	}
+
+	if !nodeReady(no) {
+		reject("node is not ready")
+	}
+	if no.Spec.Unschedulable && !tolerates(spec.Tolerations, &v1.Taint{Key: taintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule}) {
+		reject("node is cordoned")
+	}
+	for i := range no.Spec.Taints {
+		t := &no.Spec.Taints[i]
+		if t.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !tolerates(spec.Tolerations, t) {
+			reject("untolerated taint %s", t.ToString())
+		}
+	}
+	for k, v := range spec.NodeSelector {
+		if no.Labels[k] != v {
+			reject("node selector %s=%s mismatch", k, v)
+		}
+	}
+	if a := spec.Affinity; a != nil && a.NodeAffinity != nil && a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		if !matchNodeSelectorTerms(a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, no) {
+			reject("required node affinity mismatch")
+		}
+	}
+	for _, p := range hostPortsConflicts(spec, pods) {
+		reject("host port %d already in use", p)
+	}
+
+	return rr
+}
+
+func nodeReady(no *v1.Node) bool {
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func tolerates(tt []v1.Toleration, taint *v1.Taint) bool {
+	for i := range tt {
+		if tt[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchNodeSelectorTerms(tt []v1.NodeSelectorTerm, no *v1.Node) bool {
+	// Terms are ORed, requirements within a term are ANDed.
+	for _, t := range tt {
+		if len(t.MatchExpressions) == 0 && len(t.MatchFields) == 0 {
+			continue
+		}
+		if matchRequirements(t.MatchExpressions, no.Labels) && matchRequirements(t.MatchFields, map[string]string{"metadata.name": no.Name}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchRequirements(rr []v1.NodeSelectorRequirement, ll map[string]string) bool {
+	for _, r := range rr {
+		v, ok := ll[r.Key]
+		switch r.Operator {
+		case v1.NodeSelectorOpIn:
+			if !ok || !in(r.Values, v) {
+				return false
+			}
+		case v1.NodeSelectorOpNotIn:
+			if ok && in(r.Values, v) {
+				return false
+			}
+		case v1.NodeSelectorOpExists:
+			if !ok {
+				return false
+			}
+		case v1.NodeSelectorOpDoesNotExist:
+			if ok {
+				return false
+			}
+		case v1.NodeSelectorOpGt, v1.NodeSelectorOpLt:
+			if !ok || len(r.Values) != 1 {
+				return false
+			}
+			actual, err1 := strconv.ParseInt(v, 10, 64)
+			expected, err2 := strconv.ParseInt(r.Values[0], 10, 64)
+			if err1 != nil || err2 != nil {
+				return false
+			}
+			if (r.Operator == v1.NodeSelectorOpGt && actual <= expected) || (r.Operator == v1.NodeSelectorOpLt && actual >= expected) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func hostPortsConflicts(spec *v1.PodSpec, pods []v1.Pod) []int32 {
+	used := make(map[int32]struct{})
+	for _, po := range pods {
+		for _, co := range po.Spec.Containers {
+			for _, p := range co.Ports {
+				if p.HostPort > 0 {
+					used[p.HostPort] = struct{}{}
+				}
+			}
+		}
+	}
+	var pp []int32
+	for _, co := range spec.Containers {
+		for _, p := range co.Ports {
+			if _, ok := used[p.HostPort]; ok && p.HostPort > 0 {
+				pp = append(pp, p.HostPort)
+			}
+		}
+	}
+
+	return pp
+}
+
+// podRequests returns a pod effective resource requests.
+func podRequests(spec *v1.PodSpec) v1.ResourceList {
+	rr := make(v1.ResourceList)
+	for _, co := range spec.Containers {
+		addResources(rr, co.Resources.Requests)
+	}
+	for _, co := range spec.InitContainers {
+		for k, q := range co.Resources.Requests {
+			if c, ok := rr[k]; !ok || q.Cmp(c) > 0 {
+				rr[k] = q.DeepCopy()
+			}
+		}
+	}
+	addResources(rr, spec.Overhead)
+
+	return rr
+}
+
+func addResources(rr, delta v1.ResourceList) {
+	for k, q := range delta {
+		c := rr[k]
+		c.Add(q)
+		rr[k] = c
+	}
+}
+
+func usedResources(pods []v1.Pod) v1.ResourceList {
+	used := make(v1.ResourceList)
+	for i := range pods {
+		addResources(used, podRequests(&pods[i].Spec))
+	}
+
+	return used
+}
+
+func freeResource(no *v1.Node, used v1.ResourceList, r v1.ResourceName) resource.Quantity {
+	free := no.Status.Allocatable[r].DeepCopy()
+	free.Sub(used[r])
+
+	return free
+}
+
+// ScaleEstimate represents the cluster capacity for additional workload replicas.
+type ScaleEstimate struct {
+	// Replicas tracks the number of additional replicas requested.
+	Replicas int
+	// Placed tracks the number of additional replicas the cluster can host.
+	Placed int
+	// Shortfall tracks the requests of the replicas that could not be placed.
+	Shortfall v1.ResourceList
+}
+
+// Short returns true if some replicas could not be placed.
+func (e ScaleEstimate) Short() bool {
+	return e.Placed < e.Replicas
+}
+
+// EstimateScale simulates placing additional workload replicas on eligible
+// nodes given their requests and the nodes remaining allocatable. It waits on
+// the pods cache to load so must be called off the UI thread.
+func EstimateScale(f Factory, gvr client.GVR, path string, replicas int) (*ScaleEstimate, error) {
+	spec, _, err := fetchPodSpec(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	nodes, pods, err := fetchPlacements(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return estimateScale(spec, replicas, nodes, pods), nil
+}
+
+// estimateScale places replicas first fit on nodes meeting the pod constraints.
+func estimateScale(spec *v1.PodSpec, replicas int, nodes []v1.Node, pods []v1.Pod) *ScaleEstimate {
+	type capacity struct {
+		free  v1.ResourceList
+		slots int64
+	}
+
+	byNode := podsByNode("", pods)
+	hostPorts := hasHostPorts(spec)
+	cc := make([]capacity, 0, len(nodes))
+	for i := range nodes {
+		no := &nodes[i]
+		pp := byNode[no.Name]
+		if len(nodeConstraints(spec, no, pp)) > 0 {
+			continue
+		}
+		used := usedResources(pp)
+		c := capacity{free: make(v1.ResourceList), slots: -1}
+		for _, r := range schedulingResources {
+			c.free[r] = freeResource(no, used, r)
+		}
+		if max, ok := no.Status.Allocatable[v1.ResourcePods]; ok {
+			c.slots = max.Value() - int64(len(pp))
+		}
+		if hostPorts && c.slots != 0 {
+			c.slots = 1
+		}
+		cc = append(cc, c)
+	}
+
+	req := podRequests(spec)
+	e := ScaleEstimate{Replicas: replicas, Shortfall: make(v1.ResourceList)}
+	fits := func(c capacity) bool {
+		if c.slots == 0 {
+			return false
+		}
+		for r, want := range req {
+			free, ok := c.free[r]
+			if ok && !want.IsZero() && want.Cmp(free) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for i := 0; i < replicas; i++ {
+		placed := false
+		for j := range cc {
+			if !fits(cc[j]) {
+				continue
+			}
+			for r, want := range req {
+				if free, ok := cc[j].free[r]; ok {
+					free.Sub(want)
+					cc[j].free[r] = free
+				}
+			}
+			if cc[j].slots > 0 {
+				cc[j].slots--
+			}
+			placed = true
+			break
+		}
+		if !placed {
+			addResources(e.Shortfall, req)
+			continue
+		}
+		e.Placed++
+	}
+
+	return &e
+}
+
+func hasHostPorts(spec *v1.PodSpec) bool {
+	for _, co := range spec.Containers {
+		for _, p := range co.Ports {
+			if p.HostPort > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
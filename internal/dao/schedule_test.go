@@ -0,0 +1,203 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewNodeFits(t *testing.T) {
+	nodes := []v1.Node{
+		makeFitNode("n1", "2", "4Gi", nil),
+		makeFitNode("n2", "2", "4Gi", []v1.Taint{{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}}),
+		makeFitNode("n3", "1", "4Gi", nil),
+	}
+	nodes[0].Labels["disk"] = "ssd"
+	nodes[1].Labels["disk"] = "ssd"
+	pods := []v1.Pod{
+		makeFitPod("p1", "n3", "500m"),
+		makeFitPod("self", "n1", "1"),
+	}
+
+	uu := map[string]struct {
+		spec    v1.PodSpec
+		fits    []string
+		reasons map[string][]string
+	}{
+		"plain": {
+			spec: makeFitSpec("600m"),
+			fits: []string{"n1"},
+			reasons: map[string][]string{
+				"n2": {"untolerated taint dedicated=gpu:NoSchedule"},
+				"n3": {"insufficient cpu (requests 600m, free 500m)"},
+			},
+		},
+		"tolerations": {
+			spec: func() v1.PodSpec {
+				s := makeFitSpec("100m")
+				s.Tolerations = []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "gpu", Effect: v1.TaintEffectNoSchedule}}
+				return s
+			}(),
+			fits:    []string{"n1", "n2", "n3"},
+			reasons: map[string][]string{},
+		},
+		"affinity": {
+			spec: func() v1.PodSpec {
+				s := makeFitSpec("100m")
+				s.NodeSelector = map[string]string{"disk": "ssd"}
+				s.Affinity = &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{
+								{
+									MatchExpressions: []v1.NodeSelectorRequirement{
+										{Key: "kubernetes.io/hostname", Operator: v1.NodeSelectorOpNotIn, Values: []string{"n1"}},
+									},
+								},
+							},
+						},
+					},
+				}
+				return s
+			}(),
+			fits: []string{},
+			reasons: map[string][]string{
+				"n1": {"required node affinity mismatch"},
+				"n2": {"untolerated taint dedicated=gpu:NoSchedule"},
+				"n3": {"node selector disk=ssd mismatch"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff := newNodeFits(&u.spec, "default/self", nodes, pods)
+			assert.Equal(t, len(nodes), len(ff))
+			fits := []string{}
+			for _, f := range ff {
+				if f.Fits {
+					fits = append(fits, f.Node)
+					continue
+				}
+				assert.Equal(t, u.reasons[f.Node], f.Reasons, f.Node)
+			}
+			assert.Equal(t, u.fits, fits)
+		})
+	}
+}
+
+func TestPodRequests(t *testing.T) {
+	spec := makeFitSpec("200m")
+	spec.Containers = append(spec.Containers, spec.Containers[0])
+	spec.InitContainers = []v1.Container{
+		{Name: "init", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}}},
+	}
+
+	rr := podRequests(&spec)
+	cpu := rr[v1.ResourceCPU]
+	assert.Equal(t, "1", cpu.String())
+}
+
+func TestEstimateScale(t *testing.T) {
+	nodes := []v1.Node{
+		makeFitNode("n1", "2", "4Gi", nil),
+		makeFitNode("n2", "2", "4Gi", []v1.Taint{{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}}),
+		makeFitNode("n3", "1", "4Gi", nil),
+	}
+	pods := []v1.Pod{
+		makeFitPod("p1", "n1", "500m"),
+		makeFitPod("p2", "n3", "500m"),
+	}
+
+	uu := map[string]struct {
+		spec      v1.PodSpec
+		replicas  int
+		placed    int
+		shortfall string
+	}{
+		"fits": {
+			spec:     makeFitSpec("500m"),
+			replicas: 4,
+			placed:   4,
+		},
+		"short": {
+			spec:      makeFitSpec("500m"),
+			replicas:  6,
+			placed:    4,
+			shortfall: "1",
+		},
+		"hostPorts": {
+			spec: func() v1.PodSpec {
+				s := makeFitSpec("100m")
+				s.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: 80, HostPort: 8080}}
+				return s
+			}(),
+			replicas:  3,
+			placed:    2,
+			shortfall: "100m",
+		},
+		"none": {
+			spec: makeFitSpec("500m"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := estimateScale(&u.spec, u.replicas, nodes, pods)
+			assert.Equal(t, u.replicas, e.Replicas)
+			assert.Equal(t, u.placed, e.Placed)
+			assert.Equal(t, u.shortfall != "", e.Short())
+			if u.shortfall != "" {
+				cpu := e.Shortfall[v1.ResourceCPU]
+				assert.Equal(t, u.shortfall, cpu.String())
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeFitNode(n, cpu, mem string, tt []v1.Taint) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Labels: map[string]string{"kubernetes.io/hostname": n}},
+		Spec:       v1.NodeSpec{Taints: tt},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(mem),
+				v1.ResourcePods:   resource.MustParse("110"),
+			},
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		},
+	}
+}
+
+func makeFitSpec(cpu string) v1.PodSpec {
+	return v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name: "c1",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			},
+		},
+	}
+}
+
+func makeFitPod(n, node, cpu string) v1.Pod {
+	spec := makeFitSpec(cpu)
+	spec.NodeName = node
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Spec:       spec,
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const spotMaxEvents = 20
+
+// interruptionRX tracks node events related to capacity reclaims.
+var interruptionRX = regexp.MustCompile(`(?i)spot|preempt|interrupt|terminat|rebalance|disrupt|evict`)
+
+// NodeInterruption represents a node spot/preemptible interruption report.
+type NodeInterruption struct {
+	Node      string         `json:"node"`
+	Lifecycle string         `json:"lifecycle"`
+	Provider  string         `json:"provider,omitempty"`
+	Notice    string         `json:"notice,omitempty"`
+	Remaining string         `json:"remaining,omitempty"`
+	Pods      []string       `json:"pods"`
+	Events    []EventSummary `json:"events,omitempty"`
+}
+
+// FetchNodeInterruption reports a node interruption notice, the pods at risk
+// and the node disruption events.
+func FetchNodeInterruption(f Factory, path string) (*NodeInterruption, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	no, err := FetchNode(ctx, f, path)
+	if err != nil {
+		return nil, err
+	}
+
+	lc := render.NodeLifecycle(no, time.Now())
+	ni := NodeInterruption{
+		Node:      no.Name,
+		Lifecycle: lc.String(),
+		Provider:  lc.Provider,
+		Notice:    lc.Notice,
+	}
+	if lc.Remaining != nil {
+		ni.Remaining = duration.HumanDuration(*lc.Remaining)
+	}
+
+	dial := f.Client().DialOrDie().CoreV1()
+	pp, err := dial.Pods(client.AllNamespaces).List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=" + no.Name})
+	if err != nil {
+		return nil, err
+	}
+	ni.Pods = make([]string, 0, len(pp.Items))
+	for _, po := range pp.Items {
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		ni.Pods = append(ni.Pods, client.FQN(po.Namespace, po.Name))
+	}
+
+	sel := "involvedObject.kind=Node,involvedObject.name=" + no.Name
+	ee, err := dial.Events(client.AllNamespaces).List(ctx, metav1.ListOptions{FieldSelector: sel})
+	if err != nil {
+		return nil, err
+	}
+	ni.Events = summarizeEvents(latestEvents(interruptionEvents(ee.Items), spotMaxEvents))
+
+	return &ni, nil
+}
+
+func interruptionEvents(ee []v1.Event) []v1.Event {
+	res := make([]v1.Event, 0, len(ee))
+	for _, e := range ee {
+		if interruptionRX.MatchString(e.Reason) || interruptionRX.MatchString(e.Message) {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestInterruptionEvents(t *testing.T) {
+	ee := []v1.Event{
+		{Reason: "NodeReady", Message: "Node n1 status is now: NodeReady"},
+		{Reason: "SpotInterrupted", Message: "Spot interruption warning was triggered"},
+		{Reason: "Unconsolidatable", Message: "Can't replace with a cheaper node"},
+		{Reason: "DisruptionBlocked", Message: "Cannot disrupt Node: pdb prevents pod evictions"},
+		{Reason: "TerminatingOnInterruption", Message: "Interruption triggered termination for the Node"},
+	}
+
+	rr := interruptionEvents(ee)
+	assert.Equal(t, 3, len(rr))
+	assert.Equal(t, "SpotInterrupted", rr[0].Reason)
+	assert.Equal(t, "DisruptionBlocked", rr[1].Reason)
+	assert.Equal(t, "TerminatingOnInterruption", rr[2].Reason)
+}
@@ -16,12 +16,13 @@ import (
 )
 
 var (
-	_ Accessor    = (*StatefulSet)(nil)
-	_ Nuker       = (*StatefulSet)(nil)
-	_ Loggable    = (*StatefulSet)(nil)
-	_ Restartable = (*StatefulSet)(nil)
-	_ Scalable    = (*StatefulSet)(nil)
-	_ Controller  = (*StatefulSet)(nil)
+	_ Accessor      = (*StatefulSet)(nil)
+	_ Nuker         = (*StatefulSet)(nil)
+	_ Loggable      = (*StatefulSet)(nil)
+	_ Restartable   = (*StatefulSet)(nil)
+	_ Scalable      = (*StatefulSet)(nil)
+	_ Controller    = (*StatefulSet)(nil)
+	_ Partitionable = (*StatefulSet)(nil)
 )
 
 // StatefulSet represents a K8s sts.
@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ClaimRetain tracks claims kept once their statefulset pods are gone.
+	ClaimRetain = "Retain"
+	// ClaimDelete tracks claims removed by the statefulset controller.
+	ClaimDelete = "Delete"
+)
+
+// StsClaims represents the volume claims a statefulset leaves behind once
+// scaled down or deleted.
+type StsClaims struct {
+	// Policy tracks the applicable persistentVolumeClaimRetentionPolicy.
+	Policy string
+	// Claims tracks the claims paths.
+	Claims []string
+}
+
+// Retained returns the claims the cluster keeps around.
+func (s *StsClaims) Retained() []string {
+	if s.Policy == ClaimDelete {
+		return nil
+	}
+
+	return s.Claims
+}
+
+// FetchStsClaims returns the claims of the statefulset pods ordinals at or
+// past the given replicas count. Deleted picks the whenDeleted retention policy
+// over the whenScaled one.
+func FetchStsClaims(f Factory, path string, replicas int32, deleted bool) (*StsClaims, error) {
+	o, err := f.Get("apps/v1/statefulsets", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var sts appsv1.StatefulSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+		return nil, err
+	}
+	if len(sts.Spec.VolumeClaimTemplates) == 0 {
+		return &StsClaims{Policy: ClaimRetain}, nil
+	}
+
+	oo, err := f.List("v1/persistentvolumeclaims", sts.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			nn = append(nn, u.GetName())
+		}
+	}
+	tpls := make([]string, 0, len(sts.Spec.VolumeClaimTemplates))
+	for _, t := range sts.Spec.VolumeClaimTemplates {
+		tpls = append(tpls, t.Name)
+	}
+
+	field := "whenScaled"
+	if deleted {
+		field = "whenDeleted"
+	}
+	c := StsClaims{Policy: claimRetention(u, field)}
+	for _, n := range stsClaims(sts.Name, tpls, nn, replicas) {
+		c.Claims = append(c.Claims, client.FQN(sts.Namespace, n))
+	}
+
+	return &c, nil
+}
+
+// claimRetention returns a retention policy setting. The field is not known to
+// older clusters which always retain claims.
+func claimRetention(u *unstructured.Unstructured, field string) string {
+	p, _, _ := unstructured.NestedString(u.Object, "spec", "persistentVolumeClaimRetentionPolicy", field)
+	if p != ClaimDelete {
+		return ClaimRetain
+	}
+
+	return p
+}
+
+// stsClaims returns the claims named TEMPLATE-STS-ORDINAL with an ordinal at or
+// past the given replicas count.
+func stsClaims(sts string, tpls, claims []string, replicas int32) []string {
+	var cc []string
+	for _, n := range claims {
+		for _, t := range tpls {
+			prefix := t + "-" + sts + "-"
+			if !strings.HasPrefix(n, prefix) {
+				continue
+			}
+			ord, err := strconv.Atoi(strings.TrimPrefix(n, prefix))
+			if err != nil || int32(ord) < replicas {
+				continue
+			}
+			cc = append(cc, n)
+			break
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
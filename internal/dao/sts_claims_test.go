@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestStsClaims(t *testing.T) {
+	claims := []string{"data-db-0", "data-db-1", "data-db-2", "logs-db-2", "data-db-blee", "data-dbx-3", "fred"}
+
+	uu := map[string]struct {
+		replicas int32
+		e        []string
+	}{
+		"deleted":  {replicas: 0, e: []string{"data-db-0", "data-db-1", "data-db-2", "logs-db-2"}},
+		"scaled":   {replicas: 2, e: []string{"data-db-2", "logs-db-2"}},
+		"unscaled": {replicas: 3},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, stsClaims("db", []string{"data", "logs"}, claims, u.replicas))
+		})
+	}
+}
+
+func TestClaimRetention(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"persistentVolumeClaimRetentionPolicy": map[string]interface{}{
+				"whenDeleted": "Delete",
+			},
+		},
+	}}
+
+	assert.Equal(t, ClaimDelete, claimRetention(&u, "whenDeleted"))
+	assert.Equal(t, ClaimRetain, claimRetention(&u, "whenScaled"))
+	assert.Equal(t, ClaimRetain, claimRetention(&unstructured.Unstructured{Object: map[string]interface{}{}}, "whenDeleted"))
+}
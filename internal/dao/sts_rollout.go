@@ -0,0 +1,270 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// StsPodUpdated tracks ordinals running the update revision.
+	StsPodUpdated = "Updated"
+	// StsPodPending tracks ordinals due for the update revision.
+	StsPodPending = "Pending"
+	// StsPodHeld tracks ordinals kept on their revision by the partition.
+	StsPodHeld = "Held"
+	// StsPodMissing tracks ordinals with no pod.
+	StsPodMissing = "Missing"
+
+	// StsPausedPartitionAnnotation tracks the partition of a statefulset
+	// rollout paused by k9s.
+	StsPausedPartitionAnnotation = "k9s.io/paused-partition"
+)
+
+// StsOrdinal represents a statefulset pod update status.
+type StsOrdinal struct {
+	Ordinal  int
+	Path     string
+	Revision string
+	Status   string
+	Phase    string
+	Ready    bool
+	Created  time.Time
+}
+
+// StsRollout represents a statefulset rolling update progress per ordinal.
+type StsRollout struct {
+	Path            string
+	Strategy        string
+	Replicas        int32
+	Partition       int32
+	Paused          bool
+	CurrentRevision string
+	UpdateRevision  string
+	Ordinals        []StsOrdinal
+}
+
+// Updated returns the number of ordinals running the update revision.
+func (r *StsRollout) Updated() int {
+	var n int
+	for _, o := range r.Ordinals {
+		if o.Status == StsPodUpdated {
+			n++
+		}
+	}
+
+	return n
+}
+
+// FetchStsRollout returns a statefulset pods revisions per ordinal.
+func FetchStsRollout(f Factory, path string) (*StsRollout, error) {
+	u, err := loadUnstructured(f, client.NewGVR("apps/v1/statefulsets"), path)
+	if err != nil {
+		return nil, err
+	}
+	var sts appsv1.StatefulSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+		return nil, err
+	}
+	pods, err := fetchPods(f, sts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStsRollout(path, &sts, pods), nil
+}
+
+// SetPartition sets a statefulset rolling update partition. Only the ordinals
+// at or past the partition are updated. A paused rollout is resumed.
+func (s *StatefulSet) SetPartition(ctx context.Context, path string, partition int32) error {
+	sts, err := s.getStatefulSet(path)
+	if err != nil {
+		return err
+	}
+	if err := checkPartition(sts, partition); err != nil {
+		return err
+	}
+
+	return patchResource(ctx, s.Factory, s.gvr, path, "", partitionPatch(partition, nil))
+}
+
+// IsRolloutPaused checks if a statefulset rollout was paused.
+func (s *StatefulSet) IsRolloutPaused(path string) (bool, error) {
+	sts, err := s.getStatefulSet(path)
+	if err != nil {
+		return false, err
+	}
+	_, ok := sts.Annotations[StsPausedPartitionAnnotation]
+
+	return ok, nil
+}
+
+// PauseRollout holds a statefulset rollout by moving its partition past the
+// last ordinal. Resuming restores the partition recorded on pause.
+func (s *StatefulSet) PauseRollout(ctx context.Context, path string, pause bool) error {
+	sts, err := s.getStatefulSet(path)
+	if err != nil {
+		return err
+	}
+	v, paused := sts.Annotations[StsPausedPartitionAnnotation]
+	if pause == paused {
+		if paused {
+			return fmt.Errorf("%s rollout is already paused", path)
+		}
+		return fmt.Errorf("%s rollout is not paused", path)
+	}
+
+	if pause {
+		if err := checkPartition(sts, 0); err != nil {
+			return err
+		}
+		prev := strconv.Itoa(int(stsPartition(sts)))
+		return patchResource(ctx, s.Factory, s.gvr, path, "", partitionPatch(stsReplicas(sts), &prev))
+	}
+
+	p, err := strconv.Atoi(v)
+	if err != nil || p < 0 {
+		p = 0
+	}
+
+	return patchResource(ctx, s.Factory, s.gvr, path, "", partitionPatch(int32(p), nil))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func checkPartition(sts *appsv1.StatefulSet, partition int32) error {
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return fmt.Errorf("%s uses the %s update strategy", sts.Name, appsv1.OnDeleteStatefulSetStrategyType)
+	}
+	if partition < 0 || partition > stsReplicas(sts) {
+		return fmt.Errorf("partition must be between 0 and %d", stsReplicas(sts))
+	}
+
+	return nil
+}
+
+// partitionPatch sets the partition and the paused annotation or clears it
+// when paused is nil.
+func partitionPatch(partition int32, paused *string) map[string]interface{} {
+	var val interface{}
+	if paused != nil {
+		val = *paused
+	}
+
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{StsPausedPartitionAnnotation: val},
+		},
+		"spec": map[string]interface{}{
+			"updateStrategy": map[string]interface{}{
+				"type":          string(appsv1.RollingUpdateStatefulSetStrategyType),
+				"rollingUpdate": map[string]interface{}{"partition": partition},
+			},
+		},
+	}
+}
+
+func stsReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *sts.Spec.Replicas
+}
+
+func stsPartition(sts *appsv1.StatefulSet) int32 {
+	ru := sts.Spec.UpdateStrategy.RollingUpdate
+	if ru == nil || ru.Partition == nil {
+		return 0
+	}
+
+	return *ru.Partition
+}
+
+func newStsRollout(path string, sts *appsv1.StatefulSet, pods []v1.Pod) *StsRollout {
+	r := StsRollout{
+		Path:            path,
+		Strategy:        string(sts.Spec.UpdateStrategy.Type),
+		Replicas:        stsReplicas(sts),
+		Partition:       stsPartition(sts),
+		CurrentRevision: sts.Status.CurrentRevision,
+		UpdateRevision:  sts.Status.UpdateRevision,
+	}
+	if r.Strategy == "" {
+		r.Strategy = string(appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+	_, r.Paused = sts.Annotations[StsPausedPartitionAnnotation]
+
+	byOrdinal := make(map[int]*v1.Pod, len(pods))
+	for i := range pods {
+		if !isOwnedBy(pods[i].OwnerReferences, sts.UID) {
+			continue
+		}
+		if ord, ok := stsOrdinal(sts.Name, pods[i].Name); ok {
+			byOrdinal[ord] = &pods[i]
+		}
+	}
+	ords := make([]int, 0, len(byOrdinal))
+	for o := range byOrdinal {
+		ords = append(ords, o)
+	}
+	for o := 0; o < int(r.Replicas); o++ {
+		if _, ok := byOrdinal[o]; !ok {
+			ords = append(ords, o)
+		}
+	}
+	sort.Ints(ords)
+
+	for _, o := range ords {
+		so := StsOrdinal{
+			Ordinal: o,
+			Path:    client.FQN(sts.Namespace, sts.Name+"-"+strconv.Itoa(o)),
+			Status:  StsPodMissing,
+		}
+		if po, ok := byOrdinal[o]; ok {
+			so.Revision = po.Labels[RevisionHashLabel]
+			so.Phase = string(po.Status.Phase)
+			so.Ready = isPodServing(po)
+			so.Created = po.CreationTimestamp.Time
+			so.Status = r.ordinalStatus(o, so.Revision)
+		}
+		r.Ordinals = append(r.Ordinals, so)
+	}
+
+	return &r
+}
+
+// ordinalStatus returns whether an ordinal is updated, due for an update or
+// held back by the partition.
+func (r *StsRollout) ordinalStatus(ordinal int, rev string) string {
+	switch {
+	case r.UpdateRevision == "" || rev == r.UpdateRevision:
+		return StsPodUpdated
+	case r.Strategy == string(appsv1.RollingUpdateStatefulSetStrategyType) && int32(ordinal) < r.Partition:
+		return StsPodHeld
+	default:
+		return StsPodPending
+	}
+}
+
+// stsOrdinal returns a statefulset pod ordinal from its name.
+func stsOrdinal(sts, pod string) (int, bool) {
+	if !strings.HasPrefix(pod, sts+"-") {
+		return 0, false
+	}
+	o, err := strconv.Atoi(strings.TrimPrefix(pod, sts+"-"))
+	if err != nil || o < 0 {
+		return 0, false
+	}
+
+	return o, true
+}
@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewStsRollout(t *testing.T) {
+	replicas, partition := int32(4), int32(2)
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "db", UID: types.UID("u1")},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{CurrentRevision: "db-1", UpdateRevision: "db-2"},
+	}
+	pod := func(n, rev string, uid types.UID) v1.Pod {
+		controller := true
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "ns1",
+				Name:            n,
+				Labels:          map[string]string{RevisionHashLabel: rev},
+				OwnerReferences: []metav1.OwnerReference{{UID: uid, Controller: &controller}},
+			},
+			Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+			},
+		}
+	}
+	pods := []v1.Pod{
+		pod("db-3", "db-2", "u1"),
+		pod("db-0", "db-1", "u1"),
+		pod("db-1", "db-1", "u1"),
+		pod("db-4", "db-1", "u1"),
+		pod("db-2", "db-1", "u2"),
+		pod("dbx-2", "db-1", "u1"),
+	}
+
+	r := newStsRollout("ns1/db", &sts, pods)
+	assert.Equal(t, int32(2), r.Partition)
+	assert.False(t, r.Paused)
+	assert.Equal(t, 1, r.Updated())
+
+	ss := make([]string, 0, len(r.Ordinals))
+	for _, o := range r.Ordinals {
+		ss = append(ss, o.Path+":"+o.Status)
+	}
+	assert.Equal(t, []string{
+		"ns1/db-0:" + StsPodHeld,
+		"ns1/db-1:" + StsPodHeld,
+		"ns1/db-2:" + StsPodMissing,
+		"ns1/db-3:" + StsPodUpdated,
+		"ns1/db-4:" + StsPodPending,
+	}, ss)
+	assert.True(t, r.Ordinals[0].Ready)
+}
+
+func TestCheckPartition(t *testing.T) {
+	replicas := int32(3)
+	uu := map[string]struct {
+		strategy  appsv1.StatefulSetUpdateStrategyType
+		partition int32
+		err       bool
+	}{
+		"ok":       {strategy: appsv1.RollingUpdateStatefulSetStrategyType, partition: 3},
+		"default":  {partition: 1},
+		"negative": {strategy: appsv1.RollingUpdateStatefulSetStrategyType, partition: -1, err: true},
+		"toast":    {strategy: appsv1.RollingUpdateStatefulSetStrategyType, partition: 4, err: true},
+		"onDelete": {strategy: appsv1.OnDeleteStatefulSetStrategyType, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sts := appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       &replicas,
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: u.strategy},
+				},
+			}
+			assert.Equal(t, u.err, checkPartition(&sts, u.partition) != nil)
+		})
+	}
+}
@@ -7,15 +7,17 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var (
-	_ Accessor   = (*Service)(nil)
-	_ Loggable   = (*Service)(nil)
-	_ Controller = (*Service)(nil)
+	_ Accessor    = (*Service)(nil)
+	_ Loggable    = (*Service)(nil)
+	_ Controller  = (*Service)(nil)
+	_ PodSelector = (*Service)(nil)
 )
 
 // Service represents a k8s service.
@@ -46,6 +48,19 @@ func (s *Service) Pod(fqn string) (string, error) {
 	return podFromSelector(s.Factory, svc.Namespace, svc.Spec.Selector)
 }
 
+// PodSelector returns the label selector matching the service backing pods.
+func (s *Service) PodSelector(fqn string) (*metav1.LabelSelector, error) {
+	svc, err := s.GetInstance(fqn)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("no valid selector found on Service %s", fqn)
+	}
+
+	return &metav1.LabelSelector{MatchLabels: svc.Spec.Selector}, nil
+}
+
 // GetInstance returns a service instance.
 func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 	o, err := s.Factory.Get(s.gvr.String(), fqn, true, labels.Everything())
@@ -66,20 +81,57 @@ func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 // Helpers...
 
 func podFromSelector(f Factory, ns string, sel map[string]string) (string, error) {
-	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	pod, err := selectPod(f, ns, labels.Set(sel).AsSelector(), false)
 	if err != nil {
 		return "", err
 	}
 
+	return client.FQN(pod.Namespace, pod.Name), nil
+}
+
+// selectPod returns a pod matching the selector, preferring ready pods. Only
+// ready pods qualify when ready is set.
+func selectPod(f Factory, ns string, sel labels.Selector, ready bool) (*v1.Pod, error) {
+	oo, err := f.List("v1/pods", ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(oo) == 0 {
-		return "", fmt.Errorf("no matching pods for %v", sel)
+		return nil, fmt.Errorf("no matching pods for %v", sel)
 	}
 
-	var pod v1.Pod
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(oo[0].(*unstructured.Unstructured).Object, &pod)
-	if err != nil {
-		return "", err
+	var first *v1.Pod
+	for _, o := range oo {
+		var pod v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
+		if err != nil {
+			return nil, err
+		}
+		if isPodServing(&pod) {
+			return &pod, nil
+		}
+		if first == nil {
+			first = &pod
+		}
+	}
+	if ready {
+		return nil, fmt.Errorf("no ready pods matching %v", sel)
 	}
 
-	return client.FQN(pod.Namespace, pod.Name), nil
+	return first, nil
+}
+
+// isPodServing returns true if the pod is running, ready and not terminating.
+func isPodServing(pod *v1.Pod) bool {
+	if pod.Status.Phase != v1.PodRunning || pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
 }
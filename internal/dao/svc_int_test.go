@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsPodServing(t *testing.T) {
+	now := metav1.Now()
+	ready := []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+	uu := map[string]struct {
+		phase      v1.PodPhase
+		conditions []v1.PodCondition
+		deleted    *metav1.Time
+		e          bool
+	}{
+		"ready": {
+			phase:      v1.PodRunning,
+			conditions: ready,
+			e:          true,
+		},
+		"unready": {
+			phase:      v1.PodRunning,
+			conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+		},
+		"noConditions": {
+			phase: v1.PodRunning,
+		},
+		"pending": {
+			phase:      v1.PodPending,
+			conditions: ready,
+		},
+		"terminating": {
+			phase:      v1.PodRunning,
+			conditions: ready,
+			deleted:    &now,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: u.deleted},
+				Status:     v1.PodStatus{Phase: u.phase, Conditions: u.conditions},
+			}
+			assert.Equal(t, u.e, isPodServing(&pod))
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// TimelineNormal tracks an informational timeline entry.
+	TimelineNormal = "Normal"
+	// TimelineWarning tracks a timeline entry worth looking into.
+	TimelineWarning = "Warning"
+	// TimelineError tracks a failure timeline entry.
+	TimelineError = "Error"
+
+	// TimelineEvent tracks entries sourced from events.
+	TimelineEvent = "event"
+	// TimelineContainer tracks entries sourced from containers states.
+	TimelineContainer = "container"
+	// TimelinePod tracks entries sourced from pods conditions.
+	TimelinePod = "pod"
+
+	timelineMaxEntries = 500
+)
+
+// TimelineEntry represents a resource timeline occurrence.
+type TimelineEntry struct {
+	Time     time.Time
+	Severity string
+	Source   string
+	Object   string
+	Reason   string
+	Message  string
+	Count    int32
+}
+
+// FetchTimeline returns a pod or workload events merged with its pods
+// conditions and containers state transitions, oldest first.
+func FetchTimeline(f Factory, gvr client.GVR, path string) ([]TimelineEntry, error) {
+	ns, _ := client.Namespaced(path)
+	uids, pods, err := timelineSubjects(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo, err := f.List("v1/events", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var e v1.Event
+		if err := fromUnstructured(o, &e); err != nil {
+			return nil, err
+		}
+		if _, ok := uids[e.InvolvedObject.UID]; ok {
+			ee = append(ee, e)
+		}
+	}
+
+	return buildTimeline(ee, pods), nil
+}
+
+func buildTimeline(ee []v1.Event, pods []v1.Pod) []TimelineEntry {
+	tt := make([]TimelineEntry, 0, len(ee))
+	for _, e := range ee {
+		tt = append(tt, eventEntry(e))
+	}
+	for i := range pods {
+		tt = append(tt, podTransitions(&pods[i])...)
+	}
+	sort.SliceStable(tt, func(i, j int) bool {
+		return tt[i].Time.Before(tt[j].Time)
+	})
+	if len(tt) > timelineMaxEntries {
+		tt = tt[len(tt)-timelineMaxEntries:]
+	}
+
+	return tt
+}
+
+// timelineSubjects returns the uids of a resource and its dependents along
+// with the pods it manages.
+func timelineSubjects(f Factory, gvr client.GVR, path string) (map[types.UID]struct{}, []v1.Pod, error) {
+	uids := make(map[types.UID]struct{})
+	if gvr.String() == "v1/pods" {
+		o, err := f.Get(gvr.String(), path, true, labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, nil, err
+		}
+		uids[po.UID] = struct{}{}
+		return uids, []v1.Pod{po}, nil
+	}
+
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	uids[u.GetUID()] = struct{}{}
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil || !ok {
+		return nil, nil, fmt.Errorf("no pod selector found on %s", path)
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return nil, nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gvr.String() == "apps/v1/deployments" {
+		oo, err := f.List("apps/v1/replicasets", u.GetNamespace(), true, sel)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, o := range oo {
+			var rs appsv1.ReplicaSet
+			if err := fromUnstructured(o, &rs); err != nil {
+				return nil, nil, err
+			}
+			if isOwnedBy(rs.OwnerReferences, u.GetUID()) {
+				uids[rs.UID] = struct{}{}
+			}
+		}
+	}
+
+	oo, err := f.List("v1/pods", u.GetNamespace(), true, sel)
+	if err != nil {
+		return nil, nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, nil, err
+		}
+		uids[po.UID] = struct{}{}
+		pods = append(pods, po)
+	}
+
+	return uids, pods, nil
+}
+
+func eventEntry(e v1.Event) TimelineEntry {
+	obj := strings.ToLower(e.InvolvedObject.Kind) + "/" + e.InvolvedObject.Name
+	if co := fieldPathContainer(e.InvolvedObject.FieldPath); co != "" {
+		obj += " " + co
+	}
+	severity := TimelineNormal
+	if e.Type == v1.EventTypeWarning {
+		severity = TimelineWarning
+		if strings.HasPrefix(e.Reason, "Failed") {
+			severity = TimelineError
+		}
+	}
+
+	return TimelineEntry{
+		Time:     eventTime(e).Time,
+		Severity: severity,
+		Source:   TimelineEvent,
+		Object:   obj,
+		Reason:   e.Reason,
+		Message:  strings.TrimSpace(e.Message),
+		Count:    e.Count,
+	}
+}
+
+// podTransitions returns a pod conditions and containers states as timeline
+// entries.
+func podTransitions(po *v1.Pod) []TimelineEntry {
+	obj := "pod/" + po.Name
+	var tt []TimelineEntry
+	for _, c := range po.Status.Conditions {
+		if c.LastTransitionTime.IsZero() {
+			continue
+		}
+		switch {
+		case c.Type == v1.PodScheduled && c.Status == v1.ConditionTrue:
+			tt = append(tt, TimelineEntry{Time: c.LastTransitionTime.Time, Severity: TimelineNormal, Source: TimelinePod, Object: obj, Reason: "Scheduled"})
+		case c.Type == v1.PodReady && c.Status == v1.ConditionTrue:
+			tt = append(tt, TimelineEntry{Time: c.LastTransitionTime.Time, Severity: TimelineNormal, Source: TimelinePod, Object: obj, Reason: "Ready"})
+		case c.Type == v1.PodReady:
+			tt = append(tt, TimelineEntry{Time: c.LastTransitionTime.Time, Severity: TimelineWarning, Source: TimelinePod, Object: obj, Reason: "NotReady", Message: c.Message})
+		}
+	}
+
+	ss := append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...)
+	for _, s := range ss {
+		co := obj + " " + s.Name
+		tt = append(tt, stateTransitions(co, s.LastTerminationState)...)
+		tt = append(tt, stateTransitions(co, s.State)...)
+	}
+
+	return tt
+}
+
+func stateTransitions(obj string, s v1.ContainerState) []TimelineEntry {
+	switch {
+	case s.Running != nil && !s.Running.StartedAt.IsZero():
+		return []TimelineEntry{{Time: s.Running.StartedAt.Time, Severity: TimelineNormal, Source: TimelineContainer, Object: obj, Reason: "Started"}}
+	case s.Terminated != nil:
+		t := s.Terminated
+		var tt []TimelineEntry
+		if !t.StartedAt.IsZero() {
+			tt = append(tt, TimelineEntry{Time: t.StartedAt.Time, Severity: TimelineNormal, Source: TimelineContainer, Object: obj, Reason: "Started"})
+		}
+		if t.FinishedAt.IsZero() {
+			return tt
+		}
+		reason, severity := t.Reason, TimelineNormal
+		if reason == "" {
+			reason = "Terminated"
+		}
+		switch {
+		case reason == "OOMKilled":
+			severity = TimelineError
+		case t.ExitCode != 0:
+			severity = TimelineWarning
+		}
+		msg := fmt.Sprintf("exit code %d", t.ExitCode)
+		if t.Message != "" {
+			msg += ": " + strings.TrimSpace(t.Message)
+		}
+		return append(tt, TimelineEntry{Time: t.FinishedAt.Time, Severity: severity, Source: TimelineContainer, Object: obj, Reason: reason, Message: msg})
+	}
+
+	return nil
+}
+
+// fieldPathContainer extracts a container name from an event field path, ie
+// spec.containers{fred}.
+func fieldPathContainer(p string) string {
+	i, j := strings.Index(p, "{"), strings.LastIndex(p, "}")
+	if i == -1 || j <= i {
+		return ""
+	}
+
+	return p[i+1 : j]
+}
+
+func isOwnedBy(rr []metav1.OwnerReference, uid types.UID) bool {
+	for _, r := range rr {
+		if r.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fromUnstructured(o runtime.Object, v interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, v)
+}
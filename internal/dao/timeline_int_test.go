@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	t0 := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(s int) metav1.Time { return metav1.NewTime(t0.Add(time.Duration(s) * time.Second)) }
+
+	ee := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1", FieldPath: "spec.containers{c1}"},
+			Type:           v1.EventTypeNormal,
+			Reason:         "Pulled",
+			Message:        "Successfully pulled image ",
+			LastTimestamp:  at(2),
+			Count:          1,
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "FailedMount",
+			LastTimestamp:  at(1),
+		},
+	}
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodScheduled, Status: v1.ConditionTrue, LastTransitionTime: at(0)},
+				{Type: v1.PodReady, Status: v1.ConditionFalse, LastTransitionTime: at(6), Message: "containers not ready"},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:  "c1",
+					State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: at(7)}},
+					LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						ExitCode:   137,
+						StartedAt:  at(3),
+						FinishedAt: at(5),
+					}},
+				},
+			},
+		},
+	}
+
+	tt := buildTimeline(ee, []v1.Pod{po})
+	assert.Equal(t, 7, len(tt))
+
+	reasons := make([]string, 0, len(tt))
+	for _, e := range tt {
+		reasons = append(reasons, e.Reason)
+	}
+	assert.Equal(t, []string{"Scheduled", "FailedMount", "Pulled", "Started", "OOMKilled", "NotReady", "Started"}, reasons)
+	assert.Equal(t, TimelineError, tt[1].Severity)
+	assert.Equal(t, "pod/p1 c1", tt[2].Object)
+	assert.Equal(t, "Successfully pulled image", tt[2].Message)
+	assert.Equal(t, TimelineError, tt[4].Severity)
+	assert.Equal(t, "exit code 137", tt[4].Message)
+	assert.Equal(t, TimelineWarning, tt[5].Severity)
+}
+
+func TestFieldPathContainer(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"container": {path: "spec.containers{fred}", e: "fred"},
+		"init":      {path: "spec.initContainers{blee}", e: "blee"},
+		"none":      {path: "spec"},
+		"blank":     {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, fieldPathContainer(u.path))
+		})
+	}
+}
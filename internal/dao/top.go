@@ -0,0 +1,264 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// TopByNamespace aggregates pods usage per namespace.
+	TopByNamespace = "namespace"
+	// TopByDeployment aggregates pods usage per workload with namespaces subtotals.
+	TopByDeployment = "deployment"
+	// TopByNode aggregates pods usage per node.
+	TopByNode = "node"
+
+	// TopSortName sorts aggregates by name.
+	TopSortName = "NAME"
+	// TopSortPods sorts aggregates by pods count.
+	TopSortPods = "PODS"
+	// TopSortCPU sorts aggregates by cpu usage.
+	TopSortCPU = "CPU"
+	// TopSortMEM sorts aggregates by memory usage.
+	TopSortMEM = "MEM"
+	// TopSortCPULimit sorts aggregates by cpu usage percent of limits.
+	TopSortCPULimit = "%CPU/L"
+	// TopSortMEMLimit sorts aggregates by memory usage percent of limits.
+	TopSortMEMLimit = "%MEM/L"
+
+	podTemplateHashLabel = "pod-template-hash"
+	unscheduledNode      = "<unscheduled>"
+)
+
+// TopGroups lists the supported usage aggregations.
+var TopGroups = []string{TopByNamespace, TopByDeployment, TopByNode}
+
+// TopUsage represents pods usage aggregate. CPU is in millicores and memory
+// in bytes.
+type TopUsage struct {
+	Name               string
+	Pods               int
+	CPU, MEM           int64
+	CPULimit, MEMLimit int64
+
+	// cpuLimited and memLimited track the usage of containers with limits.
+	cpuLimited, memLimited int64
+}
+
+// CPUPercent returns the cpu usage percent of limits or -1 if no limits
+// are set. Only containers with limits are accounted for.
+func (u TopUsage) CPUPercent() int {
+	if u.CPULimit == 0 {
+		return -1
+	}
+	return client.ToPercentage(u.cpuLimited, u.CPULimit)
+}
+
+// MEMPercent returns the memory usage percent of limits or -1 if no limits
+// are set. Only containers with limits are accounted for.
+func (u TopUsage) MEMPercent() int {
+	if u.MEMLimit == 0 {
+		return -1
+	}
+	return client.ToPercentage(u.memLimited, u.MEMLimit)
+}
+
+func (u *TopUsage) add(o TopUsage) {
+	u.Pods += o.Pods
+	u.CPU += o.CPU
+	u.MEM += o.MEM
+	u.CPULimit += o.CPULimit
+	u.MEMLimit += o.MEMLimit
+	u.cpuLimited += o.cpuLimited
+	u.memLimited += o.memLimited
+}
+
+// TopGroup represents an aggregate along with its breakdown if any.
+type TopGroup struct {
+	TopUsage
+
+	Items []TopUsage
+}
+
+// TopReport represents the cluster pods usage aggregated by namespace,
+// workload or node.
+type TopReport struct {
+	By     string
+	Total  TopUsage
+	Groups []TopGroup
+}
+
+// FetchTop aggregates all pods usage and limits.
+func FetchTop(f Factory, by string) (*TopReport, error) {
+	oo, err := f.List("v1/pods", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	mx, err := client.DialMetrics(f.Client()).FetchPodsMetrics(ctx, client.AllNamespaces)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pods metrics: %w", err)
+	}
+
+	return AggregateTop(pods, mx.Items, by)
+}
+
+// AggregateTop sums up running pods usage and limits by namespace,
+// deployment or node.
+func AggregateTop(pods []v1.Pod, mx []mv1beta1.PodMetrics, by string) (*TopReport, error) {
+	usage := make(map[string]map[string]v1.ResourceList, len(mx))
+	for _, m := range mx {
+		cc := make(map[string]v1.ResourceList, len(m.Containers))
+		for _, c := range m.Containers {
+			cc[c.Name] = c.Usage
+		}
+		usage[client.FQN(m.Namespace, m.Name)] = cc
+	}
+
+	r := TopReport{By: by, Total: TopUsage{Name: "TOTAL"}}
+	groups, index := make(map[string]*TopGroup), make(map[string]map[string]int)
+	for i := range pods {
+		po := &pods[i]
+		if po.Status.Phase != v1.PodRunning {
+			continue
+		}
+		u := podTop(po, usage[client.FQN(po.Namespace, po.Name)])
+		r.Total.add(u)
+
+		var group, item string
+		switch by {
+		case TopByNamespace:
+			group = po.Namespace
+		case TopByNode:
+			group = po.Spec.NodeName
+			if group == "" {
+				group = unscheduledNode
+			}
+		case TopByDeployment:
+			group, item = po.Namespace, client.FQN(po.Namespace, podWorkload(po))
+		default:
+			return nil, fmt.Errorf("invalid top aggregation %q. Expecting one of %s", by, strings.Join(TopGroups, ", "))
+		}
+
+		g, ok := groups[group]
+		if !ok {
+			g = &TopGroup{TopUsage: TopUsage{Name: group}}
+			groups[group], index[group] = g, make(map[string]int)
+		}
+		g.add(u)
+		if item == "" {
+			continue
+		}
+		idx, ok := index[group][item]
+		if !ok {
+			idx = len(g.Items)
+			index[group][item] = idx
+			g.Items = append(g.Items, TopUsage{Name: item})
+		}
+		g.Items[idx].add(u)
+	}
+
+	r.Groups = make([]TopGroup, 0, len(groups))
+	for _, g := range groups {
+		r.Groups = append(r.Groups, *g)
+	}
+	r.Sort(TopSortName, true)
+
+	return &r, nil
+}
+
+// podTop returns a pod usage and limits given its containers usage.
+func podTop(po *v1.Pod, cc map[string]v1.ResourceList) TopUsage {
+	u := TopUsage{Pods: 1}
+	for _, c := range cc {
+		u.CPU += c.Cpu().MilliValue()
+		u.MEM += c.Memory().Value()
+	}
+	for _, co := range po.Spec.Containers {
+		c := cc[co.Name]
+		if l := co.Resources.Limits.Cpu().MilliValue(); l > 0 {
+			u.CPULimit += l
+			u.cpuLimited += c.Cpu().MilliValue()
+		}
+		if l := co.Resources.Limits.Memory().Value(); l > 0 {
+			u.MEMLimit += l
+			u.memLimited += c.Memory().Value()
+		}
+	}
+
+	return u
+}
+
+// Sort orders the aggregates and their breakdown by a given column.
+func (r *TopReport) Sort(col string, asc bool) {
+	less := topLess(col, asc)
+	sort.Slice(r.Groups, func(i, j int) bool {
+		return less(r.Groups[i].TopUsage, r.Groups[j].TopUsage)
+	})
+	for _, g := range r.Groups {
+		sort.Slice(g.Items, func(i, j int) bool {
+			return less(g.Items[i], g.Items[j])
+		})
+	}
+}
+
+func topLess(col string, asc bool) func(a, b TopUsage) bool {
+	var key func(u TopUsage) int64
+	switch col {
+	case TopSortPods:
+		key = func(u TopUsage) int64 { return int64(u.Pods) }
+	case TopSortCPU:
+		key = func(u TopUsage) int64 { return u.CPU }
+	case TopSortMEM:
+		key = func(u TopUsage) int64 { return u.MEM }
+	case TopSortCPULimit:
+		key = func(u TopUsage) int64 { return int64(u.CPUPercent()) }
+	case TopSortMEMLimit:
+		key = func(u TopUsage) int64 { return int64(u.MEMPercent()) }
+	}
+
+	return func(a, b TopUsage) bool {
+		if key == nil || key(a) == key(b) {
+			return a.Name < b.Name
+		}
+		if asc {
+			return key(a) < key(b)
+		}
+		return key(a) > key(b)
+	}
+}
+
+// podWorkload returns the pod owning workload, resolving replicasets to
+// their deployment.
+func podWorkload(po *v1.Pod) string {
+	for _, r := range po.OwnerReferences {
+		if r.Controller == nil || !*r.Controller {
+			continue
+		}
+		if r.Kind == "ReplicaSet" {
+			if h, ok := po.Labels[podTemplateHashLabel]; ok && strings.HasSuffix(r.Name, "-"+h) {
+				return strings.TrimSuffix(r.Name, "-"+h)
+			}
+		}
+		return r.Name
+	}
+
+	return po.Name
+}
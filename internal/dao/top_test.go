@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestAggregateTop(t *testing.T) {
+	pods := []v1.Pod{
+		makeTopPod("ns1", "web-5d8f7-abc", "5d8f7", "web-5d8f7", "n1", "200m"),
+		makeTopPod("ns1", "web-5d8f7-def", "5d8f7", "web-5d8f7", "n2", "200m"),
+		makeTopPod("ns2", "db-0", "", "", "n1", ""),
+	}
+	mx := []mv1beta1.PodMetrics{
+		makeTopMetrics("ns1", "web-5d8f7-abc", "100m"),
+		makeTopMetrics("ns1", "web-5d8f7-def", "50m"),
+		makeTopMetrics("ns2", "db-0", "300m"),
+	}
+
+	r, err := dao.AggregateTop(pods, mx, dao.TopByDeployment)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, r.Total.Pods)
+	assert.Equal(t, int64(450), r.Total.CPU)
+	assert.Equal(t, 2, len(r.Groups))
+	assert.Equal(t, "ns1", r.Groups[0].Name)
+	assert.Equal(t, 1, len(r.Groups[0].Items))
+	assert.Equal(t, "ns1/web", r.Groups[0].Items[0].Name)
+	assert.Equal(t, 37, r.Groups[0].Items[0].CPUPercent())
+	assert.Equal(t, "ns2/db-0", r.Groups[1].Items[0].Name)
+	assert.Equal(t, -1, r.Groups[1].Items[0].CPUPercent())
+
+	r, err = dao.AggregateTop(pods, mx, dao.TopByNode)
+	assert.Nil(t, err)
+	r.Sort(dao.TopSortCPU, false)
+	assert.Equal(t, "n1", r.Groups[0].Name)
+	assert.Equal(t, int64(400), r.Groups[0].CPU)
+	assert.Empty(t, r.Groups[0].Items)
+
+	// Usage of containers without limits is left out of the limits percent.
+	sidecar := makeTopPod("ns3", "api-0", "", "", "n1", "100m")
+	sidecar.Spec.Containers = append(sidecar.Spec.Containers, v1.Container{Name: "c2"})
+	smx := makeTopMetrics("ns3", "api-0", "50m")
+	smx.Containers = append(smx.Containers, mv1beta1.ContainerMetrics{Name: "c2", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")}})
+	r, err = dao.AggregateTop([]v1.Pod{sidecar}, []mv1beta1.PodMetrics{smx}, dao.TopByNamespace)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(550), r.Total.CPU)
+	assert.Equal(t, 50, r.Total.CPUPercent())
+
+	_, err = dao.AggregateTop(pods, mx, "fred")
+	assert.NotNil(t, err)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeTopPod(ns, n, hash, rs, node, lim string) v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n, Labels: map[string]string{}},
+		Spec: v1.PodSpec{
+			NodeName:   node,
+			Containers: []v1.Container{{Name: "c1"}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	if hash != "" {
+		ctrl := true
+		po.Labels["pod-template-hash"] = hash
+		po.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: rs, Controller: &ctrl}}
+	}
+	if lim != "" {
+		po.Spec.Containers[0].Resources.Limits = v1.ResourceList{v1.ResourceCPU: resource.MustParse(lim)}
+	}
+
+	return po
+}
+
+func makeTopMetrics(ns, n, cpu string) mv1beta1.PodMetrics {
+	return mv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Containers: []mv1beta1.ContainerMetrics{
+			{Name: "c1", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)}},
+		},
+	}
+}
@@ -0,0 +1,237 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// TopologySpread tracks a topologySpreadConstraint check.
+	TopologySpread = "Spread"
+	// TopologyAntiAffinity tracks a pod anti-affinity check.
+	TopologyAntiAffinity = "AntiAffinity"
+	// TopologyDistribution tracks a plain pods distribution with no constraints.
+	TopologyDistribution = "Distribution"
+
+	// TopologyRequired tracks a hard anti-affinity term.
+	TopologyRequired = "Required"
+	// TopologyPreferred tracks a soft anti-affinity term.
+	TopologyPreferred = "Preferred"
+
+	unscheduledDomain = "<unscheduled>"
+)
+
+// defaultTopologyKeys tracks the domains reported when a workload sets no constraints.
+var defaultTopologyKeys = []string{v1.LabelZoneFailureDomainStable, v1.LabelHostname}
+
+// TopologyDomain represents the pods scheduled in a topology domain.
+type TopologyDomain struct {
+	Value string
+	Pods  []string
+}
+
+// TopologyCheck represents a workload placement constraint evaluation.
+type TopologyCheck struct {
+	Kind, Key, Policy string
+	MaxSkew, Skew     int
+	Violated          bool
+	Domains           []TopologyDomain
+}
+
+// TopologyReport represents a workload pods distribution across topology domains.
+type TopologyReport struct {
+	Workload string
+	Checks   []TopologyCheck
+}
+
+// workloadSpec tracks the pod template of a pod controller ie dp, sts, ds.
+type workloadSpec struct {
+	Spec struct {
+		Selector *metav1.LabelSelector `json:"selector"`
+		Template v1.PodTemplateSpec    `json:"template"`
+	} `json:"spec"`
+}
+
+// FetchTopology evaluates a workload topology spread constraints and pod
+// anti-affinities against its current pods placement.
+func FetchTopology(f Factory, gvr client.GVR, path string) (*TopologyReport, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var w workloadSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &w); err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(w.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, _ := client.Namespaced(path)
+	pods, err := fetchPods(f, ns)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := fetchNodeLabels(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTopologyReport(path, sel, &w.Spec.Template.Spec, pods, nodes)
+}
+
+func fetchPods(f Factory, ns string) ([]v1.Pod, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	return pp, nil
+}
+
+func fetchNodeLabels(f Factory) (map[string]map[string]string, error) {
+	oo, err := f.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nn := make(map[string]map[string]string, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		nn[u.GetName()] = u.GetLabels()
+	}
+
+	return nn, nil
+}
+
+// newTopologyReport evaluates placement constraints. Domains are derived from
+// all nodes carrying the topology key, node affinities and taints are ignored.
+func newTopologyReport(path string, sel labels.Selector, spec *v1.PodSpec, pods []v1.Pod, nodes map[string]map[string]string) (*TopologyReport, error) {
+	r := TopologyReport{Workload: path}
+	for _, c := range spec.TopologySpreadConstraints {
+		s, err := metav1.LabelSelectorAsSelector(c.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		check := evalTopology(TopologySpread, c.TopologyKey, s, pods, nodes)
+		check.Policy, check.MaxSkew = string(c.WhenUnsatisfiable), int(c.MaxSkew)
+		check.Violated = check.Skew > check.MaxSkew
+		r.Checks = append(r.Checks, check)
+	}
+	if a := spec.Affinity; a != nil && a.PodAntiAffinity != nil {
+		for _, t := range a.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			check, err := evalAntiAffinity(t, TopologyRequired, pods, nodes)
+			if err != nil {
+				return nil, err
+			}
+			r.Checks = append(r.Checks, check)
+		}
+		for _, t := range a.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			check, err := evalAntiAffinity(t.PodAffinityTerm, TopologyPreferred, pods, nodes)
+			if err != nil {
+				return nil, err
+			}
+			r.Checks = append(r.Checks, check)
+		}
+	}
+	if len(r.Checks) == 0 {
+		for _, k := range defaultTopologyKeys {
+			r.Checks = append(r.Checks, evalTopology(TopologyDistribution, k, sel, pods, nodes))
+		}
+	}
+
+	return &r, nil
+}
+
+func evalAntiAffinity(t v1.PodAffinityTerm, policy string, pods []v1.Pod, nodes map[string]map[string]string) (TopologyCheck, error) {
+	s, err := metav1.LabelSelectorAsSelector(t.LabelSelector)
+	if err != nil {
+		return TopologyCheck{}, err
+	}
+	check := evalTopology(TopologyAntiAffinity, t.TopologyKey, s, pods, nodes)
+	check.Policy, check.MaxSkew = policy, 1
+	for _, d := range check.Domains {
+		if d.Value != unscheduledDomain && len(d.Pods) > 1 {
+			check.Violated = true
+		}
+	}
+
+	return check, nil
+}
+
+// evalTopology groups the pods matching a selector by topology domain.
+func evalTopology(kind, key string, sel labels.Selector, pods []v1.Pod, nodes map[string]map[string]string) TopologyCheck {
+	check := TopologyCheck{Kind: kind, Key: key}
+	domains := make(map[string][]string)
+	for _, ll := range nodes {
+		if v, ok := ll[key]; ok {
+			domains[v] = nil
+		}
+	}
+	for _, po := range pods {
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if !sel.Matches(labels.Set(po.Labels)) {
+			continue
+		}
+		d := unscheduledDomain
+		if po.Spec.NodeName != "" {
+			v, ok := nodes[po.Spec.NodeName][key]
+			if !ok {
+				continue
+			}
+			d = v
+		}
+		domains[d] = append(domains[d], client.FQN(po.Namespace, po.Name))
+	}
+
+	min, max := -1, 0
+	for v, pp := range domains {
+		sort.Strings(pp)
+		check.Domains = append(check.Domains, TopologyDomain{Value: v, Pods: pp})
+		if v == unscheduledDomain {
+			continue
+		}
+		if min == -1 || len(pp) < min {
+			min = len(pp)
+		}
+		if len(pp) > max {
+			max = len(pp)
+		}
+	}
+	if min >= 0 {
+		check.Skew = max - min
+	}
+	sort.Slice(check.Domains, func(i, j int) bool {
+		return check.Domains[i].Value < check.Domains[j].Value
+	})
+
+	return check
+}
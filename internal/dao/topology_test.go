@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestNewTopologyReport(t *testing.T) {
+	nodes := map[string]map[string]string{
+		"n1": {v1.LabelZoneFailureDomainStable: "z1", v1.LabelHostname: "n1"},
+		"n2": {v1.LabelZoneFailureDomainStable: "z1", v1.LabelHostname: "n2"},
+		"n3": {v1.LabelZoneFailureDomainStable: "z2", v1.LabelHostname: "n3"},
+	}
+	pods := []v1.Pod{
+		makeTopoPod("p1", "n1", v1.PodRunning),
+		makeTopoPod("p2", "n1", v1.PodRunning),
+		makeTopoPod("p3", "n2", v1.PodRunning),
+		makeTopoPod("p4", "", v1.PodPending),
+		makeTopoPod("p5", "n3", v1.PodSucceeded),
+	}
+	appSel := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}}
+	sel, _ := metav1.LabelSelectorAsSelector(appSel)
+
+	uu := map[string]struct {
+		spec     v1.PodSpec
+		kinds    []string
+		skews    []int
+		violated []bool
+	}{
+		"none": {
+			kinds:    []string{TopologyDistribution, TopologyDistribution},
+			skews:    []int{3, 2},
+			violated: []bool{false, false},
+		},
+		"spread": {
+			spec: v1.PodSpec{
+				TopologySpreadConstraints: []v1.TopologySpreadConstraint{
+					{MaxSkew: 1, TopologyKey: v1.LabelZoneFailureDomainStable, WhenUnsatisfiable: v1.DoNotSchedule, LabelSelector: appSel},
+					{MaxSkew: 2, TopologyKey: v1.LabelHostname, WhenUnsatisfiable: v1.ScheduleAnyway, LabelSelector: appSel},
+				},
+			},
+			kinds:    []string{TopologySpread, TopologySpread},
+			skews:    []int{3, 2},
+			violated: []bool{true, false},
+		},
+		"anti-affinity": {
+			spec: v1.PodSpec{
+				Affinity: &v1.Affinity{
+					PodAntiAffinity: &v1.PodAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+							{TopologyKey: v1.LabelHostname, LabelSelector: appSel},
+						},
+					},
+				},
+			},
+			kinds:    []string{TopologyAntiAffinity},
+			skews:    []int{2},
+			violated: []bool{true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := newTopologyReport("default/fred", sel, &u.spec, pods, nodes)
+			assert.Nil(t, err)
+			assert.Equal(t, len(u.kinds), len(r.Checks))
+			for i, c := range r.Checks {
+				assert.Equal(t, u.kinds[i], c.Kind)
+				assert.Equal(t, u.skews[i], c.Skew)
+				assert.Equal(t, u.violated[i], c.Violated)
+			}
+		})
+	}
+}
+
+func TestEvalTopologyDomains(t *testing.T) {
+	nodes := map[string]map[string]string{
+		"n1": {v1.LabelZoneFailureDomainStable: "z1"},
+		"n2": {v1.LabelZoneFailureDomainStable: "z2"},
+	}
+	pods := []v1.Pod{
+		makeTopoPod("p1", "n1", v1.PodRunning),
+		makeTopoPod("p2", "", v1.PodPending),
+	}
+
+	c := evalTopology(TopologyDistribution, v1.LabelZoneFailureDomainStable, labels.Everything(), pods, nodes)
+	assert.Equal(t, []TopologyDomain{
+		{Value: unscheduledDomain, Pods: []string{"default/p2"}},
+		{Value: "z1", Pods: []string{"default/p1"}},
+		{Value: "z2"},
+	}, c.Domains)
+	assert.Equal(t, 1, c.Skew)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeTopoPod(n, node string, phase v1.PodPhase) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, Labels: map[string]string{"app": "fred"}},
+		Spec:       v1.PodSpec{NodeName: node},
+		Status:     v1.PodStatus{Phase: phase},
+	}
+}
@@ -0,0 +1,240 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// TrashGVRAnnotation tracks the resource gvr of a trashed manifest.
+	TrashGVRAnnotation = "k9s.io/trash-gvr"
+
+	// MaxTrash tracks the max number of trashed manifests per cluster.
+	MaxTrash = 500
+)
+
+var (
+	_ Accessor = (*Trash)(nil)
+	_ Nuker    = (*Trash)(nil)
+)
+
+// Trash represents snapshots of deleted resources.
+type Trash struct {
+	NonResource
+}
+
+// Delete purges a trashed manifest.
+func (t *Trash) Delete(path string, cascade, force bool) error {
+	return os.Remove(path)
+}
+
+// List returns a collection of trashed manifests.
+func (t *Trash) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		return nil, errors.New("no trash dir found in context")
+	}
+
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() {
+			continue
+		}
+		u, err := loadTrash(filepath.Join(dir, f.Name()))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping trash file %s", f.Name())
+			continue
+		}
+		oo = append(oo, render.TrashRes{
+			File:   f,
+			Dir:    dir,
+			GVR:    u.GetAnnotations()[TrashGVRAnnotation],
+			Object: u,
+		})
+	}
+
+	return oo, nil
+}
+
+// Restore re-creates a trashed resource. Owned resources are not restored
+// since their owner will manage their lifecycle.
+func (t *Trash) Restore(ctx context.Context, path string) error {
+	u, err := loadTrash(path)
+	if err != nil {
+		return err
+	}
+	if len(u.GetOwnerReferences()) > 0 {
+		return fmt.Errorf("%s is owned by %s and can not be restored", u.GetName(), u.GetOwnerReferences()[0].Kind)
+	}
+	gvr := client.NewGVR(u.GetAnnotations()[TrashGVRAnnotation])
+	if gvr.String() == "" {
+		return fmt.Errorf("no resource type found for %s", path)
+	}
+
+	auth, err := t.Client().CanI(u.GetNamespace(), gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to create %s", gvr)
+	}
+
+	cleanTrash(u)
+	dial := t.Client().DynDialOrDie().Resource(gvr.GVR())
+	if u.GetNamespace() == "" {
+		_, err = dial.Create(ctx, u, metav1.CreateOptions{})
+	} else {
+		_, err = dial.Namespace(u.GetNamespace()).Create(ctx, u, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// Snapshot saves a resource manifest in the trash directory prior to deletion.
+// Secrets are only trashed when opted in so their data does not land on disk
+// unless asked for. Trashed manifests are only readable by the current user.
+func Snapshot(f Factory, gvr client.GVR, path, dir string, secrets bool) (string, error) {
+	if gvr.String() == "v1/secrets" && !secrets {
+		log.Debug().Msgf("Skipping trash snapshot of secret %s", path)
+		return "", nil
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return "", err
+		}
+		u = &unstructured.Unstructured{Object: m}
+	}
+	u = u.DeepCopy()
+	aa := u.GetAnnotations()
+	if aa == nil {
+		aa = make(map[string]string, 1)
+	}
+	aa[TrashGVRAnnotation] = gvr.String()
+	u.SetAnnotations(aa)
+
+	raw, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return "", err
+	}
+	file := filepath.Join(dir, trashFileName(gvr, u.GetName()))
+	if err := ioutil.WriteFile(file, raw, 0600); err != nil {
+		return "", err
+	}
+
+	return file, nil
+}
+
+// PruneTrash purges the oldest manifests past MaxTrash entries.
+func PruneTrash(dir string) {
+	pruneTrash(dir, MaxTrash)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func trashFileName(gvr client.GVR, name string) string {
+	return fmt.Sprintf("%s-%s-%d.yml", strings.ToLower(gvr.R()), name, time.Now().UnixNano())
+}
+
+// pruneTrash removes the oldest trashed manifests past the given max.
+func pruneTrash(dir string, max int) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to read trash dir %s", dir)
+		return
+	}
+	files := make([]os.FileInfo, 0, len(ff))
+	for _, f := range ff {
+		if !f.IsDir() {
+			files = append(files, f)
+		}
+	}
+	if len(files) <= max {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	for _, f := range files[:len(files)-max] {
+		if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+			log.Warn().Err(err).Msgf("Unable to purge trash file %s", f.Name())
+		}
+	}
+}
+
+func loadTrash(path string) (*unstructured.Unstructured, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	js, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	var u unstructured.Unstructured
+	if err := u.UnmarshalJSON(js); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func cleanTrash(u *unstructured.Unstructured) {
+	aa := u.GetAnnotations()
+	delete(aa, TrashGVRAnnotation)
+	if len(aa) == 0 {
+		aa = nil
+	}
+	u.SetAnnotations(aa)
+	stripServerFields(u)
+}
+
+// stripServerFields removes server populated fields from a manifest.
+func stripServerFields(u *unstructured.Unstructured) {
+	u.SetResourceVersion("")
+	u.SetUID("")
+	u.SetSelfLink("")
+	u.SetGeneration(0)
+	u.SetCreationTimestamp(metav1.Time{})
+	u.SetDeletionTimestamp(nil)
+	u.SetManagedFields(nil)
+	unstructured.RemoveNestedField(u.Object, "status")
+}
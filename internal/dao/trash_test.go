@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashList(t *testing.T) {
+	var tr Trash
+	ctx := context.WithValue(context.Background(), internal.KeyDir, "testdata/trash")
+	oo, err := tr.List(ctx, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(oo))
+	o := oo[0].(render.TrashRes)
+	assert.Equal(t, "v1/configmaps", o.GVR)
+	assert.Equal(t, "cm1", o.Object.GetName())
+}
+
+func TestTrashRestoreOwned(t *testing.T) {
+	var tr Trash
+	err := tr.Restore(context.Background(), "testdata/trash/pods-p1-2.yml")
+
+	assert.EqualError(t, err, "p1 is owned by ReplicaSet and can not be restored")
+}
+
+func TestCleanTrash(t *testing.T) {
+	u, err := loadTrash("testdata/trash/pods-p1-2.yml")
+	assert.Nil(t, err)
+
+	cleanTrash(u)
+	assert.Empty(t, u.GetAnnotations())
+	_, ok := u.Object["status"]
+	assert.False(t, ok)
+
+	u, err = loadTrash("testdata/trash/configmaps-cm1-1.yml")
+	assert.Nil(t, err)
+	cleanTrash(u)
+	assert.Equal(t, "", u.GetResourceVersion())
+	assert.Equal(t, "", string(u.GetUID()))
+	assert.True(t, u.GetCreationTimestamp().IsZero())
+}
+
+func TestSnapshotSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-trash")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	f := newDepFactory()
+	f.add("v1/secrets", makeSecret("default", "s1"))
+	gvr := client.NewGVR("v1/secrets")
+	file, err := Snapshot(f, gvr, "default/s1", filepath.Join(dir, "fred"), false)
+	assert.Nil(t, err)
+	assert.Equal(t, "", file)
+
+	file, err = Snapshot(f, gvr, "default/s1", filepath.Join(dir, "fred"), true)
+	assert.Nil(t, err)
+	fi, err := os.Stat(file)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	fi, err = os.Stat(filepath.Join(dir, "fred"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0700), fi.Mode().Perm())
+}
+
+func TestPruneTrash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-trash")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		f := filepath.Join(dir, fmt.Sprintf("pods-p%d.yml", i))
+		assert.Nil(t, ioutil.WriteFile(f, []byte("fred"), 0600))
+		at := now.Add(time.Duration(i) * time.Second)
+		assert.Nil(t, os.Chtimes(f, at, at))
+	}
+	pruneTrash(dir, 3)
+
+	ff, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(ff))
+	assert.Equal(t, "pods-p2.yml", ff[0].Name())
+}
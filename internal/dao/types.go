@@ -2,7 +2,6 @@ package dao
 
 import (
 	"context"
-	"io"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -85,9 +84,15 @@ type DrainOptions struct {
 type NodeMaintainer interface {
 	// ToggleCordon toggles cordon/uncordon a node.
 	ToggleCordon(path string, cordon bool) error
+}
+
+// DrainReporter receives drain progress events.
+type DrainReporter func(DrainEvent)
 
-	// Drain drains the given node.
-	Drain(path string, opts DrainOptions, w io.Writer) error
+// Drainable represents resources that can be drained.
+type Drainable interface {
+	// Drain evicts the given node pods, reporting each pod progress.
+	Drain(ctx context.Context, path string, opts DrainOptions, report DrainReporter) error
 }
 
 // Loggable represents resources with logs.
@@ -96,6 +101,15 @@ type Loggable interface {
 	TailLogs(ctx context.Context, c LogChan, opts LogOptions) error
 }
 
+// Debuggable represents resources accepting ephemeral debug containers.
+type Debuggable interface {
+	// Debug adds an ephemeral debug container and returns its name.
+	Debug(ctx context.Context, path string, opts DebugOptions) (string, error)
+
+	// WaitForDebug waits for a debug container to be running.
+	WaitForDebug(ctx context.Context, path, co string) error
+}
+
 // Describer describes a resource.
 type Describer interface {
 	// Describe describes a resource.
@@ -117,6 +131,18 @@ type Controller interface {
 	Pod(path string) (string, error)
 }
 
+// PodSelector represents a resource fronting pods matching a label selector.
+type PodSelector interface {
+	// PodSelector returns the label selector matching the resource pods.
+	PodSelector(path string) (*metav1.LabelSelector, error)
+}
+
+// ChaosMonkey represents a controller whose pods can be randomly killed.
+type ChaosMonkey interface {
+	// Victims returns up to count random pods owned by the controller.
+	Victims(path string, count int) ([]string, error)
+}
+
 // Nuker represents a resource deleter.
 type Nuker interface {
 	// Delete removes a resource from the api server.
@@ -135,12 +161,51 @@ type Restartable interface {
 	Restart(ctx context.Context, path string) error
 }
 
+// Promotable represents a progressive delivery resource.
+type Promotable interface {
+	// Promote advances a paused rollout. Full skips all remaining steps.
+	Promote(ctx context.Context, path string, full bool) error
+
+	// Abort aborts a rollout and reverts traffic to the stable version.
+	Abort(ctx context.Context, path string) error
+
+	// Retry restarts an aborted or failed rollout.
+	Retry(ctx context.Context, path string) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
 	Run(path string) error
 }
 
+// Suspendable represents a resource whose schedule can be suspended.
+type Suspendable interface {
+	// IsSuspended checks if a resource schedule is suspended.
+	IsSuspended(path string) (bool, error)
+
+	// Suspend suspends or resumes a resource schedule.
+	Suspend(ctx context.Context, path string, suspend bool) error
+}
+
+// Partitionable represents a resource rolled out in stages.
+type Partitionable interface {
+	// SetPartition sets the ordinal past which pods are updated.
+	SetPartition(ctx context.Context, path string, partition int32) error
+
+	// IsRolloutPaused checks if a resource rollout is paused.
+	IsRolloutPaused(path string) (bool, error)
+
+	// PauseRollout pauses or resumes a resource rollout.
+	PauseRollout(ctx context.Context, path string, pause bool) error
+}
+
+// Rerunnable represents a resource that can be run again.
+type Rerunnable interface {
+	// Rerun creates a new run off a resource and returns its name.
+	Rerun(ctx context.Context, path string) (string, error)
+}
+
 // Logger represents a resource that exposes logs.
 type Logger interface {
 	// Logs tails a resource logs.
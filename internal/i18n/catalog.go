@@ -0,0 +1,60 @@
+// Package i18n provides message catalogs for translating K9s UI strings.
+package i18n
+
+import (
+	"io/ioutil"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Catalog maps English messages to their translations.
+type Catalog map[string]string
+
+var (
+	catalog Catalog
+	mx      sync.RWMutex
+)
+
+// T returns the translation of a message or the message itself if none is found.
+func T(msg string) string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	if t, ok := catalog[msg]; ok && t != "" {
+		return t
+	}
+
+	return msg
+}
+
+// Load loads a message catalog from a yaml file.
+func Load(path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var c struct {
+		Messages Catalog `yaml:"messages"`
+	}
+	if err := yaml.Unmarshal(raw, &c); err != nil {
+		return err
+	}
+	Set(c.Messages)
+
+	return nil
+}
+
+// Set installs the given message catalog.
+func Set(c Catalog) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	catalog = c
+}
+
+// Reset reverts to the stock English messages.
+func Reset() {
+	Set(nil)
+}
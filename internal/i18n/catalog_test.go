@@ -0,0 +1,61 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestT(t *testing.T) {
+	defer i18n.Reset()
+
+	uu := map[string]struct {
+		c   i18n.Catalog
+		msg string
+		e   string
+	}{
+		"none": {
+			msg: "Delete",
+			e:   "Delete",
+		},
+		"translated": {
+			c:   i18n.Catalog{"Delete": "Supprimer"},
+			msg: "Delete",
+			e:   "Supprimer",
+		},
+		"missing": {
+			c:   i18n.Catalog{"Delete": "Supprimer"},
+			msg: "Describe",
+			e:   "Describe",
+		},
+		"blank": {
+			c:   i18n.Catalog{"Delete": ""},
+			msg: "Delete",
+			e:   "Delete",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i18n.Set(u.c)
+			assert.Equal(t, u.e, i18n.T(u.msg))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	defer i18n.Reset()
+
+	assert.Nil(t, i18n.Load("testdata/fr.yml"))
+	assert.Equal(t, "Annuler", i18n.T("Cancel"))
+	assert.Equal(t, "Affichage de %s", i18n.T("Viewing %s"))
+}
+
+func TestLoadMissing(t *testing.T) {
+	defer i18n.Reset()
+
+	assert.NotNil(t, i18n.Load("testdata/zorg.yml"))
+	assert.Equal(t, "Cancel", i18n.T("Cancel"))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 )
 
 // ClusterInfoListener registers a listener for model changes.
@@ -86,9 +87,9 @@ func (c *ClusterInfo) Reset(f dao.Factory) {
 // Refresh fetches latest cluster meta.
 func (c *ClusterInfo) Refresh() {
 	data := NewClusterMeta()
-	data.Context = c.cluster.ContextName()
-	data.Cluster = c.cluster.ClusterName()
-	data.User = c.cluster.UserName()
+	data.Context = render.Redact(c.cluster.ContextName())
+	data.Cluster = render.Redact(c.cluster.ClusterName())
+	data.User = render.Redact(c.cluster.UserName())
 	data.K9sVer = c.version
 	data.K8sVer = c.cluster.Version()
 
@@ -20,6 +20,7 @@ type FishBuff struct {
 	*CmdBuff
 
 	suggestionFn    SuggestionFunc
+	recallFn        SuggestionFunc
 	suggestions     []string
 	suggestionIndex int
 }
@@ -100,6 +101,20 @@ func (f *FishBuff) SetSuggestionFn(fn SuggestionFunc) {
 	f.suggestionFn = fn
 }
 
+// SetRecallFn sets up past commands recall.
+func (f *FishBuff) SetRecallFn(fn SuggestionFunc) {
+	f.recallFn = fn
+}
+
+// Recall returns the past commands matching a query, best match first.
+func (f *FishBuff) Recall(q string) []string {
+	if f.recallFn == nil {
+		return nil
+	}
+
+	return f.recallFn(q)
+}
+
 // Notify publish suggestions to all listeners.
 func (f *FishBuff) Notify() {
 	if f.suggestionFn == nil {
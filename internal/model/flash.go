@@ -3,8 +3,12 @@ package model
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 )
 
@@ -56,6 +60,16 @@ type Flash struct {
 	cancel  context.CancelFunc
 	delay   time.Duration
 	msgChan chan LevelMessage
+	lastErr *client.ErrorReport
+	repeat  flashRepeat
+	mx      sync.RWMutex
+}
+
+// flashRepeat tracks a repeated error message.
+type flashRepeat struct {
+	msg   string
+	shown bool
+	count int
 }
 
 // NewFlash returns a new instance.
@@ -66,6 +80,21 @@ func NewFlash(dur time.Duration) *Flash {
 	}
 }
 
+// SetDelay sets the flash clear delay.
+func (f *Flash) SetDelay(d time.Duration) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.delay = d
+}
+
+func (f *Flash) getDelay() time.Duration {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.delay
+}
+
 // Channel returns the flash channel.
 func (f *Flash) Channel() FlashChan {
 	return f.msgChan
@@ -73,29 +102,52 @@ func (f *Flash) Channel() FlashChan {
 
 // Info displays an info flash message.
 func (f *Flash) Info(msg string) {
-	f.SetMessage(FlashInfo, msg)
+	f.SetMessage(FlashInfo, i18n.T(msg))
 }
 
 // Infof displays a formatted info flash message.
 func (f *Flash) Infof(fmat string, args ...interface{}) {
-	f.Info(fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashInfo, fmt.Sprintf(i18n.T(fmat), args...))
 }
 
 // Warn displays a warning flash message.
 func (f *Flash) Warn(msg string) {
 	log.Warn().Msg(msg)
-	f.SetMessage(FlashWarn, msg)
+	f.SetMessage(FlashWarn, i18n.T(msg))
 }
 
 // Warnf displays a formatted warning flash message.
 func (f *Flash) Warnf(fmat string, args ...interface{}) {
-	f.Warn(fmt.Sprintf(fmat, args...))
+	log.Warn().Msgf(fmat, args...)
+	f.SetMessage(FlashWarn, fmt.Sprintf(i18n.T(fmat), args...))
 }
 
-// Err displays an error flash message.
+// Err displays an error flash message. Classified api errors are tagged and
+// retained so they can be inspected in the error pane. Error messages are
+// displayed as is.
 func (f *Flash) Err(err error) {
 	log.Error().Msg(err.Error())
-	f.SetMessage(FlashErr, err.Error())
+	r := client.ClassifyError(err)
+	if !r.Known() {
+		f.SetMessage(FlashErr, err.Error())
+		return
+	}
+	f.mx.Lock()
+	f.lastErr = &r
+	f.mx.Unlock()
+	f.SetMessage(FlashErr, fmt.Sprintf(i18n.T("%s: %s (:error for details)"), r.Kind, r.Message))
+}
+
+// LastErr returns the last classified error if any.
+func (f *Flash) LastErr() (client.ErrorReport, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	if f.lastErr == nil {
+		return client.ErrorReport{}, false
+	}
+
+	return *f.lastErr, true
 }
 
 // Errf displays a formatted error flash message.
@@ -108,16 +160,23 @@ func (f *Flash) Errf(fmat string, args ...interface{}) {
 		}
 	}
 	log.Error().Err(err).Msgf(fmat, args...)
-	f.SetMessage(FlashErr, fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashErr, fmt.Sprintf(i18n.T(fmat), args...))
 }
 
 // Clear clears the flash message.
 func (f *Flash) Clear() {
+	f.hide()
 	f.fireCleared()
 }
 
-// SetMessage sets the flash level message.
+// SetMessage sets the flash level message. Errors repeating while the same
+// error is still displayed, ie failing watches, are not flashed again. Names
+// and sensitive values are masked in demo and privacy modes.
 func (f *Flash) SetMessage(level FlashLevel, msg string) {
+	var ok bool
+	if msg, ok = f.dedup(level, render.RedactMessage(msg)); !ok {
+		return
+	}
 	if f.cancel != nil {
 		f.cancel()
 		f.cancel = nil
@@ -131,12 +190,47 @@ func (f *Flash) SetMessage(level FlashLevel, msg string) {
 	go f.refresh(ctx)
 }
 
+// dedup checks if a message must be flashed. Identical errors are
+// suppressed while displayed and annotated once flashed again.
+func (f *Flash) dedup(level FlashLevel, msg string) (string, bool) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	r := &f.repeat
+	if level != FlashErr {
+		r.shown = false
+		return msg, true
+	}
+	if r.msg == msg && r.shown {
+		r.count++
+		return msg, false
+	}
+	count := r.count
+	if r.msg != msg {
+		count = 0
+	}
+	f.repeat = flashRepeat{msg: msg, shown: true}
+	if count > 0 {
+		return fmt.Sprintf("%s (repeated %d times)", msg, count), true
+	}
+
+	return msg, true
+}
+
+// hide notes the current message is no longer displayed.
+func (f *Flash) hide() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.repeat.shown = false
+}
+
 func (f *Flash) refresh(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(f.delay):
+		case <-time.After(f.getDelay()):
+			f.hide()
 			f.fireCleared()
 			return
 		}
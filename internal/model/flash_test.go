@@ -6,8 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestFlash(t *testing.T) {
@@ -48,6 +52,55 @@ func TestFlash(t *testing.T) {
 	}
 }
 
+func TestFlashTranslatedOnce(t *testing.T) {
+	i18n.Set(i18n.Catalog{"Deleted %s": "Delete %s", "Delete fred": "Boom"})
+	defer i18n.Reset()
+
+	const delay = 1 * time.Millisecond
+	for _, level := range []model.FlashLevel{model.FlashInfo, model.FlashWarn} {
+		f := model.NewFlash(delay)
+		v := newFlash()
+		go v.listen(f.Channel())
+
+		if level == model.FlashInfo {
+			f.Infof("Deleted %s", "fred")
+		} else {
+			f.Warnf("Deleted %s", "fred")
+		}
+
+		time.Sleep(5 * delay)
+		_, l, m := v.getMetrics()
+		assert.Equal(t, level, l)
+		assert.Equal(t, "Delete fred", m)
+	}
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+	f.Err(errors.New("Delete fred"))
+	time.Sleep(5 * delay)
+	_, _, m := v.getMetrics()
+	assert.Equal(t, "Delete fred", m)
+}
+
+func TestFlashLastErr(t *testing.T) {
+	f := model.NewFlash(1 * time.Millisecond)
+	v := newFlash()
+	go v.listen(f.Channel())
+
+	f.Err(errors.New("blee"))
+	_, ok := f.LastErr()
+	assert.False(t, ok)
+
+	f.Err(apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "fred"))
+	r, ok := f.LastErr()
+	assert.True(t, ok)
+	assert.Equal(t, client.ErrKindNotFound, r.Kind)
+	time.Sleep(5 * time.Millisecond)
+	_, _, m := v.getMetrics()
+	assert.Equal(t, `NotFound: pods "fred" not found (:error for details)`, m)
+}
+
 func TestFlashBurst(t *testing.T) {
 	const delay = 1 * time.Millisecond
 
@@ -67,6 +120,43 @@ func TestFlashBurst(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("test-%d", count), m)
 }
 
+func TestFlashRepeatedErr(t *testing.T) {
+	const delay = 50 * time.Millisecond
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+
+	for i := 0; i < 5; i++ {
+		f.Err(errors.New("watch failed"))
+	}
+	f.Err(errors.New("blee"))
+
+	time.Sleep(2 * delay)
+	s, l, m := v.getMetrics()
+	assert.Equal(t, 2, s)
+	assert.Equal(t, model.FlashErr, l)
+	assert.Equal(t, "blee", m)
+}
+
+func TestFlashRepeatedErrCleared(t *testing.T) {
+	const delay = 10 * time.Millisecond
+
+	f := model.NewFlash(delay)
+	v := newFlash()
+	go v.listen(f.Channel())
+
+	f.Err(errors.New("watch failed"))
+	f.Err(errors.New("watch failed"))
+	time.Sleep(3 * delay)
+	f.Err(errors.New("watch failed"))
+
+	time.Sleep(delay / 2)
+	s, _, m := v.getMetrics()
+	assert.Equal(t, 2, s)
+	assert.Equal(t, "watch failed (repeated 1 times)", m)
+}
+
 type flash struct {
 	set, clear int
 	level      model.FlashLevel
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Frecency ranks entries by how often and how recently they were used.
+type Frecency struct {
+	hits map[string]usage
+	mx   sync.RWMutex
+}
+
+type usage struct {
+	Count int       `json:"count"`
+	Last  time.Time `json:"last"`
+}
+
+// NewFrecency returns a new instance.
+func NewFrecency() *Frecency {
+	return &Frecency{hits: make(map[string]usage)}
+}
+
+// LoadFrecency loads entries ranks from a file if present.
+func LoadFrecency(path string) (*Frecency, error) {
+	f := NewFrecency()
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(raw, &f.hits); err != nil {
+		return nil, err
+	}
+	if f.hits == nil {
+		f.hits = make(map[string]usage)
+	}
+
+	return f, nil
+}
+
+// Save persists entries ranks to a file.
+func (f *Frecency) Save(path string) error {
+	f.mx.RLock()
+	raw, err := yaml.Marshal(f.hits)
+	f.mx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// Track records a use of the given entry.
+func (f *Frecency) Track(k string, now time.Time) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	u := f.hits[k]
+	u.Count++
+	u.Last = now
+	f.hits[k] = u
+}
+
+// Score returns the entry rank. Recent uses weigh more than older ones.
+func (f *Frecency) Score(k string, now time.Time) float64 {
+	f.mx.RLock()
+	u, ok := f.hits[k]
+	f.mx.RUnlock()
+	if !ok {
+		return 0
+	}
+	w := 0.5
+	switch age := now.Sub(u.Last); {
+	case age < time.Hour:
+		w = 4
+	case age < 24*time.Hour:
+		w = 2
+	case age < 7*24*time.Hour:
+		w = 1
+	}
+
+	return float64(u.Count) * w
+}
+
+// Sort orders the given entries by descending rank, preserving the order of ties.
+func (f *Frecency) Sort(kk []string, now time.Time) {
+	sort.SliceStable(kk, func(i, j int) bool {
+		return f.Score(kk[i], now) > f.Score(kk[j], now)
+	})
+}
@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrecencyScore(t *testing.T) {
+	now := time.Now()
+	f := model.NewFrecency()
+	f.Track("pods", now.Add(-2*time.Hour))
+	f.Track("pods", now.Add(-time.Minute))
+	f.Track("svc", now.Add(-48*time.Hour))
+	f.Track("dp", now.Add(-30*24*time.Hour))
+
+	uu := map[string]struct {
+		k string
+		e float64
+	}{
+		"recent": {k: "pods", e: 8},
+		"week":   {k: "svc", e: 1},
+		"old":    {k: "dp", e: 0.5},
+		"none":   {k: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f.Score(u.k, now))
+		})
+	}
+}
+
+func TestFrecencySort(t *testing.T) {
+	now := time.Now()
+	f := model.NewFrecency()
+	f.Track("svc", now)
+	f.Track("dp", now.Add(-48*time.Hour))
+
+	kk := []string{"pods", "dp", "cm", "svc"}
+	f.Sort(kk, now)
+	assert.Equal(t, []string{"svc", "dp", "pods", "cm"}, kk)
+}
+
+func TestFrecencyPersist(t *testing.T) {
+	now := time.Now()
+	f := model.NewFrecency()
+	f.Track("pods", now)
+	f.Track("pods", now)
+
+	dir, err := ioutil.TempDir("", "k9s-frecency")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "palette.yml")
+	assert.Nil(t, f.Save(path))
+
+	l, err := model.LoadFrecency(path)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(8), l.Score("pods", now))
+
+	l, err = model.LoadFrecency(filepath.Join(dir, "none.yml"))
+	assert.Nil(t, err)
+	assert.Equal(t, float64(0), l.Score("pods", now))
+}
@@ -1,9 +1,14 @@
 package model
 
 import (
+	"io/ioutil"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/rs/zerolog/log"
+	"github.com/sahilm/fuzzy"
+	"sigs.k8s.io/yaml"
 )
 
 // MaxHistory tracks max command history
@@ -13,6 +18,7 @@ const MaxHistory = 20
 type History struct {
 	commands []string
 	limit    int
+	mx       sync.RWMutex
 }
 
 // NewHistory returns a new instance.
@@ -24,7 +30,10 @@ func NewHistory(limit int) *History {
 
 // List returns the current command history.
 func (h *History) List() []string {
-	return h.commands
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return append([]string(nil), h.commands...)
 }
 
 // Push adds a new item.
@@ -34,6 +43,8 @@ func (h *History) Push(c string) {
 	}
 
 	c = strings.ToLower(c)
+	h.mx.Lock()
+	defer h.mx.Unlock()
 	if i := h.indexOf(c); i != -1 {
 		return
 	}
@@ -47,14 +58,35 @@ func (h *History) Push(c string) {
 // Clear clears out the stack.
 func (h *History) Clear() {
 	log.Debug().Msgf("History CLEARED!!!")
+	h.mx.Lock()
+	defer h.mx.Unlock()
 	h.commands = nil
 }
 
 // Empty returns true if no history.
 func (h *History) Empty() bool {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
 	return len(h.commands) == 0
 }
 
+// Recall returns the commands fuzzy matching a query, best and most recent
+// matches first.
+func (h *History) Recall(q string) []string {
+	cc := h.List()
+	if q == "" {
+		return cc
+	}
+	mm := fuzzy.Find(strings.ToLower(q), cc)
+	rr := make([]string, 0, len(mm))
+	for _, m := range mm {
+		rr = append(rr, m.Str)
+	}
+
+	return rr
+}
+
 func (h *History) indexOf(s string) int {
 	for i, c := range h.commands {
 		if c == s {
@@ -63,3 +95,78 @@ func (h *History) indexOf(s string) int {
 	}
 	return -1
 }
+
+// ----------------------------------------------------------------------------
+
+// Histories tracks commands histories per cluster context.
+type Histories struct {
+	limit    int
+	contexts map[string]*History
+	mx       sync.Mutex
+}
+
+// NewHistories returns a new instance.
+func NewHistories(limit int) *Histories {
+	return &Histories{
+		limit:    limit,
+		contexts: make(map[string]*History),
+	}
+}
+
+// LoadHistories loads commands histories from disk. A missing file yields
+// blank histories.
+func LoadHistories(path string, limit int) (*Histories, error) {
+	hh := NewHistories(limit)
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return hh, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cc map[string][]string
+	if err := yaml.Unmarshal(raw, &cc); err != nil {
+		return nil, err
+	}
+	for ctx, commands := range cc {
+		h := hh.For(ctx)
+		for i := len(commands) - 1; i >= 0; i-- {
+			h.Push(commands[i])
+		}
+	}
+
+	return hh, nil
+}
+
+// For returns a context history.
+func (h *Histories) For(ctx string) *History {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	hh, ok := h.contexts[ctx]
+	if !ok {
+		hh = NewHistory(h.limit)
+		h.contexts[ctx] = hh
+	}
+
+	return hh
+}
+
+// Save persists the non empty histories.
+func (h *Histories) Save(path string) error {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	cc := make(map[string][]string, len(h.contexts))
+	for ctx, hh := range h.contexts {
+		if !hh.Empty() {
+			cc[ctx] = hh.List()
+		}
+	}
+	raw, err := yaml.Marshal(cc)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
@@ -2,6 +2,9 @@ package model_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/model"
@@ -29,3 +32,37 @@ func TestHistoryDups(t *testing.T) {
 
 	assert.Equal(t, []string{"cmd3", "cmd2", "cmd1"}, h.List())
 }
+
+func TestHistoryRecall(t *testing.T) {
+	h := model.NewHistory(5)
+	for _, c := range []string{"deploy", "svc", "po kube-system", "ctx dev"} {
+		h.Push(c)
+	}
+
+	assert.Equal(t, h.List(), h.Recall(""))
+	assert.Equal(t, []string{"po kube-system", "deploy"}, h.Recall("PO"))
+	assert.Equal(t, []string{"ctx dev"}, h.Recall("cdv"))
+	assert.Equal(t, 0, len(h.Recall("blee")))
+}
+
+func TestHistoriesPersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-history")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "history.yml")
+
+	hh, err := model.LoadHistories(path, 2)
+	assert.Nil(t, err)
+	for _, c := range []string{"po", "svc", "deploy"} {
+		hh.For("c1").Push(c)
+	}
+	hh.For("c2").Push("ns")
+	hh.For("c3")
+	assert.Nil(t, hh.Save(path))
+
+	hh, err = model.LoadHistories(path, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"deploy", "svc"}, hh.For("c1").List())
+	assert.Equal(t, []string{"ns"}, hh.For("c2").List())
+	assert.True(t, hh.For("c3").Empty())
+}
@@ -0,0 +1,99 @@
+package model
+
+import "sync"
+
+// MaxJournal tracks the max number of reversible operations per context.
+const MaxJournal = 20
+
+// Operation represents a reversible operation.
+type Operation struct {
+	// Context tracks the cluster context the operation was performed on.
+	Context string
+
+	// Description describes the operation.
+	Description string
+
+	// Inverse describes the operation that reverts this one.
+	Inverse string
+
+	// Revert reverts the operation.
+	Revert func() error
+}
+
+// Journal tracks reversible operations per context, most recent first.
+type Journal struct {
+	ops   map[string][]Operation
+	limit int
+	mx    sync.RWMutex
+}
+
+// NewJournal returns a new instance.
+func NewJournal(limit int) *Journal {
+	return &Journal{
+		ops:   make(map[string][]Operation),
+		limit: limit,
+	}
+}
+
+// Push records a new operation for its context.
+func (j *Journal) Push(op Operation) {
+	if op.Revert == nil {
+		return
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	oo := j.ops[op.Context]
+	if len(oo) >= j.limit {
+		oo = oo[:j.limit-1]
+	}
+	j.ops[op.Context] = append([]Operation{op}, oo...)
+}
+
+// Peek returns the most recent operation of a given context.
+func (j *Journal) Peek(ctx string) (Operation, bool) {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+	oo := j.ops[ctx]
+	if len(oo) == 0 {
+		return Operation{}, false
+	}
+
+	return oo[0], true
+}
+
+// Pop removes and returns the most recent operation of a given context.
+func (j *Journal) Pop(ctx string) (Operation, bool) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	oo := j.ops[ctx]
+	if len(oo) == 0 {
+		return Operation{}, false
+	}
+	j.ops[ctx] = oo[1:]
+
+	return oo[0], true
+}
+
+// List returns the operations recorded for a given context.
+func (j *Journal) List(ctx string) []Operation {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	return append([]Operation(nil), j.ops[ctx]...)
+}
+
+// Empty returns true if no operations were recorded for a given context.
+func (j *Journal) Empty(ctx string) bool {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	return len(j.ops[ctx]) == 0
+}
+
+// Clear clears out the journal.
+func (j *Journal) Clear() {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	j.ops = make(map[string][]Operation)
+}
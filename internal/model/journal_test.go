@@ -0,0 +1,55 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal(t *testing.T) {
+	j := model.NewJournal(3)
+	for i := 1; i < 5; i++ {
+		j.Push(model.Operation{
+			Context:     "c1",
+			Description: fmt.Sprintf("op%d", i),
+			Revert:      func() error { return nil },
+		})
+	}
+	j.Push(model.Operation{Context: "c1", Description: "noRevert"})
+
+	assert.Equal(t, 3, len(j.List("c1")))
+	op, ok := j.Peek("c1")
+	assert.True(t, ok)
+	assert.Equal(t, "op4", op.Description)
+
+	op, ok = j.Pop("c1")
+	assert.True(t, ok)
+	assert.Equal(t, "op4", op.Description)
+	op, _ = j.Peek("c1")
+	assert.Equal(t, "op3", op.Description)
+
+	j.Clear()
+	assert.True(t, j.Empty("c1"))
+	_, ok = j.Pop("c1")
+	assert.False(t, ok)
+}
+
+func TestJournalContexts(t *testing.T) {
+	j := model.NewJournal(3)
+	j.Push(model.Operation{Context: "c1", Description: "op1", Revert: func() error { return nil }})
+	j.Push(model.Operation{Context: "c2", Description: "op2", Revert: func() error { return nil }})
+
+	op, ok := j.Peek("c1")
+	assert.True(t, ok)
+	assert.Equal(t, "op1", op.Description)
+	_, ok = j.Pop("c3")
+	assert.False(t, ok)
+
+	op, ok = j.Pop("c2")
+	assert.True(t, ok)
+	assert.Equal(t, "op2", op.Description)
+	assert.True(t, j.Empty("c2"))
+	assert.False(t, j.Empty("c1"))
+}
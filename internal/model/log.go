@@ -37,6 +37,9 @@ type Log struct {
 	filter       string
 	lastSent     int
 	flushTimeout time.Duration
+	backend      dao.LogBackend
+	backendCfg   *config.LogBackend
+	historyEnd   time.Time
 }
 
 // NewLog returns a new model.
@@ -73,6 +76,21 @@ func (l *Log) Configure(opts *config.Logger) {
 	l.logOptions.SinceSeconds = opts.SinceSeconds
 }
 
+// SetBackend sets a historical logs backend merged ahead of the live logs.
+func (l *Log) SetBackend(cfg *config.LogBackend) error {
+	if !cfg.IsEnabled() {
+		l.backend, l.backendCfg = nil, nil
+		return nil
+	}
+	b, err := dao.NewLogBackend(cfg)
+	if err != nil {
+		return err
+	}
+	l.backend, l.backendCfg = b, cfg
+
+	return nil
+}
+
 // GetPath returns resource path.
 func (l *Log) GetPath() string {
 	return l.logOptions.Path
@@ -92,7 +110,7 @@ func (l *Log) Init(f dao.Factory) {
 func (l *Log) Clear() {
 	l.mx.Lock()
 	{
-		l.lines, l.lastSent = dao.LogItems{}, 0
+		l.lines, l.lastSent, l.historyEnd = dao.LogItems{}, 0, time.Time{}
 	}
 	l.mx.Unlock()
 	l.fireLogCleared()
@@ -132,6 +150,9 @@ func (l *Log) Stop() {
 func (l *Log) Set(items dao.LogItems) {
 	l.mx.Lock()
 	defer l.mx.Unlock()
+	for _, item := range items {
+		item.Parse()
+	}
 	l.lines = items
 	l.fireLogCleared()
 	l.fireLogChanged(items)
@@ -182,19 +203,65 @@ func (l *Log) load() error {
 		}
 		return err
 	}
+	if l.backend != nil && l.gvr == client.NewGVR("v1/pods") && !l.logOptions.Previous {
+		go l.loadHistory(ctx)
+	}
 
 	return nil
 }
 
+// loadHistory merges the backend logs rotated away from the kubelet ahead of
+// the live logs.
+func (l *Log) loadHistory(ctx context.Context) {
+	ii, err := dao.HistoricalLogs(ctx, l.backend, l.logOptions, l.backendCfg.Since, l.backendCfg.Limit)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error().Err(err).Msgf("Historical logs failed")
+			l.fireLogError(fmt.Errorf("historical logs failed: %w", err))
+		}
+		return
+	}
+	if len(ii) == 0 || ctx.Err() != nil {
+		return
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	if len(l.lines) == 0 {
+		if t, err := time.Parse(time.RFC3339Nano, ii[len(ii)-1].Timestamp); err == nil {
+			l.historyEnd = t
+		}
+	}
+	for _, i := range ii {
+		i.Parse()
+	}
+	l.lines = dao.MergeLogs(ii, l.lines)
+	if n := int(l.logOptions.Lines); n > 0 && len(l.lines) > n {
+		l.lines = l.lines[len(l.lines)-n:]
+	}
+	l.lastSent = len(l.lines)
+	l.fireLogCleared()
+	l.fireLogChanged(l.lines)
+}
+
 // Append adds a log line.
 func (l *Log) Append(line *dao.LogItem) {
 	if line == nil || line.IsEmpty() {
 		return
 	}
+	line.Parse()
 
 	l.mx.Lock()
 	defer l.mx.Unlock()
 
+	// Skip live lines already pulled from the historical logs backend.
+	if !l.historyEnd.IsZero() {
+		if t, err := time.Parse(time.RFC3339Nano, line.Timestamp); err == nil && !t.After(l.historyEnd) {
+			return
+		}
+		l.historyEnd = time.Time{}
+	}
+
 	l.logOptions.SinceTime = line.Timestamp
 	if l.lines == nil {
 		l.fireLogCleared()
@@ -7,9 +7,11 @@ import (
 
 // MenuHint represents keyboard mnemonic.
 type MenuHint struct {
+	ID          string
 	Mnemonic    string
 	Description string
 	Visible     bool
+	Disabled    bool
 }
 
 // IsBlank checks if menu hint is a place holder.
@@ -0,0 +1,223 @@
+package model
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// MaxRecordingFrames tracks the max number of frames kept by a recording.
+const MaxRecordingFrames = 2_000
+
+// Frame represents a recorded view state.
+type Frame struct {
+	At        time.Time    `json:"at"`
+	View      string       `json:"view"`
+	Namespace string       `json:"namespace,omitempty"`
+	Header    []string     `json:"header"`
+	Rows      []render.Row `json:"rows,omitempty"`
+}
+
+// NewFrame returns a frame snapshotting the given view data.
+func NewFrame(at time.Time, view string, data render.TableData) Frame {
+	f := Frame{
+		At:        at,
+		View:      view,
+		Namespace: data.Namespace,
+		Header:    data.Header.Columns(true),
+		Rows:      make([]render.Row, 0, len(data.RowEvents)),
+	}
+	for _, re := range data.RowEvents {
+		f.Rows = append(f.Rows, re.Row)
+	}
+
+	return f
+}
+
+// Redact returns a copy of the frame with the values of a given resource
+// masked by the privacy rules. Rows ids are masked along with their names.
+func (f Frame) Redact(gvr string) Frame {
+	if !render.IsPrivacyMode() {
+		return f
+	}
+	rows := make([]render.Row, 0, len(f.Rows))
+	for _, r := range f.Rows {
+		row := render.Row{
+			ID:     render.RedactCell(gvr, "NAME", r.ID),
+			Fields: make(render.Fields, len(r.Fields)),
+		}
+		for i, field := range r.Fields {
+			if i < len(f.Header) {
+				field = render.RedactCell(gvr, f.Header[i], field)
+			}
+			row.Fields[i] = field
+		}
+		rows = append(rows, row)
+	}
+	f.Rows = rows
+	f.Namespace = render.RedactText(f.Namespace)
+
+	return f
+}
+
+// TableData returns the frame as table data.
+func (f Frame) TableData() render.TableData {
+	data := render.TableData{
+		Header:    make(render.Header, 0, len(f.Header)),
+		RowEvents: make(render.RowEvents, 0, len(f.Rows)),
+		Namespace: f.Namespace,
+	}
+	for _, h := range f.Header {
+		data.Header = append(data.Header, render.HeaderColumn{Name: h})
+	}
+	for _, r := range f.Rows {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, r))
+	}
+
+	return data
+}
+
+func (f Frame) same(o Frame) bool {
+	return f.View == o.View && f.Namespace == o.Namespace &&
+		reflect.DeepEqual(f.Header, o.Header) && reflect.DeepEqual(f.Rows, o.Rows)
+}
+
+// Recording tracks a session frames and a playback position.
+type Recording struct {
+	frames []Frame
+	index  int
+	limit  int
+	mx     sync.RWMutex
+}
+
+// NewRecording returns a new recording keeping at most limit frames.
+func NewRecording(limit int) *Recording {
+	return &Recording{limit: limit}
+}
+
+// LoadRecording loads a recording from a json lines file.
+func LoadRecording(path string) (*Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing recording %q", path)
+		}
+	}()
+
+	var r Recording
+	d := json.NewDecoder(bufio.NewReader(f))
+	for d.More() {
+		var fr Frame
+		if err := d.Decode(&fr); err != nil {
+			return nil, err
+		}
+		r.frames = append(r.frames, fr)
+	}
+
+	return &r, nil
+}
+
+// Record appends a frame unless nothing changed since the last one. The
+// oldest frames are dropped once the recording limit is reached.
+func (r *Recording) Record(f Frame) bool {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if l := len(r.frames); l > 0 && r.frames[l-1].same(f) {
+		return false
+	}
+	if r.limit > 0 && len(r.frames) >= r.limit {
+		r.frames = append(r.frames[:0], r.frames[len(r.frames)-r.limit+1:]...)
+	}
+	r.frames = append(r.frames, f)
+
+	return true
+}
+
+// Len returns the number of frames.
+func (r *Recording) Len() int {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return len(r.frames)
+}
+
+// Save persists the frames as json lines.
+func (r *Recording) Save(path string) error {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing recording %q", path)
+		}
+	}()
+
+	e := json.NewEncoder(f)
+	for _, fr := range r.frames {
+		if err := e.Encode(fr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Current returns the frame at the playback position.
+func (r *Recording) Current() (Frame, bool) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	if len(r.frames) == 0 {
+		return Frame{}, false
+	}
+
+	return r.frames[r.index], true
+}
+
+// Position returns the playback frame number and the frames count.
+func (r *Recording) Position() (int, int) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return r.index + 1, len(r.frames)
+}
+
+// Next steps forward. Returns false if already on the last frame.
+func (r *Recording) Next() bool {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.index+1 >= len(r.frames) {
+		return false
+	}
+	r.index++
+
+	return true
+}
+
+// Prev steps back. Returns false if already on the first frame.
+func (r *Recording) Prev() bool {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.index == 0 {
+		return false
+	}
+	r.index--
+
+	return true
+}
@@ -0,0 +1,89 @@
+package model_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingRecord(t *testing.T) {
+	r := model.NewRecording(model.MaxRecordingFrames)
+	now := time.Now()
+
+	assert.True(t, r.Record(model.NewFrame(now, "pods", frameData("fred"))))
+	assert.False(t, r.Record(model.NewFrame(now.Add(time.Second), "pods", frameData("fred"))))
+	assert.True(t, r.Record(model.NewFrame(now.Add(2*time.Second), "pods", frameData("fred", "blee"))))
+	assert.Equal(t, 2, r.Len())
+}
+
+func TestRecordingLimit(t *testing.T) {
+	r := model.NewRecording(2)
+	now := time.Now()
+	r.Record(model.NewFrame(now, "pods", frameData("fred")))
+	r.Record(model.NewFrame(now, "svc", frameData("blee")))
+	r.Record(model.NewFrame(now, "dp", frameData("zorg")))
+
+	assert.Equal(t, 2, r.Len())
+	f, ok := r.Current()
+	assert.True(t, ok)
+	assert.Equal(t, "svc", f.View)
+}
+
+func TestRecordingPlayback(t *testing.T) {
+	r := model.NewRecording(model.MaxRecordingFrames)
+	now := time.Now()
+	r.Record(model.NewFrame(now, "pods", frameData("fred")))
+	r.Record(model.NewFrame(now, "svc", frameData("blee")))
+
+	dir, err := ioutil.TempDir("", "k9s-rec")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.rec")
+	assert.Nil(t, r.Save(path))
+	rr, err := model.LoadRecording(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rr.Len())
+
+	f, ok := rr.Current()
+	assert.True(t, ok)
+	assert.Equal(t, "pods", f.View)
+	assert.False(t, rr.Prev())
+	assert.True(t, rr.Next())
+	f, _ = rr.Current()
+	assert.Equal(t, "svc", f.View)
+	assert.False(t, rr.Next())
+	n, total := rr.Position()
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, total)
+
+	data := f.TableData()
+	assert.Equal(t, "ns-1", data.Namespace)
+	assert.Equal(t, []string{"NAME", "AGE"}, data.Header.Columns(true))
+	assert.Equal(t, "blee", data.RowEvents[0].Row.Fields[0])
+}
+
+// Helpers...
+
+func frameData(nn ...string) render.TableData {
+	data := render.TableData{
+		Namespace: "ns-1",
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "AGE", Time: true},
+		},
+	}
+	for _, n := range nn {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventAdd, render.Row{
+			ID:     "ns-1/" + n,
+			Fields: render.Fields{n, "1m"},
+		}))
+	}
+
+	return data
+}
@@ -34,6 +34,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.ScreenDump{},
 		Renderer: &render.ScreenDump{},
 	},
+	"trash": {
+		DAO:      &dao.Trash{},
+		Renderer: &render.Trash{},
+	},
 	"rbac": {
 		DAO:      &dao.Rbac{},
 		Renderer: &render.Rbac{},
@@ -42,6 +46,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Policy{},
 		Renderer: &render.Policy{},
 	},
+	"whocan": {
+		DAO:      &dao.WhoCan{},
+		Renderer: &render.WhoCan{},
+	},
 	"users": {
 		DAO:      &dao.Subject{},
 		Renderer: &render.Subject{},
@@ -84,6 +92,7 @@ var Registry = map[string]ResourceMeta{
 		TreeRenderer: &xray.Pod{},
 	},
 	"v1/namespaces": {
+		DAO:      &dao.Namespace{},
 		Renderer: &render.Namespace{},
 	},
 	"v1/nodes": {
@@ -112,6 +121,7 @@ var Registry = map[string]ResourceMeta{
 		TreeRenderer: &xray.Deployment{},
 	},
 	"apps/v1/replicasets": {
+		DAO:          &dao.ReplicaSet{},
 		Renderer:     &render.ReplicaSet{},
 		TreeRenderer: &xray.ReplicaSet{},
 	},
@@ -182,6 +192,44 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
+	// Karpenter...
+	"karpenter.sh/v1/nodepools": {
+		Renderer: &render.NodePool{},
+	},
+	"karpenter.sh/v1beta1/nodepools": {
+		Renderer: &render.NodePool{},
+	},
+	"karpenter.sh/v1/nodeclaims": {
+		Renderer: &render.NodeClaim{},
+	},
+	"karpenter.sh/v1beta1/nodeclaims": {
+		Renderer: &render.NodeClaim{},
+	},
+
+	// Progressive delivery...
+	"argoproj.io/v1alpha1/rollouts": {
+		DAO:      &dao.Rollout{},
+		Renderer: &render.Rollout{},
+	},
+	"argoproj.io/v1alpha1/analysisruns": {
+		Renderer: &render.AnalysisRun{},
+	},
+	"flagger.app/v1beta1/canaries": {
+		DAO:      &dao.Canary{},
+		Renderer: &render.Canary{},
+	},
+
+	// Knative...
+	"serving.knative.dev/v1/services": {
+		Renderer: &render.KnService{},
+	},
+	"serving.knative.dev/v1/revisions": {
+		Renderer: &render.KnRevision{},
+	},
+	"serving.knative.dev/v1/routes": {
+		Renderer: &render.KnRoute{},
+	},
+
 	// RBAC...
 	"rbac.authorization.k8s.io/v1/clusterroles": {
 		DAO:      &dao.Rbac{},
@@ -13,10 +13,16 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const initRefreshRate = 300 * time.Millisecond
+const (
+	initRefreshRate = 300 * time.Millisecond
+
+	// frozenCheckRate tracks how often a frozen table checks whether auto refresh resumed.
+	frozenCheckRate = time.Second
+)
 
 // TableListener represents a table model listener.
 type TableListener interface {
@@ -156,7 +162,8 @@ func (t *Table) InNamespace(ns string) bool {
 	return len(t.data.RowEvents) > 0 && t.namespace == ns
 }
 
-// SetRefreshRate sets model refresh duration.
+// SetRefreshRate sets model refresh duration. A zero rate freezes the table
+// so that it only refreshes on demand.
 func (t *Table) SetRefreshRate(d time.Duration) {
 	t.refreshRate = d
 }
@@ -189,6 +196,10 @@ func (t *Table) updater(ctx context.Context) {
 			return
 		case <-time.After(rate):
 			rate = t.refreshRate
+			if rate <= 0 {
+				rate = frozenCheckRate
+				continue
+			}
 			t.refresh(ctx)
 		}
 	}
@@ -224,6 +235,42 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 	return a.List(ctx, ns)
 }
 
+// joins indexes the related resources referenced by the custom columns off
+// the informers caches.
+func (t *Table) joins(ctx context.Context, jj []render.ColumnJoin) render.Joins {
+	if len(jj) == 0 {
+		return nil
+	}
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil
+	}
+
+	rr := make(render.Joins, len(jj))
+	for _, j := range jj {
+		meta, err := dao.MetaAccess.MetaFor(client.NewGVR(j.GVR))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to join %q", j.GVR)
+			continue
+		}
+		ns := client.ClusterScope
+		if meta.Namespaced {
+			ns = client.CleanseNamespace(t.namespace)
+			if client.IsClusterScoped(t.namespace) {
+				ns = client.AllNamespaces
+			}
+		}
+		oo, err := factory.List(j.GVR, ns, false, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to join %q", j.GVR)
+			continue
+		}
+		rr[j] = render.NewJoinIndex(j, oo)
+	}
+
+	return rr
+}
+
 func (t *Table) reconcile(ctx context.Context) error {
 	meta := t.resourceMeta()
 	var (
@@ -243,7 +290,7 @@ func (t *Table) reconcile(ctx context.Context) error {
 
 	var rows render.Rows
 	if len(oo) > 0 {
-		if _, ok := meta.Renderer.(*render.Generic); ok {
+		if isGeneric(meta.Renderer) {
 			table, ok := oo[0].(*metav1beta1.Table)
 			if !ok {
 				return fmt.Errorf("expecting a meta table but got %T", oo[0])
@@ -259,6 +306,14 @@ func (t *Table) reconcile(ctx context.Context) error {
 			}
 		}
 	}
+	header := meta.Renderer.Header(t.namespace)
+	if extra, ok := render.ExtraColumnsFor(t.gvr.String()); ok && !isGeneric(meta.Renderer) {
+		jj := t.joins(ctx, extra.Joins())
+		for i := range rows {
+			extra.RenderJoined(oo[i], header, &rows[i], jj)
+		}
+		header = extra.Header(header)
+	}
 
 	t.mx.Lock()
 	defer t.mx.Unlock()
@@ -268,7 +323,7 @@ func (t *Table) reconcile(ctx context.Context) error {
 		t.data.Clear()
 	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.data.SetHeader(t.namespace, header)
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -295,6 +350,12 @@ func (t *Table) resourceMeta() ResourceMeta {
 			DAO:      &dao.Table{},
 			Renderer: &render.Generic{},
 		}
+		if cc, ok := dao.MetaAccess.CRDColumnsFor(t.gvr); ok {
+			meta = ResourceMeta{
+				DAO:      &dao.Resource{},
+				Renderer: render.NewCustomResource(cc),
+			}
+		}
 	}
 	if meta.DAO == nil {
 		meta.DAO = &dao.Resource{}
@@ -328,6 +389,22 @@ func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error
 	return nil
 }
 
+// HasGenericRenderer checks if a known resource is rendered off the api
+// server table columns, in which case user defined columns are not supported.
+func HasGenericRenderer(gvr client.GVR) bool {
+	if _, err := dao.MetaAccess.MetaFor(gvr); err != nil {
+		return false
+	}
+	t := Table{gvr: gvr}
+
+	return isGeneric(t.resourceMeta().Renderer)
+}
+
+func isGeneric(re Renderer) bool {
+	_, ok := re.(*render.Generic)
+	return ok
+}
+
 func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Renderer) error {
 	gr, ok := re.(*render.Generic)
 	if !ok {
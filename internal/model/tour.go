@@ -0,0 +1,56 @@
+package model
+
+// TourStep represents a guided tour step.
+type TourStep struct {
+	// Message describes what to do next.
+	Message string
+
+	// Keys lists the key names completing the step.
+	Keys []string
+}
+
+// Tour walks users through a series of steps advancing on keypresses.
+type Tour struct {
+	steps []TourStep
+	index int
+}
+
+// NewTour returns a new tour.
+func NewTour(steps []TourStep) *Tour {
+	return &Tour{steps: steps}
+}
+
+// Current returns the current step if the tour is not done.
+func (t *Tour) Current() (TourStep, bool) {
+	if t.Done() {
+		return TourStep{}, false
+	}
+
+	return t.steps[t.index], true
+}
+
+// Progress returns the current step number and the total steps count.
+func (t *Tour) Progress() (int, int) {
+	return t.index + 1, len(t.steps)
+}
+
+// Advance moves to the next step if the given key completes the current one.
+func (t *Tour) Advance(key string) bool {
+	s, ok := t.Current()
+	if !ok {
+		return false
+	}
+	for _, k := range s.Keys {
+		if k == key {
+			t.index++
+			return true
+		}
+	}
+
+	return false
+}
+
+// Done returns true once all steps are completed.
+func (t *Tour) Done() bool {
+	return t.index >= len(t.steps)
+}
@@ -0,0 +1,31 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTourAdvance(t *testing.T) {
+	tt := model.NewTour([]model.TourStep{
+		{Message: "filter", Keys: []string{"/"}},
+		{Message: "describe", Keys: []string{"d", "Enter"}},
+	})
+
+	s, ok := tt.Current()
+	assert.True(t, ok)
+	assert.Equal(t, "filter", s.Message)
+	assert.False(t, tt.Advance("d"))
+
+	assert.True(t, tt.Advance("/"))
+	n, total := tt.Progress()
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, total)
+
+	assert.True(t, tt.Advance("Enter"))
+	assert.True(t, tt.Done())
+	_, ok = tt.Current()
+	assert.False(t, ok)
+	assert.False(t, tt.Advance("/"))
+}
@@ -0,0 +1,204 @@
+package model
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ViewUsage tracks how a view was used.
+type ViewUsage struct {
+	Visits int           `json:"visits"`
+	Time   time.Duration `json:"time"`
+}
+
+// UsageStat represents a ranked usage entry.
+type UsageStat struct {
+	Name   string        `json:"name"`
+	Count  int           `json:"count"`
+	Time   time.Duration `json:"-"`
+	Active string        `json:"time,omitempty"`
+}
+
+// Usage tracks commands and views usage. Stats are only ever kept locally.
+type Usage struct {
+	Commands map[string]int        `json:"commands,omitempty"`
+	Views    map[string]*ViewUsage `json:"views,omitempty"`
+
+	current  string
+	since    time.Time
+	disabled bool
+	mx       sync.Mutex
+}
+
+// NewUsage returns a new instance.
+func NewUsage() *Usage {
+	return &Usage{
+		Commands: make(map[string]int),
+		Views:    make(map[string]*ViewUsage),
+	}
+}
+
+// LoadUsage loads usage stats from disk. A missing file yields blank stats.
+func LoadUsage(path string) (*Usage, error) {
+	u := NewUsage()
+	if err := u.Reload(path); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// Reload replaces the stats with the ones saved on disk. A missing file
+// leaves the stats untouched.
+func (u *Usage) Reload(path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var l Usage
+	if err := yaml.Unmarshal(raw, &l); err != nil {
+		return err
+	}
+
+	u.mx.Lock()
+	defer u.mx.Unlock()
+	u.Commands, u.Views = l.Commands, l.Views
+	if u.Commands == nil {
+		u.Commands = make(map[string]int)
+	}
+	if u.Views == nil {
+		u.Views = make(map[string]*ViewUsage)
+	}
+
+	return nil
+}
+
+// Enabled returns true if usage is being tracked.
+func (u *Usage) Enabled() bool {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	return !u.disabled
+}
+
+// SetEnabled turns usage tracking on or off.
+func (u *Usage) SetEnabled(b bool) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	u.disabled = !b
+	u.current, u.since = "", time.Time{}
+}
+
+// TrackCommand records a command use.
+func (u *Usage) TrackCommand(cmd string) {
+	if cmd == "" {
+		return
+	}
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	if u.disabled {
+		return
+	}
+	u.Commands[cmd]++
+}
+
+// EnterView records a view visit and accrues the time spent on the previous view.
+func (u *Usage) EnterView(view string, now time.Time) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	if u.disabled || view == u.current {
+		return
+	}
+	u.accrue(now)
+	u.current, u.since = view, now
+	if view == "" {
+		return
+	}
+	v, ok := u.Views[view]
+	if !ok {
+		v = &ViewUsage{}
+		u.Views[view] = v
+	}
+	v.Visits++
+}
+
+func (u *Usage) accrue(now time.Time) {
+	if u.current == "" {
+		return
+	}
+	if v, ok := u.Views[u.current]; ok {
+		v.Time += now.Sub(u.since)
+	}
+	u.since = now
+}
+
+// Save persists the stats, accruing the time spent on the current view.
+func (u *Usage) Save(path string, now time.Time) error {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	if u.disabled {
+		return nil
+	}
+	u.accrue(now)
+	raw, err := yaml.Marshal(u)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// CommandStats returns commands ranked by use.
+func (u *Usage) CommandStats() []UsageStat {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	ss := make([]UsageStat, 0, len(u.Commands))
+	for k, v := range u.Commands {
+		ss = append(ss, UsageStat{Name: k, Count: v})
+	}
+	sort.SliceStable(ss, func(i, j int) bool {
+		if ss[i].Count == ss[j].Count {
+			return ss[i].Name < ss[j].Name
+		}
+		return ss[i].Count > ss[j].Count
+	})
+
+	return ss
+}
+
+// ViewStats returns views ranked by time spent, including the current view.
+func (u *Usage) ViewStats(now time.Time) []UsageStat {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	ss := make([]UsageStat, 0, len(u.Views))
+	for k, v := range u.Views {
+		s := UsageStat{Name: k, Count: v.Visits, Time: v.Time}
+		if k == u.current {
+			s.Time += now.Sub(u.since)
+		}
+		s.Active = s.Time.Round(time.Second).String()
+		ss = append(ss, s)
+	}
+	sort.SliceStable(ss, func(i, j int) bool {
+		if ss[i].Time == ss[j].Time {
+			return ss[i].Name < ss[j].Name
+		}
+		return ss[i].Time > ss[j].Time
+	})
+
+	return ss
+}
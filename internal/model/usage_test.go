@@ -0,0 +1,81 @@
+package model_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageViews(t *testing.T) {
+	u, now := model.NewUsage(), time.Now()
+	u.EnterView("Pods", now)
+	u.EnterView("Pods", now.Add(time.Second))
+	u.EnterView("Services", now.Add(10*time.Second))
+	u.EnterView("Pods", now.Add(12*time.Second))
+
+	ss := u.ViewStats(now.Add(15 * time.Second))
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, model.UsageStat{Name: "Pods", Count: 2, Time: 13 * time.Second, Active: "13s"}, ss[0])
+	assert.Equal(t, model.UsageStat{Name: "Services", Count: 1, Time: 2 * time.Second, Active: "2s"}, ss[1])
+}
+
+func TestUsageCommands(t *testing.T) {
+	u := model.NewUsage()
+	for _, c := range []string{"dp", "po", "po", "", "svc", "po", "dp"} {
+		u.TrackCommand(c)
+	}
+
+	assert.Equal(t, []model.UsageStat{
+		{Name: "po", Count: 3},
+		{Name: "dp", Count: 2},
+		{Name: "svc", Count: 1},
+	}, u.CommandStats())
+}
+
+func TestUsageSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-usage")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "usage.yml")
+
+	u, err := model.LoadUsage(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(u.CommandStats()))
+
+	now := time.Now()
+	u.TrackCommand("po")
+	u.EnterView("Pods", now)
+	assert.Nil(t, u.Save(path, now.Add(time.Minute)))
+
+	l, err := model.LoadUsage(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UsageStat{{Name: "po", Count: 1}}, l.CommandStats())
+	assert.Equal(t, []model.UsageStat{{Name: "Pods", Count: 1, Time: time.Minute, Active: "1m0s"}}, l.ViewStats(now))
+}
+
+func TestUsageDisabled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-usage")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "usage.yml")
+
+	u := model.NewUsage()
+	u.TrackCommand("po")
+	assert.Nil(t, u.Save(path, time.Now()))
+
+	u.SetEnabled(false)
+	assert.False(t, u.Enabled())
+	u.TrackCommand("dp")
+	u.EnterView("Pods", time.Now())
+	assert.Equal(t, []model.UsageStat{{Name: "po", Count: 1}}, u.CommandStats())
+	assert.Equal(t, 0, len(u.ViewStats(time.Now())))
+
+	l := model.NewUsage()
+	assert.Nil(t, l.Reload(path))
+	assert.Equal(t, []model.UsageStat{{Name: "po", Count: 1}}, l.CommandStats())
+}
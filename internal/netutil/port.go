@@ -0,0 +1,49 @@
+package netutil
+
+import (
+	"math/rand"
+	"net"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// FreePort returns an available local port on the given address.
+func FreePort(address string) (string, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(address, "0"))
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// FreePortIn returns an available local port on the given address, picked at
+// random within the preferred ranges. Falls back to any free port when the
+// ranges are exhausted.
+func FreePortIn(address string, rr []config.PortRange) (string, error) {
+	var pp []int
+	for _, r := range rr {
+		for p := r.Min; p <= r.Max; p++ {
+			pp = append(pp, p)
+		}
+	}
+	if len(pp) > 0 {
+		for _, i := range rand.Perm(len(pp)) {
+			port := strconv.Itoa(pp[i])
+			l, err := net.Listen("tcp", net.JoinHostPort(address, port))
+			if err != nil {
+				continue
+			}
+			if err := l.Close(); err != nil {
+				return "", err
+			}
+			return port, nil
+		}
+		log.Warn().Msgf("No free ports in ranges %v. Picking any port", rr)
+	}
+
+	return FreePort(address)
+}
@@ -0,0 +1,36 @@
+package netutil_test
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreePort(t *testing.T) {
+	p, err := netutil.FreePort("127.0.0.1")
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, "0", p)
+}
+
+func TestFreePortIn(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	busy := l.Addr().(*net.TCPAddr).Port
+
+	p, err := netutil.FreePortIn("127.0.0.1", []config.PortRange{{Min: busy, Max: busy}})
+	assert.Nil(t, err)
+	assert.NotEqual(t, strconv.Itoa(busy), p)
+
+	free, err := netutil.FreePort("127.0.0.1")
+	assert.Nil(t, err)
+	n, _ := strconv.Atoi(free)
+	p, err = netutil.FreePortIn("127.0.0.1", []config.PortRange{{Min: busy, Max: busy}, {Min: n, Max: n}})
+	assert.Nil(t, err)
+	assert.Equal(t, free, p)
+}
@@ -177,7 +177,7 @@ func ToContainerPorts(pp []v1.ContainerPort) string {
 		}
 		ports[i] += strconv.Itoa(int(p.ContainerPort))
 		if p.Protocol != "TCP" {
-			ports[i] += "╱" + string(p.Protocol)
+			ports[i] += Glyph(ProtocolSign) + string(p.Protocol)
 		}
 	}
 
@@ -0,0 +1,173 @@
+package render
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+var (
+	extraColsMx sync.RWMutex
+	extraCols   = make(map[string]*ExtraColumns)
+)
+
+// ExtraColumns represents user defined json path columns merged into a
+// resource renderer output. Columns named after an existing column replace
+// its values, others are inserted before the trailing VALID and AGE columns.
+// Joined columns values are read off the related objects.
+type ExtraColumns struct {
+	cols    []CRDColumn
+	parsers []*jsonpath.JSONPath
+	keys    []*jsonpath.JSONPath
+	mx      sync.Mutex
+}
+
+// NewExtraColumns returns new user defined columns. Columns with invalid
+// json paths render blank.
+func NewExtraColumns(cols []CRDColumn) *ExtraColumns {
+	cc := make([]CRDColumn, len(cols))
+	kk := make([]*jsonpath.JSONPath, len(cols))
+	for i, col := range cols {
+		col.Name = strings.ToUpper(col.Name)
+		cc[i] = col
+		if col.Join != nil {
+			kk[i] = parseJSONPath(col.Name, col.Join.Key)
+		}
+	}
+
+	return &ExtraColumns{cols: cc, parsers: parseColumns(cc), keys: kk}
+}
+
+// SetExtraColumns sets the user defined columns per resource.
+func SetExtraColumns(cc map[string][]CRDColumn) {
+	extraColsMx.Lock()
+	defer extraColsMx.Unlock()
+
+	extraCols = make(map[string]*ExtraColumns, len(cc))
+	for gvr, cols := range cc {
+		if len(cols) > 0 {
+			extraCols[gvr] = NewExtraColumns(cols)
+		}
+	}
+}
+
+// ExtraColumnsFor returns a resource user defined columns if any.
+func ExtraColumnsFor(gvr string) (*ExtraColumns, bool) {
+	extraColsMx.RLock()
+	defer extraColsMx.RUnlock()
+
+	e, ok := extraCols[gvr]
+	return e, ok
+}
+
+// Header merges the user defined columns into a resource header.
+func (e *ExtraColumns) Header(h Header) Header {
+	at, idx := e.layout(h)
+	hh := make(Header, 0, len(h)+len(e.cols))
+	hh = append(hh, h[:at]...)
+	for i, col := range e.cols {
+		if idx[i] == -1 {
+			hh = append(hh, HeaderColumn{Name: col.Name, Wide: col.Priority > 0})
+		}
+	}
+	hh = append(hh, h[at:]...)
+
+	return hh
+}
+
+// Joins returns the distinct related resources lookups if any.
+func (e *ExtraColumns) Joins() []ColumnJoin {
+	var jj []ColumnJoin
+	seen := make(map[ColumnJoin]struct{})
+	for _, col := range e.cols {
+		if col.Join == nil {
+			continue
+		}
+		if _, ok := seen[*col.Join]; ok {
+			continue
+		}
+		seen[*col.Join] = struct{}{}
+		jj = append(jj, *col.Join)
+	}
+
+	return jj
+}
+
+// Render merges the user defined columns values into a resource row given
+// the resource header.
+func (e *ExtraColumns) Render(o interface{}, h Header, r *Row) {
+	e.RenderJoined(o, h, r, nil)
+}
+
+// RenderJoined merges the user defined columns values into a resource row
+// given the resource header and the related objects. Joined columns without
+// a related object render blank.
+func (e *ExtraColumns) RenderJoined(o interface{}, h Header, r *Row, jj Joins) {
+	m := objectMap(o)
+	at, idx := e.layout(h)
+	if at > len(r.Fields) {
+		at = len(r.Fields)
+	}
+
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	vv := make([]string, 0, len(e.cols))
+	for i, col := range e.cols {
+		v := columnCell(e.parsers[i], col, e.related(i, m, jj))
+		if idx[i] >= 0 && idx[i] < len(r.Fields) {
+			r.Fields[idx[i]] = v
+			continue
+		}
+		vv = append(vv, v)
+	}
+	ff := make(Fields, 0, len(r.Fields)+len(vv))
+	ff = append(ff, r.Fields[:at]...)
+	ff = append(ff, vv...)
+	r.Fields = append(ff, r.Fields[at:]...)
+}
+
+// related returns the object a column values are read off.
+func (e *ExtraColumns) related(i int, m map[string]interface{}, jj Joins) map[string]interface{} {
+	j := e.cols[i].Join
+	if j == nil {
+		return m
+	}
+
+	return jj[*j].Related(objectNamespace(m), jsonPathValue(e.keys[i], m))
+}
+
+// layout returns where new columns are inserted along with the user defined
+// columns indices in the resource header if already present.
+func (e *ExtraColumns) layout(h Header) (int, []int) {
+	at := len(h)
+	for _, n := range []string{"VALID", ageCol} {
+		if i := h.IndexOf(n, true); i >= 0 && i < at {
+			at = i
+		}
+	}
+	idx := make([]int, len(e.cols))
+	for i, col := range e.cols {
+		idx[i] = h.IndexOf(col.Name, true)
+	}
+
+	return at, idx
+}
+
+func objectMap(o interface{}) map[string]interface{} {
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		return v.Object
+	case *PodWithMetrics:
+		if v.Raw != nil {
+			return v.Raw.Object
+		}
+	case *NodeWithMetrics:
+		if v.Raw != nil {
+			return v.Raw.Object
+		}
+	}
+
+	return nil
+}
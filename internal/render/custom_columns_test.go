@@ -0,0 +1,105 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExtraColumns(t *testing.T) {
+	e := render.NewExtraColumns([]render.CRDColumn{
+		{Name: "zone", JSONPath: ".spec.nodeSelector.zone"},
+		{Name: "app", JSONPath: ".metadata.labels.app", Priority: 1},
+		{Name: "ip", JSONPath: ".status.hostIP"},
+	})
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "IP"},
+		render.HeaderColumn{Name: "VALID", Wide: true},
+		render.HeaderColumn{Name: "AGE"},
+	}
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "p1", "labels": map[string]interface{}{"app": "fred"}},
+		"spec":     map[string]interface{}{"nodeSelector": map[string]interface{}{"zone": "us-east-1a"}},
+		"status":   map[string]interface{}{"hostIP": "10.0.0.1"},
+	}}
+	r := render.Row{ID: "p1", Fields: render.Fields{"p1", "1.1.1.1", "", "2m"}}
+
+	e.Render(&render.PodWithMetrics{Raw: o}, h, &r)
+	hh := e.Header(h)
+	assert.Equal(t, []string{"NAME", "IP", "ZONE", "APP", "VALID", "AGE"}, hh.Columns(true))
+	assert.True(t, hh[3].Wide)
+	assert.Equal(t, render.Fields{"p1", "10.0.0.1", "us-east-1a", "fred", "", "2m"}, r.Fields)
+}
+
+func TestExtraColumnsFor(t *testing.T) {
+	render.SetExtraColumns(map[string][]render.CRDColumn{
+		"v1/pods": {{Name: "zone", JSONPath: ".spec.nodeSelector.zone"}},
+		"v1/svc":  nil,
+	})
+	defer render.SetExtraColumns(nil)
+
+	_, ok := render.ExtraColumnsFor("v1/pods")
+	assert.True(t, ok)
+	_, ok = render.ExtraColumnsFor("v1/svc")
+	assert.False(t, ok)
+}
+
+func TestExtraColumnsJoined(t *testing.T) {
+	node := render.ColumnJoin{GVR: "v1/nodes", Key: ".spec.nodeName"}
+	hpa := render.ColumnJoin{GVR: "autoscaling/v1/horizontalpodautoscalers", Key: ".metadata.labels.app", On: ".spec.scaleTargetRef.name"}
+	e := render.NewExtraColumns([]render.CRDColumn{
+		{Name: "pool", JSONPath: ".metadata.labels.pool", Join: &node},
+		{Name: "replicas", JSONPath: ".status.currentReplicas", Join: &hpa},
+		{Name: "zone", JSONPath: ".metadata.labels.zone", Join: &node},
+	})
+	assert.Equal(t, []render.ColumnJoin{node, hpa}, e.Joins())
+
+	jj := render.Joins{
+		node: render.NewJoinIndex(node, []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "n1", "labels": map[string]interface{}{"pool": "gpu", "zone": "us-east-1a"}},
+			}},
+		}),
+		hpa: render.NewJoinIndex(hpa, []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns2", "name": "h2"},
+				"spec":     map[string]interface{}{"scaleTargetRef": map[string]interface{}{"name": "fred"}},
+				"status":   map[string]interface{}{"currentReplicas": int64(5)},
+			}},
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns1", "name": "h1"},
+				"spec":     map[string]interface{}{"scaleTargetRef": map[string]interface{}{"name": "fred"}},
+				"status":   map[string]interface{}{"currentReplicas": int64(3)},
+			}},
+		}),
+	}
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "AGE"},
+	}
+
+	uu := map[string]struct {
+		node string
+		e    render.Fields
+	}{
+		"joined":  {node: "n1", e: render.Fields{"p1", "gpu", "3", "us-east-1a", "2m"}},
+		"missing": {node: "n2", e: render.Fields{"p1", "", "3", "", "2m"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns1", "name": "p1", "labels": map[string]interface{}{"app": "fred"}},
+				"spec":     map[string]interface{}{"nodeName": u.node},
+			}}
+			r := render.Row{ID: "ns1/p1", Fields: render.Fields{"p1", "2m"}}
+			e.RenderJoined(o, h, &r, jj)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package render
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultJoinOn = ".metadata.name"
+
+// ColumnJoin represents a related resource lookup for a user defined column,
+// ie a pod node or a deployment hpa. A related object matches when its On
+// json path value equals the object Key json path value. Namespaced related
+// objects must live in the object namespace.
+type ColumnJoin struct {
+	// GVR tracks the related resource, ie v1/nodes.
+	GVR string
+	// Key tracks the object json path yielding the join key, ie .spec.nodeName.
+	Key string
+	// On tracks the related object json path matched against the key.
+	// Defaults to the related object name.
+	On string
+}
+
+// OnPath returns the related object json path matched against the key.
+func (j ColumnJoin) OnPath() string {
+	if j.On == "" {
+		return defaultJoinOn
+	}
+
+	return j.On
+}
+
+// Joins tracks the related objects indexes per join.
+type Joins map[ColumnJoin]JoinIndex
+
+// JoinIndex tracks related objects by namespace and join value.
+type JoinIndex map[string]map[string]interface{}
+
+// NewJoinIndex indexes related objects on a join On json path value.
+func NewJoinIndex(j ColumnJoin, oo []runtime.Object) JoinIndex {
+	idx := make(JoinIndex, len(oo))
+	jp := parseJSONPath(j.GVR, j.OnPath())
+	if jp == nil {
+		return idx
+	}
+	for _, o := range oo {
+		m := objectMap(o)
+		if k := jsonPathValue(jp, m); k != "" {
+			idx[client.FQN(objectNamespace(m), k)] = m
+		}
+	}
+
+	return idx
+}
+
+// Related returns the related object matching a key in a given namespace.
+// Cluster scoped related objects match in all namespaces.
+func (i JoinIndex) Related(ns, key string) map[string]interface{} {
+	if key == "" {
+		return nil
+	}
+	if m, ok := i[client.FQN(ns, key)]; ok {
+		return m
+	}
+
+	return i[key]
+}
+
+func objectNamespace(m map[string]interface{}) string {
+	md, ok := m["metadata"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	ns, _ := md["namespace"].(string)
+
+	return ns
+}
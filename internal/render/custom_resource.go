@@ -0,0 +1,141 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	// CRDColumnDate tracks date columns rendered as ages.
+	CRDColumnDate = "date"
+	// CRDColumnInteger tracks integer columns.
+	CRDColumnInteger = "integer"
+	// CRDColumnNumber tracks number columns.
+	CRDColumnNumber = "number"
+)
+
+// CRDColumn represents a custom resource column derived from its
+// definition printer columns or schema.
+type CRDColumn struct {
+	Name, Type, JSONPath string
+	Priority             int
+	// Join tracks a related resource the json path applies to if any.
+	Join *ColumnJoin
+}
+
+// CustomResource renders custom resources using their definition columns.
+type CustomResource struct {
+	cols    []CRDColumn
+	parsers []*jsonpath.JSONPath
+}
+
+// NewCustomResource returns a new custom resource renderer. Columns with
+// invalid json paths render blank.
+func NewCustomResource(cols []CRDColumn) *CustomResource {
+	return &CustomResource{cols: cols, parsers: parseColumns(cols)}
+}
+
+// ColorerFunc colors a resource row.
+func (CustomResource) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (c *CustomResource) Header(ns string) Header {
+	h := make(Header, 0, len(c.cols)+4)
+	h = append(h, HeaderColumn{Name: "NAMESPACE"}, HeaderColumn{Name: "NAME"})
+	for _, col := range c.cols {
+		hc := HeaderColumn{Name: strings.ToUpper(col.Name), Wide: col.Priority > 0}
+		switch col.Type {
+		case CRDColumnDate:
+			hc.Time, hc.Decorator = true, AgeDecorator
+		case CRDColumnInteger, CRDColumnNumber:
+			hc.Align = tview.AlignRight
+		}
+		h = append(h, hc)
+	}
+	h = append(h,
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	)
+
+	return h
+}
+
+// Render renders a K8s resource to screen.
+func (c *CustomResource) Render(o interface{}, ns string, r *Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+
+	r.ID = client.MetaFQN(metav1.ObjectMeta{Namespace: u.GetNamespace(), Name: u.GetName()})
+	r.Fields = make(Fields, 0, len(c.cols)+4)
+	r.Fields = append(r.Fields, u.GetNamespace(), u.GetName())
+	for i, col := range c.cols {
+		r.Fields = append(r.Fields, columnCell(c.parsers[i], col, u.Object))
+	}
+	r.Fields = append(r.Fields,
+		mapToStr(u.GetLabels()),
+		toAge(u.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// parseColumns compiles the columns json paths. Invalid paths yield nil
+// parsers.
+func parseColumns(cols []CRDColumn) []*jsonpath.JSONPath {
+	pp := make([]*jsonpath.JSONPath, len(cols))
+	for i, col := range cols {
+		pp[i] = parseJSONPath(col.Name, col.JSONPath)
+	}
+
+	return pp
+}
+
+// parseJSONPath compiles a json path or returns nil if invalid.
+func parseJSONPath(name, path string) *jsonpath.JSONPath {
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", path)); err != nil {
+		log.Warn().Err(err).Msgf("Invalid column %s json path %q", name, path)
+		return nil
+	}
+
+	return jp
+}
+
+// jsonPathValue returns a json path value on a given object or blank if none.
+func jsonPathValue(jp *jsonpath.JSONPath, o map[string]interface{}) string {
+	if jp == nil || o == nil {
+		return ""
+	}
+	var b bytes.Buffer
+	if err := jp.Execute(&b, o); err != nil {
+		return ""
+	}
+
+	return b.String()
+}
+
+func columnCell(jp *jsonpath.JSONPath, col CRDColumn, o map[string]interface{}) string {
+	s := jsonPathValue(jp, o)
+	if col.Type != CRDColumnDate || s == "" {
+		return s
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+
+	return toAge(metav1.Time{Time: t})
+}
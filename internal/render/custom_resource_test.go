@@ -0,0 +1,54 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCustomResourceHeader(t *testing.T) {
+	c := render.NewCustomResource([]render.CRDColumn{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "Replicas", Type: render.CRDColumnInteger, JSONPath: ".spec.replicas"},
+		{Name: "Image", Type: "string", JSONPath: ".spec.image", Priority: 1},
+	})
+
+	h := c.Header("")
+	assert.Equal(t, []string{"NAMESPACE", "NAME", "PHASE", "REPLICAS", "IMAGE", "LABELS", "AGE"}, h.Columns(true))
+	assert.True(t, h[4].Wide)
+}
+
+func TestCustomResourceRender(t *testing.T) {
+	c := render.NewCustomResource([]render.CRDColumn{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "Replicas", Type: render.CRDColumnInteger, JSONPath: ".spec.replicas"},
+		{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+		{Name: "Missing", Type: "string", JSONPath: ".status.blee"},
+		{Name: "Toast", Type: "string", JSONPath: ".status[["},
+	})
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Fred",
+		"metadata": map[string]interface{}{
+			"namespace":         "default",
+			"name":              "fred",
+			"creationTimestamp": "2020-05-02T10:00:00Z",
+			"labels":            map[string]interface{}{"app": "fred"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Synced", "status": "False"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+
+	var r render.Row
+	assert.Nil(t, c.Render(&o, "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "Running", "3", "True", "", "", "app=fred"}, r.Fields[:len(r.Fields)-1])
+}
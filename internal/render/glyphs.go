@@ -0,0 +1,58 @@
+package render
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// PortMapSign separates a service port from its node port.
+	PortMapSign = "►"
+
+	// ProtocolSign separates a port from its protocol.
+	ProtocolSign = "╱"
+
+	// InfinitySign signals an unbounded value.
+	InfinitySign = "∞"
+
+	// EllipsisSign signals a truncated value.
+	EllipsisSign = "…"
+)
+
+var (
+	asciiMode int32
+
+	// asciiGlyphs swaps glyphs for their ascii fallbacks.
+	asciiGlyphs = strings.NewReplacer(
+		PortMapSign, ">",
+		ProtocolSign, "~",
+		InfinitySign, "inf",
+		EllipsisSign, "...",
+		"Δ", "*",
+		"↑", "^",
+		"↓", "v",
+	)
+)
+
+// SetASCIIMode toggles ascii only glyphs.
+func SetASCIIMode(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&asciiMode, v)
+}
+
+// IsASCIIMode returns true if only ascii glyphs must be rendered.
+func IsASCIIMode() bool {
+	return atomic.LoadInt32(&asciiMode) == 1
+}
+
+// Glyph returns a string with its glyphs swapped for ascii ones in ascii mode.
+func Glyph(s string) string {
+	if !IsASCIIMode() {
+		return s
+	}
+
+	return asciiGlyphs.Replace(s)
+}
@@ -0,0 +1,30 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlyph(t *testing.T) {
+	uu := map[string]struct {
+		ascii bool
+		s, e  string
+	}{
+		"unicode": {s: "80►30080╱UDP", e: "80►30080╱UDP"},
+		"ports":   {ascii: true, s: "80►30080╱UDP", e: "80>30080~UDP"},
+		"range":   {ascii: true, s: "0-∞", e: "0-inf"},
+		"sign":    {ascii: true, s: "[red::b]↑", e: "[red::b]^"},
+		"plain":   {ascii: true, s: "fred", e: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			render.SetASCIIMode(u.ascii)
+			defer render.SetASCIIMode(false)
+			assert.Equal(t, u.e, render.Glyph(u.s))
+		})
+	}
+}
@@ -17,6 +17,8 @@ type HeaderColumn struct {
 	Wide      bool
 	MX        bool
 	Time      bool
+	// MaxWidth caps the column width unless the view settings truncate it.
+	MaxWidth int
 }
 
 // Clone copies a header.
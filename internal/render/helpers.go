@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
@@ -324,3 +325,36 @@ func sortLabels(m map[string]string) (keys, vals []string) {
 
 	return
 }
+
+// statusCondition represents a CRD status condition.
+type statusCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type statusConditions []statusCondition
+
+func (cc statusConditions) find(t string) (statusCondition, bool) {
+	for _, c := range cc {
+		if c.Type == t {
+			return c, true
+		}
+	}
+
+	return statusCondition{}, false
+}
+
+func (cc statusConditions) isTrue(t string) bool {
+	c, ok := cc.find(t)
+	return ok && c.Status == string(v1.ConditionTrue)
+}
+
+func (cc statusConditions) status(t string) string {
+	if c, ok := cc.find(t); ok {
+		return c.Status
+	}
+
+	return MissingValue
+}
@@ -0,0 +1,106 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	// HPAKindMetric tracks an autoscaler metric row.
+	HPAKindMetric = "Metric"
+	// HPAKindReplicas tracks an autoscaler replicas row.
+	HPAKindReplicas = "Replicas"
+	// HPAKindEvent tracks an autoscaler scaling event row.
+	HPAKindEvent = "Event"
+)
+
+// HPALive renders an autoscaler live status to screen.
+type HPALive struct{}
+
+// ColorerFunc colors a resource row.
+func (HPALive) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		if len(re.Row.Fields) < 6 {
+			return StdColor
+		}
+		switch re.Row.Fields[0] {
+		case HPAKindReplicas:
+			return tcell.ColorAqua
+		case HPAKindEvent:
+			if re.Row.Fields[2] == "Warning" {
+				return ErrColor
+			}
+			return tcell.ColorGray
+		}
+		if re.Row.Fields[2] == "<unknown>" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (HPALive) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
+		HeaderColumn{Name: "TARGET", Align: tview.AlignRight},
+		HeaderColumn{Name: "LIVE", Align: tview.AlignRight},
+		HeaderColumn{Name: "DETAILS"},
+	}
+}
+
+// Render renders an autoscaler status item to screen.
+func (HPALive) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(HPAItem)
+	if !ok {
+		return fmt.Errorf("expected HPAItem, but got %T", o)
+	}
+
+	r.ID = i.Kind + ":" + i.ID
+	r.Fields = Fields{
+		i.Kind,
+		i.Name,
+		i.Current,
+		i.Target,
+		i.Live,
+		i.Details,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// HPAItem represents an autoscaler status item, ie a metric, the replicas
+// trend or a scaling event.
+type HPAItem struct {
+	ID, Kind, Name                 string
+	Current, Target, Live, Details string
+}
+
+// Sparkline renders a series as a line of blocks scaled to the series max.
+func Sparkline(vv []int32) string {
+	var top int32
+	for _, v := range vv {
+		if v > top {
+			top = v
+		}
+	}
+	rr := make([]rune, 0, len(vv))
+	for _, v := range vv {
+		if top == 0 || v <= 0 {
+			rr = append(rr, tchart.Sparks[0])
+			continue
+		}
+		rr = append(rr, tchart.Sparks[int(v)*(len(tchart.Sparks)-1)/int(top)])
+	}
+
+	return string(rr)
+}
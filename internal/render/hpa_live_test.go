@@ -0,0 +1,55 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPALiveRender(t *testing.T) {
+	var (
+		h render.HPALive
+		r render.Row
+	)
+	i := render.HPAItem{
+		ID:      "Resource:cpu",
+		Kind:    render.HPAKindMetric,
+		Name:    "Resource cpu",
+		Current: "45%",
+		Target:  "50%",
+		Live:    "47%",
+	}
+
+	assert.Nil(t, h.Render(i, "", &r))
+	assert.Equal(t, "Metric:Resource:cpu", r.ID)
+	assert.Equal(t, render.Fields{"Metric", "Resource cpu", "45%", "50%", "47%", ""}, r.Fields)
+}
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []int32
+		e  string
+	}{
+		"empty": {},
+		"zeros": {
+			vv: []int32{0, 0},
+			e:  "▁▁",
+		},
+		"flat": {
+			vv: []int32{3, 3, 3},
+			e:  "███",
+		},
+		"ramp": {
+			vv: []int32{1, 2, 4, 7},
+			e:  "▂▃▅█",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv))
+		})
+	}
+}
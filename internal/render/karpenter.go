@@ -0,0 +1,254 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// KarpenterNodePoolLabel tracks the node pool a claim or node belongs to.
+	KarpenterNodePoolLabel = "karpenter.sh/nodepool"
+
+	karpenterCapacityTypeLabel = "karpenter.sh/capacity-type"
+	karpenterNodesResource     = "nodes"
+)
+
+// karpenterNodePool tracks the Karpenter NodePool fields we care about.
+// Handles both karpenter.sh v1 and v1beta1 shapes.
+type karpenterNodePool struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Weight   *int32          `json:"weight,omitempty"`
+		Limits   v1.ResourceList `json:"limits,omitempty"`
+		Template struct {
+			Spec struct {
+				NodeClassRef struct {
+					Kind string `json:"kind"`
+					Name string `json:"name"`
+				} `json:"nodeClassRef"`
+			} `json:"spec"`
+		} `json:"template"`
+		Disruption struct {
+			ConsolidationPolicy string `json:"consolidationPolicy,omitempty"`
+		} `json:"disruption"`
+	} `json:"spec"`
+	Status struct {
+		Resources  v1.ResourceList  `json:"resources,omitempty"`
+		Conditions statusConditions `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// karpenterNodeClaim tracks the Karpenter NodeClaim fields we care about.
+type karpenterNodeClaim struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Status struct {
+		NodeName   string           `json:"nodeName,omitempty"`
+		ProviderID string           `json:"providerID,omitempty"`
+		Conditions statusConditions `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// NodePool renders a Karpenter NodePool to screen.
+type NodePool struct{}
+
+// ColorerFunc colors a resource row.
+func (NodePool) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (NodePool) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "NODECLASS"},
+		HeaderColumn{Name: "NODES", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEMORY", Align: tview.AlignRight},
+		HeaderColumn{Name: "CONSOLIDATION"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "WEIGHT", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (n NodePool) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected NodePool, but got %T", o)
+	}
+	var np karpenterNodePool
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &np)
+	if err != nil {
+		return err
+	}
+
+	weight := MissingValue
+	if np.Spec.Weight != nil {
+		weight = strconv.Itoa(int(*np.Spec.Weight))
+	}
+	nodes := "0"
+	if q, ok := np.Status.Resources[karpenterNodesResource]; ok {
+		nodes = q.String()
+	}
+
+	r.ID = client.MetaFQN(np.ObjectMeta)
+	r.Fields = Fields{
+		np.Name,
+		missing(np.Spec.Template.Spec.NodeClassRef.Name),
+		nodes,
+		usageOfLimit(np.Status.Resources, np.Spec.Limits, v1.ResourceCPU),
+		usageOfLimit(np.Status.Resources, np.Spec.Limits, v1.ResourceMemory),
+		missing(np.Spec.Disruption.ConsolidationPolicy),
+		np.Status.Conditions.status("Ready"),
+		weight,
+		asStatus(n.diagnose(np)),
+		toAge(np.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (NodePool) diagnose(np karpenterNodePool) error {
+	if c, ok := np.Status.Conditions.find("Ready"); ok && c.Status == string(v1.ConditionFalse) {
+		return fmt.Errorf("%s %s", c.Reason, c.Message)
+	}
+	for res, limit := range np.Spec.Limits {
+		used, ok := np.Status.Resources[res]
+		if ok && used.Cmp(limit) >= 0 {
+			return fmt.Errorf("%s limit reached", res)
+		}
+	}
+
+	return nil
+}
+
+// NodeClaim renders a Karpenter NodeClaim to screen.
+type NodeClaim struct{}
+
+// ColorerFunc colors a resource row.
+func (NodeClaim) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 || c == ErrColor {
+			return c
+		}
+		switch re.Row.Fields[statusCol] {
+		case "Launching", "Registering", "Initializing":
+			return AddColor
+		case "Drifted", "Disrupting", "Terminating":
+			return KillColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (NodeClaim) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "CAPACITY"},
+		HeaderColumn{Name: "ZONE"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "NODEPOOL"},
+		HeaderColumn{Name: "PROVIDERID", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (n NodeClaim) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected NodeClaim, but got %T", o)
+	}
+	var nc karpenterNodeClaim
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &nc)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(nc.ObjectMeta)
+	r.Fields = Fields{
+		nc.Name,
+		missing(nc.Labels[v1.LabelInstanceTypeStable]),
+		missing(nc.Labels[karpenterCapacityTypeLabel]),
+		missing(nc.Labels[v1.LabelZoneFailureDomainStable]),
+		missing(nc.Status.NodeName),
+		nc.Status.Conditions.status("Ready"),
+		nodeClaimPhase(nc),
+		missing(nc.Labels[KarpenterNodePoolLabel]),
+		missing(nc.Status.ProviderID),
+		asStatus(n.diagnose(nc)),
+		toAge(nc.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (NodeClaim) diagnose(nc karpenterNodeClaim) error {
+	for _, t := range []string{"Launched", "Registered", "Initialized"} {
+		c, ok := nc.Status.Conditions.find(t)
+		if ok && c.Status == string(v1.ConditionFalse) && c.Message != "" {
+			return fmt.Errorf("%s: %s", t, c.Message)
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nodeClaimPhase returns the claim lifecycle phase.
+func nodeClaimPhase(nc karpenterNodeClaim) string {
+	if nc.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	cc := nc.Status.Conditions
+	switch {
+	case cc.isTrue("Disrupting"):
+		return "Disrupting"
+	case cc.isTrue("Drifted"):
+		return "Drifted"
+	case !cc.isTrue("Launched"):
+		return "Launching"
+	case !cc.isTrue("Registered"):
+		return "Registering"
+	case !cc.isTrue("Initialized"):
+		return "Initializing"
+	case cc.isTrue("Empty"), cc.isTrue("Consolidatable"):
+		return "Consolidatable"
+	default:
+		return "Running"
+	}
+}
+
+func usageOfLimit(used, limits v1.ResourceList, res v1.ResourceName) string {
+	u := "0"
+	if q, ok := used[res]; ok {
+		u = q.String()
+	}
+	if q, ok := limits[res]; ok {
+		return u + "/" + q.String()
+	}
+
+	return u
+}
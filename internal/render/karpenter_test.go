@@ -0,0 +1,37 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodePoolRender(t *testing.T) {
+	c := render.NodePool{}
+	r := render.NewRow(10)
+
+	assert.Nil(t, c.Render(load(t, "nodepool"), "", &r))
+	assert.Equal(t, "default", r.ID)
+	assert.Equal(t, render.Fields{"default", "default", "3", "12/100", "48Gi/400Gi", "WhenEmptyOrUnderutilized", "True", "10", ""}, r.Fields[:9])
+}
+
+func TestNodeClaimRender(t *testing.T) {
+	c := render.NodeClaim{}
+	r := render.NewRow(11)
+
+	assert.Nil(t, c.Render(load(t, "nodeclaim"), "", &r))
+	assert.Equal(t, "default-x7k2p", r.ID)
+	assert.Equal(t, render.Fields{
+		"default-x7k2p",
+		"m5.xlarge",
+		"spot",
+		"us-west-2a",
+		"ip-10-0-12-34.us-west-2.compute.internal",
+		"True",
+		"Drifted",
+		"default",
+		"aws:///us-west-2a/i-0a1b2c3d4e5f67890",
+		"",
+	}, r.Fields[:10])
+}
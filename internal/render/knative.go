@@ -0,0 +1,319 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// KnServiceLabel tracks the Knative service a revision belongs to.
+	KnServiceLabel = "serving.knative.dev/service"
+	// KnRevisionLabel tracks the Knative revision a pod belongs to.
+	KnRevisionLabel = "serving.knative.dev/revision"
+
+	// KnActive tracks a revision serving traffic.
+	KnActive = "Active"
+	// KnScaledToZero tracks a revision with no pods left.
+	KnScaledToZero = "ScaledToZero"
+	// KnActivating tracks a revision scaling up from zero.
+	KnActivating = "Activating"
+
+	knGenerationLabel = "serving.knative.dev/configurationGeneration"
+	knMinScale        = "autoscaling.knative.dev/min-scale"
+	knMaxScale        = "autoscaling.knative.dev/max-scale"
+	knTarget          = "autoscaling.knative.dev/target"
+	knClass           = "autoscaling.knative.dev/class"
+)
+
+// knTraffic represents a Knative traffic target.
+type knTraffic struct {
+	RevisionName   string `json:"revisionName,omitempty"`
+	LatestRevision *bool  `json:"latestRevision,omitempty"`
+	Tag            string `json:"tag,omitempty"`
+	Percent        *int64 `json:"percent,omitempty"`
+}
+
+// knServiceRes tracks the Knative Service and Route fields we care about.
+type knServiceRes struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Status struct {
+		URL                       string           `json:"url,omitempty"`
+		LatestReadyRevisionName   string           `json:"latestReadyRevisionName,omitempty"`
+		LatestCreatedRevisionName string           `json:"latestCreatedRevisionName,omitempty"`
+		Traffic                   []knTraffic      `json:"traffic,omitempty"`
+		Conditions                statusConditions `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// knRevisionRes tracks the Knative Revision fields we care about.
+type knRevisionRes struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		ActualReplicas  *int32           `json:"actualReplicas,omitempty"`
+		DesiredReplicas *int32           `json:"desiredReplicas,omitempty"`
+		Conditions      statusConditions `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// KnService renders a Knative Service to screen.
+type KnService struct{}
+
+// ColorerFunc colors a resource row.
+func (KnService) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (KnService) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "URL"},
+		HeaderColumn{Name: "LATEST"},
+		HeaderColumn{Name: "TRAFFIC"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LATEST CREATED", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (KnService) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected KnService, but got %T", o)
+	}
+	var svc knServiceRes
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &svc)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(svc.ObjectMeta)
+	r.Fields = Fields{
+		svc.Name,
+		missing(svc.Status.URL),
+		missing(svc.Status.LatestReadyRevisionName),
+		knTrafficSplit(svc.Status.Traffic, svc.Status.LatestReadyRevisionName),
+		svc.Status.Conditions.status("Ready"),
+		missing(svc.Status.LatestCreatedRevisionName),
+		asStatus(knDiagnose(svc.Status.Conditions)),
+		toAge(svc.CreationTimestamp),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// KnRoute renders a Knative Route to screen.
+type KnRoute struct{}
+
+// ColorerFunc colors a resource row.
+func (KnRoute) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (KnRoute) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "URL"},
+		HeaderColumn{Name: "TRAFFIC"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (KnRoute) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected KnRoute, but got %T", o)
+	}
+	var rt knServiceRes
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rt)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(rt.ObjectMeta)
+	r.Fields = Fields{
+		rt.Name,
+		missing(rt.Status.URL),
+		knTrafficSplit(rt.Status.Traffic, ""),
+		rt.Status.Conditions.status("Ready"),
+		asStatus(knDiagnose(rt.Status.Conditions)),
+		toAge(rt.CreationTimestamp),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// KnRevision renders a Knative Revision to screen.
+type KnRevision struct{}
+
+// ColorerFunc colors a resource row.
+func (KnRevision) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		stateCol := h.IndexOf("STATE", true)
+		if stateCol == -1 || c == ErrColor {
+			return c
+		}
+		switch re.Row.Fields[stateCol] {
+		case KnActivating:
+			return AddColor
+		case KnScaledToZero:
+			return CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (KnRevision) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SERVICE"},
+		HeaderColumn{Name: "GENERATION", Align: tview.AlignRight},
+		HeaderColumn{Name: "CONCURRENCY", Align: tview.AlignRight},
+		HeaderColumn{Name: "TARGET", Align: tview.AlignRight},
+		HeaderColumn{Name: "SCALE", Align: tview.AlignRight},
+		HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "CLASS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (KnRevision) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected KnRevision, but got %T", o)
+	}
+	var rev knRevisionRes
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rev)
+	if err != nil {
+		return err
+	}
+
+	concurrency := "unlimited"
+	if c := rev.Spec.ContainerConcurrency; c != nil && *c > 0 {
+		concurrency = strconv.FormatInt(*c, 10)
+	}
+	lo, hi := knAnnotation(rev.Annotations, knMinScale, "0"), knAnnotation(rev.Annotations, knMaxScale, Glyph(InfinitySign))
+	replicas := MissingValue
+	if rev.Status.ActualReplicas != nil {
+		replicas = strconv.Itoa(int(*rev.Status.ActualReplicas))
+		if rev.Status.DesiredReplicas != nil {
+			replicas += "/" + strconv.Itoa(int(*rev.Status.DesiredReplicas))
+		}
+	}
+
+	r.ID = client.MetaFQN(rev.ObjectMeta)
+	r.Fields = Fields{
+		rev.Name,
+		missing(rev.Labels[KnServiceLabel]),
+		missing(rev.Labels[knGenerationLabel]),
+		concurrency,
+		knAnnotation(rev.Annotations, knTarget, MissingValue),
+		lo + "-" + hi,
+		replicas,
+		knRevisionState(rev),
+		rev.Status.Conditions.status("Ready"),
+		knAnnotation(rev.Annotations, knClass, MissingValue),
+		asStatus(knDiagnose(rev.Status.Conditions)),
+		toAge(rev.CreationTimestamp),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// knTrafficSplit renders traffic targets ie rev-00002:90% rev-00001:10%.
+func knTrafficSplit(tt []knTraffic, latest string) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		if t.Percent == nil || *t.Percent == 0 {
+			continue
+		}
+		n := t.RevisionName
+		if n == "" && t.LatestRevision != nil && *t.LatestRevision {
+			n = latest
+		}
+		if n == "" {
+			n = "@latest"
+		}
+		if t.Tag != "" {
+			n += "(" + t.Tag + ")"
+		}
+		ss = append(ss, n+":"+strconv.FormatInt(*t.Percent, 10)+"%")
+	}
+	if len(ss) == 0 {
+		return MissingValue
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// knRevisionState reports whether a revision serves traffic or scaled to zero.
+func knRevisionState(rev knRevisionRes) string {
+	c, ok := rev.Status.Conditions.find("Active")
+	if !ok {
+		return MissingValue
+	}
+	switch {
+	case c.Status == string(v1.ConditionTrue):
+		return KnActive
+	case c.Status == string(v1.ConditionUnknown):
+		return KnActivating
+	case rev.Status.ActualReplicas == nil || *rev.Status.ActualReplicas == 0:
+		return KnScaledToZero
+	default:
+		return c.Reason
+	}
+}
+
+func knAnnotation(aa map[string]string, k, dflt string) string {
+	if v, ok := aa[k]; ok && v != "" {
+		return v
+	}
+	// Older Knative releases use camel cased autoscaling annotations.
+	if v, ok := aa[strings.Replace(k, "-s", "S", 1)]; ok && v != "" {
+		return v
+	}
+
+	return dflt
+}
+
+func knDiagnose(cc statusConditions) error {
+	if c, ok := cc.find("Ready"); ok && c.Status == string(v1.ConditionFalse) {
+		return fmt.Errorf("%s %s", c.Reason, c.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnServiceRender(t *testing.T) {
+	c := render.KnService{}
+	r := render.NewRow(8)
+
+	assert.Nil(t, c.Render(load(t, "ksvc"), "", &r))
+	assert.Equal(t, "default/hello", r.ID)
+	assert.Equal(t, render.Fields{
+		"hello",
+		"http://hello.default.example.com",
+		"hello-00002",
+		"hello-00002:80% hello-00001(old):20%",
+		"True",
+		"hello-00002",
+		"",
+	}, r.Fields[:7])
+}
+
+func TestKnRouteRender(t *testing.T) {
+	c := render.KnRoute{}
+	r := render.NewRow(6)
+
+	assert.Nil(t, c.Render(load(t, "ksvc"), "", &r))
+	assert.Equal(t, render.Fields{"hello", "http://hello.default.example.com", "hello-00002:80% hello-00001(old):20%", "True", ""}, r.Fields[:5])
+}
+
+func TestKnRevisionRender(t *testing.T) {
+	c := render.KnRevision{}
+	r := render.NewRow(12)
+
+	assert.Nil(t, c.Render(load(t, "krev"), "", &r))
+	assert.Equal(t, "default/hello-00001", r.ID)
+	assert.Equal(t, render.Fields{
+		"hello-00001",
+		"hello",
+		"1",
+		"10",
+		"50",
+		"0-5",
+		"0/0",
+		render.KnScaledToZero,
+		"True",
+		"<none>",
+		"",
+	}, r.Fields[:11])
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
@@ -12,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -34,6 +36,7 @@ func (Node) Header(_ string) Header {
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "STATUS"},
 		HeaderColumn{Name: "ROLE"},
+		HeaderColumn{Name: "LIFECYCLE"},
 		HeaderColumn{Name: "VERSION"},
 		HeaderColumn{Name: "KERNEL", Wide: true},
 		HeaderColumn{Name: "INTERNAL-IP", Wide: true},
@@ -72,6 +75,7 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	iIP, eIP = missing(iIP), missing(eIP)
 
 	c, a, p := gatherNodeMX(&no, oo.MX)
+	lc := NodeLifecycle(&no, time.Now())
 
 	statuses := make(sort.StringSlice, 10)
 	status(no.Status, no.Spec.Unschedulable, statuses)
@@ -85,6 +89,7 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		no.Name,
 		join(statuses, ","),
 		join(roles, ","),
+		lc.String(),
 		no.Status.NodeInfo.KubeletVersion,
 		no.Status.NodeInfo.KernelVersion,
 		iIP,
@@ -96,14 +101,20 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		a.cpu,
 		a.mem,
 		mapToStr(no.Labels),
-		asStatus(n.diagnose(statuses)),
+		asStatus(n.diagnose(statuses, lc)),
 		toAge(no.ObjectMeta.CreationTimestamp),
 	}
 
 	return nil
 }
 
-func (Node) diagnose(ss []string) error {
+func (Node) diagnose(ss []string, lc Lifecycle) error {
+	if lc.Evaporating() {
+		if lc.Remaining != nil && *lc.Remaining > 0 {
+			return fmt.Errorf("interruption notice %s, reclaim in %s", lc.Notice, duration.HumanDuration(*lc.Remaining))
+		}
+		return fmt.Errorf("interruption notice %s", lc.Notice)
+	}
 	if len(ss) == 0 {
 		return nil
 	}
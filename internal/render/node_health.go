@@ -0,0 +1,31 @@
+package render
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeHealthy tracks a node with no adverse conditions.
+const NodeHealthy = "OK"
+
+// NodeHealth returns a node adverse conditions as badges, ie NotReady or
+// MemoryPressure. Besides Ready, node conditions are adverse when true.
+func NodeHealth(cc []v1.NodeCondition) string {
+	var bb []string
+	for _, c := range cc {
+		switch {
+		case c.Type == v1.NodeReady:
+			if c.Status != v1.ConditionTrue {
+				bb = append([]string{"Not" + string(v1.NodeReady)}, bb...)
+			}
+		case c.Status == v1.ConditionTrue:
+			bb = append(bb, string(c.Type))
+		}
+	}
+	if len(bb) == 0 {
+		return NodeHealthy
+	}
+
+	return strings.Join(bb, ",")
+}
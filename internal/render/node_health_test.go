@@ -0,0 +1,53 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNodeHealth(t *testing.T) {
+	uu := map[string]struct {
+		cc []v1.NodeCondition
+		e  string
+	}{
+		"none": {e: render.NodeHealthy},
+		"healthy": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+			},
+			e: render.NodeHealthy,
+		},
+		"notReady": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionUnknown},
+			},
+			e: "NotReady",
+		},
+		"pressure": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+			},
+			e: "MemoryPressure",
+		},
+		"toast": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+				{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue},
+				{Type: v1.NodeReady, Status: v1.ConditionFalse},
+			},
+			e: "NotReady,MemoryPressure,DiskPressure",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.NodeHealth(u.cc))
+		})
+	}
+}
@@ -16,13 +16,13 @@ func TestNodeRender(t *testing.T) {
 	}
 
 	var no render.Node
-	r := render.NewRow(14)
+	r := render.NewRow(15)
 	err := no.Render(&pom, "", &r)
 	assert.Nil(t, err)
 
 	assert.Equal(t, "minikube", r.ID)
-	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "10", "10", "0", "0", "4000", "7874"}
-	assert.Equal(t, e, r.Fields[:13])
+	e := render.Fields{"minikube", "Ready", "master", "<none>", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "10", "10", "0", "0", "4000", "7874"}
+	assert.Equal(t, e, r.Fields[:14])
 }
 
 func BenchmarkNodeRender(b *testing.B) {
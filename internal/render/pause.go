@@ -0,0 +1,29 @@
+package render
+
+import "strconv"
+
+// PausedAnnotation tracks the replica count of a workload paused by k9s.
+const PausedAnnotation = "k9s.io/paused-replicas"
+
+// PausedReplicas returns the replica count remembered when a workload was paused.
+func PausedReplicas(annotations map[string]string) (int32, bool) {
+	v, ok := annotations[PausedAnnotation]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+
+	return int32(n), true
+}
+
+func pausedStatus(annotations map[string]string) string {
+	n, ok := PausedReplicas(annotations)
+	if !ok {
+		return ""
+	}
+
+	return "paused(" + strconv.Itoa(int(n)) + ")"
+}
@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPausedReplicas(t *testing.T) {
+	uu := map[string]struct {
+		aa map[string]string
+		n  int32
+		ok bool
+	}{
+		"none": {},
+		"paused": {
+			aa: map[string]string{render.PausedAnnotation: "3"},
+			n:  3,
+			ok: true,
+		},
+		"bad": {
+			aa: map[string]string{render.PausedAnnotation: "blee"},
+		},
+		"negative": {
+			aa: map[string]string{render.PausedAnnotation: "-1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, ok := render.PausedReplicas(u.aa)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.n, n)
+		})
+	}
+}
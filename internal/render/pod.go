@@ -68,7 +68,9 @@ func (Pod) Header(ns string) Header {
 		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "IP"},
 		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "REASON", Wide: true, MaxWidth: reasonMaxWidth},
 		HeaderColumn{Name: "QOS", Wide: true},
+		HeaderColumn{Name: "NODE-HEALTH", Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
@@ -106,7 +108,9 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		perc.memLim,
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
+		PodReason(&po),
 		p.mapQOS(po.Status.QOSClass),
+		na(pwm.NodeHealth),
 		mapToStr(po.Labels),
 		asStatus(p.diagnose(phase, cr, len(ss))),
 		toAge(po.ObjectMeta.CreationTimestamp),
@@ -133,6 +137,8 @@ func (p Pod) diagnose(phase string, cr, ct int) error {
 type PodWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.PodMetrics
+	// NodeHealth tracks the hosting node health badges if known.
+	NodeHealth string
 }
 
 // GetObjectKind returns a schema object.
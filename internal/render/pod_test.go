@@ -154,13 +154,30 @@ func TestPodRender(t *testing.T) {
 	}
 
 	var po render.Pod
-	r := render.NewRow(14)
+	r := render.NewRow(15)
 	err := po.Render(&pom, "", &r)
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "0", "Running", "10", "10", "10", "14", render.NAValue, "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"default", "nginx", "1/1", "0", "Running", "10", "10", "10", "14", render.NAValue, "5", "172.17.0.6", "minikube", "", "BE"}
+	assert.Equal(t, e, r.Fields[:15])
+}
+
+func TestPodRenderNodeHealth(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:        load(t, "po"),
+		MX:         makePodMX("nginx", "10m", "10Mi"),
+		NodeHealth: "MemoryPressure",
+	}
+
+	var po render.Pod
+	r := render.NewRow(16)
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, "MemoryPressure", r.Fields[15])
+
+	pom.NodeHealth = ""
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, render.NAValue, r.Fields[15])
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -185,13 +202,13 @@ func TestPodInitRender(t *testing.T) {
 	}
 
 	var po render.Pod
-	r := render.NewRow(14)
+	r := render.NewRow(15)
 	err := po.Render(&pom, "", &r)
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "0", "Init:0/1", "10", "10", "10", "14", render.NAValue, "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"default", "nginx", "1/1", "0", "Init:0/1", "10", "10", "10", "14", render.NAValue, "5", "172.17.0.6", "minikube", "", "BE"}
+	assert.Equal(t, e, r.Fields[:15])
 }
 
 // ----------------------------------------------------------------------------
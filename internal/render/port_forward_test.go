@@ -30,6 +30,10 @@ func TestPortForwardRender(t *testing.T) {
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
+		"Active",
+		"2",
+		"1,024",
+		"1m",
 		"",
 		"2m",
 	}, r.Fields)
@@ -58,3 +62,19 @@ func (f fwd) Active() bool {
 func (f fwd) Age() string {
 	return "2m"
 }
+
+func (f fwd) State() string {
+	return "Active"
+}
+
+func (f fwd) Restarts() int {
+	return 2
+}
+
+func (f fwd) Bytes() int64 {
+	return 1024
+}
+
+func (f fwd) Uptime() string {
+	return "1m"
+}
@@ -2,9 +2,11 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +28,18 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() string
+
+	// State returns forwarder health state.
+	State() string
+
+	// Restarts returns the number of tunnel restarts.
+	Restarts() int
+
+	// Bytes returns the number of bytes transferred.
+	Bytes() int64
+
+	// Uptime returns the time since the tunnel was last established.
+	Uptime() string
 }
 
 // PortForward renders a portforwards to screen.
@@ -33,8 +47,19 @@ type PortForward struct{}
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() ColorerFunc {
-	return func(ns string, _ Header, re RowEvent) tcell.Color {
-		return tcell.ColorSkyblue
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATE", true)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return tcell.ColorSkyblue
+		}
+		switch re.Row.Fields[idx] {
+		case "Unhealthy":
+			return ErrColor
+		case "Restarting":
+			return ModColor
+		default:
+			return tcell.ColorSkyblue
+		}
 	}
 }
 
@@ -48,6 +73,10 @@ func (PortForward) Header(ns string) Header {
 		HeaderColumn{Name: "URL"},
 		HeaderColumn{Name: "C"},
 		HeaderColumn{Name: "N"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "RESTARTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "BYTES", Align: tview.AlignRight},
+		HeaderColumn{Name: "UPTIME"},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
 	}
@@ -72,6 +101,10 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0]),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
+		pf.State(),
+		strconv.Itoa(pf.Restarts()),
+		AsThousands(pf.Bytes()),
+		pf.Uptime(),
 		"",
 		pf.Age(),
 	}
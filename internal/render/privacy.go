@@ -0,0 +1,183 @@
+package render
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// PrivacyMask replaces values masked in privacy mode.
+	PrivacyMask = "****"
+
+	// maxPrivacyNames caps the number of masked resource names tracked.
+	maxPrivacyNames = 10000
+
+	// minPrivacyName skips masking short names in texts.
+	minPrivacyName = 3
+)
+
+var (
+	privacyMode  int32
+	privacyMx    sync.RWMutex
+	privacyRR    []PrivacyRule
+	privacyNames = make(map[string]struct{})
+)
+
+// PrivacyRule masks sensitive column values while screen sharing.
+type PrivacyRule struct {
+	gvrs, cols map[string]struct{}
+	rx         *regexp.Regexp
+}
+
+// NewPrivacyRule returns a rule masking the given resources columns. Blank
+// resources match all resources and blank columns all columns. Only the
+// values parts matching the pattern are masked, whole values if blank.
+func NewPrivacyRule(gvrs, cols []string, pattern string) (PrivacyRule, error) {
+	r := PrivacyRule{gvrs: toSet(gvrs, false), cols: toSet(cols, true)}
+	if pattern != "" {
+		rx, err := regexp.Compile(pattern)
+		if err != nil {
+			return r, err
+		}
+		r.rx = rx
+	}
+
+	return r, nil
+}
+
+// SetPrivacyRules sets the privacy mode redaction rules.
+func SetPrivacyRules(rr []PrivacyRule) {
+	privacyMx.Lock()
+	defer privacyMx.Unlock()
+
+	privacyRR = rr
+	privacyNames = make(map[string]struct{})
+}
+
+// SetPrivacyMode toggles privacy mode.
+func SetPrivacyMode(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&privacyMode, v)
+}
+
+// IsPrivacyMode returns true if sensitive values must be masked.
+func IsPrivacyMode() bool {
+	return atomic.LoadInt32(&privacyMode) == 1
+}
+
+// RedactCell masks a resource column value matching a privacy rule. Masked
+// names are tracked so they are also masked in titles and manifests.
+func RedactCell(gvr, col, s string) string {
+	if !IsPrivacyMode() || s == "" || s == NAValue || s == MissingValue {
+		return s
+	}
+	col = strings.ToUpper(col)
+	v := redactCell(gvr, col, s)
+	if col == "NAME" && v != s {
+		trackName(s)
+	}
+
+	return v
+}
+
+func redactCell(gvr, col, s string) string {
+	privacyMx.RLock()
+	defer privacyMx.RUnlock()
+
+	for _, r := range privacyRR {
+		if !r.matches(gvr, col) {
+			continue
+		}
+		if r.rx == nil {
+			return PrivacyMask
+		}
+		s = r.rx.ReplaceAllString(s, PrivacyMask)
+	}
+
+	return s
+}
+
+// RedactPath masks a resource path name matching a privacy rule on names.
+func RedactPath(gvr, path string) string {
+	ns, n := client.Namespaced(path)
+
+	return client.FQN(ns, RedactCell(gvr, "NAME", n))
+}
+
+func trackName(n string) {
+	privacyMx.Lock()
+	defer privacyMx.Unlock()
+
+	if len(privacyNames) >= maxPrivacyNames {
+		return
+	}
+	privacyNames[n] = struct{}{}
+}
+
+// RedactText masks the parts of a text matching pattern rules that are not
+// scoped to resources or columns, ie annotation values in a manifest, along
+// with the resource names masked so far, ie in titles, crumbs or manifests.
+func RedactText(s string) string {
+	if !IsPrivacyMode() {
+		return s
+	}
+	privacyMx.RLock()
+	defer privacyMx.RUnlock()
+
+	for _, r := range privacyRR {
+		if r.rx == nil || len(r.gvrs) != 0 || len(r.cols) != 0 {
+			continue
+		}
+		s = r.rx.ReplaceAllString(s, PrivacyMask)
+	}
+	nn := make([]string, 0, len(privacyNames))
+	for n := range privacyNames {
+		if len(n) >= minPrivacyName {
+			nn = append(nn, n)
+		}
+	}
+	// Longest names first so names sharing a prefix are fully masked.
+	sort.Slice(nn, func(i, j int) bool { return len(nn[i]) > len(nn[j]) })
+	for _, n := range nn {
+		s = strings.ReplaceAll(s, n, PrivacyMask)
+	}
+
+	return s
+}
+
+func (r PrivacyRule) matches(gvr, col string) bool {
+	if len(r.gvrs) != 0 {
+		if _, ok := r.gvrs[gvr]; !ok {
+			return false
+		}
+	}
+	if len(r.cols) == 0 {
+		return true
+	}
+	_, ok := r.cols[col]
+
+	return ok
+}
+
+func toSet(ss []string, upper bool) map[string]struct{} {
+	if len(ss) == 0 {
+		return nil
+	}
+	m := make(map[string]struct{}, len(ss))
+	for _, s := range ss {
+		if upper {
+			s = strings.ToUpper(s)
+		}
+		m[s] = struct{}{}
+	}
+
+	return m
+}
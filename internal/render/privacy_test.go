@@ -0,0 +1,81 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactCell(t *testing.T) {
+	defer render.SetPrivacyMode(false)
+	defer render.SetPrivacyRules(nil)
+
+	ip, err := render.NewPrivacyRule(nil, nil, `\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	assert.Nil(t, err)
+	sec, err := render.NewPrivacyRule([]string{"v1/secrets"}, []string{"name"}, "")
+	assert.Nil(t, err)
+	render.SetPrivacyRules([]render.PrivacyRule{ip, sec})
+
+	assert.Equal(t, "10.0.0.1", render.RedactCell("v1/pods", "IP", "10.0.0.1"))
+
+	render.SetPrivacyMode(true)
+	uu := map[string]struct {
+		gvr, col, s, e string
+	}{
+		"ip": {
+			gvr: "v1/nodes", col: "INTERNAL-IP", s: "10.0.0.1", e: render.PrivacyMask,
+		},
+		"partial": {
+			gvr: "v1/services", col: "EXTERNAL-IP", s: "10.0.0.1,fred.com", e: render.PrivacyMask + ",fred.com",
+		},
+		"secret": {
+			gvr: "v1/secrets", col: "NAME", s: "db-creds", e: render.PrivacyMask,
+		},
+		"other": {
+			gvr: "v1/configmaps", col: "NAME", s: "db-creds", e: "db-creds",
+		},
+		"missing": {
+			gvr: "v1/secrets", col: "NAME", s: render.MissingValue, e: render.MissingValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.RedactCell(u.gvr, u.col, u.s))
+		})
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	defer render.SetPrivacyMode(false)
+	defer render.SetPrivacyRules(nil)
+
+	tok, err := render.NewPrivacyRule(nil, nil, `token-\w+`)
+	assert.Nil(t, err)
+	sec, err := render.NewPrivacyRule([]string{"v1/secrets"}, nil, `fred`)
+	assert.Nil(t, err)
+	render.SetPrivacyRules([]render.PrivacyRule{tok, sec})
+	render.SetPrivacyMode(true)
+
+	assert.Equal(t, "a: "+render.PrivacyMask+"\nb: fred", render.RedactText("a: token-blee\nb: fred"))
+
+	_, err = render.NewPrivacyRule(nil, nil, `(`)
+	assert.NotNil(t, err)
+}
+
+func TestRedactTextNames(t *testing.T) {
+	defer render.SetPrivacyMode(false)
+	defer render.SetPrivacyRules(nil)
+
+	sec, err := render.NewPrivacyRule([]string{"v1/secrets"}, []string{"NAME"}, "")
+	assert.Nil(t, err)
+	render.SetPrivacyRules([]render.PrivacyRule{sec})
+	render.SetPrivacyMode(true)
+
+	assert.Equal(t, "name: db-creds-tls", render.RedactText("name: db-creds-tls"))
+	assert.Equal(t, "fred/"+render.PrivacyMask, render.RedactPath("v1/secrets", "fred/db-creds"))
+	assert.Equal(t, render.PrivacyMask, render.RedactCell("v1/secrets", "NAME", "db-creds-tls"))
+	assert.Equal(t, "name: "+render.PrivacyMask+"\nref: "+render.PrivacyMask, render.RedactText("name: db-creds-tls\nref: db-creds"))
+}
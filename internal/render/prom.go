@@ -0,0 +1,78 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+// PromMetric renders prometheus metrics samples to screen.
+type PromMetric struct{}
+
+// ColorerFunc colors a resource row.
+func (PromMetric) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorLightSkyBlue
+	}
+}
+
+// Header returns a header row.
+func (PromMetric) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "LABELS"},
+		HeaderColumn{Name: "VALUE", Align: tview.AlignRight},
+		HeaderColumn{Name: "HELP", Wide: true},
+	}
+}
+
+// Render renders a metric sample to screen.
+func (PromMetric) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(PromSample)
+	if !ok {
+		return fmt.Errorf("expected PromSample, but got %T", o)
+	}
+
+	labels := s.LabelsString()
+	r.ID = s.Name
+	if labels != "" {
+		r.ID += "{" + labels + "}"
+	}
+	r.Fields = Fields{
+		s.Name,
+		s.Type,
+		labels,
+		s.Value,
+		s.Help,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PromSample represents a prometheus metric sample.
+type PromSample struct {
+	Name, Type, Help, Value string
+	Labels                  map[string]string
+}
+
+// LabelsString returns the sample labels sorted by name.
+func (s PromSample) LabelsString() string {
+	kk := make([]string, 0, len(s.Labels))
+	for k := range s.Labels {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ll := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ll = append(ll, k+"="+s.Labels[k])
+	}
+
+	return strings.Join(ll, ",")
+}
@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromMetricRender(t *testing.T) {
+	var (
+		p render.PromMetric
+		r render.Row
+	)
+	s := render.PromSample{
+		Name:   "http_requests_total",
+		Type:   "counter",
+		Help:   "Total requests.",
+		Value:  "1027",
+		Labels: map[string]string{"method": "post", "code": "200"},
+	}
+
+	assert.Nil(t, p.Render(s, "", &r))
+	assert.Equal(t, "http_requests_total{code=200,method=post}", r.ID)
+	assert.Equal(t, render.Fields{"http_requests_total", "counter", "code=200,method=post", "1027", "Total requests."}, r.Fields)
+}
@@ -0,0 +1,111 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// reasonMaxWidth caps the REASON column width. Cut reasons show in full
+// when inspecting the cell.
+const reasonMaxWidth = 60
+
+// PodReason explains why a pod is not healthy, from its containers states
+// and conditions. Healthy pods have no reason.
+func PodReason(po *v1.Pod) string {
+	if po.DeletionTimestamp != nil || po.Status.Phase == v1.PodSucceeded {
+		return ""
+	}
+	if po.Status.Reason != "" {
+		return joinReason(po.Status.Reason, po.Status.Message)
+	}
+
+	css := append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...)
+	for _, cs := range append(css, po.Status.ContainerStatuses...) {
+		if r := containerReason(cs); r != "" {
+			return r
+		}
+	}
+
+	for _, t := range []v1.PodConditionType{v1.PodScheduled, v1.PodInitialized, v1.ContainersReady, v1.PodReady} {
+		for _, c := range po.Status.Conditions {
+			if c.Type == t && c.Status == v1.ConditionFalse {
+				return joinReason(c.Reason, c.Message)
+			}
+		}
+	}
+
+	return ""
+}
+
+func containerReason(cs v1.ContainerStatus) string {
+	switch {
+	case cs.State.Waiting != nil:
+		w := cs.State.Waiting
+		if w.Reason == ContainerCreating || w.Reason == PodInitializing {
+			return ""
+		}
+		if t := cs.LastTerminationState.Terminated; t != nil {
+			return joinReason(w.Reason, terminatedReason(cs.Name, t))
+		}
+		return joinReason(w.Reason, w.Message)
+	case cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0:
+		return joinReason(cs.State.Terminated.Reason, terminatedReason(cs.Name, cs.State.Terminated))
+	case cs.State.Running != nil && !cs.Ready:
+		return fmt.Sprintf("Unready: container %s is not ready", cs.Name)
+	}
+
+	return ""
+}
+
+func terminatedReason(co string, t *v1.ContainerStateTerminated) string {
+	s := fmt.Sprintf("container %s exited with code %d", co, t.ExitCode)
+	if t.Message != "" {
+		s += " " + t.Message
+	}
+
+	return s
+}
+
+// DeploymentReason explains why a deployment is not progressing or not
+// available from its conditions.
+func DeploymentReason(cc []appsv1.DeploymentCondition) string {
+	for _, t := range []appsv1.DeploymentConditionType{appsv1.DeploymentReplicaFailure, appsv1.DeploymentProgressing, appsv1.DeploymentAvailable} {
+		for _, c := range cc {
+			if c.Type != t {
+				continue
+			}
+			if (t == appsv1.DeploymentReplicaFailure) == (c.Status == v1.ConditionTrue) {
+				return joinReason(c.Reason, c.Message)
+			}
+		}
+	}
+
+	return ""
+}
+
+// ReplicaSetReason explains why a replicaset fails to create its pods.
+func ReplicaSetReason(cc []appsv1.ReplicaSetCondition) string {
+	for _, c := range cc {
+		if c.Type == appsv1.ReplicaSetReplicaFailure && c.Status == v1.ConditionTrue {
+			return joinReason(c.Reason, c.Message)
+		}
+	}
+
+	return ""
+}
+
+// joinReason returns a one line reason along with its message if any.
+func joinReason(reason, msg string) string {
+	msg = strings.Join(strings.Fields(msg), " ")
+	switch {
+	case reason == "":
+		return msg
+	case msg == "":
+		return reason
+	default:
+		return reason + ": " + msg
+	}
+}
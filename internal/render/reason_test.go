@@ -0,0 +1,135 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPodReason(t *testing.T) {
+	uu := map[string]struct {
+		s v1.PodStatus
+		e string
+	}{
+		"healthy": {
+			s: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+		},
+		"evicted": {
+			s: v1.PodStatus{
+				Phase:   v1.PodFailed,
+				Reason:  "Evicted",
+				Message: "The node was low on resource:\n  memory.",
+			},
+			e: "Evicted: The node was low on resource: memory.",
+		},
+		"crashloop": {
+			s: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:                 "c1",
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}},
+					},
+				},
+			},
+			e: "CrashLoopBackOff: container c1 exited with code 1",
+		},
+		"creating": {
+			s: v1.PodStatus{
+				Phase: v1.PodPending,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: render.ContainerCreating}}},
+				},
+			},
+		},
+		"init": {
+			s: v1.PodStatus{
+				Phase: v1.PodPending,
+				InitContainerStatuses: []v1.ContainerStatus{
+					{Name: "i1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}}},
+				},
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: render.PodInitializing}}},
+				},
+			},
+			e: "ImagePullBackOff: Back-off pulling image",
+		},
+		"unready": {
+			s: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+			e: "Unready: container c1 is not ready",
+		},
+		"unschedulable": {
+			s: v1.PodStatus{
+				Phase: v1.PodPending,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady"},
+					{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available."},
+				},
+			},
+			e: "Unschedulable: 0/3 nodes are available.",
+		},
+		"completed": {
+			s: v1.PodStatus{
+				Phase: v1.PodSucceeded,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "PodCompleted"},
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.PodReason(&v1.Pod{Status: u.s}))
+		})
+	}
+}
+
+func TestDeploymentReason(t *testing.T) {
+	uu := map[string]struct {
+		cc []appsv1.DeploymentCondition
+		e  string
+	}{
+		"healthy": {
+			cc: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: v1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+				{Type: appsv1.DeploymentProgressing, Status: v1.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+			},
+		},
+		"unavailable": {
+			cc: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: v1.ConditionFalse, Reason: "MinimumReplicasUnavailable", Message: "Deployment does not have minimum availability."},
+			},
+			e: "MinimumReplicasUnavailable: Deployment does not have minimum availability.",
+		},
+		"quota": {
+			cc: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: v1.ConditionFalse, Reason: "MinimumReplicasUnavailable"},
+				{Type: appsv1.DeploymentReplicaFailure, Status: v1.ConditionTrue, Reason: "FailedCreate", Message: "exceeded quota"},
+			},
+			e: "FailedCreate: exceeded quota",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.DeploymentReason(u.cc))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	redactMode int32
+
+	redactMx    sync.RWMutex
+	redactNames = make(map[string]string)
+
+	redactAdjectives = []string{
+		"amber", "brave", "calm", "dusty", "eager", "fuzzy", "gentle", "happy",
+		"icy", "jolly", "keen", "lucky", "mellow", "noble", "proud", "quiet",
+	}
+	redactNouns = []string{
+		"otter", "falcon", "badger", "heron", "lynx", "panda", "raven", "tiger",
+		"walrus", "zebra", "koala", "moose", "gecko", "bison", "crane", "dingo",
+	}
+)
+
+// SetRedactMode toggles redaction of resource names.
+func SetRedactMode(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&redactMode, v)
+}
+
+// IsRedactMode returns true if resource names must be redacted.
+func IsRedactMode() bool {
+	return atomic.LoadInt32(&redactMode) == 1
+}
+
+// Redact replaces a value with a stable fake value when redaction is on.
+// Blank and n/a values are left untouched.
+func Redact(s string) string {
+	if !IsRedactMode() || s == "" || s == NAValue || s == MissingValue {
+		return s
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	v := h.Sum32()
+
+	r := fmt.Sprintf("%s-%s-%02x",
+		redactAdjectives[v%uint32(len(redactAdjectives))],
+		redactNouns[(v>>8)%uint32(len(redactNouns))],
+		(v>>16)&0xff,
+	)
+	trackRedacted(s, r)
+
+	return r
+}
+
+// RedactNames replaces the names redacted so far within a text, ie the
+// resource names listed in a confirmation dialog.
+func RedactNames(s string) string {
+	if !IsRedactMode() {
+		return s
+	}
+	redactMx.RLock()
+	defer redactMx.RUnlock()
+
+	nn := make([]string, 0, len(redactNames))
+	for n := range redactNames {
+		if len(n) >= minPrivacyName {
+			nn = append(nn, n)
+		}
+	}
+	// Longest names first so names sharing a prefix are fully replaced.
+	sort.Slice(nn, func(i, j int) bool { return len(nn[i]) > len(nn[j]) })
+	for _, n := range nn {
+		s = strings.ReplaceAll(s, n, redactNames[n])
+	}
+
+	return s
+}
+
+// RedactMessage masks the names and sensitive values in a displayed message, ie
+// flashes and confirmations, the same way they are masked in tables.
+func RedactMessage(s string) string {
+	return RedactText(RedactNames(s))
+}
+
+func trackRedacted(n, r string) {
+	redactMx.Lock()
+	defer redactMx.Unlock()
+
+	if _, ok := redactNames[n]; ok || len(redactNames) >= maxPrivacyNames {
+		return
+	}
+	redactNames[n] = r
+}
@@ -0,0 +1,62 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	defer render.SetRedactMode(false)
+
+	assert.Equal(t, "fred", render.Redact("fred"))
+
+	render.SetRedactMode(true)
+	uu := map[string]struct {
+		s string
+	}{
+		"name": {s: "fred"},
+		"ns":   {s: "kube-system"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.Redact(u.s)
+			assert.NotEqual(t, u.s, r)
+			assert.Equal(t, r, render.Redact(u.s))
+		})
+	}
+	assert.NotEqual(t, render.Redact("fred"), render.Redact("blee"))
+	assert.Equal(t, "", render.Redact(""))
+	assert.Equal(t, render.NAValue, render.Redact(render.NAValue))
+}
+
+func TestRedactNames(t *testing.T) {
+	defer render.SetRedactMode(false)
+
+	assert.Equal(t, "Delete ns-1/fred?", render.RedactNames("Delete ns-1/fred?"))
+
+	render.SetRedactMode(true)
+	ns, n := render.Redact("ns-1"), render.Redact("fred")
+	assert.Equal(t, "Delete "+ns+"/"+n+"?", render.RedactNames("Delete ns-1/fred?"))
+	assert.Equal(t, "Delete 2 pods?", render.RedactNames("Delete 2 pods?"))
+}
+
+func TestRedactMessage(t *testing.T) {
+	defer render.SetRedactMode(false)
+	defer render.SetPrivacyMode(false)
+	defer render.SetPrivacyRules(nil)
+
+	sec, err := render.NewPrivacyRule([]string{"v1/secrets"}, []string{"NAME"}, "")
+	assert.Nil(t, err)
+	render.SetPrivacyRules([]render.PrivacyRule{sec})
+	render.SetPrivacyMode(true)
+	assert.Equal(t, render.PrivacyMask, render.RedactCell("v1/secrets", "NAME", "db-creds"))
+	assert.Equal(t, "Delete v1/secrets fred/"+render.PrivacyMask+" succeeded", render.RedactMessage("Delete v1/secrets fred/db-creds succeeded"))
+
+	render.SetRedactMode(true)
+	ns := render.Redact("fred")
+	assert.Equal(t, "Delete v1/secrets "+ns+"/"+render.PrivacyMask+" succeeded", render.RedactMessage("Delete v1/secrets fred/db-creds succeeded"))
+}
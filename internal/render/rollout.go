@@ -0,0 +1,433 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// RolloutCanary tracks an Argo Rollout canary strategy.
+	RolloutCanary = "Canary"
+	// RolloutBlueGreen tracks an Argo Rollout blue/green strategy.
+	RolloutBlueGreen = "BlueGreen"
+	// RolloutAborted tracks an aborted Argo Rollout.
+	RolloutAborted = "Aborted"
+)
+
+// rolloutStep tracks an Argo Rollout canary step.
+type rolloutStep struct {
+	SetWeight *int32                 `json:"setWeight,omitempty"`
+	Pause     map[string]interface{} `json:"pause,omitempty"`
+	Analysis  map[string]interface{} `json:"analysis,omitempty"`
+}
+
+// analysisRunStatus tracks an Argo Rollout analysis run reference.
+type analysisRunStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// argoRollout tracks the Argo Rollout fields we care about.
+type argoRollout struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Replicas *int32 `json:"replicas,omitempty"`
+		Paused   bool   `json:"paused,omitempty"`
+		Strategy struct {
+			Canary *struct {
+				Steps []rolloutStep `json:"steps,omitempty"`
+			} `json:"canary,omitempty"`
+			BlueGreen *struct {
+				ActiveService  string `json:"activeService"`
+				PreviewService string `json:"previewService,omitempty"`
+			} `json:"blueGreen,omitempty"`
+		} `json:"strategy"`
+	} `json:"spec"`
+	Status struct {
+		Phase             string `json:"phase,omitempty"`
+		Message           string `json:"message,omitempty"`
+		Abort             bool   `json:"abort,omitempty"`
+		Replicas          int32  `json:"replicas,omitempty"`
+		UpdatedReplicas   int32  `json:"updatedReplicas,omitempty"`
+		ReadyReplicas     int32  `json:"readyReplicas,omitempty"`
+		AvailableReplicas int32  `json:"availableReplicas,omitempty"`
+		CurrentStepIndex  *int32 `json:"currentStepIndex,omitempty"`
+		Canary            struct {
+			CurrentStepAnalysisRunStatus       *analysisRunStatus `json:"currentStepAnalysisRunStatus,omitempty"`
+			CurrentBackgroundAnalysisRunStatus *analysisRunStatus `json:"currentBackgroundAnalysisRunStatus,omitempty"`
+			Weights                            *struct {
+				Canary struct {
+					Weight int32 `json:"weight"`
+				} `json:"canary"`
+			} `json:"weights,omitempty"`
+		} `json:"canary"`
+		BlueGreen struct {
+			PrePromotionAnalysisRunStatus  *analysisRunStatus `json:"prePromotionAnalysisRunStatus,omitempty"`
+			PostPromotionAnalysisRunStatus *analysisRunStatus `json:"postPromotionAnalysisRunStatus,omitempty"`
+		} `json:"blueGreen"`
+	} `json:"status"`
+}
+
+// flaggerCanary tracks the Flagger Canary fields we care about.
+type flaggerCanary struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		TargetRef struct {
+			Kind string `json:"kind"`
+			Name string `json:"name"`
+		} `json:"targetRef"`
+		SkipAnalysis bool `json:"skipAnalysis,omitempty"`
+		Analysis     struct {
+			Threshold  int32 `json:"threshold,omitempty"`
+			MaxWeight  int32 `json:"maxWeight,omitempty"`
+			StepWeight int32 `json:"stepWeight,omitempty"`
+		} `json:"analysis"`
+	} `json:"spec"`
+	Status struct {
+		Phase              string      `json:"phase,omitempty"`
+		CanaryWeight       int32       `json:"canaryWeight"`
+		FailedChecks       int32       `json:"failedChecks"`
+		Iterations         int32       `json:"iterations"`
+		LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+		Conditions         []struct {
+			Type    string `json:"type"`
+			Status  string `json:"status"`
+			Message string `json:"message,omitempty"`
+		} `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// Rollout renders an Argo Rollout to screen.
+type Rollout struct{}
+
+// ColorerFunc colors a resource row.
+func (Rollout) ColorerFunc() ColorerFunc {
+	return progressiveColorer
+}
+
+// Header returns a header row.
+func (Rollout) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STRATEGY"},
+		HeaderColumn{Name: "STEP", Align: tview.AlignRight},
+		HeaderColumn{Name: "WEIGHT", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		HeaderColumn{Name: "AVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "ANALYSIS"},
+		HeaderColumn{Name: "MESSAGE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ro Rollout) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Rollout, but got %T", o)
+	}
+	var a argoRollout
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &a)
+	if err != nil {
+		return err
+	}
+
+	desired := int32(1)
+	if a.Spec.Replicas != nil {
+		desired = *a.Spec.Replicas
+	}
+	strategy, step, weight := rolloutProgress(a)
+
+	r.ID = client.MetaFQN(a.ObjectMeta)
+	r.Fields = Fields{
+		a.Name,
+		strategy,
+		step,
+		weight,
+		strconv.Itoa(int(a.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(desired)),
+		strconv.Itoa(int(a.Status.UpdatedReplicas)),
+		strconv.Itoa(int(a.Status.AvailableReplicas)),
+		rolloutPhase(a),
+		rolloutAnalysis(a),
+		missing(a.Status.Message),
+		asStatus(ro.diagnose(a, desired)),
+		toAge(a.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (Rollout) diagnose(a argoRollout, desired int32) error {
+	if a.Status.Abort {
+		return fmt.Errorf("rollout aborted %s", a.Status.Message)
+	}
+	if a.Status.Phase == "Degraded" {
+		return fmt.Errorf("rollout degraded %s", a.Status.Message)
+	}
+	if a.Status.AvailableReplicas < desired {
+		return fmt.Errorf("available replicas %d/%d", a.Status.AvailableReplicas, desired)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Canary renders a Flagger Canary to screen.
+type Canary struct{}
+
+// ColorerFunc colors a resource row.
+func (Canary) ColorerFunc() ColorerFunc {
+	return progressiveColorer
+}
+
+// Header returns a header row.
+func (Canary) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TARGET"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "WEIGHT", Align: tview.AlignRight},
+		HeaderColumn{Name: "FAILED CHECKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "ITERATIONS", Align: tview.AlignRight},
+		HeaderColumn{Name: "SKIP ANALYSIS", Wide: true},
+		HeaderColumn{Name: "LAST TRANSITION", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c Canary) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Canary, but got %T", o)
+	}
+	var fc flaggerCanary
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &fc)
+	if err != nil {
+		return err
+	}
+
+	weight := strconv.Itoa(int(fc.Status.CanaryWeight))
+	if fc.Spec.Analysis.MaxWeight > 0 {
+		weight += "/" + strconv.Itoa(int(fc.Spec.Analysis.MaxWeight))
+	}
+	checks := strconv.Itoa(int(fc.Status.FailedChecks))
+	if fc.Spec.Analysis.Threshold > 0 {
+		checks += "/" + strconv.Itoa(int(fc.Spec.Analysis.Threshold))
+	}
+	transition := MissingValue
+	if !fc.Status.LastTransitionTime.IsZero() {
+		transition = toAge(fc.Status.LastTransitionTime)
+	}
+
+	r.ID = client.MetaFQN(fc.ObjectMeta)
+	r.Fields = Fields{
+		fc.Name,
+		fc.Spec.TargetRef.Kind + "/" + fc.Spec.TargetRef.Name,
+		missing(fc.Status.Phase),
+		weight,
+		checks,
+		strconv.Itoa(int(fc.Status.Iterations)),
+		boolToStr(fc.Spec.SkipAnalysis),
+		transition,
+		asStatus(c.diagnose(fc)),
+		toAge(fc.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (Canary) diagnose(fc flaggerCanary) error {
+	if fc.Status.Phase != "Failed" {
+		return nil
+	}
+	for _, c := range fc.Status.Conditions {
+		if c.Type == "Promoted" && c.Message != "" {
+			return fmt.Errorf("canary failed %s", c.Message)
+		}
+	}
+
+	return fmt.Errorf("canary failed")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func progressiveColorer(ns string, h Header, re RowEvent) tcell.Color {
+	c := DefaultColorer(ns, h, re)
+	statusCol := h.IndexOf("STATUS", true)
+	if statusCol == -1 || c == ErrColor {
+		return c
+	}
+	switch re.Row.Fields[statusCol] {
+	case "Progressing", "Promoting", "Finalising", "Initializing", "Waiting":
+		return AddColor
+	case "Paused", "WaitingPromotion":
+		return HighlightColor
+	case "Degraded", "Failed", RolloutAborted:
+		return ErrColor
+	}
+
+	return c
+}
+
+// rolloutProgress returns a rollout strategy, current step and canary weight.
+func rolloutProgress(a argoRollout) (strategy, step, weight string) {
+	if a.Spec.Strategy.BlueGreen != nil {
+		return RolloutBlueGreen, MissingValue, MissingValue
+	}
+	if a.Spec.Strategy.Canary == nil {
+		return MissingValue, MissingValue, MissingValue
+	}
+
+	steps := a.Spec.Strategy.Canary.Steps
+	idx := int32(len(steps))
+	if a.Status.CurrentStepIndex != nil {
+		idx = *a.Status.CurrentStepIndex
+	}
+	step = MissingValue
+	if len(steps) > 0 {
+		step = strconv.Itoa(int(idx)) + "/" + strconv.Itoa(len(steps))
+	}
+	if a.Status.Canary.Weights != nil {
+		return RolloutCanary, step, strconv.Itoa(int(a.Status.Canary.Weights.Canary.Weight))
+	}
+
+	return RolloutCanary, step, strconv.Itoa(int(canaryWeight(steps, idx)))
+}
+
+// canaryWeight returns the weight set by the last setWeight step prior to the
+// given step index. A completed rollout routes all traffic to the new pods.
+func canaryWeight(steps []rolloutStep, idx int32) int32 {
+	if int(idx) >= len(steps) {
+		return 100
+	}
+	var w int32
+	for i := int32(0); i < idx; i++ {
+		if steps[i].SetWeight != nil {
+			w = *steps[i].SetWeight
+		}
+	}
+
+	return w
+}
+
+func rolloutPhase(a argoRollout) string {
+	if a.Status.Abort {
+		return RolloutAborted
+	}
+	if a.Status.Phase == "" && a.Spec.Paused {
+		return "Paused"
+	}
+
+	return missing(a.Status.Phase)
+}
+
+func rolloutAnalysis(a argoRollout) string {
+	for _, s := range []*analysisRunStatus{
+		a.Status.Canary.CurrentStepAnalysisRunStatus,
+		a.Status.Canary.CurrentBackgroundAnalysisRunStatus,
+		a.Status.BlueGreen.PrePromotionAnalysisRunStatus,
+		a.Status.BlueGreen.PostPromotionAnalysisRunStatus,
+	} {
+		if s != nil && s.Status != "" {
+			return s.Status
+		}
+	}
+
+	return MissingValue
+}
+
+// ----------------------------------------------------------------------------
+
+// AnalysisRun renders an Argo Rollouts AnalysisRun to screen.
+type AnalysisRun struct{}
+
+// ColorerFunc colors a resource row.
+func (AnalysisRun) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 || c == ErrColor {
+			return c
+		}
+		switch re.Row.Fields[statusCol] {
+		case "Pending", "Running":
+			return AddColor
+		case "Successful":
+			return CompletedColor
+		case "Inconclusive":
+			return HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (AnalysisRun) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "ROLLOUT"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "METRICS", Align: tview.AlignRight},
+		HeaderColumn{Name: "MESSAGE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (AnalysisRun) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected AnalysisRun, but got %T", o)
+	}
+	phase, _, _ := unstructured.NestedString(raw.Object, "status", "phase")
+	msg, _, _ := unstructured.NestedString(raw.Object, "status", "message")
+	mm, _, _ := unstructured.NestedSlice(raw.Object, "status", "metricResults")
+	var passed int
+	for _, m := range mm {
+		if mr, ok := m.(map[string]interface{}); ok && mr["phase"] == "Successful" {
+			passed++
+		}
+	}
+	rollout := MissingValue
+	for _, ref := range raw.GetOwnerReferences() {
+		if ref.Kind == "Rollout" {
+			rollout = ref.Name
+		}
+	}
+
+	var err error
+	if phase == "Failed" || phase == "Error" {
+		err = fmt.Errorf("analysis %s %s", phase, msg)
+	}
+
+	r.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	r.Fields = Fields{
+		raw.GetName(),
+		rollout,
+		missing(phase),
+		strconv.Itoa(passed) + "/" + strconv.Itoa(len(mm)),
+		missing(msg),
+		asStatus(err),
+		toAge(raw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutRender(t *testing.T) {
+	c := render.Rollout{}
+	r := render.NewRow(12)
+
+	assert.Nil(t, c.Render(load(t, "rollout"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"fred", "Canary", "3/5", "50", "4/4", "2", "4", "Progressing", "Running", "<none>", ""}, r.Fields[:11])
+}
+
+func TestCanaryRender(t *testing.T) {
+	c := render.Canary{}
+	r := render.NewRow(10)
+
+	assert.Nil(t, c.Render(load(t, "canary"), "", &r))
+	assert.Equal(t, "default/blee", r.ID)
+	assert.Equal(t, render.Fields{"blee", "Deployment/blee", "Progressing", "30/50", "1/5", "0", "false"}, r.Fields[:7])
+}
+
+func TestAnalysisRunRender(t *testing.T) {
+	c := render.AnalysisRun{}
+	r := render.NewRow(7)
+
+	assert.Nil(t, c.Render(load(t, "analysisrun"), "", &r))
+	assert.Equal(t, "default/fred-6d8f9c7b4-3", r.ID)
+	assert.Equal(t, render.Fields{"fred-6d8f9c7b4-3", "fred", "Running", "1/2", "<none>", ""}, r.Fields[:6])
+}
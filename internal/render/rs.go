@@ -27,6 +27,7 @@ func (ReplicaSet) Header(ns string) Header {
 		HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
 		HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
 		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "REASON", Wide: true, MaxWidth: reasonMaxWidth},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
@@ -52,6 +53,7 @@ func (r ReplicaSet) Render(o interface{}, ns string, row *Row) error {
 		strconv.Itoa(int(*rs.Spec.Replicas)),
 		strconv.Itoa(int(rs.Status.Replicas)),
 		strconv.Itoa(int(rs.Status.ReadyReplicas)),
+		ReplicaSetReason(rs.Status.Conditions),
 		mapToStr(rs.Labels),
 		asStatus(r.diagnose(rs)),
 		toAge(rs.ObjectMeta.CreationTimestamp),
@@ -0,0 +1,125 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	lifecycleSpot        = "spot"
+	lifecyclePreemptible = "preemptible"
+
+	// gkePreemptibleTTL tracks GKE preemptible VMs maximum lifetime.
+	gkePreemptibleTTL = 24 * time.Hour
+)
+
+// spotLabels tracks the providers labels flagging spot/preemptible capacity.
+var spotLabels = []struct {
+	key, val, provider, kind string
+}{
+	{karpenterCapacityTypeLabel, "spot", "karpenter", lifecycleSpot},
+	{"eks.amazonaws.com/capacityType", "SPOT", "eks", lifecycleSpot},
+	{"cloud.google.com/gke-spot", "true", "gke", lifecycleSpot},
+	{"cloud.google.com/gke-preemptible", "true", "gke", lifecyclePreemptible},
+	{"kubernetes.azure.com/scalesetpriority", "spot", "aks", lifecycleSpot},
+	{"node.kubernetes.io/lifecycle", "spot", "", lifecycleSpot},
+}
+
+// interruptionTaints tracks the taints signaling an imminent node reclaim along
+// with the provider notice period. Zero means no known deadline.
+var interruptionTaints = map[string]time.Duration{
+	"aws-node-termination-handler/spot-itn":                 2 * time.Minute,
+	"aws-node-termination-handler/rebalance-recommendation": 0,
+	"cloud.google.com/impending-node-termination":           30 * time.Second,
+	"karpenter.sh/disrupted":                                0,
+	"karpenter.sh/disruption":                               0,
+}
+
+// interruptionConditions tracks the node conditions signaling an imminent reclaim.
+var interruptionConditions = map[v1.NodeConditionType]time.Duration{
+	"VMEventScheduled": 30 * time.Second,
+}
+
+// Lifecycle represents a node capacity lifecycle.
+type Lifecycle struct {
+	Kind, Provider string
+	// Notice tracks the taint or condition announcing an interruption.
+	Notice string
+	// Remaining tracks the time left before reclaim if known.
+	Remaining *time.Duration
+}
+
+// Evaporating returns true if the node received an interruption notice.
+func (l Lifecycle) Evaporating() bool {
+	return l.Notice != ""
+}
+
+// String returns the lifecycle indicator ie spot(1m20s).
+func (l Lifecycle) String() string {
+	kind := l.Kind
+	switch {
+	case kind == "" && !l.Evaporating():
+		return MissingValue
+	case kind == "":
+		kind = "interrupted"
+	}
+	switch {
+	case l.Remaining != nil && *l.Remaining > 0:
+		return fmt.Sprintf("%s(%s)", kind, duration.HumanDuration(*l.Remaining))
+	case l.Evaporating() && l.Kind != "":
+		return kind + "(interrupted)"
+	default:
+		return kind
+	}
+}
+
+// NodeLifecycle detects spot/preemptible nodes and their interruption notices.
+func NodeLifecycle(no *v1.Node, now time.Time) Lifecycle {
+	var l Lifecycle
+	for _, s := range spotLabels {
+		if no.Labels[s.key] == s.val {
+			l.Kind, l.Provider = s.kind, s.provider
+			break
+		}
+	}
+
+	for _, t := range no.Spec.Taints {
+		grace, ok := interruptionTaints[t.Key]
+		if !ok {
+			continue
+		}
+		l.Notice = t.Key
+		if grace > 0 && t.TimeAdded != nil {
+			l.Remaining = remaining(t.TimeAdded.Add(grace), now)
+		}
+		break
+	}
+	if !l.Evaporating() {
+		for _, c := range no.Status.Conditions {
+			grace, ok := interruptionConditions[c.Type]
+			if !ok || c.Status != v1.ConditionTrue {
+				continue
+			}
+			l.Notice = string(c.Type)
+			l.Remaining = remaining(c.LastTransitionTime.Add(grace), now)
+			break
+		}
+	}
+	if !l.Evaporating() && l.Kind == lifecyclePreemptible && l.Provider == "gke" {
+		l.Remaining = remaining(no.CreationTimestamp.Add(gkePreemptibleTTL), now)
+	}
+
+	return l
+}
+
+func remaining(deadline, now time.Time) *time.Duration {
+	d := deadline.Sub(now)
+	if d < 0 {
+		d = 0
+	}
+
+	return &d
+}
@@ -0,0 +1,88 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodeLifecycle(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		no          v1.Node
+		kind, e     string
+		evaporating bool
+	}{
+		"on-demand": {
+			no: v1.Node{},
+			e:  render.MissingValue,
+		},
+		"eks-spot": {
+			no:   makeSpotNode(map[string]string{"eks.amazonaws.com/capacityType": "SPOT"}, nil),
+			kind: "spot",
+			e:    "spot",
+		},
+		"spot-itn": {
+			no: makeSpotNode(map[string]string{"karpenter.sh/capacity-type": "spot"}, []v1.Taint{
+				{
+					Key:       "aws-node-termination-handler/spot-itn",
+					Effect:    v1.TaintEffectNoSchedule,
+					TimeAdded: &metav1.Time{Time: now.Add(-30 * time.Second)},
+				},
+			}),
+			kind:        "spot",
+			e:           "spot(90s)",
+			evaporating: true,
+		},
+		"spot-expired": {
+			no: makeSpotNode(map[string]string{"kubernetes.azure.com/scalesetpriority": "spot"}, []v1.Taint{
+				{
+					Key:       "aws-node-termination-handler/spot-itn",
+					Effect:    v1.TaintEffectNoSchedule,
+					TimeAdded: &metav1.Time{Time: now.Add(-5 * time.Minute)},
+				},
+			}),
+			kind:        "spot",
+			e:           "spot(interrupted)",
+			evaporating: true,
+		},
+		"disrupted": {
+			no:          makeSpotNode(nil, []v1.Taint{{Key: "karpenter.sh/disrupted", Effect: v1.TaintEffectNoSchedule}}),
+			e:           "interrupted",
+			evaporating: true,
+		},
+		"preemptible": {
+			no: func() v1.Node {
+				no := makeSpotNode(map[string]string{"cloud.google.com/gke-preemptible": "true"}, nil)
+				no.CreationTimestamp = metav1.Time{Time: now.Add(-20 * time.Hour)}
+				return no
+			}(),
+			kind: "preemptible",
+			e:    "preemptible(4h)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l := render.NodeLifecycle(&u.no, now)
+			assert.Equal(t, u.kind, l.Kind)
+			assert.Equal(t, u.e, l.String())
+			assert.Equal(t, u.evaporating, l.Evaporating())
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeSpotNode(ll map[string]string, tt []v1.Taint) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: ll},
+		Spec:       v1.NodeSpec{Taints: tt},
+	}
+}
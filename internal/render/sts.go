@@ -24,6 +24,7 @@ func (StatefulSet) Header(ns string) Header {
 		HeaderColumn{Name: "NAMESPACE"},
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "PAUSED"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "SERVICE"},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
@@ -51,6 +52,7 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		sts.Namespace,
 		sts.Name,
 		strconv.Itoa(int(sts.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(sts.Status.Replicas)),
+		pausedStatus(sts.Annotations),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
 		podContainerNames(sts.Spec.Template.Spec, true),
@@ -139,10 +139,10 @@ func ToPorts(pp []v1.ServicePort) string {
 			ports[i] = p.Name + ":"
 		}
 		ports[i] += strconv.Itoa(int(p.Port)) +
-			"►" +
+			Glyph(PortMapSign) +
 			strconv.Itoa(int(p.NodePort))
 		if p.Protocol != "TCP" {
-			ports[i] += "╱" + string(p.Protocol)
+			ports[i] += Glyph(ProtocolSign) + string(p.Protocol)
 		}
 	}
 
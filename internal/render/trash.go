@@ -0,0 +1,81 @@
+package render
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Trash renders deleted resources snapshots to screen.
+type Trash struct{}
+
+// ColorerFunc colors a resource row.
+func (Trash) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("OWNED", true)
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] == "true" {
+			return CompletedColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Trash) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "OWNED"},
+		HeaderColumn{Name: "FILE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Trash) Render(o interface{}, ns string, r *Row) error {
+	t, ok := o.(TrashRes)
+	if !ok {
+		return fmt.Errorf("expecting trash, but got %T", o)
+	}
+
+	r.ID = filepath.Join(t.Dir, t.File.Name())
+	r.Fields = Fields{
+		client.FQN(t.Object.GetNamespace(), t.Object.GetName()),
+		t.GVR,
+		boolToStr(len(t.Object.GetOwnerReferences()) > 0),
+		t.File.Name(),
+		"",
+		timeToAge(t.File.ModTime()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TrashRes represents a trashed resource manifest.
+type TrashRes struct {
+	File   os.FileInfo
+	Dir    string
+	GVR    string
+	Object *unstructured.Unstructured
+}
+
+// GetObjectKind returns a schema object.
+func (t TrashRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TrashRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,34 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTrashRender(t *testing.T) {
+	u := unstructured.Unstructured{}
+	u.SetNamespace("default")
+	u.SetName("cm1")
+
+	var tr render.Trash
+	var r render.Row
+	o := render.TrashRes{
+		File:   fileInfo{},
+		Dir:    "fred/blee",
+		GVR:    "v1/configmaps",
+		Object: &u,
+	}
+
+	assert.Nil(t, tr.Render(o, "", &r))
+	assert.Equal(t, "fred/blee/bob", r.ID)
+	assert.Equal(t, render.Fields{
+		"default/cm1",
+		"v1/configmaps",
+		"false",
+		"bob",
+		"",
+	}, r.Fields[:len(r.Fields)-1])
+}
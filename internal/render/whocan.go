@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhoCan renders the subjects granted a rbac query to screen.
+type WhoCan struct{}
+
+// ColorerFunc colors a resource row.
+func (WhoCan) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("RESOURCE NAMES", true)
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "" {
+			return ModColor
+		}
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (WhoCan) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "SCOPE"},
+		HeaderColumn{Name: "BINDING"},
+		HeaderColumn{Name: "ROLE"},
+		HeaderColumn{Name: "RESOURCE NAMES"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (WhoCan) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(WhoCanRes)
+	if !ok {
+		return fmt.Errorf("expecting WhoCanRes but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Name,
+		res.Kind,
+		res.Namespace,
+		res.Scope,
+		res.Binding,
+		res.Role,
+		res.ResourceNames,
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WhoCanRes represents a subject granted a rbac query by a binding. Scope
+// tracks the binding namespace or * for cluster wide grants.
+type WhoCanRes struct {
+	Kind, Name, Namespace string
+	Scope, Binding, Role  string
+	ResourceNames         string
+}
+
+// ID returns the subject grant unique id.
+func (w WhoCanRes) ID() string {
+	return w.Kind + ":" + client.FQN(w.Namespace, w.Name) + "@" + client.FQN(w.Scope, w.Binding)
+}
+
+// GetObjectKind returns a schema object.
+func (WhoCanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WhoCanRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,28 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoCanRender(t *testing.T) {
+	var w render.WhoCan
+
+	var r render.Row
+	o := render.WhoCanRes{
+		Kind:          "ServiceAccount",
+		Name:          "fred",
+		Namespace:     "blee",
+		Scope:         "blee",
+		Binding:       "RB:zorg",
+		Role:          "CR:edit",
+		ResourceNames: "p1,p2",
+	}
+
+	assert.Nil(t, w.Render(o, "", &r))
+	assert.Equal(t, "ServiceAccount:blee/fred@blee/RB:zorg", r.ID)
+	assert.Equal(t, render.Fields{"fred", "ServiceAccount", "blee", "blee", "RB:zorg", "CR:edit", "p1,p2", ""}, r.Fields)
+	assert.Equal(t, len(w.Header("")), len(r.Fields))
+}
@@ -76,7 +76,7 @@ func (g *Gauge) Draw(sc tcell.Screen) {
 	mid := image.Point{X: rect.Min.X + rect.Dx()/2, Y: rect.Min.Y + rect.Dy()/2 - 1}
 	style := tcell.StyleDefault.Background(g.bgColor)
 	style = style.Foreground(tcell.ColorYellow)
-	sc.SetContent(mid.X, mid.Y, '⠔', nil, style)
+	sc.SetContent(mid.X, mid.Y, glyph('⠔'), nil, style)
 
 	max := g.data.MaxDigits()
 	if max < g.resolution {
@@ -135,9 +135,9 @@ func (g *Gauge) drawDial(sc tcell.Screen, m Matrix, o image.Point, style tcell.S
 		for c := 0; c < len(m[r]); c++ {
 			dot := m[r][c]
 			if dot == dots[0] {
-				sc.SetContent(o.X+c, o.Y+r, dots[1], nil, g.dimmed)
+				sc.SetContent(o.X+c, o.Y+r, glyph(dots[1]), nil, g.dimmed)
 			} else {
-				sc.SetContent(o.X+c, o.Y+r, dot, nil, style)
+				sc.SetContent(o.X+c, o.Y+r, glyph(dot), nil, style)
 			}
 		}
 	}
@@ -166,8 +166,8 @@ func printDelta(sc tcell.Screen, d delta, o image.Point, s tcell.Style) {
 	s = s.Dim(false)
 	switch d {
 	case DeltaLess:
-		sc.SetContent(o.X-1, o.Y+1, '↓', nil, s)
+		sc.SetContent(o.X-1, o.Y+1, glyph('↓'), nil, s)
 	case DeltaMore:
-		sc.SetContent(o.X-1, o.Y+1, '↑', nil, s)
+		sc.SetContent(o.X-1, o.Y+1, glyph('↑'), nil, s)
 	}
 }
@@ -0,0 +1,60 @@
+package tchart
+
+import (
+	"sync/atomic"
+
+	"github.com/derailed/tview"
+)
+
+var asciiMode int32
+
+// asciiGlyphs maps the charts glyphs to their ascii fallbacks.
+var asciiGlyphs = map[rune]rune{
+	tview.BoxDrawingsHeavyHorizontal:       '-',
+	tview.BoxDrawingsHeavyVertical:         '|',
+	tview.BoxDrawingsHeavyDownAndRight:     '+',
+	tview.BoxDrawingsHeavyDownAndLeft:      '+',
+	tview.BoxDrawingsHeavyUpAndRight:       '+',
+	tview.BoxDrawingsHeavyUpAndLeft:        '+',
+	tview.BoxDrawingsHeavyVerticalAndLeft:  '+',
+	tview.BoxDrawingsHeavyVerticalAndRight: '+',
+	lh:                                     '-',
+	rh:                                     '-',
+	hv:                                     '|',
+	lv:                                     '|',
+	'⠂':                                    '.',
+	'▤':                                    '#',
+	'▥':                                    '#',
+	'⠔':                                    ':',
+	'↓':                                    'v',
+	'↑':                                    '^',
+	'▁':                                    '_',
+	'▂':                                    '_',
+	'▃':                                    '.',
+	'▄':                                    '.',
+	'▅':                                    ':',
+	'▆':                                    ':',
+	'▇':                                    '|',
+	'█':                                    '#',
+}
+
+// SetASCIIMode toggles ascii only charts glyphs.
+func SetASCIIMode(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&asciiMode, v)
+}
+
+// glyph returns the ascii fallback of a glyph in ascii mode.
+func glyph(r rune) rune {
+	if atomic.LoadInt32(&asciiMode) == 0 {
+		return r
+	}
+	if a, ok := asciiGlyphs[r]; ok {
+		return a
+	}
+
+	return r
+}
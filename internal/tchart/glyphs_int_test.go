@@ -0,0 +1,17 @@
+package tchart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlyph(t *testing.T) {
+	assert.Equal(t, '█', glyph('█'))
+
+	SetASCIIMode(true)
+	defer SetASCIIMode(false)
+	assert.Equal(t, '#', glyph('█'))
+	assert.Equal(t, '^', glyph('↑'))
+	assert.Equal(t, 'x', glyph('x'))
+}
@@ -9,7 +9,8 @@ import (
 	"github.com/gdamore/tcell"
 )
 
-var sparks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+// Sparks tracks the sparkline glyphs from lowest to highest.
+var Sparks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
 
 type block struct {
 	full    int
@@ -70,6 +71,13 @@ func (s *SparkLine) Add(m Metric) {
 	s.data = append(s.data, m)
 }
 
+// SetMetrics replaces the graph metrics.
+func (s *SparkLine) SetMetrics(mm []Metric) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.data = append([]Metric(nil), mm...)
+}
+
 // Draw draws the graph.
 func (s *SparkLine) Draw(screen tcell.Screen) {
 	s.Component.Draw(screen)
@@ -97,7 +105,7 @@ func (s *SparkLine) Draw(screen tcell.Screen) {
 		idx = len(s.data) - rect.Dx()/2
 	}
 
-	scale := float64(len(sparks)*(rect.Dy()-pad)) / float64(max)
+	scale := float64(len(Sparks)*(rect.Dy()-pad)) / float64(max)
 	c1, c2 := s.colorForSeries()
 	for _, d := range s.data[idx:] {
 		b := toBlocks(d, scale)
@@ -122,14 +130,14 @@ func (s *SparkLine) drawBlock(r image.Rectangle, screen tcell.Screen, x, y int,
 
 	zeroY := r.Max.Y - r.Dy()
 	for i := 0; i < b.full; i++ {
-		screen.SetContent(x, y, sparks[len(sparks)-1], nil, style)
+		screen.SetContent(x, y, glyph(Sparks[len(Sparks)-1]), nil, style)
 		y--
 		if y <= zeroY {
 			break
 		}
 	}
 	if b.partial != 0 {
-		screen.SetContent(x, y, b.partial, nil, style)
+		screen.SetContent(x, y, glyph(b.partial), nil, style)
 	}
 }
 
@@ -164,12 +172,12 @@ func toBlocks(m Metric, scale float64) blocks {
 
 func makeBlocks(v int64, scale float64) block {
 	scaled := int(math.Round(float64(v) * scale))
-	p, b := scaled%len(sparks), block{full: scaled / len(sparks)}
+	p, b := scaled%len(Sparks), block{full: scaled / len(Sparks)}
 	if b.full == 0 && v > 0 && p == 0 {
 		p = 4
 	}
-	if v > 0 && p >= 0 && p < len(sparks) {
-		b.partial = sparks[p]
+	if v > 0 && p >= 0 && p < len(Sparks) {
+		b.partial = Sparks[p]
 	}
 
 	return b
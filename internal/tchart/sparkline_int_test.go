@@ -47,6 +47,17 @@ func TestCutSet(t *testing.T) {
 	}
 }
 
+func TestSetMetrics(t *testing.T) {
+	s := NewSparkLine("s")
+	s.Add(Metric{S1: 1})
+	mm := []Metric{{S1: 2, S2: 3}, {S1: 4, S2: 5}}
+	s.SetMetrics(mm)
+	mm[0].S1 = 10
+
+	assert.Equal(t, []Metric{{S1: 2, S2: 3}, {S1: 4, S2: 5}}, s.data)
+	assert.Equal(t, int64(5), s.computeMax())
+}
+
 func TestToBlocks(t *testing.T) {
 	uu := map[string]struct {
 		m Metric
@@ -60,24 +71,24 @@ func TestToBlocks(t *testing.T) {
 			m: Metric{S1: 100, S2: 10},
 			s: 0.5,
 			e: blocks{
-				s1: block{full: 6, partial: sparks[2]},
-				s2: block{full: 0, partial: sparks[5]},
+				s1: block{full: 6, partial: Sparks[2]},
+				s2: block{full: 0, partial: Sparks[5]},
 			},
 		},
 		"max_fault": {
 			m: Metric{S1: 10, S2: 100},
 			s: 0.5,
 			e: blocks{
-				s1: block{full: 0, partial: sparks[5]},
-				s2: block{full: 6, partial: sparks[2]},
+				s1: block{full: 0, partial: Sparks[5]},
+				s2: block{full: 6, partial: Sparks[2]},
 			},
 		},
 		"over": {
 			m: Metric{S1: 22, S2: 999},
 			s: float64(8*20) / float64(999),
 			e: blocks{
-				s1: block{full: 0, partial: sparks[4]},
-				s2: block{full: 20, partial: sparks[0]},
+				s1: block{full: 0, partial: Sparks[4]},
+				s2: block{full: 20, partial: Sparks[0]},
 			},
 		},
 	}
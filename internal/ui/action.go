@@ -14,21 +14,41 @@ type (
 
 	// KeyAction represents a keyboard action.
 	KeyAction struct {
+		ID          string
 		Description string
 		Action      ActionHandler
 		Visible     bool
 		Shared      bool
+		Disabled    bool
 	}
 
 	// KeyActions tracks mappings between keystrokes and actions.
 	KeyActions map[tcell.Key]KeyAction
 )
 
+// Well known actions identifiers. Unlike descriptions, ids are not
+// translated.
+const (
+	ActionEdit     = "edit"
+	ActionDelete   = "delete"
+	ActionKill     = "kill"
+	ActionShell    = "shell"
+	ActionAttach   = "attach"
+	ActionLogs     = "logs"
+	ActionDescribe = "describe"
+	ActionRestart  = "restart"
+)
+
 // NewKeyAction returns a new keyboard action.
 func NewKeyAction(d string, a ActionHandler, display bool) KeyAction {
 	return KeyAction{Description: d, Action: a, Visible: display}
 }
 
+// NewKeyActionWithID returns a new keyboard action identified by id.
+func NewKeyActionWithID(id, d string, a ActionHandler, display bool) KeyAction {
+	return KeyAction{ID: id, Description: d, Action: a, Visible: display}
+}
+
 // NewSharedKeyAction returns a new shared keyboard action.
 func NewSharedKeyAction(d string, a ActionHandler, display bool) KeyAction {
 	return KeyAction{Description: d, Action: a, Visible: display, Shared: true}
@@ -62,11 +82,28 @@ func (a KeyActions) Delete(kk ...tcell.Key) {
 	}
 }
 
+// SetDisabled flags the action bound to the given key as not permitted.
+func (a KeyActions) SetDisabled(k tcell.Key, b bool) {
+	if v, ok := a[k]; ok {
+		v.Disabled = b
+		a[k] = v
+	}
+}
+
 // Hints returns a collection of hints.
 func (a KeyActions) Hints() model.MenuHints {
+	return a.hints(false)
+}
+
+// AllHints returns a collection of hints including shared actions.
+func (a KeyActions) AllHints() model.MenuHints {
+	return a.hints(true)
+}
+
+func (a KeyActions) hints(shared bool) model.MenuHints {
 	kk := make([]int, 0, len(a))
 	for k := range a {
-		if !a[k].Shared {
+		if shared || !a[k].Shared {
 			kk = append(kk, int(k))
 		}
 	}
@@ -77,9 +114,11 @@ func (a KeyActions) Hints() model.MenuHints {
 		if name, ok := tcell.KeyNames[tcell.Key(k)]; ok {
 			hh = append(hh,
 				model.MenuHint{
+					ID:          a[tcell.Key(k)].ID,
 					Mnemonic:    name,
 					Description: a[tcell.Key(k)].Description,
 					Visible:     a[tcell.Key(k)].Visible,
+					Disabled:    a[tcell.Key(k)].Disabled,
 				},
 			)
 		} else {
@@ -20,3 +20,27 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionsAllHints(t *testing.T) {
+	kk := ui.KeyActions{
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+		ui.KeyB: ui.NewSharedKeyAction("blee", nil, false),
+	}
+
+	assert.Equal(t, 1, len(kk.Hints()))
+	hh := kk.AllHints()
+	assert.Equal(t, 2, len(hh))
+	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee"}, hh[0])
+}
+
+func TestKeyActionsSetDisabled(t *testing.T) {
+	kk := ui.KeyActions{
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+	}
+	kk.SetDisabled(ui.KeyF, true)
+	kk.SetDisabled(ui.KeyZ, true)
+
+	assert.Equal(t, 1, len(kk))
+	assert.True(t, kk[ui.KeyF].Disabled)
+	assert.Equal(t, model.MenuHint{Mnemonic: "f", Description: "fred", Visible: true, Disabled: true}, kk.Hints()[0])
+}
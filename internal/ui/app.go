@@ -23,6 +23,7 @@ type App struct {
 
 // NewApp returns a new app.
 func NewApp(cfg *config.Config, context string) *App {
+	ApplyRenderMode(cfg.K9s.GetRenderMode())
 	a := App{
 		Application:  tview.NewApplication(),
 		actions:      make(KeyActions),
@@ -36,7 +37,7 @@ func NewApp(cfg *config.Config, context string) *App {
 	a.views = map[string]tview.Primitive{
 		"menu":   NewMenu(a.Styles),
 		"logo":   NewLogo(a.Styles),
-		"prompt": NewPrompt(a.Config.K9s.NoIcons, a.Styles),
+		"prompt": NewPrompt(a.Config.K9s.GetNoIcons(), a.Styles),
 		"crumbs": NewCrumbs(a.Styles),
 	}
 
@@ -3,9 +3,12 @@ package ui
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/fsnotify/fsnotify"
@@ -25,6 +28,12 @@ type Configurator struct {
 	CustomView *config.CustomView
 	BenchFile  string
 	skinFile   string
+	reloadFns  []func()
+}
+
+// OnConfigReload registers a callback fired once the configuration reloaded.
+func (c *Configurator) OnConfigReload(fn func()) {
+	c.reloadFns = append(c.reloadFns, fn)
 }
 
 // HasSkin returns true if a skin file was located.
@@ -77,6 +86,108 @@ func (c *Configurator) RefreshCustomViews() {
 		log.Error().Err(err).Msgf("Custom view load failed %s", config.K9sViewConfigFile)
 		return
 	}
+	render.SetExtraColumns(customColumns(c.CustomView.K9s.Views))
+}
+
+func customColumns(vv map[string]config.ViewSetting) map[string][]render.CRDColumn {
+	cc := make(map[string][]render.CRDColumn, len(vv))
+	for gvr, v := range vv {
+		if len(v.CustomColumns) > 0 && model.HasGenericRenderer(client.NewGVR(gvr)) {
+			log.Warn().Msgf("[Config] Custom columns are ignored on %q as it is rendered off the api server columns", gvr)
+		}
+		for _, c := range v.CustomColumns {
+			col := render.CRDColumn{Name: c.Name, JSONPath: c.JSONPath}
+			if c.Wide {
+				col.Priority = 1
+			}
+			if c.Join != nil {
+				col.Join = &render.ColumnJoin{GVR: c.Join.Resource, Key: c.Join.Key, On: c.Join.On}
+			}
+			cc[gvr] = append(cc[gvr], col)
+		}
+	}
+
+	return cc
+}
+
+// ConfigWatcher watches for K9s configuration changes. Changes K9s saved
+// itself are skipped.
+func (c *Configurator) ConfigWatcher(ctx context.Context, s synchronizer) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case evt := <-w.Events:
+				if evt.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				if evt.Op&fsnotify.Create != 0 && filepath.Dir(evt.Name) == config.K9sContextsDir {
+					if err := w.Add(evt.Name); err != nil {
+						log.Warn().Err(err).Msgf("Unable to watch context config %s", evt.Name)
+					}
+					continue
+				}
+				if evt.Name != config.K9sConfigFile && !config.IsContextConfigFile(evt.Name) {
+					continue
+				}
+				if c.Config.OwnWrite(evt.Name) {
+					continue
+				}
+				s.QueueUpdateDraw(func() {
+					if err := c.Config.Reload(config.K9sConfigFile); err != nil {
+						log.Error().Err(err).Msgf("Config reload failed %s", config.K9sConfigFile)
+						return
+					}
+					for _, fn := range c.reloadFns {
+						fn()
+					}
+				})
+			case err := <-w.Errors:
+				log.Info().Err(err).Msg("Config watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msgf("ConfigWatcher Done `%s!!", config.K9sConfigFile)
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing Config watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	log.Debug().Msgf("ConfigWatcher watching `%s", config.K9sConfigFile)
+	if config.IsSplitConfig(config.K9sContextsDir) {
+		if err := watchContexts(w, config.K9sContextsDir); err != nil {
+			log.Warn().Err(err).Msgf("Unable to watch context configs %s", config.K9sContextsDir)
+		}
+	}
+	return w.Add(filepath.Dir(config.K9sConfigFile))
+}
+
+// watchContexts watches the contexts directory along with each context
+// directory so editors replacing files are picked up.
+func watchContexts(w *fsnotify.Watcher, dir string) error {
+	if err := w.Add(dir); err != nil {
+		return err
+	}
+	ee, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range ee {
+		if !e.IsDir() {
+			continue
+		}
+		if err := w.Add(filepath.Join(dir, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // StylesWatcher watches for skin file changes.
@@ -130,6 +241,14 @@ func (c *Configurator) RefreshStyles(context string) {
 	} else {
 		c.Styles.Reset()
 	}
+	if p := c.activeProfile(); p != nil && p.SkinFile() != "" {
+		if err := c.Styles.Load(p.SkinFile()); err != nil {
+			log.Warn().Err(err).Msgf("Unable to load profile skin file -- %s", p.SkinFile())
+		} else {
+			c.updateStyles(p.SkinFile())
+			return
+		}
+	}
 	if err := c.Styles.Load(clusterSkins); err != nil {
 		log.Info().Msgf("No context specific skin file found -- %s", clusterSkins)
 	} else {
@@ -145,6 +264,14 @@ func (c *Configurator) RefreshStyles(context string) {
 	c.updateStyles(config.K9sStylesFile)
 }
 
+func (c *Configurator) activeProfile() *config.Profile {
+	if c.Config == nil || c.Config.K9s == nil {
+		return nil
+	}
+
+	return c.Config.K9s.ActiveProfile()
+}
+
 func (c *Configurator) updateStyles(f string) {
 	c.skinFile = f
 	if !c.HasSkin() {
@@ -6,15 +6,23 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 )
 
+// Markable represents a component with marked items.
+type Markable interface {
+	// MarkCount returns the number of marked items.
+	MarkCount() int
+}
+
 // Crumbs represents user breadcrumbs.
 type Crumbs struct {
 	*tview.TextView
 
 	styles *config.Styles
 	stack  *model.Stack
+	pinned string
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -55,6 +63,17 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack
 func (c *Crumbs) StackTop(top model.Component) {}
 
+// MarksChanged refreshes the marks count of the active crumb.
+func (c *Crumbs) MarksChanged() {
+	c.refresh(c.stack.Flatten())
+}
+
+// SetPinnedFilter shows the filter carried across views if any.
+func (c *Crumbs) SetPinnedFilter(f string) {
+	c.pinned = f
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
@@ -65,7 +84,21 @@ func (c *Crumbs) refresh(crumbs []string) {
 		}
 		fmt.Fprintf(c, "[%s:%s:b] <%s> [-:%s:-] ",
 			c.styles.Frame().Crumb.FgColor,
-			bgColor, strings.Replace(strings.ToLower(crumb), " ", "", -1),
+			bgColor, strings.Replace(strings.ToLower(render.RedactText(crumb)), " ", "", -1),
+			c.styles.Body().BgColor)
+	}
+	if m, ok := c.stack.Top().(Markable); ok && m.MarkCount() > 0 {
+		fmt.Fprintf(c, "[%s:%s:b] <%d marked> [-:%s:-] ",
+			c.styles.Frame().Crumb.FgColor,
+			c.styles.Frame().Crumb.BgColor,
+			m.MarkCount(),
+			c.styles.Body().BgColor)
+	}
+	if c.pinned != "" {
+		fmt.Fprintf(c, "[%s:%s:b] <pinned /%s> [-:%s:-] ",
+			c.styles.Frame().Crumb.FgColor,
+			c.styles.Frame().Crumb.BgColor,
+			tview.Escape(c.pinned),
 			c.styles.Body().BgColor)
 	}
 }
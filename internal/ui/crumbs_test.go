@@ -26,8 +26,34 @@ func TestNewCrumbs(t *testing.T) {
 	assert.Equal(t, "[black:aqua:b] <c1> [-:black:-] [black:aqua:b] <c2> [-:black:-] [black:orange:b] <c3> [-:black:-] \n", v.GetText(false))
 }
 
+func TestCrumbsMarks(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.StackPushed(makeComponent("c1"))
+	v.StackPushed(markedComponent{c: makeComponent("c2"), marks: 3})
+
+	assert.Equal(t, "[black:aqua:b] <c1> [-:black:-] [black:orange:b] <c2> [-:black:-] [black:aqua:b] <3 marked> [-:black:-] \n", v.GetText(false))
+}
+
+func TestCrumbsPinnedFilter(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.StackPushed(makeComponent("c1"))
+	v.SetPinnedFilter("payments-")
+
+	assert.Equal(t, "[black:orange:b] <c1> [-:black:-] [black:aqua:b] <pinned /payments-> [-:black:-] \n", v.GetText(false))
+
+	v.SetPinnedFilter("")
+	assert.Equal(t, "[black:orange:b] <c1> [-:black:-] \n", v.GetText(false))
+}
+
 // Helpers...
 
+type markedComponent struct {
+	c
+	marks int
+}
+
+func (m markedComponent) MarkCount() int { return m.marks }
+
 type c struct {
 	name string
 }
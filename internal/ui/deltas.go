@@ -94,6 +94,10 @@ func deltaDur(o, n string) (string, bool) {
 
 // Deltas signals diffs between 2 strings.
 func Deltas(o, n string) string {
+	return render.Glyph(deltas(o, n))
+}
+
+func deltas(o, n string) string {
 	o, n = strings.TrimSpace(o), strings.TrimSpace(n)
 	if o == "" || o == render.NAValue {
 		return ""
@@ -1,6 +1,9 @@
 package dialog
 
 import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -12,8 +15,15 @@ type (
 	confirmFunc func()
 )
 
-// ShowConfirm pops a confirmation dialog.
-func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel cancelFunc) {
+// ShowConfirm pops a confirmation dialog honoring the given confirmation level.
+// For typed confirmations, name must be entered before the action is acknowledged.
+func ShowConfirm(pages *ui.Pages, level config.ConfirmLevel, name, title, msg string, ack confirmFunc, cancel cancelFunc) {
+	if level == config.ConfirmNone {
+		ack()
+		cancel()
+		return
+	}
+
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -21,18 +31,22 @@ func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel can
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
 		SetLabelColor(tcell.ColorAqua).
 		SetFieldTextColor(tcell.ColorOrange)
-	f.AddButton("Cancel", func() {
+	typed := addTypedField(f, level, name)
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismissConfirm(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
+		if !typed() {
+			return
+		}
 		ack()
 		dismissConfirm(pages)
 		cancel()
 	})
 
-	modal := tview.NewModalForm("<"+title+">", f)
-	modal.SetText(msg)
+	modal := tview.NewModalForm("<"+i18n.T(title)+">", f)
+	modal.SetText(render.RedactMessage(i18n.T(msg)))
 	modal.SetDoneFunc(func(int, string) {
 		dismissConfirm(pages)
 		cancel()
@@ -44,3 +58,30 @@ func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel can
 func dismissConfirm(pages *ui.Pages) {
 	pages.RemovePage(confirmKey)
 }
+
+// addTypedField adds a confirmation input field for typed confirmations.
+// It returns a func reporting whether the confirmation was satisfied.
+func addTypedField(f *tview.Form, level config.ConfirmLevel, name string) func() bool {
+	if level != config.ConfirmTyped || name == "" {
+		return func() bool { return true }
+	}
+
+	// Demo and privacy modes ask for the redacted name so the real one is never shown.
+	name = render.RedactMessage(name)
+	var text string
+	label := i18n.T("Type") + " " + name + ":"
+	f.AddInputField(label, "", 30, nil, nil)
+	in := f.GetFormItem(f.GetFormItemCount() - 1).(*tview.InputField)
+	in.SetChangedFunc(func(s string) {
+		text = s
+		in.SetLabel(label)
+	})
+
+	return func() bool {
+		if text == name {
+			return true
+		}
+		in.SetLabel(i18n.T("No match!") + " " + label)
+		return false
+	}
+}
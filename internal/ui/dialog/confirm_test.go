@@ -3,6 +3,7 @@ package dialog
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +20,7 @@ func TestConfirmDialog(t *testing.T) {
 	caFunc := func() {
 		assert.True(t, true)
 	}
-	ShowConfirm(p, "Blee", "Yo", ackFunc, caFunc)
+	ShowConfirm(p, config.ConfirmYesNo, "", "Blee", "Yo", ackFunc, caFunc)
 
 	d := p.GetPrimitive(confirmKey).(*tview.ModalForm)
 	assert.NotNil(t, d)
@@ -27,3 +28,65 @@ func TestConfirmDialog(t *testing.T) {
 	dismissConfirm(p)
 	assert.Nil(t, p.GetPrimitive(confirmKey))
 }
+
+func TestConfirmDialogNone(t *testing.T) {
+	p := ui.NewPages()
+
+	var acked bool
+	ShowConfirm(p, config.ConfirmNone, "fred", "Blee", "Yo", func() { acked = true }, func() {})
+
+	assert.True(t, acked)
+	assert.Nil(t, p.GetPrimitive(confirmKey))
+}
+
+func TestTypedField(t *testing.T) {
+	uu := map[string]struct {
+		level config.ConfirmLevel
+		name  string
+		text  string
+		count int
+		label string
+		e     bool
+	}{
+		"yesno": {
+			level: config.ConfirmYesNo,
+			name:  "fred",
+			e:     true,
+		},
+		"typed": {
+			level: config.ConfirmTyped,
+			name:  "fred",
+			text:  "fred",
+			count: 1,
+			label: "Type fred:",
+			e:     true,
+		},
+		"mismatch": {
+			level: config.ConfirmTyped,
+			name:  "fred",
+			text:  "fre",
+			count: 1,
+			label: "No match! Type fred:",
+		},
+		"noName": {
+			level: config.ConfirmTyped,
+			e:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := tview.NewForm()
+			typed := addTypedField(f, u.level, u.name)
+			assert.Equal(t, u.count, f.GetFormItemCount())
+			if u.count > 0 {
+				f.GetFormItem(0).(*tview.InputField).SetText(u.text)
+			}
+			assert.Equal(t, u.e, typed())
+			if u.count > 0 {
+				assert.Equal(t, u.label, f.GetFormItem(0).GetLabel())
+			}
+		})
+	}
+}
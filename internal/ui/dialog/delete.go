@@ -1,6 +1,9 @@
 package dialog
 
 import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -13,9 +16,16 @@ type (
 	cancelFunc func()
 )
 
-// ShowDelete pops a resource deletion dialog.
-func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
+// ShowDelete pops a resource deletion dialog honoring the given confirmation level.
+// For typed confirmations, name must be entered before the deletion is acknowledged.
+func ShowDelete(pages *ui.Pages, level config.ConfirmLevel, name, msg string, ok okFunc, cancel cancelFunc) {
 	cascade, force := true, false
+	if level == config.ConfirmNone {
+		ok(cascade, force)
+		cancel()
+		return
+	}
+
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -23,25 +33,29 @@ func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
 		SetLabelColor(tcell.ColorAqua).
 		SetFieldTextColor(tcell.ColorOrange)
-	f.AddCheckbox("Cascade:", cascade, func(checked bool) {
+	f.AddCheckbox(i18n.T("Cascade:"), cascade, func(checked bool) {
 		cascade = checked
 	})
-	f.AddCheckbox("Force:", force, func(checked bool) {
+	f.AddCheckbox(i18n.T("Force:"), force, func(checked bool) {
 		force = checked
 	})
-	f.AddButton("Cancel", func() {
+	typed := addTypedField(f, level, name)
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismissDelete(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
+		if !typed() {
+			return
+		}
 		ok(cascade, force)
 		dismissDelete(pages)
 		cancel()
 	})
 	f.SetFocus(2)
 
-	confirm := tview.NewModalForm("<Delete>", f)
-	confirm.SetText(msg)
+	confirm := tview.NewModalForm("<"+i18n.T("Delete")+">", f)
+	confirm.SetText(render.RedactMessage(i18n.T(msg)))
 	confirm.SetDoneFunc(func(int, string) {
 		dismissDelete(pages)
 		cancel()
@@ -3,6 +3,7 @@ package dialog
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +19,7 @@ func TestDeleteDialog(t *testing.T) {
 	caFunc := func() {
 		assert.True(t, true)
 	}
-	ShowDelete(p, "Yo", okFunc, caFunc)
+	ShowDelete(p, config.ConfirmYesNo, "", "Yo", okFunc, caFunc)
 
 	d := p.GetPrimitive(deleteKey).(*tview.ModalForm)
 	assert.NotNil(t, d)
@@ -0,0 +1,81 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const inputsKey = "inputs"
+
+type inputsFunc func(vv map[string]string)
+
+// ShowInputs pops a form prompting for the given inputs. The values are only
+// acknowledged once they all pass validation.
+func ShowInputs(pages *ui.Pages, title, msg string, ii []config.PluginInput, ack inputsFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	vv := make(map[string]string, len(ii))
+	for _, i := range ii {
+		addInputField(f, i, vv)
+	}
+
+	modal := tview.NewModalForm("<"+i18n.T(title)+">", f)
+	modal.SetText(i18n.T(msg))
+	f.AddButton(i18n.T("Cancel"), func() {
+		dismissInputs(pages)
+		cancel()
+	})
+	f.AddButton(i18n.T("OK"), func() {
+		for _, i := range ii {
+			if err := i.Validate(vv[i.Name]); err != nil {
+				modal.SetText(err.Error())
+				return
+			}
+		}
+		dismissInputs(pages)
+		ack(vv)
+	})
+	modal.SetDoneFunc(func(int, string) {
+		dismissInputs(pages)
+		cancel()
+	})
+	pages.AddPage(inputsKey, modal, false, false)
+	pages.ShowPage(inputsKey)
+}
+
+func dismissInputs(pages *ui.Pages) {
+	pages.RemovePage(inputsKey)
+}
+
+// addInputField adds a drop down for inputs with options or a text field
+// otherwise, tracking the entered values by input name.
+func addInputField(f *tview.Form, i config.PluginInput, vv map[string]string) {
+	name := i.Name
+	vv[name] = i.Default
+	if len(i.Options) == 0 {
+		f.AddInputField(i.Label(), i.Default, 30, nil, func(s string) {
+			vv[name] = s
+		})
+		return
+	}
+
+	var initial int
+	for idx, o := range i.Options {
+		if o == i.Default {
+			initial = idx
+		}
+	}
+	vv[name] = i.Options[initial]
+	f.AddDropDown(i.Label(), i.Options, initial, func(o string, _ int) {
+		vv[name] = o
+	})
+}
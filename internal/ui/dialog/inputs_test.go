@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputsDialog(t *testing.T) {
+	p := ui.NewPages()
+	ii := []config.PluginInput{
+		{Name: "replicas", Default: "2"},
+		{Name: "level", Default: "debug", Options: []string{"info", "debug"}},
+	}
+	ShowInputs(p, "Blee", "Yo", ii, func(map[string]string) {}, func() {})
+
+	d := p.GetPrimitive(inputsKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissInputs(p)
+	assert.Nil(t, p.GetPrimitive(inputsKey))
+}
+
+func TestAddInputField(t *testing.T) {
+	f := tview.NewForm()
+	vv := make(map[string]string)
+	addInputField(f, config.PluginInput{Name: "replicas", Default: "2"}, vv)
+	addInputField(f, config.PluginInput{Name: "level", Default: "debug", Options: []string{"info", "debug"}}, vv)
+	addInputField(f, config.PluginInput{Name: "mode", Options: []string{"fast", "slow"}}, vv)
+
+	assert.Equal(t, 3, f.GetFormItemCount())
+	assert.Equal(t, map[string]string{"replicas": "2", "level": "debug", "mode": "fast"}, vv)
+}
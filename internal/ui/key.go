@@ -10,6 +10,12 @@ func initKeys() {
 	tcell.KeyNames[tcell.Key(KeyHelp)] = "?"
 	tcell.KeyNames[tcell.Key(KeySlash)] = "/"
 	tcell.KeyNames[tcell.Key(KeySpace)] = "space"
+	tcell.KeyNames[tcell.Key(KeyTilde)] = "~"
+	tcell.KeyNames[tcell.Key(KeyLBracket)] = "["
+	tcell.KeyNames[tcell.Key(KeyRBracket)] = "]"
+	tcell.KeyNames[tcell.Key(KeyLess)] = "<"
+	tcell.KeyNames[tcell.Key(KeyGreater)] = ">"
+	tcell.KeyNames[tcell.Key(KeyEqual)] = "="
 
 	initNumbKeys()
 	initStdKeys()
@@ -73,10 +79,16 @@ const (
 	KeyX
 	KeyY
 	KeyZ
-	KeyHelp  = 63
-	KeySlash = 47
-	KeyColon = 58
-	KeySpace = 32
+	KeyHelp     = 63
+	KeySlash    = 47
+	KeyColon    = 58
+	KeySpace    = 32
+	KeyTilde    = 126
+	KeyLBracket = 91
+	KeyRBracket = 93
+	KeyLess     = 60
+	KeyEqual    = 61
+	KeyGreater  = 62
 )
 
 // Define Shift Keys
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const maxKeyLog = 5
+
+// KeyLog displays the most recently pressed keys.
+type KeyLog struct {
+	*tview.TextView
+
+	keys []string
+}
+
+// NewKeyLog returns a new key log view.
+func NewKeyLog(styles *config.Styles) *KeyLog {
+	k := KeyLog{
+		TextView: tview.NewTextView(),
+		keys:     make([]string, 0, maxKeyLog),
+	}
+	k.SetTextAlign(tview.AlignRight)
+	k.SetBorderPadding(0, 0, 1, 1)
+	k.StylesChanged(styles)
+	styles.AddListener(&k)
+
+	return &k
+}
+
+// StylesChanged notifies listener the skin changed.
+func (k *KeyLog) StylesChanged(s *config.Styles) {
+	k.SetBackgroundColor(s.BgColor())
+	k.SetTextColor(s.Frame().Menu.KeyColor.Color())
+}
+
+// Record tracks a key press.
+func (k *KeyLog) Record(evt *tcell.EventKey) {
+	if len(k.keys) == maxKeyLog {
+		k.keys = k.keys[1:]
+	}
+	k.keys = append(k.keys, KeyName(evt))
+	k.SetText(strings.Join(k.keys, " "))
+}
+
+// Keys returns the recorded keys.
+func (k *KeyLog) Keys() []string {
+	return k.keys
+}
+
+// KeyName returns a human readable key name.
+func KeyName(evt *tcell.EventKey) string {
+	if evt.Key() != tcell.KeyRune {
+		return "<" + strings.ToLower(evt.Name()) + ">"
+	}
+	if evt.Rune() == ' ' {
+		return "<space>"
+	}
+
+	return string(evt.Rune())
+}
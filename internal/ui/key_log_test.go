@@ -0,0 +1,48 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyLogRecord(t *testing.T) {
+	k := ui.NewKeyLog(config.NewStyles())
+	for _, r := range "abcdef" {
+		k.Record(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+	}
+	k.Record(tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl))
+
+	assert.Equal(t, []string{"c", "d", "e", "f", "<ctrl+d>"}, k.Keys())
+	assert.Equal(t, "c d e f <ctrl+d>", k.GetText(true))
+}
+
+func TestKeyName(t *testing.T) {
+	uu := map[string]struct {
+		evt *tcell.EventKey
+		e   string
+	}{
+		"rune": {
+			evt: tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone),
+			e:   "x",
+		},
+		"space": {
+			evt: tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone),
+			e:   "<space>",
+		},
+		"enter": {
+			evt: tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone),
+			e:   "<enter>",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.KeyName(u.evt))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
@@ -153,7 +154,7 @@ func (m *Menu) formatMenu(h model.MenuHint, size int) string {
 	}
 	i, err := strconv.Atoi(h.Mnemonic)
 	if err == nil {
-		return formatNSMenu(i, h.Description, m.styles.Frame())
+		return formatNSMenu(i, i18n.T(h.Description), m.styles.Frame())
 	}
 
 	return formatPlainMenu(h, size, m.styles.Frame())
@@ -196,8 +197,21 @@ func formatNSMenu(i int, name string, styles config.Frame) string {
 
 func formatPlainMenu(h model.MenuHint, size int, styles config.Frame) string {
 	menuFmt := " [key:-:b]%-" + strconv.Itoa(size+2) + "s [fg:-:d]%s "
-	fmat := strings.Replace(menuFmt, "[key", "["+styles.Menu.KeyColor.String(), 1)
-	fmat = strings.Replace(fmat, "[fg", "["+styles.Menu.FgColor.String(), 1)
+	key, fg := styles.Menu.KeyColor.String(), styles.Menu.FgColor.String()
+	if h.Disabled {
+		key = disabledColor(styles)
+		fg = key
+	}
+	fmat := strings.Replace(menuFmt, "[key", "["+key, 1)
+	fmat = strings.Replace(fmat, "[fg", "["+fg, 1)
 	fmat = strings.Replace(fmat, ":bg:", ":"+styles.Title.BgColor.String()+":", -1)
-	return fmt.Sprintf(fmat, toMnemonic(h.Mnemonic), h.Description)
+	return fmt.Sprintf(fmat, toMnemonic(h.Mnemonic), i18n.T(h.Description))
+}
+
+func disabledColor(styles config.Frame) string {
+	if styles.Menu.DisabledColor == "" {
+		return "gray"
+	}
+
+	return styles.Menu.DisabledColor.String()
 }
@@ -22,6 +22,17 @@ func TestNewMenu(t *testing.T) {
 	assert.Equal(t, " [dodgerblue:-:b]<b> [white:-:d]bleeB ", v.GetCell(1, 1).Text)
 }
 
+func TestMenuDisabled(t *testing.T) {
+	v := ui.NewMenu(config.NewStyles())
+	v.HydrateMenu(model.MenuHints{
+		{Mnemonic: "a", Description: "bleeA", Visible: true, Disabled: true},
+		{Mnemonic: "b", Description: "bleeB", Visible: true},
+	})
+
+	assert.Equal(t, " [gray:-:b]<a> [gray:-:d]bleeA ", v.GetCell(0, 0).Text)
+	assert.Equal(t, " [dodgerblue:-:b]<b> [white:-:d]bleeB ", v.GetCell(1, 0).Text)
+}
+
 func TestActionHints(t *testing.T) {
 	uu := map[string]struct {
 		aa ui.KeyActions
@@ -0,0 +1,39 @@
+package ui
+
+import "github.com/gdamore/tcell"
+
+// MouseScreen reports left clicks off a terminal screen. Mouse events are
+// swallowed, any other events are passed through to the application.
+type MouseScreen struct {
+	tcell.Screen
+
+	clickFn func(x, y int)
+	buttons tcell.ButtonMask
+	enabled bool
+}
+
+// NewMouseScreen returns a new screen reporting clicks.
+func NewMouseScreen(s tcell.Screen, clickFn func(x, y int)) *MouseScreen {
+	return &MouseScreen{Screen: s, clickFn: clickFn}
+}
+
+// PollEvent returns the next non mouse event. The mouse is enabled on the
+// first poll since the screen might not be initialized until then.
+func (s *MouseScreen) PollEvent() tcell.Event {
+	if !s.enabled {
+		s.Screen.EnableMouse()
+		s.enabled = true
+	}
+	for {
+		evt := s.Screen.PollEvent()
+		m, ok := evt.(*tcell.EventMouse)
+		if !ok {
+			return evt
+		}
+		b := m.Buttons()
+		if b&tcell.Button1 != 0 && s.buttons&tcell.Button1 == 0 {
+			s.clickFn(m.Position())
+		}
+		s.buttons = b
+	}
+}
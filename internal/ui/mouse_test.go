@@ -0,0 +1,29 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMouseScreen(t *testing.T) {
+	sim := tcell.NewSimulationScreen("")
+	assert.Nil(t, sim.Init())
+	defer sim.Fini()
+
+	var clicks [][2]int
+	s := ui.NewMouseScreen(sim, func(x, y int) {
+		clicks = append(clicks, [2]int{x, y})
+	})
+	sim.InjectMouse(2, 1, tcell.Button1, tcell.ModNone)
+	sim.InjectMouse(3, 1, tcell.Button1, tcell.ModNone)
+	sim.InjectMouse(3, 1, tcell.ButtonNone, tcell.ModNone)
+	sim.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+
+	evt, ok := s.PollEvent().(*tcell.EventKey)
+	assert.True(t, ok)
+	assert.Equal(t, tcell.KeyEnter, evt.Key())
+	assert.Equal(t, [][2]int{{2, 1}}, clicks)
+}
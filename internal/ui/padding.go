@@ -5,6 +5,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
@@ -59,6 +60,29 @@ func Pad(s string, width int) string {
 	return s + strings.Repeat(" ", width-len(s))
 }
 
+// TruncateCell cuts a cell value exceeding a column max width on the left,
+// in the middle or on the right.
+func TruncateCell(s string, tr config.ColumnTruncation) string {
+	rr := []rune(s)
+	if tr.Width <= 0 || len(rr) <= tr.Width {
+		return s
+	}
+	ellipsis := render.Glyph(render.EllipsisSign)
+	n := tr.Width - len([]rune(ellipsis))
+	if n <= 0 {
+		return string(rr[:tr.Width])
+	}
+	switch tr.Mode {
+	case config.TruncateLeft:
+		return ellipsis + string(rr[len(rr)-n:])
+	case config.TruncateMiddle:
+		head := n - n/2
+		return string(rr[:head]) + ellipsis + string(rr[len(rr)-n/2:])
+	default:
+		return string(rr[:n]) + ellipsis
+	}
+}
+
 func toAgeHuman(s string) string {
 	d, err := time.ParseDuration(s)
 	if err != nil {
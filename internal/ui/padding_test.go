@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
@@ -115,6 +116,51 @@ func TestPad(t *testing.T) {
 	}
 }
 
+func TestTruncateCell(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		tr config.ColumnTruncation
+		e  string
+	}{
+		"noWidth": {
+			s: "docker.io/fred/blee:1.0",
+			e: "docker.io/fred/blee:1.0",
+		},
+		"fits": {
+			s:  "fred",
+			tr: config.ColumnTruncation{Width: 4},
+			e:  "fred",
+		},
+		"right": {
+			s:  "docker.io/fred/blee:1.0",
+			tr: config.ColumnTruncation{Width: 10},
+			e:  "docker.io…",
+		},
+		"left": {
+			s:  "docker.io/fred/blee:1.0",
+			tr: config.ColumnTruncation{Width: 10, Mode: config.TruncateLeft},
+			e:  "…/blee:1.0",
+		},
+		"middle": {
+			s:  "docker.io/fred/blee:1.0",
+			tr: config.ColumnTruncation{Width: 10, Mode: config.TruncateMiddle},
+			e:  "docke…:1.0",
+		},
+		"unicode": {
+			s:  "ééééé",
+			tr: config.ColumnTruncation{Width: 3, Mode: config.TruncateMiddle},
+			e:  "é…é",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, TruncateCell(u.s, u.tr))
+		})
+	}
+}
+
 func BenchmarkMaxColumn(b *testing.B) {
 	table := render.TableData{
 		Header: render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "B"}},
@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	paletteWidth  = 70
+	paletteHeight = 20
+)
+
+// PaletteItem represents a palette entry.
+type PaletteItem struct {
+	Name        string
+	Description string
+	Hint        string
+	Action      func()
+}
+
+func (p PaletteItem) String() string {
+	return p.Name + " " + p.Description
+}
+
+// PaletteItems represents a collection of palette entries.
+type PaletteItems []PaletteItem
+
+// Filter returns the items fuzzy matching the given query, best matches first.
+func (pp PaletteItems) Filter(q string) PaletteItems {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return pp
+	}
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		ss = append(ss, p.String())
+	}
+	mm := fuzzy.Find(q, ss)
+	res := make(PaletteItems, 0, len(mm))
+	for _, m := range mm {
+		res = append(res, pp[m.Index])
+	}
+
+	return res
+}
+
+// Palette represents a fuzzy searchable list of actions.
+type Palette struct {
+	*tview.Flex
+
+	input   *tview.InputField
+	list    *tview.List
+	items   PaletteItems
+	matches PaletteItems
+	doneFn  func()
+}
+
+// NewPalette returns a new palette.
+func NewPalette(title string, styles *config.Styles) *Palette {
+	p := Palette{
+		Flex:  tview.NewFlex(),
+		input: tview.NewInputField(),
+		list:  tview.NewList(),
+	}
+
+	p.input.SetLabel("> ")
+	p.input.SetLabelColor(styles.Frame().Title.FilterColor.Color())
+	p.input.SetFieldBackgroundColor(styles.BgColor())
+	p.input.SetFieldTextColor(styles.FgColor())
+	p.input.SetChangedFunc(p.Filter)
+	p.input.SetInputCapture(p.keyboard)
+	p.input.SetDoneFunc(func(k tcell.Key) {
+		switch k {
+		case tcell.KeyEnter:
+			p.fire()
+		case tcell.KeyEscape:
+			p.done()
+		}
+	})
+
+	p.list.ShowSecondaryText(false)
+	p.list.SetMainTextColor(styles.FgColor())
+	p.list.SetSelectedTextColor(styles.BgColor())
+	p.list.SetSelectedBackgroundColor(styles.Table().CursorColor.Color())
+	p.list.SetBackgroundColor(styles.BgColor())
+
+	p.SetDirection(tview.FlexRow).
+		AddItem(p.input, 1, 0, true).
+		AddItem(p.list, 0, 1, false)
+	p.SetBorder(true)
+	p.SetBorderColor(styles.Frame().Border.FocusColor.Color())
+	p.SetBackgroundColor(styles.BgColor())
+	p.SetTitle(fmt.Sprintf(" [aqua::b]%s ", title))
+
+	return &p
+}
+
+// Draw draws the palette centered on screen.
+func (p *Palette) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	w, h := paletteWidth, paletteHeight
+	if w > sw-2 {
+		w = sw - 2
+	}
+	if h > sh-2 {
+		h = sh - 2
+	}
+	p.SetRect((sw-w)/2, (sh-h)/2, w, h)
+	p.Flex.Draw(screen)
+}
+
+// SetItems sets the palette entries.
+func (p *Palette) SetItems(ii PaletteItems) {
+	p.items = ii
+	p.Filter(p.input.GetText())
+}
+
+// SetDoneFn sets a function to be called when the palette is dismissed.
+func (p *Palette) SetDoneFn(f func()) {
+	p.doneFn = f
+}
+
+// Matches returns the entries matching the current query.
+func (p *Palette) Matches() PaletteItems {
+	return p.matches
+}
+
+// Filter narrows the palette entries to the ones matching the query.
+func (p *Palette) Filter(q string) {
+	p.matches = p.items.Filter(q)
+	p.list.Clear()
+	for _, m := range p.matches {
+		txt := m.Name
+		if m.Hint != "" {
+			txt = fmt.Sprintf("[::b]%-10s[::-] %s", "<"+m.Hint+">", m.Name)
+		}
+		if m.Description != "" {
+			txt += " [gray::-]" + tview.Escape(m.Description)
+		}
+		p.list.AddItem(txt, "", 0, nil)
+	}
+}
+
+func (p *Palette) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	switch evt.Key() {
+	case tcell.KeyUp, tcell.KeyCtrlP:
+		p.move(-1)
+		return nil
+	case tcell.KeyDown, tcell.KeyCtrlN:
+		p.move(1)
+		return nil
+	}
+
+	return evt
+}
+
+func (p *Palette) move(delta int) {
+	n := p.list.GetItemCount()
+	if n == 0 {
+		return
+	}
+	p.list.SetCurrentItem((p.list.GetCurrentItem() + delta + n) % n)
+}
+
+func (p *Palette) fire() {
+	idx := p.list.GetCurrentItem()
+	if idx < 0 || idx >= len(p.matches) {
+		return
+	}
+	p.done()
+	if a := p.matches[idx].Action; a != nil {
+		a()
+	}
+}
+
+func (p *Palette) done() {
+	if p.doneFn != nil {
+		p.doneFn()
+	}
+}
@@ -0,0 +1,54 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteItemsFilter(t *testing.T) {
+	ii := ui.PaletteItems{
+		{Name: "Describe", Hint: "d"},
+		{Name: "Delete", Hint: "ctrl-d"},
+		{Name: "Logs", Description: "Show container logs", Hint: "l"},
+	}
+
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"none":  {q: "", e: []string{"Describe", "Delete", "Logs"}},
+		"blank": {q: "  ", e: []string{"Describe", "Delete", "Logs"}},
+		"fuzzy": {q: "dsc", e: []string{"Describe"}},
+		"desc":  {q: "container", e: []string{"Logs"}},
+		"nada":  {q: "zorg", e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			nn := []string{}
+			for _, i := range ii.Filter(u.q) {
+				nn = append(nn, i.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestPaletteMatches(t *testing.T) {
+	var fired string
+	p := ui.NewPalette("Actions", config.NewStyles())
+	p.SetItems(ui.PaletteItems{
+		{Name: "Describe", Action: func() { fired = "Describe" }},
+		{Name: "Logs", Action: func() { fired = "Logs" }},
+	})
+	assert.Equal(t, 2, len(p.Matches()))
+
+	p.Filter("lgs")
+	assert.Equal(t, 1, len(p.Matches()))
+	p.Matches()[0].Action()
+	assert.Equal(t, "Logs", fired)
+}
@@ -17,8 +17,15 @@ const (
 var (
 	_ PromptModel = (*model.FishBuff)(nil)
 	_ Suggester   = (*model.FishBuff)(nil)
+	_ Recaller    = (*model.FishBuff)(nil)
 )
 
+// Recaller recalls past commands.
+type Recaller interface {
+	// Recall returns the past commands matching a query, best match first.
+	Recall(q string) []string
+}
+
 // Suggester provides suggestions.
 type Suggester interface {
 	// CurrentSuggestion returns the current suggestion.
@@ -76,6 +83,10 @@ type Prompt struct {
 	styles  *config.Styles
 	model   PromptModel
 	spacer  int
+
+	// recall tracks the past commands cycled through via ctrl-r.
+	recall      []string
+	recallIndex int
 }
 
 // NewPrompt returns a new command view.
@@ -121,6 +132,7 @@ func (p *Prompt) SetModel(m PromptModel) {
 	}
 	p.model = m
 	p.model.AddListener(p)
+	p.resetRecall()
 }
 
 func (p *Prompt) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -129,7 +141,12 @@ func (p *Prompt) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
+	if evt.Key() != tcell.KeyCtrlR {
+		p.resetRecall()
+	}
 	switch evt.Key() {
+	case tcell.KeyCtrlR:
+		p.recallNext()
 	case tcell.KeyBackspace2, tcell.KeyBackspace, tcell.KeyDelete:
 		p.model.Delete()
 	case tcell.KeyRune:
@@ -160,6 +177,28 @@ func (p *Prompt) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+// recallNext replaces the prompt text with the next past command fuzzy
+// matching the text typed before the first recall.
+func (p *Prompt) recallNext() {
+	r, ok := p.model.(Recaller)
+	if !ok {
+		return
+	}
+	if p.recall == nil {
+		p.recall, p.recallIndex = r.Recall(p.model.GetText()), -1
+	}
+	if len(p.recall) == 0 {
+		return
+	}
+	p.recallIndex = (p.recallIndex + 1) % len(p.recall)
+	p.model.SetText(p.recall[p.recallIndex])
+	p.suggest(p.model.GetText(), fmt.Sprintf("  (recall %d/%d)", p.recallIndex+1, len(p.recall)))
+}
+
+func (p *Prompt) resetRecall() {
+	p.recall, p.recallIndex = nil, -1
+}
+
 // StylesChanged notifies skin changed.
 func (p *Prompt) StylesChanged(s *config.Styles) {
 	p.styles = s
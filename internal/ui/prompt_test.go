@@ -1,11 +1,13 @@
 package ui_test
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,3 +45,29 @@ func TestCmdMode(t *testing.T) {
 		assert.Equal(t, f, v.InCmdMode())
 	}
 }
+
+func TestCmdRecall(t *testing.T) {
+	m := model.NewFishBuff(':', model.CommandBuffer)
+	h := model.NewHistory(10)
+	for _, c := range []string{"deploy", "po kube-system", "svc", "pod default"} {
+		h.Push(c)
+	}
+	m.SetRecallFn(func(q string) sort.StringSlice { return h.Recall(q) })
+	v := ui.NewPrompt(true, config.NewStyles())
+	v.SetModel(m)
+	m.SetText("po")
+
+	v.SendKey(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone))
+	assert.Equal(t, "pod default", m.GetText())
+	assert.Equal(t, "\x00> [::b]pod default[gray::-]  (recall 1/3)\n", v.GetText(false))
+	v.SendKey(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone))
+	assert.Equal(t, "po kube-system", m.GetText())
+	v.SendKey(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone))
+	assert.Equal(t, "deploy", m.GetText())
+	v.SendKey(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone))
+	assert.Equal(t, "pod default", m.GetText())
+
+	v.SendStrokes("s")
+	v.SendKey(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone))
+	assert.Equal(t, "pod defaults", m.GetText())
+}
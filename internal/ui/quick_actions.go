@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/tview"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const quickActionFmt = "[%s:%s:b] %s [%s:-:-] %s "
+
+// QuickActionIDs tracks the actions shown as buttons in order.
+var QuickActionIDs = []string{ActionLogs, ActionShell, ActionDescribe, ActionDelete, ActionRestart}
+
+// QuickActions displays the top contextual actions of the current view as
+// labeled buttons.
+type QuickActions struct {
+	*tview.TextView
+
+	styles *config.Styles
+	hints  model.MenuHints
+	labels []string
+}
+
+// NewQuickActions returns a new quick actions strip.
+func NewQuickActions(styles *config.Styles) *QuickActions {
+	q := QuickActions{
+		TextView: tview.NewTextView(),
+		styles:   styles,
+	}
+	q.SetTextAlign(tview.AlignLeft)
+	q.SetBorderPadding(0, 0, 1, 1)
+	q.SetDynamicColors(true)
+	q.StylesChanged(styles)
+	styles.AddListener(&q)
+
+	return &q
+}
+
+// StylesChanged notifies skin changed.
+func (q *QuickActions) StylesChanged(s *config.Styles) {
+	q.styles = s
+	q.SetBackgroundColor(s.BgColor())
+	q.refresh()
+}
+
+// StackPushed notifies a component was added.
+func (q *QuickActions) StackPushed(c model.Component) {
+	q.hydrate(c.Hints())
+}
+
+// StackPopped notifies a component was removed.
+func (q *QuickActions) StackPopped(o, top model.Component) {
+	if top == nil {
+		q.hydrate(nil)
+		return
+	}
+	q.hydrate(top.Hints())
+}
+
+// StackTop notifies the top component.
+func (q *QuickActions) StackTop(t model.Component) {
+	q.hydrate(t.Hints())
+}
+
+// Actions returns the actions currently shown.
+func (q *QuickActions) Actions() model.MenuHints {
+	return q.hints
+}
+
+// Hydrate refreshes the buttons when the current view actions changed.
+func (q *QuickActions) Hydrate(hh model.MenuHints) {
+	q.hydrate(hh)
+}
+
+// ActionAt returns the action whose button is at the given screen location.
+func (q *QuickActions) ActionAt(x, y int) (model.MenuHint, bool) {
+	rx, ry, w, h := q.GetInnerRect()
+	if y < ry || y >= ry+h || x < rx || x >= rx+w {
+		return model.MenuHint{}, false
+	}
+	var start int
+	for i, l := range q.labels {
+		end := start + runewidth.StringWidth(l)
+		if x-rx >= start && x-rx < end {
+			return q.hints[i], true
+		}
+		start = end + 1
+	}
+
+	return model.MenuHint{}, false
+}
+
+func (q *QuickActions) hydrate(hh model.MenuHints) {
+	q.hints = QuickHints(hh)
+	q.refresh()
+}
+
+func (q *QuickActions) refresh() {
+	frame := q.styles.Frame()
+	bg, fg := frame.Menu.KeyColor.String(), frame.Menu.FgColor.String()
+	ss := make([]string, 0, len(q.hints))
+	q.labels = make([]string, 0, len(q.hints))
+	for _, h := range q.hints {
+		mn, desc := toMnemonic(h.Mnemonic), i18n.T(h.Description)
+		ss = append(ss, fmt.Sprintf(quickActionFmt, "black", bg, tview.Escape(mn), fg, desc))
+		q.labels = append(q.labels, " "+mn+"  "+desc+" ")
+	}
+	q.SetText(strings.Join(ss, " "))
+}
+
+// QuickHints picks the visible and enabled quick actions out of a view hints.
+func QuickHints(hh model.MenuHints) model.MenuHints {
+	qq := make(model.MenuHints, 0, len(QuickActionIDs))
+	for _, id := range QuickActionIDs {
+		for _, h := range hh {
+			if h.ID == id && h.Visible && !h.Disabled {
+				qq = append(qq, h)
+				break
+			}
+		}
+	}
+
+	return qq
+}
@@ -0,0 +1,85 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuickHints(t *testing.T) {
+	hh := model.MenuHints{
+		{ID: ui.ActionDelete, Mnemonic: "ctrl-d", Description: "Delete", Visible: true},
+		{Mnemonic: "y", Description: "YAML", Visible: true},
+		{ID: ui.ActionShell, Mnemonic: "s", Description: "Shell", Visible: true, Disabled: true},
+		{ID: ui.ActionLogs, Mnemonic: "l", Description: "Journaux", Visible: true},
+		{ID: ui.ActionDescribe, Mnemonic: "d", Description: "Describe"},
+		{Mnemonic: "r", Description: "Restart", Visible: true},
+	}
+
+	assert.Equal(t, model.MenuHints{
+		{ID: ui.ActionLogs, Mnemonic: "l", Description: "Journaux", Visible: true},
+		{ID: ui.ActionDelete, Mnemonic: "ctrl-d", Description: "Delete", Visible: true},
+	}, ui.QuickHints(hh))
+	assert.Equal(t, 0, len(ui.QuickHints(nil)))
+}
+
+func TestQuickActions(t *testing.T) {
+	q := ui.NewQuickActions(config.NewStyles())
+	q.StackPushed(makeComponent("po"))
+	assert.Equal(t, 0, len(q.Actions()))
+	assert.Equal(t, "", q.GetText(true))
+
+	q.StackTop(quickComponent{c: makeComponent("po")})
+	assert.Equal(t, 2, len(q.Actions()))
+	assert.Equal(t, "[black:dodgerblue:b] <l> [white:-:-] Logs  [black:dodgerblue:b] <ctrl-d> [white:-:-] Delete ", q.GetText(false))
+
+	q.Hydrate(nil)
+	assert.Equal(t, 0, len(q.Actions()))
+	q.StackPopped(nil, quickComponent{c: makeComponent("po")})
+	assert.Equal(t, 2, len(q.Actions()))
+	q.StackPopped(nil, nil)
+	assert.Equal(t, 0, len(q.Actions()))
+}
+
+func TestQuickActionsActionAt(t *testing.T) {
+	q := ui.NewQuickActions(config.NewStyles())
+	q.SetRect(0, 10, 80, 1)
+	q.StackTop(quickComponent{c: makeComponent("po")})
+
+	uu := map[string]struct {
+		x, y int
+		id   string
+		ok   bool
+	}{
+		"padding":   {x: 0, y: 10},
+		"logs":      {x: 1, y: 10, id: ui.ActionLogs, ok: true},
+		"logsEnd":   {x: 11, y: 10, id: ui.ActionLogs, ok: true},
+		"separator": {x: 12, y: 10},
+		"delete":    {x: 13, y: 10, id: ui.ActionDelete, ok: true},
+		"past":      {x: 31, y: 10},
+		"otherRow":  {x: 1, y: 9},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h, ok := q.ActionAt(u.x, u.y)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.id, h.ID)
+		})
+	}
+}
+
+type quickComponent struct {
+	c
+}
+
+func (quickComponent) Hints() model.MenuHints {
+	return model.MenuHints{
+		{ID: ui.ActionDelete, Mnemonic: "ctrl-d", Description: "Delete", Visible: true},
+		{ID: ui.ActionLogs, Mnemonic: "l", Description: "Logs", Visible: true},
+	}
+}
@@ -11,6 +11,7 @@ type SelectTable struct {
 
 	model      Tabular
 	selectedFn func(string) string
+	changedFn  func(row int)
 	marks      map[string]struct{}
 }
 
@@ -77,6 +78,11 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetChangedFn defines a function to be notified when the selected row changes.
+func (s *SelectTable) SetChangedFn(f func(row int)) {
+	s.changedFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	r, _ := s.GetSelection()
@@ -104,6 +110,9 @@ func (s *SelectTable) selectionChanged(r, c int) {
 	}
 	cell := s.GetCell(r, c)
 	s.SetSelectedStyle(tcell.ColorBlack, cell.Color, tcell.AttrBold)
+	if s.changedFn != nil {
+		s.changedFn(r)
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -113,6 +122,29 @@ func (s *SelectTable) ClearMarks() {
 	}
 }
 
+// MarkAll marks all given items.
+func (s *SelectTable) MarkAll(ids []string) {
+	for _, id := range ids {
+		s.marks[id] = struct{}{}
+	}
+}
+
+// InvertMarks toggles the marks of all given items.
+func (s *SelectTable) InvertMarks(ids []string) {
+	for _, id := range ids {
+		if _, ok := s.marks[id]; ok {
+			delete(s.marks, id)
+			continue
+		}
+		s.marks[id] = struct{}{}
+	}
+}
+
+// MarkCount returns the number of marked items.
+func (s *SelectTable) MarkCount() int {
+	return len(s.marks)
+}
+
 // DeleteMark delete a marked item.
 func (s *SelectTable) DeleteMark(k string) {
 	delete(s.marks, k)
@@ -32,6 +32,7 @@ type Table struct {
 	gvr     client.GVR
 	sortCol SortColumn
 	header  render.Header
+	cols    []string
 	Path    string
 	Extras  string
 	*SelectTable
@@ -43,7 +44,27 @@ type Table struct {
 	decorateFn  DecorateFunc
 	wide        bool
 	toast       bool
+	frozen      bool
 	hasMetrics  bool
+	lastSel     string
+	lastNS      string
+	vanished    string
+	visual      *visualRange
+	pivot       string
+	quick       []quickFilter
+	query       filterQuery
+	queryText   string
+	filterErr   string
+	highlights  cellHighlights
+
+	marksChangedFn func(count int)
+	filterErrFn    func(error)
+}
+
+// visualRange tracks a range selection anchored on a given row.
+type visualRange struct {
+	anchor string
+	base   map[string]struct{}
 }
 
 // NewTable returns a new table view.
@@ -86,6 +107,30 @@ func (t *Table) Init(ctx context.Context) {
 // GVR returns a resource descriptor.
 func (t *Table) GVR() client.GVR { return t.gvr }
 
+// SetFrozen toggles the frozen indicator of a table that only refreshes on demand.
+func (t *Table) SetFrozen(b bool) {
+	t.frozen = b
+	t.UpdateTitle()
+}
+
+// IsFrozen checks if the table only refreshes on demand.
+func (t *Table) IsFrozen() bool { return t.frozen }
+
+// truncation returns a column truncation from the view settings if any or
+// from the column max width otherwise.
+func (t *Table) truncation(h render.HeaderColumn) (config.ColumnTruncation, bool) {
+	if t.viewSetting != nil {
+		if tr, ok := t.viewSetting.Truncation(h.Name); ok {
+			return tr, true
+		}
+	}
+	if h.MaxWidth > 0 {
+		return config.ColumnTruncation{Width: h.MaxWidth, Mode: config.TruncateRight}, true
+	}
+
+	return config.ColumnTruncation{}, false
+}
+
 // ViewSettingsChanged notifies listener the view configuration changed.
 func (t *Table) ViewSettingsChanged(settings config.ViewSetting) {
 	t.viewSetting = &settings
@@ -191,8 +236,231 @@ func (t *Table) Update(data render.TableData) {
 	if t.decorateFn != nil {
 		data = t.decorateFn(data)
 	}
+	t.pruneMarks(data)
+	anchor := t.stickyAnchor()
+	if data.Namespace != t.lastNS {
+		anchor, t.vanished = "", ""
+	}
+	t.lastNS = data.Namespace
 	t.doUpdate(t.filtered(data))
+	t.restoreSelection(anchor, data)
+	t.UpdateTitle()
+}
+
+// VisibleIDs returns the ids of all rows matching the current filter.
+func (t *Table) VisibleIDs() []string {
+	ids := make([]string, 0, t.GetRowCount())
+	for r := 1; r < t.GetRowCount(); r++ {
+		if id, ok := t.GetCell(r, 0).GetReference().(string); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// SetMarksChangedFn defines a function to be notified when the marks count changes.
+func (t *Table) SetMarksChangedFn(f func(count int)) {
+	t.marksChangedFn = f
+}
+
+// SetFilterErrFn defines a function to be notified when a filter expression is invalid.
+func (t *Table) SetFilterErrFn(f func(error)) {
+	t.filterErrFn = f
+}
+
+// filterFailed reports an invalid filter once per expression.
+func (t *Table) filterFailed(q string, err error) {
+	if q == t.filterErr {
+		return
+	}
+	t.filterErr = q
+	log.Error().Err(err).Msg("Filter")
+	if t.filterErrFn != nil {
+		t.filterErrFn(err)
+	}
+}
+
+// MarksChanged notifies the marks were updated.
+func (t *Table) MarksChanged() {
+	if t.marksChangedFn != nil {
+		t.marksChangedFn(t.MarkCount())
+	}
+}
+
+// InVisual returns true if a range selection is in progress.
+func (t *Table) InVisual() bool {
+	return t.visual != nil
+}
+
+// StartVisual starts a range selection anchored on the selected row.
+func (t *Table) StartVisual() bool {
+	anchor := t.selectedID()
+	if anchor == "" {
+		return false
+	}
+	base := make(map[string]struct{}, len(t.marks))
+	for k := range t.marks {
+		base[k] = struct{}{}
+	}
+	t.visual = &visualRange{anchor: anchor, base: base}
+	t.ExtendVisual()
 	t.UpdateTitle()
+
+	return true
+}
+
+// StopVisual ends the range selection and keeps the marked rows.
+func (t *Table) StopVisual() {
+	t.visual = nil
+	t.UpdateTitle()
+}
+
+// CancelVisual ends the range selection and restores the prior marks.
+func (t *Table) CancelVisual() {
+	if t.visual == nil {
+		return
+	}
+	t.SelectTable.ClearMarks()
+	t.MarkAll(mapKeys(t.visual.base))
+	t.visual = nil
+	t.Refresh()
+}
+
+// ExtendVisual marks all rows between the range anchor and the selected row.
+// Returns true if the marks changed.
+func (t *Table) ExtendVisual() bool {
+	if t.visual == nil {
+		return false
+	}
+	from, to := -1, t.GetSelectedRowIndex()
+	for r := 1; r < t.GetRowCount(); r++ {
+		if id, ok := t.GetCell(r, 0).GetReference().(string); ok && id == t.visual.anchor {
+			from = r
+			break
+		}
+	}
+	if from < 0 || to <= 0 {
+		return false
+	}
+	if from > to {
+		from, to = to, from
+	}
+	marks := make(map[string]struct{}, len(t.visual.base))
+	for k := range t.visual.base {
+		marks[k] = struct{}{}
+	}
+	for r := from; r <= to && r < t.GetRowCount(); r++ {
+		if id, ok := t.GetCell(r, 0).GetReference().(string); ok {
+			marks[id] = struct{}{}
+		}
+	}
+	if sameKeys(marks, t.marks) {
+		return false
+	}
+	t.SelectTable.ClearMarks()
+	t.MarkAll(mapKeys(marks))
+	t.Refresh()
+	t.MarksChanged()
+
+	return true
+}
+
+func mapKeys(m map[string]struct{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+
+	return kk
+}
+
+func sameKeys(m1, m2 map[string]struct{}) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k := range m1 {
+		if _, ok := m2[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pruneMarks drops marks of resources that no longer exist so that marks track live objects only.
+func (t *Table) pruneMarks(data render.TableData) {
+	var pruned bool
+	for id := range t.marks {
+		if _, ok := data.RowEvents.FindIndex(id); !ok {
+			delete(t.marks, id)
+			pruned = true
+		}
+	}
+	if pruned {
+		t.MarksChanged()
+	}
+	if t.visual == nil {
+		return
+	}
+	for id := range t.visual.base {
+		if _, ok := data.RowEvents.FindIndex(id); !ok {
+			delete(t.visual.base, id)
+		}
+	}
+}
+
+// Vanished returns the id of the previously selected row if it no longer exists.
+func (t *Table) Vanished() string {
+	return t.vanished
+}
+
+// stickyAnchor returns the id of the row the cursor should stay on. A vanished
+// selection remains the anchor until the cursor is moved.
+func (t *Table) stickyAnchor() string {
+	cur := t.selectedID()
+	if t.vanished != "" && cur == t.lastSel {
+		return t.vanished
+	}
+	t.vanished = ""
+
+	return cur
+}
+
+// restoreSelection keeps the cursor on the anchored row regardless of row order.
+func (t *Table) restoreSelection(anchor string, data render.TableData) {
+	defer func() {
+		t.lastSel = t.selectedID()
+	}()
+	if anchor == "" {
+		return
+	}
+	for r := 1; r < t.GetRowCount(); r++ {
+		if id, ok := t.GetCell(r, 0).GetReference().(string); ok && id == anchor {
+			t.vanished = ""
+			t.SelectRow(r, true)
+			return
+		}
+	}
+	if _, ok := data.RowEvents.FindIndex(anchor); ok {
+		// Filtered out but still around.
+		t.vanished = ""
+		return
+	}
+	t.vanished = anchor
+	if r := t.GetSelectedRowIndex(); r >= t.GetRowCount() {
+		t.SelectRow(t.GetRowCount()-1, true)
+	}
+}
+
+func (t *Table) selectedID() string {
+	r := t.GetSelectedRowIndex()
+	if r <= 0 || r >= t.GetRowCount() {
+		return ""
+	}
+	id, _ := t.GetCell(r, 0).GetReference().(string)
+
+	return id
 }
 
 func (t *Table) doUpdate(data render.TableData) {
@@ -220,6 +488,7 @@ func (t *Table) doUpdate(data render.TableData) {
 	bg := t.styles.Table().Header.BgColor.Color()
 
 	var col int
+	t.cols = t.cols[:0]
 	for _, h := range custData.Header {
 		if h.Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
 			continue
@@ -227,10 +496,14 @@ func (t *Table) doUpdate(data render.TableData) {
 		if h.MX && !t.hasMetrics {
 			continue
 		}
+		t.cols = append(t.cols, h.Name)
 		t.AddHeaderCell(col, h)
 		c := t.GetCell(0, col)
 		c.SetBackgroundColor(bg)
 		c.SetTextColor(fg)
+		if h.Name == t.pivot {
+			c.SetAttributes(tcell.AttrReverse)
+		}
 		col++
 	}
 	custData.RowEvents.Sort(
@@ -242,6 +515,11 @@ func (t *Table) doUpdate(data render.TableData) {
 
 	pads := make(MaxyPad, len(custData.Header))
 	ComputeMaxColumns(pads, t.sortCol.name, custData.Header, custData.RowEvents)
+	for i, h := range custData.Header {
+		if tr, ok := t.truncation(h); ok && pads[i] > tr.Width+1 {
+			pads[i] = tr.Width + 1
+		}
+	}
 	for row, re := range custData.RowEvents {
 		idx, _ := data.RowEvents.FindIndex(re.Row.ID)
 		t.buildRow(row+1, re, data.RowEvents[idx], custData.Header, pads)
@@ -273,13 +551,21 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 		if !re.Deltas.IsBlank() && !h.IsAgeCol(c) {
 			field += Deltas(re.Deltas[c], field)
 		}
+		field = render.RedactCell(t.gvr.String(), h[c].Name, field)
 
+		if h[c].Name == "NAME" || h[c].Name == "NAMESPACE" {
+			field = render.Redact(field)
+		}
+		if tr, ok := t.truncation(h[c]); ok {
+			field = TruncateCell(field, tr)
+		}
 		if h[c].Decorator != nil {
 			field = h[c].Decorator(field)
 		}
 		if h[c].Align == tview.AlignLeft {
 			field = formatCell(field, pads[c])
 		}
+		field = t.highlights.Apply(h[c].Name, field)
 
 		cell := tview.NewTableCell(field)
 		cell.SetExpansion(1)
@@ -344,6 +630,28 @@ func (t *Table) GetSelectedRow(path string) (render.Row, bool) {
 	return data.RowEvents[i].Row, true
 }
 
+// Linearize renders a table row as labeled plain text suitable for screen readers.
+func (t *Table) Linearize(r int) string {
+	if r <= 0 || r >= t.GetRowCount() {
+		return ""
+	}
+
+	ff := make([]string, 0, len(t.cols)+1)
+	for c, n := range t.cols {
+		if v := TrimCell(t.SelectTable, r, c); v != "" {
+			ff = append(ff, n+": "+v)
+		}
+	}
+	cell := t.GetCell(r, 0)
+	if id, ok := cell.GetReference().(string); ok && t.IsMarked(id) {
+		ff = append(ff, "marked")
+	} else if s := colorState(cell.Color); s != "" {
+		ff = append(ff, "state: "+s)
+	}
+
+	return fmt.Sprintf("Row %d of %d. %s", r, t.GetRowCount()-1, strings.Join(ff, ", "))
+}
+
 // NameColIndex returns the index of the resource name column.
 func (t *Table) NameColIndex() int {
 	col := 0
@@ -370,7 +678,12 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if t.toast {
 		filtered = filterToast(data)
 	}
+	if len(t.quick) > 0 {
+		filtered = filterQuick(t.quick, filtered)
+	}
+	t.highlights = nil
 	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.GetText()) {
+		t.query, t.queryText, t.filterErr = nil, "", ""
 		return filtered
 	}
 
@@ -378,14 +691,32 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if IsFuzzySelector(q) {
 		return fuzzyFilter(q[2:], filtered)
 	}
+	if IsQuerySelector(q) {
+		if q != t.queryText {
+			fq, err := compileQuery(TrimQuerySelector(q))
+			if err != nil {
+				// Keep the last valid query while the expression is being typed.
+				t.filterFailed(q, err)
+				if t.query == nil {
+					return filtered
+				}
+			} else {
+				t.query, t.queryText, t.filterErr = fq, q, ""
+			}
+		}
+		t.highlights = newCellHighlights(t.queryText, t.query)
+		return queryFilter(t.query, filtered)
+	}
 
-	filtered, err := rxFilter(t.cmdBuff.GetText(), filtered)
+	rx, err := rxFilter(q, filtered)
 	if err != nil {
-		log.Error().Err(errors.New("Invalid filter expression")).Msg("Regexp")
-		t.cmdBuff.ClearText()
+		t.filterFailed(q, errors.New("Invalid filter expression"))
+		return filtered
 	}
+	t.filterErr = ""
+	t.highlights = newCellHighlights(q, nil)
 
-	return filtered
+	return rx
 }
 
 // CmdBuff returns the associated command buffer.
@@ -430,12 +761,29 @@ func (t *Table) styleTitle() string {
 	if t.Extras != "" {
 		ns = t.Extras
 	}
+	if ns != client.ClusterScope && !client.IsAllNamespaces(ns) {
+		ns = render.RedactText(render.Redact(ns))
+	}
 	var title string
 	if ns == client.ClusterScope {
 		title = SkinTitle(fmt.Sprintf(TitleFmt, base, rc), t.styles.Frame())
 	} else {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
+	if t.frozen {
+		title += SkinTitle(FrozenFmt, t.styles.Frame())
+	}
+	if t.visual != nil {
+		title += SkinTitle(VisualFmt, t.styles.Frame())
+	}
+	if t.vanished != "" {
+		_, n := client.Namespaced(t.vanished)
+		title += SkinTitle(fmt.Sprintf(VanishedFmt, tview.Escape(render.Redact(n))), t.styles.Frame())
+	}
+
+	for _, f := range t.quick {
+		title += SkinTitle(fmt.Sprintf(QuickFilterFmt, f.col, tview.Escape(t.displayValue(f.col, f.value))), t.styles.Frame())
+	}
 
 	buff := t.cmdBuff.GetText()
 	if buff == "" {
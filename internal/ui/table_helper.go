@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 )
@@ -26,6 +28,21 @@ const (
 	// TitleFmt represents a standard view title.
 	TitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
+	// FrozenFmt represents a frozen view title indicator.
+	FrozenFmt = "<[hilite:bg:r]frozen[fg:bg:-]> "
+
+	// VisualFmt represents a range selection title indicator.
+	VisualFmt = "<[hilite:bg:r]visual[fg:bg:-]> "
+
+	// VanishedFmt represents a title indicator for a selected resource that is gone.
+	VanishedFmt = "<[filter:bg:r]%s gone[fg:bg:-]> "
+
+	// QuickFilterFmt represents a title indicator for a column value filter.
+	QuickFilterFmt = "<[filter:bg:r]%s=%s[fg:bg:-]> "
+
+	highlightOn  = "[::r]"
+	highlightOff = "[::-]"
+
 	descIndicator = "↓"
 	ascIndicator  = "↑"
 
@@ -41,6 +58,8 @@ var (
 	LableRx = regexp.MustCompile(`\A\-l`)
 
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
+
+	highlightStripper = strings.NewReplacer(highlightOn, "", highlightOff, "")
 )
 
 func mustExtractStyles(ctx context.Context) *config.Styles {
@@ -58,7 +77,7 @@ func TrimCell(tv *SelectTable, row, col int) string {
 		log.Error().Err(fmt.Errorf("No cell at location [%d:%d]", row, col)).Msg("Trim cell failed!")
 		return ""
 	}
-	return strings.TrimSpace(c.Text)
+	return strings.TrimSpace(stripHighlights(c.Text))
 }
 
 // IsLabelSelector checks if query is a label query.
@@ -107,9 +126,34 @@ func sortIndicator(sort, asc bool, style config.Table, name string) string {
 	if asc {
 		order = ascIndicator
 	}
+	order = render.Glyph(order)
 	return fmt.Sprintf("%s[%s::b]%s[::]", name, style.Header.SorterColor, order)
 }
 
+// colorState describes a row color so state is not conveyed by color alone.
+func colorState(c tcell.Color) string {
+	if c == tcell.ColorDefault || c == render.StdColor {
+		return ""
+	}
+
+	switch c {
+	case render.ErrColor:
+		return "error"
+	case render.KillColor:
+		return "terminating"
+	case render.AddColor:
+		return "added"
+	case render.ModColor:
+		return "modified"
+	case render.CompletedColor:
+		return "completed"
+	case render.HighlightColor:
+		return "highlighted"
+	default:
+		return ""
+	}
+}
+
 func formatCell(field string, padding int) string {
 	if IsASCII(field) {
 		return Pad(field, padding)
@@ -177,3 +221,76 @@ func fuzzyFilter(q string, data render.TableData) render.TableData {
 
 	return filtered
 }
+
+// cellHighlights tracks the filter regexes highlighted in cells by column
+// name. Regexes keyed by a blank column apply to all columns.
+type cellHighlights map[string][]*regexp.Regexp
+
+// newCellHighlights returns the highlights for a given regex or query filter.
+// Negated terms match nothing to highlight.
+func newCellHighlights(q string, fq filterQuery) cellHighlights {
+	hh := make(cellHighlights)
+	if fq == nil {
+		rx, err := regexp.Compile(`(?i)` + q)
+		if err != nil {
+			return nil
+		}
+		hh[""] = append(hh[""], rx)
+		return hh
+	}
+	for _, and := range fq {
+		for _, t := range and {
+			if !t.negate {
+				hh[t.col] = append(hh[t.col], t.rx)
+			}
+		}
+	}
+
+	return hh
+}
+
+// Apply highlights a column cell matching substrings. Trailing padding is
+// left out so anchored regexes still match.
+func (h cellHighlights) Apply(col, field string) string {
+	if len(h) == 0 {
+		return field
+	}
+	body := strings.TrimRight(field, " ")
+	var ii [][]int
+	for _, rx := range append(h[""], h[col]...) {
+		ii = append(ii, rx.FindAllStringIndex(body, -1)...)
+	}
+	if len(ii) == 0 {
+		return field
+	}
+	sort.Slice(ii, func(i, j int) bool {
+		if ii[i][0] == ii[j][0] {
+			return ii[i][1] > ii[j][1]
+		}
+		return ii[i][0] < ii[j][0]
+	})
+
+	var (
+		b    strings.Builder
+		last int
+	)
+	for _, i := range ii {
+		if i[1] <= last || i[0] == i[1] {
+			continue
+		}
+		if i[0] < last {
+			i[0] = last
+		}
+		b.WriteString(body[last:i[0]])
+		b.WriteString(highlightOn + body[i[0]:i[1]] + highlightOff)
+		last = i[1]
+	}
+	b.WriteString(field[last:])
+
+	return b.String()
+}
+
+// stripHighlights removes the filter highlights from a cell text.
+func stripHighlights(s string) string {
+	return highlightStripper.Replace(s)
+}
@@ -40,3 +40,102 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsQuerySelector(t *testing.T) {
+	uu := map[string]struct {
+		q string
+		e bool
+	}{
+		"rx":      {"fred|blee", false},
+		"phrase":  {"fred blee", false},
+		"literal": {"app=nginx", false},
+		"plainOr": {"fred or blee", false},
+		"column":  {"-q status=Running", true},
+		"negCol":  {"-q status!=Running node=ip-10-*", true},
+		"negTerm": {"-q !fred", true},
+		"or":      {"-q fred or blee", true},
+		"noSpace": {"-qfred", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsQuerySelector(u.q))
+		})
+	}
+}
+
+func TestTrimQuerySelector(t *testing.T) {
+	assert.Equal(t, "status=Running", TrimQuerySelector("-q  status=Running "))
+}
+
+func TestCompileQuery(t *testing.T) {
+	fq, err := compileQuery("status!=Running node=ip-10-* || !fred")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(fq))
+	assert.Equal(t, 2, len(fq[0]))
+	assert.Equal(t, "STATUS", fq[0][0].col)
+	assert.True(t, fq[0][0].negate)
+	assert.Equal(t, `(?i)\Aip-10-.*\z`, fq[0][1].rx.String())
+	assert.Equal(t, "", fq[1][0].col)
+	assert.True(t, fq[1][0].negate)
+
+	_, err = compileQuery("or")
+	assert.NotNil(t, err)
+	_, err = compileQuery("!fred[")
+	assert.NotNil(t, err)
+}
+
+func TestCellHighlightsApply(t *testing.T) {
+	fq, err := compileQuery("name=nginx-* !fred o|x")
+	assert.Nil(t, err)
+
+	uu := map[string]struct {
+		hh     cellHighlights
+		col, f string
+		e      string
+	}{
+		"none": {
+			col: "NAME",
+			f:   "nginx-1",
+			e:   "nginx-1",
+		},
+		"rx": {
+			hh:  newCellHighlights("ng", nil),
+			col: "NAME",
+			f:   "nginx-ng  ",
+			e:   "[::r]ng[::-]inx-[::r]ng[::-]  ",
+		},
+		"noMatch": {
+			hh:  newCellHighlights("zorg", nil),
+			col: "NAME",
+			f:   "nginx-1",
+			e:   "nginx-1",
+		},
+		"anchoredPadded": {
+			hh:  newCellHighlights("", fq),
+			col: "NAME",
+			f:   "nginx-1   ",
+			e:   "[::r]nginx-1[::-]   ",
+		},
+		"otherCol": {
+			hh:  newCellHighlights("", fq),
+			col: "STATUS",
+			f:   "nginx-1",
+			e:   "ngin[::r]x[::-]-1",
+		},
+		"overlaps": {
+			hh:  newCellHighlights("", fq),
+			col: "NAME",
+			f:   "nginx-box",
+			e:   "[::r]nginx-box[::-]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.hh.Apply(u.col, u.f))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/render"
+)
+
+// quickFilter narrows a table to the rows sharing a column value.
+type quickFilter struct {
+	col, value string
+}
+
+// Pivot returns the column under the column cursor.
+func (t *Table) Pivot() string {
+	for _, c := range t.cols {
+		if c == t.pivot {
+			return c
+		}
+	}
+	if len(t.cols) == 0 {
+		return ""
+	}
+
+	return t.cols[0]
+}
+
+// MovePivot moves the column cursor by a given number of visible columns.
+func (t *Table) MovePivot(delta int) {
+	if len(t.cols) == 0 {
+		return
+	}
+	idx, pivot := 0, t.Pivot()
+	for i, c := range t.cols {
+		if c == pivot {
+			idx = i
+			break
+		}
+	}
+	idx += delta
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(t.cols) {
+		idx = len(t.cols) - 1
+	}
+	t.pivot = t.cols[idx]
+	t.Refresh()
+}
+
+// SelectedCell returns the column under the column cursor along with the
+// selected row full value in that column.
+func (t *Table) SelectedCell() (string, string, bool) {
+	col := t.Pivot()
+	if col == "" {
+		return "", "", false
+	}
+	row, ok := t.GetSelectedRow(t.GetSelectedItem())
+	if !ok {
+		return "", "", false
+	}
+	idx := t.GetModel().Peek().Header.IndexOf(col, true)
+	if idx < 0 || idx >= len(row.Fields) {
+		return "", "", false
+	}
+
+	return col, t.displayValue(col, row.Fields[idx]), true
+}
+
+// ToggleQuickFilter narrows the table to the rows sharing the selected row
+// value under the column cursor. Toggling a filtered column lifts its filter.
+// Returns the filtered column, value and whether the filter is now active or
+// a blank column if there is nothing to filter on.
+func (t *Table) ToggleQuickFilter() (string, string, bool) {
+	col := t.Pivot()
+	if col == "" {
+		return "", "", false
+	}
+	for i, f := range t.quick {
+		if f.col == col {
+			t.quick = append(t.quick[:i], t.quick[i+1:]...)
+			t.Filter(t.cmdBuff.GetText())
+			return f.col, t.displayValue(f.col, f.value), false
+		}
+	}
+
+	row, ok := t.GetSelectedRow(t.GetSelectedItem())
+	if !ok {
+		return "", "", false
+	}
+	idx := t.GetModel().Peek().Header.IndexOf(col, true)
+	if idx < 0 || idx >= len(row.Fields) {
+		return "", "", false
+	}
+	t.pivot = col
+	t.quick = append(t.quick, quickFilter{col: col, value: row.Fields[idx]})
+	t.Filter(t.cmdBuff.GetText())
+
+	return col, t.displayValue(col, row.Fields[idx]), true
+}
+
+// ClearQuickFilters lifts all column value filters.
+func (t *Table) ClearQuickFilters() bool {
+	if len(t.quick) == 0 {
+		return false
+	}
+	t.quick = nil
+	t.Filter(t.cmdBuff.GetText())
+
+	return true
+}
+
+// displayValue returns a cell value as displayed on screen.
+func (t *Table) displayValue(col, v string) string {
+	v = render.RedactCell(t.gvr.String(), col, v)
+	if col == "NAME" || col == "NAMESPACE" {
+		v = render.Redact(v)
+	}
+
+	return v
+}
+
+func filterQuick(ff []quickFilter, data render.TableData) render.TableData {
+	idx := make([]int, 0, len(ff))
+	for _, f := range ff {
+		i := data.Header.IndexOf(f.col, true)
+		if i < 0 {
+			return data
+		}
+		idx = append(idx, i)
+	}
+
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	for _, re := range data.RowEvents {
+		if matchesQuick(ff, idx, re.Row.Fields) {
+			filtered.RowEvents = append(filtered.RowEvents, re)
+		}
+	}
+
+	return filtered
+}
+
+func matchesQuick(ff []quickFilter, idx []int, fields render.Fields) bool {
+	for i, f := range ff {
+		if idx[i] >= len(fields) || fields[idx[i]] != f.value {
+			return false
+		}
+	}
+
+	return true
+}
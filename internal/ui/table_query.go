@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+var (
+	queryRx     = regexp.MustCompile(`\A\-q\s`)
+	queryTermRx = regexp.MustCompile(`\A([\w%/.\-]+?)(!?=)(.*)\z`)
+	queryGlobRx = regexp.MustCompile(`[*?]`)
+)
+
+// filterQuery represents a compiled filter expression, ie a disjunction of
+// terms conjunctions. For instance `-q status!=Running node=ip-10-* or !fred`.
+type filterQuery [][]queryTerm
+
+// queryTerm matches either a column value against a glob or a row against
+// a regex when no column is specified.
+type queryTerm struct {
+	col    string
+	rx     *regexp.Regexp
+	negate bool
+}
+
+// IsQuerySelector checks if a filter uses the query language ie is prefixed
+// with -q. Plain filters are regexes so `app=nginx` matches literally.
+func IsQuerySelector(s string) bool {
+	return queryRx.MatchString(s)
+}
+
+// TrimQuerySelector extracts a query expression.
+func TrimQuerySelector(s string) string {
+	return strings.TrimSpace(queryRx.ReplaceAllString(s, ""))
+}
+
+func isQueryOr(t string) bool {
+	return t == "||" || strings.EqualFold(t, "or")
+}
+
+// compileQuery parses a filter expression. Space separated terms are and'ed
+// and `or` or `||` separates alternatives.
+func compileQuery(q string) (filterQuery, error) {
+	var (
+		fq  filterQuery
+		and []queryTerm
+	)
+	for _, s := range strings.Fields(q) {
+		if isQueryOr(s) {
+			if len(and) > 0 {
+				fq, and = append(fq, and), nil
+			}
+			continue
+		}
+		if s == "&&" || strings.EqualFold(s, "and") {
+			continue
+		}
+		t, err := compileTerm(s)
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, t)
+	}
+	if len(and) > 0 {
+		fq = append(fq, and)
+	}
+	if len(fq) == 0 {
+		return nil, fmt.Errorf("invalid filter expression %q", q)
+	}
+
+	return fq, nil
+}
+
+func compileTerm(s string) (queryTerm, error) {
+	if mm := queryTermRx.FindStringSubmatch(s); mm != nil {
+		rx, err := regexp.Compile(`(?i)\A` + globToRx(mm[3]) + `\z`)
+		if err != nil {
+			return queryTerm{}, err
+		}
+		return queryTerm{col: strings.ToUpper(mm[1]), rx: rx, negate: mm[2] == "!="}, nil
+	}
+
+	var t queryTerm
+	if len(s) > 1 && s[0] == '!' {
+		s, t.negate = s[1:], true
+	}
+	rx, err := regexp.Compile(`(?i)` + s)
+	if err != nil {
+		return queryTerm{}, err
+	}
+	t.rx = rx
+
+	return t, nil
+}
+
+func globToRx(g string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range queryGlobRx.FindAllStringIndex(g, -1) {
+		b.WriteString(regexp.QuoteMeta(g[last:loc[0]]))
+		if g[loc[0]] == '*' {
+			b.WriteString(".*")
+		} else {
+			b.WriteString(".")
+		}
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(g[last:]))
+
+	return b.String()
+}
+
+// queryFilter returns the rows matching the filter expression. Terms
+// referencing an unknown column never match.
+func queryFilter(fq filterQuery, data render.TableData) render.TableData {
+	idx := make(map[string]int)
+	for _, and := range fq {
+		for _, t := range and {
+			if t.col != "" {
+				idx[t.col] = data.Header.IndexOf(t.col, true)
+			}
+		}
+	}
+
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	for _, re := range data.RowEvents {
+		if fq.matches(idx, re.Row.Fields) {
+			filtered.RowEvents = append(filtered.RowEvents, re)
+		}
+	}
+
+	return filtered
+}
+
+func (fq filterQuery) matches(idx map[string]int, fields render.Fields) bool {
+	var row string
+	for _, and := range fq {
+		ok := true
+		for _, t := range and {
+			var m bool
+			if t.col == "" {
+				if row == "" {
+					row = strings.Join(fields, " ")
+				}
+				m = t.rx.MatchString(row)
+			} else if i := idx[t.col]; i >= 0 && i < len(fields) {
+				m = t.rx.MatchString(strings.TrimSpace(fields[i]))
+			} else {
+				ok = false
+				break
+			}
+			if m == t.negate {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
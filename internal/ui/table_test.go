@@ -33,6 +33,97 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(t, len(data.Header), v.GetColumnCount())
 }
 
+func TestTableFrozen(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	assert.False(t, v.IsFrozen())
+
+	v.SetFrozen(true)
+	assert.True(t, v.IsFrozen())
+	v.SetFrozen(false)
+	assert.False(t, v.IsFrozen())
+}
+
+func TestTableStickySelection(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+
+	v.Update(makeStickyData(map[string]string{"r1": "a", "r2": "b", "r3": "c"}))
+	v.SelectRow(2, true)
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	v.Update(makeStickyData(map[string]string{"r1": "c", "r2": "a", "r3": "b"}))
+	assert.Equal(t, 1, v.GetSelectedRowIndex())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+	assert.Empty(t, v.Vanished())
+
+	v.Update(makeStickyData(map[string]string{"r1": "c", "r3": "b"}))
+	assert.Equal(t, "r2", v.Vanished())
+
+	v.Update(makeStickyData(map[string]string{"r1": "c", "r3": "b"}))
+	assert.Equal(t, "r2", v.Vanished())
+
+	v.SelectRow(2, true)
+	v.Update(makeStickyData(map[string]string{"r1": "c", "r3": "b"}))
+	assert.Empty(t, v.Vanished())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+}
+
+func TestTableMarks(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	var count int
+	v.SetMarksChangedFn(func(n int) {
+		count = n
+	})
+	v.Update(makeStickyData(map[string]string{"r1": "a", "r2": "b", "r3": "c"}))
+
+	v.MarkAll(v.VisibleIDs())
+	assert.Equal(t, 3, v.MarkCount())
+
+	v.SelectRow(1, true)
+	v.ToggleMark()
+	v.InvertMarks(v.VisibleIDs())
+	assert.Equal(t, []string{"r1"}, v.GetSelectedItems())
+
+	v.MarkAll([]string{"r2"})
+	v.Update(makeStickyData(map[string]string{"r1": "a", "r3": "c"}))
+	assert.Equal(t, 1, v.MarkCount())
+	assert.Equal(t, 1, count)
+}
+
+func TestTableVisual(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	data := makeStickyData(map[string]string{"r1": "a", "r2": "b", "r3": "c", "r4": "d"})
+	v.SetModel(&dataModel{data: data})
+	v.Update(data)
+
+	v.SelectRow(4, true)
+	v.ToggleMark()
+	v.SelectRow(1, true)
+	assert.True(t, v.StartVisual())
+	assert.True(t, v.InVisual())
+	assert.Equal(t, 2, v.MarkCount())
+
+	v.SelectRow(3, true)
+	assert.True(t, v.ExtendVisual())
+	assert.Equal(t, 4, v.MarkCount())
+
+	v.SelectRow(2, true)
+	assert.True(t, v.ExtendVisual())
+	assert.False(t, v.ExtendVisual())
+	assert.Equal(t, 3, v.MarkCount())
+	assert.False(t, v.IsMarked("r3"))
+
+	v.CancelVisual()
+	assert.False(t, v.InVisual())
+	assert.Equal(t, []string{"r4"}, v.GetSelectedItems())
+}
+
 func TestTableSelection(t *testing.T) {
 	v := ui.NewTable(client.NewGVR("fred"))
 	v.Init(makeContext())
@@ -54,6 +145,154 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableLinearize(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	assert.Equal(t, "", v.Linearize(0))
+	assert.Equal(t, "Row 1 of 2. A: blee, B: duh, C: fred", v.Linearize(1))
+	v.SelectRow(2, true)
+	v.ToggleMark()
+	assert.Equal(t, "Row 2 of 2. A: blee, B: duh, C: zorg, marked", v.Linearize(2))
+}
+
+func TestTableQuickFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	v.SelectRow(1, true)
+
+	assert.Equal(t, "A", v.Pivot())
+	v.MovePivot(-1)
+	assert.Equal(t, "A", v.Pivot())
+	v.MovePivot(5)
+	assert.Equal(t, "C", v.Pivot())
+
+	col, val, ok := v.ToggleQuickFilter()
+	assert.True(t, ok)
+	assert.Equal(t, "C", col)
+	assert.Equal(t, "fred", val)
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	_, _, ok = v.ToggleQuickFilter()
+	assert.False(t, ok)
+	assert.Equal(t, 3, v.GetRowCount())
+
+	v.MovePivot(-2)
+	_, val, ok = v.ToggleQuickFilter()
+	assert.True(t, ok)
+	assert.Equal(t, "blee", val)
+	assert.Equal(t, 3, v.GetRowCount())
+	assert.True(t, v.ClearQuickFilters())
+	assert.False(t, v.ClearQuickFilters())
+}
+
+func TestTableTruncation(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.ViewSettingsChanged(config.ViewSetting{
+		Truncate: map[string]config.ColumnTruncation{
+			"c": {Width: 3, Mode: config.TruncateLeft},
+		},
+	})
+	v.Update(m.Peek())
+	v.SelectRow(1, true)
+
+	assert.Equal(t, "…ed", v.GetCell(1, 2).Text)
+	assert.Equal(t, "…rg", v.GetCell(2, 2).Text)
+
+	v.MovePivot(2)
+	col, val, ok := v.SelectedCell()
+	assert.True(t, ok)
+	assert.Equal(t, "C", col)
+	assert.Equal(t, "fred", val)
+}
+
+func TestTableQueryFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	filter := func(q string) {
+		v.CmdBuff().SetText(q)
+		v.Filter(q)
+	}
+
+	filter("-q c!=fred")
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "zorg", ui.TrimCell(v.SelectTable, 1, 2))
+
+	filter("-q c=fr* or c=zo?g")
+	assert.Equal(t, 3, v.GetRowCount())
+
+	filter("-q a=blee !zorg")
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "fred", ui.TrimCell(v.SelectTable, 1, 2))
+
+	filter("-q zz=blee")
+	assert.Equal(t, 1, v.GetRowCount())
+
+	filter("c=fred")
+	assert.Equal(t, 1, v.GetRowCount())
+}
+
+func TestTableQueryFilterInput(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	var errs int
+	v.SetFilterErrFn(func(error) { errs++ })
+	v.CmdBuff().SetActive(true)
+
+	for _, r := range "-q c!=fred" {
+		assert.True(t, v.FilterInput(r))
+	}
+	assert.Equal(t, "-q c!=fred", v.CmdBuff().GetText())
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, 1, errs)
+
+	for _, r := range " or (" {
+		assert.True(t, v.FilterInput(r))
+	}
+	assert.Equal(t, "-q c!=fred or (", v.CmdBuff().GetText())
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, 2, errs)
+}
+
+func TestTableFilterHighlight(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	filter := func(q string) {
+		v.CmdBuff().SetText(q)
+		v.Filter(q)
+	}
+
+	filter("RE")
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "blee ", v.GetCell(1, 0).Text)
+	assert.Equal(t, "f[::r]re[::-]d ", v.GetCell(1, 2).Text)
+	assert.Equal(t, "fred", ui.TrimCell(v.SelectTable, 1, 2))
+
+	filter("-q a=bl* c!=zorg")
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "[::r]blee[::-] ", v.GetCell(1, 0).Text)
+	assert.Equal(t, "fred ", v.GetCell(1, 2).Text)
+
+	filter("-f r1")
+	assert.Equal(t, "blee ", v.GetCell(1, 0).Text)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -88,6 +327,13 @@ func (t *mockModel) ToYAML(ctx context.Context, path string) (string, error) {
 func (t *mockModel) InNamespace(string) bool      { return true }
 func (t *mockModel) SetRefreshRate(time.Duration) {}
 
+type dataModel struct {
+	mockModel
+	data render.TableData
+}
+
+func (t *dataModel) Peek() render.TableData { return t.data }
+
 func makeTableData() render.TableData {
 	t := render.NewTableData()
 	t.Namespace = ""
@@ -114,6 +360,20 @@ func makeTableData() render.TableData {
 	return *t
 }
 
+func makeStickyData(rows map[string]string) render.TableData {
+	t := render.NewTableData()
+	t.Header = render.Header{
+		render.HeaderColumn{Name: "A"},
+	}
+	for id, v := range rows {
+		t.RowEvents = append(t.RowEvents, render.RowEvent{
+			Row: render.Row{ID: id, Fields: render.Fields{v}},
+		})
+	}
+
+	return *t
+}
+
 func makeContext() context.Context {
 	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, config.NewCustomView())
@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/tview"
+)
+
+// ApplyRenderMode tunes glyphs and colors to the terminal capabilities.
+func ApplyRenderMode(m config.RenderMode) {
+	config.SetLowColorMode(m.LowColor)
+	render.SetASCIIMode(m.ASCII)
+	tchart.SetASCIIMode(m.ASCII)
+	if m.ASCII {
+		asciiBorders()
+	}
+}
+
+func asciiBorders() {
+	tview.Borders.Horizontal = '-'
+	tview.Borders.Vertical = '|'
+	tview.Borders.TopLeft = '+'
+	tview.Borders.TopRight = '+'
+	tview.Borders.BottomLeft = '+'
+	tview.Borders.BottomRight = '+'
+	tview.Borders.LeftT = '+'
+	tview.Borders.RightT = '+'
+	tview.Borders.TopT = '+'
+	tview.Borders.BottomT = '+'
+	tview.Borders.Cross = '+'
+	tview.Borders.HorizontalFocus = '='
+	tview.Borders.VerticalFocus = '|'
+	tview.Borders.TopLeftFocus = '+'
+	tview.Borders.TopRightFocus = '+'
+	tview.Borders.BottomLeftFocus = '+'
+	tview.Borders.BottomRightFocus = '+'
+}
@@ -0,0 +1,37 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/model"
+)
+
+// Announcer announces view changes on the status line for screen readers.
+type Announcer struct {
+	app *App
+}
+
+// NewAnnouncer returns a new view announcer.
+func NewAnnouncer(a *App) *Announcer {
+	return &Announcer{app: a}
+}
+
+// StackPushed notifies a new view was added.
+func (a *Announcer) StackPushed(c model.Component) {
+	a.announce(c)
+}
+
+// StackPopped notifies a view was removed.
+func (a *Announcer) StackPopped(_, top model.Component) {
+	a.announce(top)
+}
+
+// StackTop notifies the top view.
+func (a *Announcer) StackTop(top model.Component) {
+	a.announce(top)
+}
+
+func (a *Announcer) announce(c model.Component) {
+	if c == nil {
+		return
+	}
+	a.app.Flash().Infof("Viewing %s", c.Name())
+}
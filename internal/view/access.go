@@ -0,0 +1,98 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/rs/zerolog/log"
+)
+
+// accessRule tracks the permission required by a key action.
+// A blank gvr targets the viewed resource.
+type accessRule struct {
+	gvr, verb string
+}
+
+// accessRules maps key actions ids to their required permissions.
+var accessRules = map[string]accessRule{
+	ui.ActionEdit:   {verb: client.PatchVerb},
+	ui.ActionDelete: {verb: client.DeleteVerb},
+	ui.ActionKill:   {verb: client.DeleteVerb},
+	ui.ActionShell:  {gvr: "v1/pods:exec", verb: client.CreateVerb},
+	ui.ActionAttach: {gvr: "v1/pods:attach", verb: client.CreateVerb},
+	ui.ActionLogs:   {gvr: "v1/pods:log", verb: client.GetVerb},
+}
+
+// accessState tracks the denied actions for a namespace.
+type accessState struct {
+	ns     string
+	denied map[string]bool
+}
+
+// applyAccess greys out the actions the user is not permitted to perform in the
+// current namespace. Permissions are probed in the background on namespace changes.
+func (b *Browser) applyAccess() {
+	ns := b.GetModel().GetNamespace()
+	if b.access.ns != ns || b.access.denied == nil {
+		if b.probing != ns {
+			b.probing = ns
+			go b.probeAccess(ns, b.accessChecks())
+		}
+		return
+	}
+	for k, a := range b.Actions() {
+		if _, ok := accessRules[a.ID]; ok {
+			b.Actions().SetDisabled(k, b.access.denied[a.ID])
+		}
+	}
+}
+
+func (b *Browser) accessChecks() map[string]accessRule {
+	rr := make(map[string]accessRule)
+	for _, a := range b.Actions() {
+		r, ok := accessRules[a.ID]
+		if !ok {
+			continue
+		}
+		if r.gvr == "" {
+			r.gvr = b.GVR().String()
+		}
+		rr[a.ID] = r
+	}
+
+	return rr
+}
+
+func (b *Browser) probeAccess(ns string, rr map[string]accessRule) {
+	denied := make(map[string]bool, len(rr))
+	for id, r := range rr {
+		ok, err := b.app.Conn().CanI(ns, r.gvr, []string{r.verb})
+		if !ok {
+			log.Debug().Err(err).Msgf("Access denied for %s on %s in %q", r.verb, r.gvr, ns)
+			denied[id] = true
+		}
+	}
+
+	b.app.QueueUpdateDraw(func() {
+		if b.probing == ns {
+			b.probing = ""
+		}
+		b.access = accessState{ns: ns, denied: denied}
+		if b.GetModel().GetNamespace() != ns {
+			return
+		}
+		b.applyAccess()
+		if top, ok := b.app.Content.Top().(interface{ GetTable() *Table }); ok && top.GetTable() == b.Table {
+			b.app.hydrateMenu(b.Hints())
+		}
+	})
+}
+
+// checkAccess returns false and warns if the action was denied.
+func (t *Table) checkAccess(a ui.KeyAction) bool {
+	if !a.Disabled {
+		return true
+	}
+	t.app.Flash().Warnf("You are not authorized to %s in this namespace", a.Description)
+
+	return false
+}
@@ -0,0 +1,89 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const actionMenuKey = "actionMenu"
+
+func (t *Table) actionMenuCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.app.InCmdMode() {
+		return evt
+	}
+
+	title := "Actions"
+	if n := t.MarkCount(); n > 0 {
+		title = fmt.Sprintf("Actions (%d marked)", n)
+	} else if path := t.GetSelectedItem(); path != "" {
+		title = fmt.Sprintf("Actions %s", path)
+	}
+	p := ui.NewPalette(title, t.app.Styles)
+	p.SetItems(actionItems(t.Actions(), pluginKeys(), ui.KeyM))
+	p.SetDoneFn(func() {
+		t.app.Content.RemovePage(actionMenuKey)
+		t.app.SetFocus(t.app.Content.CurrentPage().Item)
+	})
+	t.app.Content.AddPage(actionMenuKey, p, true, true)
+	t.app.Content.ShowPage(actionMenuKey)
+	t.app.SetFocus(p)
+
+	return nil
+}
+
+// actionItems converts key actions into palette entries sorted by name.
+func actionItems(aa ui.KeyActions, plugins map[tcell.Key]struct{}, skip tcell.Key) ui.PaletteItems {
+	ii := make(ui.PaletteItems, 0, len(aa))
+	for k, a := range aa {
+		if k == skip || a.Description == "" {
+			continue
+		}
+		var desc string
+		if _, ok := plugins[k]; ok {
+			desc = "plugin"
+		}
+		key, action := k, a.Action
+		ii = append(ii, ui.PaletteItem{
+			Name:        a.Description,
+			Description: desc,
+			Hint:        tcell.KeyNames[k],
+			Action: func() {
+				action(keyEvent(key))
+			},
+		})
+	}
+	sort.SliceStable(ii, func(i, j int) bool {
+		return ii[i].Name < ii[j].Name
+	})
+
+	return ii
+}
+
+// pluginKeys returns the shortcuts of all configured plugins.
+func pluginKeys() map[tcell.Key]struct{} {
+	kk := make(map[tcell.Key]struct{})
+	pp := config.NewPlugins()
+	if err := pp.Load(); err != nil {
+		return kk
+	}
+	for _, p := range pp.Plugin {
+		if k, err := asKey(p.ShortCut); err == nil {
+			kk[k] = struct{}{}
+		}
+	}
+
+	return kk
+}
+
+// keyEvent synthesizes the keyboard event mapped to a given key.
+func keyEvent(k tcell.Key) *tcell.EventKey {
+	if k >= ' ' && k < tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
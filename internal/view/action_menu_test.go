@@ -0,0 +1,59 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionItems(t *testing.T) {
+	var fired rune
+	aa := ui.KeyActions{
+		ui.KeyD: ui.NewKeyAction("Describe", func(evt *tcell.EventKey) *tcell.EventKey {
+			fired = evt.Rune()
+			return nil
+		}, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", nil, true),
+		ui.KeyM:        ui.NewKeyAction("Actions", nil, true),
+		ui.KeyShiftB:   ui.NewKeyAction("Blee", nil, true),
+	}
+
+	ii := actionItems(aa, map[tcell.Key]struct{}{ui.KeyShiftB: {}}, ui.KeyM)
+	assert.Equal(t, 3, len(ii))
+	assert.Equal(t, "Blee", ii[0].Name)
+	assert.Equal(t, "plugin", ii[0].Description)
+	assert.Equal(t, "Delete", ii[1].Name)
+	assert.Equal(t, "Ctrl-D", ii[1].Hint)
+	assert.Equal(t, "Describe", ii[2].Name)
+	assert.Equal(t, "d", ii[2].Hint)
+
+	ii[2].Action()
+	assert.Equal(t, 'd', fired)
+}
+
+func TestKeyEvent(t *testing.T) {
+	uu := map[string]struct {
+		k    tcell.Key
+		e    tcell.Key
+		rune rune
+	}{
+		"rune":  {k: ui.KeyD, e: tcell.KeyRune, rune: 'd'},
+		"shift": {k: ui.KeyShiftD, e: tcell.KeyRune, rune: 'D'},
+		"ctrl":  {k: tcell.KeyCtrlD, e: tcell.KeyCtrlD},
+		"enter": {k: tcell.KeyEnter, e: tcell.KeyEnter},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			evt := keyEvent(u.k)
+			assert.Equal(t, u.e, evt.Key())
+			assert.Equal(t, u.k, ui.AsKey(evt))
+			if u.rune != 0 {
+				assert.Equal(t, u.rune, evt.Rune())
+			}
+		})
+	}
+}
@@ -11,8 +11,12 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// AllScopes represents actions available for all views.
-const AllScopes = "all"
+const (
+	// AllScopes represents actions available for all views.
+	AllScopes = "all"
+
+	pluginInputPrefix = "ARG-"
+)
 
 // Runner represents a runnable action handler.
 type Runner interface {
@@ -124,36 +128,77 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			return nil
 		}
 
-		args := make([]string, len(p.Args))
-		for i, a := range p.Args {
-			arg, err := r.EnvFn()().Substitute(a)
-			if err != nil {
-				log.Error().Err(err).Msg("Plugin Args match failed")
-				return nil
+		env := r.EnvFn()()
+		if len(p.Inputs) == 0 {
+			runPlugin(r, p, path, env)
+			return nil
+		}
+		ack := func(vv map[string]string) {
+			for k, v := range vv {
+				env[pluginInputKey(k)] = v
 			}
-			args[i] = arg
+			runPlugin(r, p, path, env)
 		}
+		msg := fmt.Sprintf("%s %s", p.Command, strings.Join(p.Args, " "))
+		dialog.ShowInputs(r.App().Content.Pages, p.Description, msg, pluginInputs(p.Inputs, env), ack, func() {})
 
-		cb := func() {
-			opts := shellOpts{
-				clear:      true,
-				binary:     p.Command,
-				background: p.Background,
-				args:       args,
-			}
-			if run(r.App(), opts) {
-				r.App().Flash().Info("Plugin command launched successfully!")
-				return
-			}
-			r.App().Flash().Info("Plugin command failed!")
+		return nil
+	}
+}
+
+// pluginInputKey returns the environment key of a plugin input, ie $ARG-REPLICAS.
+func pluginInputKey(name string) string {
+	return pluginInputPrefix + strings.ToUpper(name)
+}
+
+// pluginInputs returns the plugin inputs with their defaults substituted.
+func pluginInputs(ii []config.PluginInput, env Env) []config.PluginInput {
+	cc := make([]config.PluginInput, len(ii))
+	for i, in := range ii {
+		if d, err := env.Substitute(in.Default); err == nil {
+			in.Default = d
 		}
-		if p.Confirm {
-			msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
-			dialog.ShowConfirm(r.App().Content.Pages, "Confirm "+p.Description, msg, cb, func() {})
-			return nil
+		cc[i] = in
+	}
+
+	return cc
+}
+
+func runPlugin(r Runner, p config.Plugin, path string, env Env) {
+	args := make([]string, len(p.Args))
+	for i, a := range p.Args {
+		arg, err := env.Substitute(a)
+		if err != nil {
+			log.Error().Err(err).Msg("Plugin Args match failed")
+			return
 		}
-		cb()
+		args[i] = arg
+	}
 
-		return nil
+	cb := func() {
+		if p.Output == config.PluginOutputPane {
+			if err := r.App().inject(NewPluginOutput(r.App(), p, args)); err != nil {
+				r.App().Flash().Err(err)
+			}
+			return
+		}
+		opts := shellOpts{
+			clear:      true,
+			binary:     p.Command,
+			background: p.Background,
+			args:       args,
+		}
+		if run(r.App(), opts) {
+			r.App().Flash().Info("Plugin command launched successfully!")
+			return
+		}
+		r.App().Flash().Info("Plugin command failed!")
+	}
+	if p.Confirm {
+		msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
+		level := r.App().Config.ConfirmLevel(config.ActionPlugin)
+		dialog.ShowConfirm(r.App().Content.Pages, level, confirmName(r.App().Config.K9s.CurrentContext, []string{path}), "Confirm "+p.Description, msg, cb, func() {})
+		return
 	}
+	cb()
 }
@@ -3,6 +3,7 @@ package view
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
@@ -68,3 +69,21 @@ func TestInScope(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginInputs(t *testing.T) {
+	env := Env{"NAMESPACE": "ns1", "NAME": "fred"}
+	ii := pluginInputs([]config.PluginInput{
+		{Name: "ns", Default: "$NAMESPACE"},
+		{Name: "replicas", Default: "1"},
+		{Name: "bozo", Default: "$BOZO"},
+	}, env)
+
+	assert.Equal(t, "ns1", ii[0].Default)
+	assert.Equal(t, "1", ii[1].Default)
+	assert.Equal(t, "$BOZO", ii[2].Default)
+
+	env[pluginInputKey("replicas")] = "3"
+	arg, err := env.Substitute("--replicas=$ARG-REPLICAS")
+	assert.Nil(t, err)
+	assert.Equal(t, "--replicas=3", arg)
+}
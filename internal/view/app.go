@@ -15,7 +15,9 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
@@ -45,7 +47,18 @@ type App struct {
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
 	cmdHistory    *model.History
+	histories     *model.Histories
 	filterHistory *model.History
+	pinnedFilter  string
+	journal       *model.Journal
+	frecency      *model.Frecency
+	usage         *model.Usage
+	tour          *model.Tour
+	recording     *model.Recording
+	recordFn      context.CancelFunc
+	captureFn     context.CancelFunc
+	peek          *PeekServer
+	peekFn        context.CancelFunc
 	conRetry      int32
 	showHeader    bool
 }
@@ -56,6 +69,8 @@ func NewApp(cfg *config.Config) *App {
 		App:           ui.NewApp(cfg, cfg.K9s.CurrentContext),
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
+		journal:       model.NewJournal(model.MaxJournal),
+		frecency:      model.NewFrecency(),
 		Content:       NewPageStack(),
 	}
 
@@ -80,6 +95,19 @@ func (a *App) Init(version string, rate int) error {
 	}
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
+	a.initDemo()
+	a.initPrivacy()
+	a.OnConfigReload(a.reloadPrivacy)
+	a.OnConfigReload(a.reloadNamespaceGuard)
+	a.OnConfigReload(a.reloadExcludes)
+	a.initUsage()
+	a.OnConfigReload(a.reloadUsage)
+	a.initHistory()
+	a.initFrecency()
+	a.initQuickActions()
+	if a.Config.K9s.GetScreenReader() {
+		a.Content.Stack.AddListener(NewAnnouncer(a))
+	}
 
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
@@ -98,6 +126,11 @@ func (a *App) Init(version string, rate int) error {
 	}
 
 	a.factory = watch.NewFactory(a.Conn())
+	a.factory.SetNamespaceGuard(a.Config.NamespaceGuard())
+	a.factory.SetNamespaceHints(a.namespaceHints())
+	if err := a.factory.NamespaceGuard().Check(ns); err != nil {
+		return err
+	}
 	if !a.isValidNS(ns) {
 		return fmt.Errorf("Invalid namespace %s", ns)
 	}
@@ -114,6 +147,7 @@ func (a *App) Init(version string, rate int) error {
 		return err
 	}
 	a.CmdBuff().SetSuggestionFn(a.suggestCommand())
+	a.CmdBuff().SetRecallFn(a.recallCommand)
 	a.CmdBuff().AddListener(a)
 
 	a.layout(ctx, version)
@@ -130,13 +164,106 @@ func (a *App) layout(ctx context.Context, version string) {
 	main.AddItem(a.statusIndicator(), 1, 1, false)
 	main.AddItem(a.Content, 0, 10, true)
 	main.AddItem(a.Crumbs(), 1, 1, false)
-	main.AddItem(flash, 1, 1, false)
+	if q := a.quickActions(); q != nil {
+		main.AddItem(q, 1, 1, false)
+	}
+	if d := a.Config.K9s.GetDemo(); d != nil && d.ShowKeys {
+		bottom := tview.NewFlex().SetDirection(tview.FlexColumn)
+		bottom.AddItem(flash, 0, 3, false)
+		bottom.AddItem(a.keyLog(), 0, 1, false)
+		main.AddItem(bottom, 1, 1, false)
+	} else {
+		main.AddItem(flash, 1, 1, false)
+	}
 
 	a.Main.AddPage("main", main, true, false)
 	a.Main.AddPage("splash", ui.NewSplash(a.Styles, version), true, true)
 	a.toggleHeader(!a.Config.K9s.GetHeadless())
 }
 
+func (a *App) initDemo() {
+	d := a.Config.K9s.GetDemo()
+	if d == nil {
+		return
+	}
+	render.SetRedactMode(d.Redact)
+	a.Flash().SetDelay(time.Duration(d.FlashDelay) * time.Second)
+	if d.ShowKeys {
+		a.Views()["keyLog"] = ui.NewKeyLog(a.Styles)
+	}
+}
+
+// initQuickActions shows the current view top actions below the crumbs if enabled.
+func (a *App) initQuickActions() {
+	if !a.Config.K9s.QuickActions {
+		return
+	}
+	q := ui.NewQuickActions(a.Styles)
+	a.Views()["quickActions"] = q
+	a.Content.Stack.AddListener(q)
+}
+
+// captureMouse installs a screen reporting clicks to the quick actions if the
+// mouse is enabled. A fresh screen must be initialized by the caller.
+func (a *App) captureMouse(init bool) error {
+	if !a.Config.K9s.EnableMouse || a.quickActions() == nil {
+		return nil
+	}
+	s, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	if init {
+		if err := s.Init(); err != nil {
+			return err
+		}
+	}
+	a.SetScreen(ui.NewMouseScreen(s, a.clickAt))
+
+	return nil
+}
+
+// clickAt runs the quick action clicked on if any.
+func (a *App) clickAt(x, y int) {
+	a.QueueUpdateDraw(func() {
+		h, ok := a.quickActions().ActionAt(x, y)
+		if !ok {
+			return
+		}
+		top, ok := a.Content.Top().(interface{ Actions() ui.KeyActions })
+		if !ok {
+			return
+		}
+		for k, act := range top.Actions() {
+			if act.ID == h.ID && !act.Disabled {
+				act.Action(keyEvent(k))
+				return
+			}
+		}
+	})
+}
+
+// Suspend suspends the application to run a command, capturing the mouse
+// again once it resumes.
+func (a *App) Suspend(f func()) bool {
+	ok := a.App.Suspend(f)
+	if ok {
+		if err := a.captureMouse(false); err != nil {
+			log.Error().Err(err).Msg("Mouse capture failed")
+		}
+	}
+
+	return ok
+}
+
+// hydrateMenu refreshes the menu and quick actions with the current view actions.
+func (a *App) hydrateMenu(hh model.MenuHints) {
+	a.Menu().HydrateMenu(hh)
+	if q := a.quickActions(); q != nil {
+		q.Hydrate(hh)
+	}
+}
+
 func (a *App) initSignals() {
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGABRT, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT)
@@ -147,6 +274,9 @@ func (a *App) initSignals() {
 			a.BailOut()
 			return
 		}
+		a.saveUsage()
+		a.saveHistory()
+		a.flushRecording()
 		nukeK9sShell(a)
 	}(sig)
 }
@@ -178,6 +308,10 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if k := a.keyLog(); k != nil {
+		k.Record(evt)
+	}
+	a.tourKey(evt)
 	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
 		return k.Action(evt)
 	}
@@ -190,6 +324,8 @@ func (a *App) bindKeys() {
 		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
 		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
 		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlP: ui.NewSharedKeyAction("Command Palette", a.paletteCmd, false),
+		tcell.KeyCtrlY: ui.NewSharedKeyAction("Privacy Mode", a.privacyCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
 	})
 }
@@ -251,12 +387,17 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	go a.usageSaver(ctx)
+	go a.readySampler(ctx)
 	if err := a.StylesWatcher(ctx, a); err != nil {
 		log.Error().Err(err).Msgf("Styles watcher failed")
 	}
 	if err := a.CustomViewsWatcher(ctx, a); err != nil {
 		log.Error().Err(err).Msgf("CustomView watcher failed")
 	}
+	if err := a.ConfigWatcher(ctx, a); err != nil {
+		log.Error().Err(err).Msgf("Config watcher failed")
+	}
 }
 
 func (a *App) clusterUpdater(ctx context.Context) {
@@ -271,6 +412,20 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 }
 
+// readySampler records the workloads ready pods whether or not they are viewed.
+func (a *App) readySampler(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dao.ReadySampleInterval):
+			if a.factory != nil {
+				dao.SampleReady(a.factory, a.Config.ActiveNamespace())
+			}
+		}
+	}
+}
+
 func (a *App) refreshCluster() {
 	c := a.Content.Top()
 	if ok := a.Conn().CheckConnectivity(); ok {
@@ -311,10 +466,51 @@ func (a *App) refreshCluster() {
 	a.clusterModel.Refresh()
 }
 
+// reloadExcludes reloads the resources metadata once the excluded resources
+// changed.
+func (a *App) reloadExcludes() {
+	if a.command == nil || !dao.MetaAccess.Exclude(a.Config.K9s.ExcludeResources) {
+		return
+	}
+	go func() {
+		if err := a.command.Reset(true); err != nil {
+			log.Error().Err(err).Msgf("Command reset failed")
+		}
+	}()
+}
+
+// reloadNamespaceGuard applies the reloaded namespace restrictions and
+// moves off the active namespace if it is now off limits.
+func (a *App) reloadNamespaceGuard() {
+	if a.factory == nil {
+		return
+	}
+	guard := a.Config.NamespaceGuard()
+	a.factory.SetNamespaceGuard(guard)
+	if err := guard.Err(); err != nil {
+		a.Flash().Err(err)
+	}
+	ns := a.Config.ActiveNamespace()
+	if guard.Allowed(ns) {
+		return
+	}
+	if err := a.switchNS(client.AllNamespaces); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Warnf("Namespace %q is now off limits", ns)
+}
+
 func (a *App) switchNS(ns string) error {
 	if ns == client.ClusterScope {
 		ns = client.AllNamespaces
 	}
+	if locked := a.Config.LockedNamespace(); locked != "" && ns != locked {
+		return fmt.Errorf("Namespace is locked to %q", locked)
+	}
+	if err := a.factory.NamespaceGuard().Check(ns); err != nil {
+		return err
+	}
 	if !a.isValidNS(ns) {
 		return fmt.Errorf("Invalid namespace %q", ns)
 	}
@@ -341,6 +537,24 @@ func (a *App) isValidNS(ns string) bool {
 	return true
 }
 
+// namespaceHints returns the namespaces to probe for access when the user
+// can't list namespaces, ie the favorites and the kubeconfig contexts namespaces.
+func (a *App) namespaceHints() []string {
+	nn := append([]string{}, a.Config.FavNamespaces()...)
+	if ns, err := a.Conn().Config().CurrentNamespaceName(); err == nil {
+		nn = append(nn, ns)
+	}
+	if cc, err := a.Conn().Config().Contexts(); err == nil {
+		for _, c := range cc {
+			if c.Namespace != "" {
+				nn = append(nn, c.Namespace)
+			}
+		}
+	}
+
+	return nn
+}
+
 func (a *App) switchCtx(name string, loadPods bool) error {
 	log.Debug().Msgf("--> Switching Context %q--%q", name, a.Config.ActiveView())
 	a.Halt()
@@ -351,6 +565,7 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
 		}
 		a.initFactory(ns)
+		a.useHistory(name)
 
 		if err := a.command.Reset(true); err != nil {
 			return err
@@ -359,8 +574,15 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			log.Error().Err(err).Msg("Config save failed!")
 		}
 		a.Config.Reset()
+		a.Config.Validate()
+		guard := a.Config.NamespaceGuard()
+		a.factory.SetNamespaceGuard(guard)
+		a.factory.SetNamespaceHints(a.namespaceHints())
 
 		a.Flash().Infof("Switching context to %s", name)
+		if err := guard.Err(); err != nil {
+			a.Flash().Err(err)
+		}
 		a.ReloadStyles(name)
 		v := a.Config.ActiveView()
 		if v == "" || v == "ctx" || v == "context" {
@@ -370,6 +592,31 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			a.Flash().Err(err)
 		}
 		a.clusterModel.Reset(a.factory)
+		a.restoreForwards()
+	}
+
+	return nil
+}
+
+func (a *App) switchProfile(name string) error {
+	if name == "base" || name == "-" {
+		name = ""
+	}
+	if err := a.Config.K9s.SetActiveProfile(name); err != nil {
+		return err
+	}
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+	a.ReloadStyles(a.Config.K9s.CurrentContext)
+	a.toggleHeader(!a.Config.K9s.GetHeadless())
+	if err := a.gotoResource(a.Config.ActiveView(), "", true); err != nil {
+		return err
+	}
+	if name == "" {
+		a.Flash().Info("Switched to base configuration")
+	} else {
+		a.Flash().Infof("Switched to profile `%s`", name)
 	}
 
 	return nil
@@ -388,6 +635,10 @@ func (a *App) BailOut() {
 		}
 	}()
 
+	a.saveUsage()
+	a.saveHistory()
+	a.flushRecording()
+	a.stopServe()
 	nukeK9sShell(a)
 	a.factory.Terminate()
 	a.App.BailOut()
@@ -397,8 +648,12 @@ func (a *App) BailOut() {
 func (a *App) Run() error {
 	a.Resume()
 
+	delay := splashDelay
+	if a.Config.K9s.GetScreenReader() {
+		delay = 0
+	}
 	go func() {
-		<-time.After(splashDelay)
+		<-time.After(delay)
 		a.QueueUpdateDraw(func() {
 			a.Main.SwitchToPage("main")
 		})
@@ -407,6 +662,13 @@ func (a *App) Run() error {
 	if err := a.command.defaultCmd(); err != nil {
 		return err
 	}
+	a.restoreForwards()
+	if err := a.captureMouse(true); err != nil {
+		return err
+	}
+	if a.Config.FirstRun() {
+		a.Flash().Info("New to K9s? Enter :tour for a guided tour")
+	}
 	if err := a.Application.Run(); err != nil {
 		return err
 	}
@@ -546,6 +808,7 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (a *App) gotoResource(cmd, path string, clearStack bool) error {
 	err := a.command.run(cmd, path, clearStack)
 	if err == nil {
+		a.trackCommand(strings.Split(cmd, " ")[0])
 		return err
 	}
 
@@ -569,6 +832,12 @@ func (a *App) inject(c model.Component) error {
 	return nil
 }
 
+// pinFilter carries a filter across view switches. A blank filter unpins it.
+func (a *App) pinFilter(q string) {
+	a.pinnedFilter = q
+	a.Crumbs().SetPinnedFilter(q)
+}
+
 func (a *App) clusterInfo() *ClusterInfo {
 	return a.Views()["clusterInfo"].(*ClusterInfo)
 }
@@ -576,3 +845,13 @@ func (a *App) clusterInfo() *ClusterInfo {
 func (a *App) statusIndicator() *ui.StatusIndicator {
 	return a.Views()["statusIndicator"].(*ui.StatusIndicator)
 }
+
+func (a *App) keyLog() *ui.KeyLog {
+	k, _ := a.Views()["keyLog"].(*ui.KeyLog)
+	return k
+}
+
+func (a *App) quickActions() *ui.QuickActions {
+	q, _ := a.Views()["quickActions"].(*ui.QuickActions)
+	return q
+}
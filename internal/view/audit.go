@@ -0,0 +1,238 @@
+package view
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	auditTitle   = "audit"
+	auditUserCol = 1
+	auditVerbCol = 2
+	auditResCol  = 3
+)
+
+// auditCmd tails the api server audit events.
+// Usage: audit [user=NAME] [verb=VERB] [resource=RES].
+func (c *Command) auditCmd(tokens []string) error {
+	f, err := dao.ParseAuditFilter(tokens[1:])
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewAudit(f))
+}
+
+// Audit presents a live tail of the api server audit events.
+type Audit struct {
+	*Table
+
+	filter dao.AuditFilter
+	events []dao.AuditEvent
+	seen   map[string]struct{}
+	model  *staticModel
+	cancel context.CancelFunc
+}
+
+// NewAudit returns a new audit events viewer.
+func NewAudit(f dao.AuditFilter) *Audit {
+	return &Audit{
+		Table:  NewTable(client.NewGVR(auditTitle)),
+		filter: f,
+		seen:   make(map[string]struct{}),
+		model:  &staticModel{Table: model.NewTable(client.NewGVR(auditTitle))},
+	}
+}
+
+// Init initializes the component.
+func (a *Audit) Init(ctx context.Context) error {
+	if err := a.Table.Init(ctx); err != nil {
+		return err
+	}
+	a.SetModel(a.model)
+	a.SetColorerFn(auditColorer)
+	a.SetBorderFocusColor(tcell.ColorMediumPurple)
+	a.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumPurple, tcell.AttrNone)
+	a.bindKeys()
+	a.refresh()
+
+	return nil
+}
+
+// Name returns the component name.
+func (a *Audit) Name() string { return auditTitle }
+
+// Start runs the component and tails the audit source.
+func (a *Audit) Start() {
+	a.Table.Start()
+
+	var ctx context.Context
+	ctx, a.cancel = context.WithCancel(context.Background())
+	out := make(chan dao.AuditEvent)
+	cfg := a.app.Config.K9s.GetAudit()
+	go func() {
+		if err := dao.TailAudit(ctx, a.app.factory, cfg, out); err != nil {
+			log.Error().Err(err).Msgf("Audit tail failed")
+			a.app.QueueUpdateDraw(func() {
+				a.app.Flash().Errf("Audit tail failed: %s", err)
+			})
+		}
+	}()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e := <-out:
+				a.app.QueueUpdateDraw(func() {
+					a.add(e, cfg.BufferSize)
+				})
+			}
+		}
+	}()
+}
+
+// Stop terminates the component.
+func (a *Audit) Stop() {
+	if a.cancel != nil {
+		a.cancel()
+		a.cancel = nil
+	}
+	a.Table.Stop()
+}
+
+func (a *Audit) bindKeys() {
+	a.Actions().Delete(tcell.KeyCtrlZ)
+	a.Actions().Add(ui.KeyActions{
+		ui.KeyU:         ui.NewKeyAction("Filter User", a.filterCmd(auditUserCol), true),
+		ui.KeyB:         ui.NewKeyAction("Filter Verb", a.filterCmd(auditVerbCol), true),
+		ui.KeyO:         ui.NewKeyAction("Filter Resource", a.filterCmd(auditResCol), true),
+		tcell.KeyCtrlU:  ui.NewKeyAction("Clear Filter", a.clearFilterCmd, true),
+		ui.KeyShiftU:    ui.NewKeyAction("Sort User", a.SortColCmd("USER", true), false),
+		ui.KeyShiftV:    ui.NewKeyAction("Sort Verb", a.SortColCmd("VERB", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.app.PrevCmd, false),
+	})
+}
+
+// filterCmd narrows the events to the selected row user, verb or resource.
+func (a *Audit) filterCmd(col int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		r, _ := a.GetSelection()
+		if r <= 0 {
+			return nil
+		}
+		v := ui.TrimCell(a.SelectTable, r, col)
+		switch col {
+		case auditUserCol:
+			a.filter.User = v
+		case auditVerbCol:
+			a.filter.Verb = v
+		case auditResCol:
+			a.filter.Resource = v
+		}
+		a.refresh()
+
+		return nil
+	}
+}
+
+func (a *Audit) clearFilterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.filter = dao.AuditFilter{}
+	a.refresh()
+
+	return nil
+}
+
+// add records an event, keeping the latest events up to the buffer size.
+// Events replayed when the tail restarts are skipped.
+func (a *Audit) add(e dao.AuditEvent, size int) {
+	if e.ID != "" {
+		if _, ok := a.seen[e.ID]; ok {
+			return
+		}
+		a.seen[e.ID] = struct{}{}
+	}
+	a.events = append(a.events, e)
+	if len(a.events) > size {
+		for _, o := range a.events[:len(a.events)-size] {
+			delete(a.seen, o.ID)
+		}
+		a.events = a.events[len(a.events)-size:]
+	}
+	if a.filter.Matches(e) {
+		a.refresh()
+	}
+}
+
+func (a *Audit) refresh() {
+	a.Extras = a.filter.String()
+	a.model.data = auditTableData(a.events, a.filter)
+	a.Update(a.model.data)
+}
+
+var auditHeader = render.Header{
+	render.HeaderColumn{Name: "TIME"},
+	render.HeaderColumn{Name: "USER"},
+	render.HeaderColumn{Name: "VERB"},
+	render.HeaderColumn{Name: "RESOURCE"},
+	render.HeaderColumn{Name: "NAME"},
+	render.HeaderColumn{Name: "CODE", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "SOURCE", Wide: true},
+	render.HeaderColumn{Name: "AGENT", Wide: true},
+}
+
+func auditColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 6 {
+		return tcell.ColorMediumPurple
+	}
+	code, _ := strconv.Atoi(re.Row.Fields[5])
+	switch {
+	case code >= 400:
+		return render.ErrColor
+	case re.Row.Fields[2] == "delete" || re.Row.Fields[2] == "deletecollection":
+		return tcell.ColorOrange
+	case re.Row.Fields[2] == "get" || re.Row.Fields[2] == "list" || re.Row.Fields[2] == "watch":
+		return tcell.ColorGray
+	}
+
+	return tcell.ColorMediumPurple
+}
+
+// auditTableData lists the events matching the filter, latest first.
+func auditTableData(ee []dao.AuditEvent, f dao.AuditFilter) render.TableData {
+	data := render.TableData{Header: auditHeader}
+	for i := len(ee) - 1; i >= 0; i-- {
+		e := ee[i]
+		if !f.Matches(e) {
+			continue
+		}
+		code := ""
+		if e.Code != 0 {
+			code = strconv.Itoa(e.Code)
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: e.ID,
+			Fields: render.Fields{
+				e.Time.Local().Format("15:04:05"),
+				e.User,
+				e.Verb,
+				e.Resource,
+				e.Path(),
+				code,
+				e.SourceIP,
+				e.Agent,
+			},
+		}))
+	}
+
+	return data
+}
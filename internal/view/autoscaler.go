@@ -0,0 +1,159 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	autoscalerTitle    = "autoscaler"
+	autoscalerKindPod  = "Unschedulable"
+	autoscalerKindLock = "ScaleDownBlocker"
+)
+
+// autoscalerCmd shows the cluster-autoscaler activity.
+func (a *App) autoscalerCmd() {
+	if err := a.inject(NewAutoscaler()); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// Autoscaler presents the cluster-autoscaler decisions and the pods affecting them.
+type Autoscaler struct {
+	*Table
+
+	model *staticModel
+}
+
+// NewAutoscaler returns a new cluster-autoscaler viewer.
+func NewAutoscaler() *Autoscaler {
+	return &Autoscaler{
+		Table: NewTable(client.NewGVR(autoscalerTitle)),
+		model: &staticModel{Table: model.NewTable(client.NewGVR(autoscalerTitle))},
+	}
+}
+
+// Init initializes the component.
+func (a *Autoscaler) Init(ctx context.Context) error {
+	if err := a.Table.Init(ctx); err != nil {
+		return err
+	}
+	a.SetModel(a.model)
+	a.SetColorerFn(autoscalerColorer)
+	a.SetBorderFocusColor(tcell.ColorMediumSeaGreen)
+	a.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSeaGreen, tcell.AttrNone)
+	a.bindKeys()
+	a.model.data = render.TableData{Header: autoscalerHeader}
+	a.Update(a.model.data)
+	go a.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (a *Autoscaler) Name() string { return autoscalerTitle }
+
+func (a *Autoscaler) bindKeys() {
+	a.Actions().Delete(tcell.KeyCtrlZ)
+	a.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto Pod", a.gotoPodCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", a.refreshCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", a.SortColCmd("KIND", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.app.PrevCmd, false),
+	})
+}
+
+func (a *Autoscaler) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go a.load()
+
+	return nil
+}
+
+func (a *Autoscaler) gotoPodCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, _ := a.GetSelection()
+	if r <= 0 {
+		return nil
+	}
+	kind := ui.TrimCell(a.SelectTable, r, 0)
+	if kind != autoscalerKindPod && kind != autoscalerKindLock {
+		return evt
+	}
+	path := ui.TrimCell(a.SelectTable, r, 1)
+	ns, n := client.Namespaced(path)
+	showPods(a.app, path, "", "metadata.namespace="+ns+",metadata.name="+n)
+
+	return nil
+}
+
+func (a *Autoscaler) load() {
+	r, err := dao.FetchAutoscaler(a.app.factory)
+	a.app.QueueUpdateDraw(func() {
+		if err != nil {
+			a.app.Flash().Err(err)
+			return
+		}
+		a.model.data = autoscalerTableData(r)
+		a.Extras = r.Status.Time
+		a.Update(a.model.data)
+		a.app.Flash().Infof("%d unschedulable pods, %d pods blocking scale down", len(r.Unschedulable), len(r.Blockers))
+	})
+}
+
+var autoscalerHeader = render.Header{
+	render.HeaderColumn{Name: "KIND"},
+	render.HeaderColumn{Name: "NAME"},
+	render.HeaderColumn{Name: "STATUS"},
+	render.HeaderColumn{Name: "DETAILS"},
+}
+
+func autoscalerColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 3 {
+		return tcell.ColorMediumSeaGreen
+	}
+	switch re.Row.Fields[0] {
+	case autoscalerKindPod:
+		return render.ErrColor
+	case autoscalerKindLock:
+		return tcell.ColorOrange
+	}
+	switch {
+	case strings.HasPrefix(re.Row.Fields[2], "Unhealthy"), re.Row.Fields[2] == "Warning":
+		return render.ErrColor
+	case re.Row.Fields[2] == "InProgress":
+		return tcell.ColorAqua
+	}
+
+	return tcell.ColorMediumSeaGreen
+}
+
+func autoscalerTableData(r *dao.AutoscalerReport) render.TableData {
+	data := render.TableData{Header: autoscalerHeader}
+	add := func(id string, ff ...string) {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{ID: id, Fields: ff}))
+	}
+	for _, c := range r.Status.Conditions {
+		add("cond:"+c.Group+":"+c.Name, c.Name, c.Group, c.Status, c.Details)
+	}
+	for _, l := range r.Status.Limits {
+		add("limits:"+l.Name, "Limits", l.Name, "", "min="+l.Min+" max="+l.Max)
+	}
+	for _, e := range r.Events {
+		name := client.FQN(e.InvolvedObject.Namespace, e.InvolvedObject.Name)
+		add("event:"+string(e.UID), "Event:"+e.Reason, strings.ToLower(e.InvolvedObject.Kind)+" "+name, e.Type, e.Message)
+	}
+	for _, p := range r.Unschedulable {
+		add("unschedulable:"+p.Path, autoscalerKindPod, p.Path, "Pending", p.Reason)
+	}
+	for _, p := range r.Blockers {
+		add("blocker:"+p.Path, autoscalerKindLock, p.Path, "Running", p.Reason)
+	}
+
+	return data
+}
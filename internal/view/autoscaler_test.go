@@ -0,0 +1,40 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestAutoscalerTableData(t *testing.T) {
+	r := dao.AutoscalerReport{
+		Status: dao.AutoscalerStatus{
+			Conditions: []dao.AutoscalerCondition{{Group: "Cluster-wide", Name: "ScaleUp", Status: "InProgress"}},
+			Limits:     []dao.NodeGroupLimits{{Name: "ng-1", Min: "1", Max: "10"}},
+		},
+		Events: []v1.Event{{
+			Reason:         "TriggeredScaleUp",
+			Type:           "Normal",
+			Message:        "pod triggered scale-up: ng-1 3->4 (max: 10)",
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "fred"},
+		}},
+		Unschedulable: []dao.AutoscalerPod{{Path: "default/fred", Reason: "0/3 nodes are available"}},
+		Blockers:      []dao.AutoscalerPod{{Path: "default/blee", Reason: "not backed by a controller"}},
+	}
+
+	data := autoscalerTableData(&r)
+
+	assert.Equal(t, 5, len(data.RowEvents))
+	assert.Equal(t, render.Fields{"Limits", "ng-1", "", "min=1 max=10"}, data.RowEvents[1].Row.Fields)
+	assert.Equal(t, render.Fields{"Event:TriggeredScaleUp", "pod default/fred", "Normal", "pod triggered scale-up: ng-1 3->4 (max: 10)"}, data.RowEvents[2].Row.Fields)
+	assert.Equal(t, "unschedulable:default/fred", data.RowEvents[3].Row.ID)
+
+	render.ErrColor = tcell.ColorRed
+	assert.Equal(t, tcell.ColorRed, autoscalerColorer("", data.Header, data.RowEvents[3]))
+	assert.Equal(t, tcell.ColorOrange, autoscalerColorer("", data.Header, data.RowEvents[4]))
+	assert.Equal(t, tcell.ColorAqua, autoscalerColorer("", data.Header, data.RowEvents[0]))
+}
@@ -2,12 +2,10 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -31,6 +29,8 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	access     accessState
+	probing    string
 }
 
 // NewBrowser returns a new browser.
@@ -54,10 +54,16 @@ func (b *Browser) Init(ctx context.Context) error {
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
 		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.MonitorAccess); e != nil {
-			return e
+			if !client.IsAllNamespaces(ns) || !b.meta.Namespaced {
+				return e
+			}
+			go b.checkDegraded(ns, e)
 		}
 	}
 	b.app.CmdBuff().Reset()
+	if b.app.pinnedFilter != "" {
+		b.CmdBuff().SetText(b.app.pinnedFilter)
+	}
 
 	b.bindKeys()
 	if b.bindKeysFn != nil {
@@ -73,7 +79,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	if row == 0 && b.GetRowCount() > 0 {
 		b.Select(1, 0)
 	}
-	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	b.applyRefreshRate()
 
 	b.CmdBuff().SetSuggestionFn(b.suggestFilter())
 
@@ -114,6 +120,19 @@ func (b *Browser) SetInstance(path string) {
 	b.GetModel().SetInstance(path)
 }
 
+// checkDegraded resolves the namespaces the resource can be listed in when
+// it can't be listed across all namespaces.
+func (b *Browser) checkDegraded(ns string, err error) {
+	nss := b.app.factory.ResolveDegraded(ns, b.GVR().String())
+	b.app.QueueUpdateDraw(func() {
+		if len(nss) == 0 {
+			b.app.Flash().Err(err)
+			return
+		}
+		b.app.Flash().Warnf("Degraded mode: listing %s in %d allowed namespaces only", b.GVR().R(), len(nss))
+	})
+}
+
 // Start initializes browser updates.
 func (b *Browser) Start() {
 	b.app.Config.ValidateFavorites()
@@ -149,6 +168,13 @@ func (b *Browser) BufferActive(state bool, k model.BufferKind) {
 		if b.GetRowCount() > 1 {
 			b.App().filterHistory.Push(b.CmdBuff().GetText())
 		}
+		if !state && b.App().pinnedFilter != "" {
+			q := b.CmdBuff().GetText()
+			b.App().pinFilter(q)
+			if q == "" {
+				b.App().Flash().Info("Filter unpinned")
+			}
+		}
 	})
 }
 
@@ -221,7 +247,7 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(b.app, "YAML", path, true).Update(raw)
+	details := NewDetails(b.app, "YAML", path, true).SetContentType(config.ContentYAML).Update(raw)
 	if err := b.App().inject(details); err != nil {
 		b.App().Flash().Err(err)
 	}
@@ -230,7 +256,16 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.GetTable().InVisual() {
+		b.GetTable().CancelVisual()
+		b.GetTable().MarksChanged()
+		return nil
+	}
 	if !b.CmdBuff().InCmdMode() {
+		if b.GetTable().ClearQuickFilters() {
+			b.UpdateTitle()
+			return nil
+		}
 		b.CmdBuff().Reset()
 		return b.App().PrevCmd(evt)
 	}
@@ -294,11 +329,22 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if len(selections) > 1 {
 			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
 		}
+		msg += bulkTargets(selections)
 		if !dao.IsK8sMeta(b.meta) {
 			b.simpleDelete(selections, msg)
 			return nil
 		}
-		b.resourceDelete(selections, msg)
+		gvr := b.GVR()
+		go func() {
+			retained, purged := orphanedClaims(b.app, gvr, selections, 0, true)
+			deps := dependentsPreview(b.app, gvr, selections)
+			b.app.QueueUpdateDraw(func() {
+				msg += deps + claimsPreview(retained.For(selections), purged)
+				b.resourceDelete(selections, msg, func(rr bulkResults) {
+					promptOrphanedClaims(b.app, retained.Succeeded(rr))
+				})
+			})
+		}()
 	}
 
 	return nil
@@ -318,28 +364,13 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
 		return evt
-
-	}
-	ns, n := client.Namespaced(path)
-
-	if ok, err := b.app.Conn().CanI(ns, b.GVR().String(), []string{"patch"}); !ok || err != nil {
-		b.App().Flash().Err(fmt.Errorf("Current user can't edit resource %s", b.GVR()))
-		return nil
 	}
 
 	b.Stop()
 	defer b.Start()
-	{
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, b.meta.SingularName)
-		args = append(args, "-n", ns)
-		if !runK(b.app, shellOpts{clear: true, args: append(args, n)}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	runEdit(b.app, b.GVR(), path)
 
-	return evt
+	return nil
 }
 
 func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -420,17 +451,20 @@ func (b *Browser) refreshActions() {
 		b.namespaceActions(aa)
 		if !b.app.Config.K9s.GetReadOnly() {
 			if client.Can(b.meta.Verbs, "edit") {
-				aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
+				aa[ui.KeyE] = ui.NewKeyActionWithID(ui.ActionEdit, "Edit", b.editCmd, true)
 			}
 			if client.Can(b.meta.Verbs, "delete") {
-				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
+				aa[tcell.KeyCtrlD] = ui.NewKeyActionWithID(ui.ActionDelete, "Delete", b.deleteCmd, true)
+			}
+			if client.Can(b.meta.Verbs, "create") && !dao.IsK9sMeta(b.meta) {
+				aa[tcell.KeyCtrlO] = ui.NewKeyAction("Duplicate", b.cloneCmd, true)
 			}
 		}
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
-		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyD] = ui.NewKeyActionWithID(ui.ActionDescribe, "Describe", b.describeCmd, true)
 	}
 
 	pluginActions(b, aa)
@@ -440,11 +474,12 @@ func (b *Browser) refreshActions() {
 	if b.bindKeysFn != nil {
 		b.bindKeysFn(b.Actions())
 	}
-	b.app.Menu().HydrateMenu(b.Hints())
+	b.applyAccess()
+	b.app.hydrateMenu(b.Hints())
 }
 
 func (b *Browser) namespaceActions(aa ui.KeyActions) {
-	if !b.meta.Namespaced || b.GetTable().Path != "" {
+	if !b.meta.Namespaced || b.GetTable().Path != "" || b.app.Config.LockedNamespace() != "" {
 		return
 	}
 	b.namespaces = make(map[int]string, config.MaxFavoritesNS)
@@ -462,46 +497,48 @@ func (b *Browser) namespaceActions(aa ui.KeyActions) {
 }
 
 func (b *Browser) simpleDelete(selections []string, msg string) {
-	dialog.ShowConfirm(b.app.Content.Pages, "Confirm Delete", msg, func() {
-		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
-		}
-		for _, sel := range selections {
-			nuker, ok := b.accessor.(dao.Nuker)
-			if !ok {
-				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
-				return
-			}
-			if err := nuker.Delete(sel, true, true); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.GetTable().DeleteMark(sel)
-			}
+	level := b.app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowConfirm(b.app.Content.Pages, level, confirmName(b.app.Config.K9s.CurrentContext, selections), "Confirm Delete", msg, func() {
+		nuker, ok := b.accessor.(dao.Nuker)
+		if !ok {
+			b.app.Flash().Errf("Invalid nuker %T", b.accessor)
+			return
 		}
-		b.refresh()
+		b.ShowDeleted()
+		runBulkAsync(b.app, "Delete", b.GVR(), selections, func(sel string) error {
+			return nuker.Delete(sel, true, true)
+		}, b.deleted)
 	}, func() {})
 }
 
-func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Content.Pages, msg, func(cascade, force bool) {
+// resourceDelete confirms and deletes the selections. The done callback is
+// called with the deletions outcomes.
+func (b *Browser) resourceDelete(selections []string, msg string, done func(bulkResults)) {
+	level := b.app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowDelete(b.app.Content.Pages, level, confirmName(b.app.Config.K9s.CurrentContext, selections), msg, func(cascade, force bool) {
 		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
-		}
-		for _, sel := range selections {
-			if err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), sel)
-				b.app.factory.DeleteForwarder(sel)
-				b.GetTable().DeleteMark(sel)
+		ctx := b.defaultContext()
+		runTrashDelete(b.app, b.GVR(), selections, func(sel string) error {
+			return b.GetModel().Delete(ctx, sel, cascade, force)
+		}, func(rr bulkResults) {
+			for _, r := range rr {
+				if r.err == nil {
+					b.app.factory.DeleteForwarder(r.path)
+				}
 			}
-		}
-		b.refresh()
+			b.deleted(rr)
+			done(rr)
+		})
 	}, func() {})
 }
+
+// deleted clears the marks of the deleted resources.
+func (b *Browser) deleted(rr bulkResults) {
+	for _, r := range rr {
+		if r.err == nil {
+			b.GetTable().DeleteMark(r.path)
+		}
+	}
+	b.GetTable().MarksChanged()
+	b.refresh()
+}
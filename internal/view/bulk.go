@@ -0,0 +1,136 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	bulkTitle       = "Bulk Results"
+	bulkConcurrency = 5
+	bulkPreview     = 10
+)
+
+// bulkResult tracks the outcome of a bulk action on a resource.
+type bulkResult struct {
+	path string
+	err  error
+}
+
+// bulkResults tracks the outcomes of a bulk action.
+type bulkResults []bulkResult
+
+// Failed returns the count of failed outcomes.
+func (rr bulkResults) Failed() int {
+	var n int
+	for _, r := range rr {
+		if r.err != nil {
+			n++
+		}
+	}
+
+	return n
+}
+
+// String lists the per resource outcomes.
+func (rr bulkResults) String() string {
+	var b strings.Builder
+	for _, r := range rr {
+		if r.err != nil {
+			fmt.Fprintf(&b, "FAILED  %s: %v\n", r.path, r.err)
+			continue
+		}
+		fmt.Fprintf(&b, "OK      %s\n", r.path)
+	}
+
+	return b.String()
+}
+
+// runBulk runs an action concurrently against the given resources. Outcomes
+// are returned in paths order.
+func runBulk(paths []string, fn func(path string) error) bulkResults {
+	rr := make(bulkResults, len(paths))
+	sem := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, path string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			rr[i] = bulkResult{path: path, err: fn(path)}
+		}(i, path)
+	}
+	wg.Wait()
+
+	return rr
+}
+
+// runBulkAsync runs a bulk action off the ui thread and reports its outcomes.
+// The done callback is called on the ui thread with the bulk outcomes.
+func runBulkAsync(a *App, action string, gvr client.GVR, paths []string, fn func(path string) error, done func(bulkResults)) {
+	if len(paths) > 1 {
+		a.Flash().Infof("%s %d marked %s...", action, len(paths), gvr)
+	}
+	go func() {
+		rr := runBulk(paths, fn)
+		a.QueueUpdateDraw(func() {
+			reportBulk(a, action, gvr, rr)
+			if done != nil {
+				done(rr)
+			}
+		})
+	}()
+}
+
+// reportBulk flashes a bulk action summary. Partial failures of multi resources
+// actions are detailed in a results view.
+func reportBulk(a *App, action string, gvr client.GVR, rr bulkResults) {
+	for _, r := range rr {
+		if r.err != nil {
+			log.Error().Err(r.err).Msgf("%s %s %s failed", action, gvr, r.path)
+		}
+	}
+	failed := rr.Failed()
+	if len(rr) == 1 {
+		if failed == 1 {
+			a.Flash().Err(rr[0].err)
+			return
+		}
+		a.Flash().Infof("%s %s %s succeeded", action, gvr, rr[0].path)
+		return
+	}
+	if failed == 0 {
+		a.Flash().Infof("%s %d %s succeeded", action, len(rr), gvr)
+		return
+	}
+
+	a.Flash().Warnf("%s failed for %d of %d %s", action, failed, len(rr), gvr)
+	details := NewDetails(a, bulkTitle, action+" "+gvr.R(), true).Update(rr.String())
+	if err := a.inject(details); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// bulkTargets lists the resources targeted by a bulk action for confirmation.
+func bulkTargets(paths []string) string {
+	if len(paths) < 2 {
+		return ""
+	}
+	ss := paths
+	if len(ss) > bulkPreview {
+		ss = ss[:bulkPreview]
+	}
+	msg := "\n\n" + strings.Join(ss, "\n")
+	if len(paths) > len(ss) {
+		msg += fmt.Sprintf("\n... and %d more", len(paths)-len(ss))
+	}
+
+	return msg
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunBulk(t *testing.T) {
+	paths := make([]string, 20)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("ns/p%d", i)
+	}
+
+	var active, peak int32
+	rr := runBulk(paths, func(path string) error {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if path == "ns/p3" || path == "ns/p7" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	assert.Equal(t, len(paths), len(rr))
+	for i, r := range rr {
+		assert.Equal(t, paths[i], r.path)
+	}
+	assert.Equal(t, 2, rr.Failed())
+	assert.True(t, peak <= bulkConcurrency)
+}
+
+func TestBulkResultsString(t *testing.T) {
+	rr := bulkResults{
+		{path: "ns/p1"},
+		{path: "ns/p2", err: errors.New("boom")},
+	}
+
+	assert.Equal(t, "OK      ns/p1\nFAILED  ns/p2: boom\n", rr.String())
+}
+
+func TestBulkTargets(t *testing.T) {
+	many := make([]string, 12)
+	for i := range many {
+		many[i] = fmt.Sprintf("p%d", i)
+	}
+
+	uu := map[string]struct {
+		paths []string
+		e     string
+	}{
+		"none": {},
+		"single": {
+			paths: []string{"ns/p1"},
+		},
+		"multi": {
+			paths: []string{"ns/p1", "ns/p2"},
+			e:     "\n\nns/p1\nns/p2",
+		},
+		"truncated": {
+			paths: many,
+			e:     "\n\np0\np1\np2\np3\np4\np5\np6\np7\np8\np9\n... and 2 more",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, bulkTargets(u.paths))
+		})
+	}
+}
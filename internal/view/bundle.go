@@ -0,0 +1,36 @@
+package view
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+// bundleCmd collects a support bundle for the active namespace in the background.
+func (a *App) bundleCmd() {
+	dir := filepath.Join(config.K9sDumpDir, a.Config.K9s.CurrentCluster)
+	if err := ensureDir(dir); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	path := filepath.Join(dir, fmt.Sprintf("bundle-%d.tar.gz", time.Now().UnixNano()))
+	ns := client.CleanseNamespace(a.Config.ActiveNamespace())
+
+	a.Flash().Info("Collecting support bundle...")
+	go func() {
+		err := dao.SaveBundle(a.factory, path, ns, a.Config.K9s.GetBundle(), config.K9sLogs)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				log.Error().Err(err).Msgf("Support bundle failed")
+				a.Flash().Err(err)
+				return
+			}
+			a.Flash().Infof("Support bundle saved to %s", path)
+		})
+	}()
+}
@@ -0,0 +1,104 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+// captureCmd records a resource watch events to disk for a given duration.
+// Usage: capture RESOURCE DURATION [NAMESPACE] or capture stop.
+func (c *Command) captureCmd(tokens []string) error {
+	if len(tokens) == 2 && tokens[1] == "stop" {
+		if c.app.captureFn == nil {
+			return errors.New("no capture in progress")
+		}
+		c.app.captureFn()
+		return nil
+	}
+	if len(tokens) < 3 {
+		return errors.New("Usage: capture RESOURCE DURATION [NAMESPACE]")
+	}
+	if c.app.captureFn != nil {
+		return errors.New("a capture is already in progress. Use `capture stop` to end it")
+	}
+	gvr, ok := c.alias.AsGVR(tokens[1])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", tokens[1])
+	}
+	d, err := time.ParseDuration(tokens[2])
+	if err != nil || d <= 0 {
+		return fmt.Errorf("invalid capture duration %q", tokens[2])
+	}
+	ns := client.CleanseNamespace(c.app.Config.ActiveNamespace())
+	if len(tokens) == 4 {
+		ns = client.CleanseNamespace(tokens[3])
+	}
+	if m, err := dao.MetaAccess.MetaFor(gvr); err == nil && !m.Namespaced {
+		ns = client.AllNamespaces
+	}
+	if err := c.guardNS(gvr, ns); err != nil {
+		return err
+	}
+	auth, err := c.app.Conn().CanI(ns, gvr.String(), []string{client.WatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("watch access denied on %s", gvr)
+	}
+
+	dir := filepath.Join(config.K9sDumpDir, c.app.Config.K9s.CurrentCluster)
+	if err := ensureDir(dir); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("capture-%s-%d.jsonl", gvr.R(), time.Now().UnixNano()))
+
+	var ctx context.Context
+	ctx, c.app.captureFn = context.WithTimeout(context.Background(), d)
+	go c.app.capture(ctx, gvr, ns, path)
+	c.app.Flash().Infof("Capturing %s events for %s...", gvr, d)
+
+	return nil
+}
+
+func (a *App) capture(ctx context.Context, gvr client.GVR, ns, path string) {
+	count, err := a.captureTo(ctx, gvr, ns, path)
+	a.QueueUpdateDraw(func() {
+		a.captureFn()
+		a.captureFn = nil
+		if err != nil {
+			log.Error().Err(err).Msgf("Capture %s failed", gvr)
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Captured %d %s events to %s", count, gvr, path)
+	})
+}
+
+func (a *App) captureTo(ctx context.Context, gvr client.GVR, ns, path string) (int, error) {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing capture %q", path)
+		}
+	}()
+
+	res := a.Conn().DynDialOrDie().Resource(gvr.GVR())
+	if client.IsAllNamespaces(ns) {
+		return dao.CaptureWatch(ctx, res, out)
+	}
+
+	return dao.CaptureWatch(ctx, res.Namespace(ns), out)
+}
@@ -0,0 +1,96 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// ChaosExtender kills random pods of a workload.
+type ChaosExtender struct {
+	ResourceViewer
+}
+
+// NewChaosExtender returns a new extender.
+func NewChaosExtender(v ResourceViewer) ResourceViewer {
+	c := ChaosExtender{ResourceViewer: v}
+	c.bindKeys(v.Actions())
+
+	return &c
+}
+
+func (c *ChaosExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Chaos Kill", c.chaosCmd, true),
+	})
+}
+
+func (c *ChaosExtender) chaosCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	cfg := c.App().Config.K9s
+	if cfg.GetReadOnly() {
+		c.App().Flash().Warn("Chaos actions are disabled in readonly mode")
+		return nil
+	}
+	chaos := cfg.GetChaos()
+	if !chaos.IsAllowed(cfg.CurrentContext) {
+		c.App().Flash().Warnf("Chaos actions are not allowed on context %q. Add it to the chaos contexts config", cfg.CurrentContext)
+		return nil
+	}
+
+	victims, err := c.victims(path, chaos.BlastRadius)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+
+	c.Stop()
+	defer c.Start()
+	msg := fmt.Sprintf("Kill %d random pod(s) of %s?\n\n%s", len(victims), path, strings.Join(victims, "\n"))
+	level := c.App().Config.ConfirmLevel(config.ActionChaos)
+	dialog.ShowConfirm(c.App().Content.Pages, level, confirmName(c.App().Config.K9s.CurrentContext, []string{path}), "Confirm Chaos", msg, func() {
+		c.kill(victims)
+	}, func() {})
+
+	return nil
+}
+
+func (c *ChaosExtender) victims(path string, count int) ([]string, error) {
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.ChaosMonkey)
+	if !ok {
+		return nil, errors.New("resource does not support chaos actions")
+	}
+
+	return m.Victims(path, count)
+}
+
+func (c *ChaosExtender) kill(victims []string) {
+	gvr := client.NewGVR("v1/pods")
+	res, err := dao.AccessorFor(c.App().factory, gvr)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		c.App().Flash().Errf("Invalid nuker %T", res)
+		return
+	}
+	runBulkAsync(c.App(), "Chaos kill", gvr, victims, nukeFn(nuker, true, false), func(bulkResults) {
+		c.Refresh()
+	})
+}
@@ -0,0 +1,219 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	cloneDialogKey = "clone"
+	maxDiffLines   = 15
+)
+
+// cloneTarget tracks where a resource is duplicated to.
+type cloneTarget struct {
+	context, namespace, name string
+	move                     bool
+}
+
+func (b *Browser) cloneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	b.Stop()
+	defer b.Start()
+	b.showCloneDialog(path)
+
+	return nil
+}
+
+func (b *Browser) showCloneDialog(path string) {
+	ns, n := client.Namespaced(path)
+	t := cloneTarget{
+		context:   b.app.Config.K9s.CurrentContext,
+		namespace: ns,
+		name:      n + "-copy",
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddInputField("Context:", t.context, 30, nil, func(s string) {
+		t.context = s
+	})
+	if ns != "" {
+		f.AddInputField("Namespace:", t.namespace, 30, nil, func(s string) {
+			t.namespace = s
+		})
+	}
+	f.AddInputField("Name:", t.name, 30, nil, func(s string) {
+		t.name = s
+	})
+	f.AddCheckbox("Move:", false, func(checked bool) {
+		t.move = checked
+	})
+	f.AddButton("OK", func() {
+		b.dismissCloneDialog()
+		if t.context == b.app.Config.K9s.CurrentContext {
+			b.clone(path, t)
+			return
+		}
+		b.cloneToContext(path, t)
+	})
+	f.AddButton("Cancel", func() {
+		b.dismissCloneDialog()
+	})
+
+	modal := tview.NewModalForm("<Duplicate>", f)
+	modal.SetText(fmt.Sprintf("Duplicate %s %s", b.GVR(), path))
+	modal.SetDoneFunc(func(int, string) {
+		b.dismissCloneDialog()
+	})
+	b.app.Content.AddPage(cloneDialogKey, modal, false, false)
+	b.app.Content.ShowPage(cloneDialogKey)
+}
+
+func (b *Browser) dismissCloneDialog() {
+	b.app.Content.RemovePage(cloneDialogKey)
+}
+
+// clone duplicates a resource in the current context. Moves are confirmed
+// first as they delete the source.
+func (b *Browser) clone(path string, t cloneTarget) {
+	if !t.move {
+		b.duplicate(path, t)
+		return
+	}
+
+	fqn := client.FQN(t.namespace, t.name)
+	msg := fmt.Sprintf("Move %s %s to %s?\nThe source %s will be deleted.", b.GVR(), path, fqn, path)
+	level := b.app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowConfirm(b.app.Content.Pages, level, confirmName(b.app.Config.K9s.CurrentContext, []string{path}), "Confirm Move", msg, func() {
+		b.duplicate(path, t)
+	}, func() {})
+}
+
+func (b *Browser) duplicate(path string, t cloneTarget) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	if err := dao.Clone(ctx, b.app.factory, b.GVR(), path, t.namespace, t.name); err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	b.app.Flash().Infof("Resource %s:%s duplicated to %s", b.GVR(), path, client.FQN(t.namespace, t.name))
+	if t.move {
+		b.cloneSourceDelete(path)
+	}
+}
+
+// cloneToContext previews the changes in the target context and applies them
+// once confirmed. The target cluster is dialed off the UI thread.
+func (b *Browser) cloneToContext(path string, t cloneTarget) {
+	b.app.Flash().Infof("Connecting to context %s...", t.context)
+	go func() {
+		dst, plan, err := b.planClone(path, t)
+		b.app.QueueUpdateDraw(func() {
+			if err != nil {
+				b.app.Flash().Err(err)
+				return
+			}
+			b.confirmCloneToContext(path, t, dst, plan)
+		})
+	}()
+}
+
+func (b *Browser) planClone(path string, t cloneTarget) (client.Connection, *dao.ClonePlan, error) {
+	cfg, err := b.app.Conn().Config().ForContext(t.context)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := client.InitConnection(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	plan, err := dao.PlanClone(ctx, b.app.factory, dst, b.GVR(), path, t.namespace, t.name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dst, plan, nil
+}
+
+func (b *Browser) confirmCloneToContext(path string, t cloneTarget, dst client.Connection, plan *dao.ClonePlan) {
+	fqn := client.FQN(plan.Manifest.GetNamespace(), t.name)
+	verb := "Create"
+	if plan.Existing != nil {
+		verb = "Replace"
+	}
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "%s %s %s in context %s?", verb, b.GVR(), fqn, t.context)
+	if t.move {
+		fmt.Fprintf(&msg, "\nThe source %s will be deleted.", path)
+	}
+	listDiff(&msg, plan.Diff)
+
+	action := config.ActionClone
+	if t.move {
+		action = config.ActionDelete
+	}
+	level := b.app.Config.ConfirmLevel(action)
+	dialog.ShowConfirm(b.app.Content.Pages, level, t.name, "Confirm Duplicate", msg.String(), func() {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			err := plan.Apply(ctx, dst)
+			b.app.QueueUpdateDraw(func() {
+				if err != nil {
+					b.app.Flash().Err(err)
+					return
+				}
+				b.app.Flash().Infof("Resource %s:%s duplicated to %s@%s", b.GVR(), path, fqn, t.context)
+				if t.move {
+					b.cloneSourceDelete(path)
+				}
+			})
+		}()
+	}, func() {})
+}
+
+func (b *Browser) cloneSourceDelete(path string) {
+	nuker, ok := b.accessor.(dao.Nuker)
+	if !ok {
+		b.app.Flash().Err(fmt.Errorf("expecting a nuker for %q", b.GVR()))
+		return
+	}
+	runTrashDelete(b.app, b.GVR(), []string{path}, nukeFn(nuker, true, false), func(bulkResults) {
+		b.refresh()
+	})
+}
+
+func listDiff(b *strings.Builder, dd []string) {
+	if len(dd) == 0 {
+		b.WriteString("\n\nNo changes.")
+		return
+	}
+	b.WriteString("\n")
+	for i, d := range dd {
+		if i == maxDiffLines {
+			fmt.Fprintf(b, "\n...and %d more line(s)", len(dd)-maxDiffLines)
+			return
+		}
+		b.WriteString("\n" + tview.Escape(d))
+	}
+}
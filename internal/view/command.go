@@ -36,6 +36,7 @@ func NewCommand(app *App) *Command {
 
 // Init initializes the command.
 func (c *Command) Init() error {
+	dao.MetaAccess.Exclude(c.app.Config.K9s.ExcludeResources)
 	c.alias = dao.NewAlias(c.app.factory)
 	if _, err := c.alias.Ensure(); err != nil {
 		return err
@@ -50,6 +51,7 @@ func (c *Command) Reset(clear bool) error {
 	c.mx.Lock()
 	defer c.mx.Unlock()
 
+	dao.MetaAccess.Exclude(c.app.Config.K9s.ExcludeResources)
 	if clear {
 		c.alias.Clear()
 	}
@@ -106,6 +108,19 @@ func (c *Command) xrayCmd(cmd string) error {
 	return c.exec(cmd, "xrays", x, true)
 }
 
+func (c *Command) profileCmd(tokens []string) error {
+	if len(tokens) < 2 {
+		name := c.app.Config.K9s.ActiveProfileName()
+		if name == "" {
+			name = "base"
+		}
+		c.app.Flash().Infof("Active profile `%s` -- available: %s", name, strings.Join(c.app.Config.K9s.Profiles.Names(), ","))
+		return nil
+	}
+
+	return c.app.switchProfile(tokens[1])
+}
+
 // BOZO!!
 // func (c *Command) checkAccess(gvr string) error {
 // 	m, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr))
@@ -137,6 +152,9 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 
 	switch cmds[0] {
 	case "ctx", "context", "contexts":
+		if c.app.Conn().Config().InClusterMode() {
+			return errors.New("Context switching is disabled while running in-cluster")
+		}
 		if len(cmds) == 2 {
 			return useContext(c.app, cmds[1])
 		}
@@ -196,6 +214,75 @@ func (c *Command) specialCmd(cmd, path string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "undo":
+		c.app.undoCmd()
+		return true
+	case "tour":
+		c.app.tourCmd()
+		return true
+	case "record":
+		c.app.recordCmd()
+		return true
+	case "bundle":
+		c.app.bundleCmd()
+		return true
+	case "error":
+		c.app.errorCmd()
+		return true
+	case "usage":
+		c.app.usageCmd()
+		return true
+	case "stats":
+		c.app.statsCmd()
+		return true
+	case "autoscaler":
+		c.app.autoscalerCmd()
+		return true
+	case "audit":
+		if err := c.auditCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "capture":
+		if err := c.captureCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "serve":
+		if err := c.serveCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "logs":
+		if err := c.logsCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "tail":
+		if err := c.tailCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "top":
+		if err := c.topCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "who-can", "whocan":
+		if err := c.whoCanCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "split":
+		if err := c.splitCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "profile":
+		if err := c.profileCmd(cmds); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -212,6 +299,28 @@ func (c *Command) specialCmd(cmd, path string) bool {
 	return false
 }
 
+// guardNS checks a raw resource access stays within the visible namespaces.
+func (c *Command) guardNS(gvr client.GVR, ns string) error {
+	g := c.app.factory.NamespaceGuard()
+	if g == nil {
+		return nil
+	}
+	if err := g.Err(); err != nil {
+		return err
+	}
+	if !client.IsAllNamespaces(ns) {
+		return g.Check(ns)
+	}
+	if gvr.String() == "v1/namespaces" {
+		return errors.New("namespaces are restricted in this context")
+	}
+	if m, err := dao.MetaAccess.MetaFor(gvr); err != nil || m.Namespaced {
+		return fmt.Errorf("namespaces are restricted in this context. Specify a namespace to access %s", gvr)
+	}
+
+	return nil
+}
+
 func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 	gvr, ok := c.alias.AsGVR(cmd)
 	if !ok {
@@ -273,7 +382,7 @@ func (c *Command) exec(cmd, gvr string, comp model.Component, clearStack bool) (
 	if err := c.app.inject(comp); err != nil {
 		return err
 	}
-	c.app.cmdHistory.Push(cmd)
+	c.app.pushHistory(cmd)
 
 	return
 }
@@ -0,0 +1,166 @@
+package view
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const commandPaletteKey = "commandPalette"
+
+// paletteCommands lists the special commands available from the palette.
+var paletteCommands = []struct {
+	cmd, desc string
+}{
+	{"help", "Show active keyboard mnemonics and help"},
+	{"alias", "Show all available resource aliases"},
+	{"xray deploy", "XRay deployments"},
+	{"undo", "Revert the last scale, pause, cordon, suspend or edit operation"},
+	{"tour", "Start or stop the guided tour"},
+	{"record", "Start or stop the session recording"},
+	{"bundle", "Collect a support bundle of the active namespace"},
+	{"error", "Inspect the last api error"},
+	{"usage", "Show your most used views and commands"},
+	{"stats", "Show the watches reconnects and failures per resource"},
+	{"autoscaler", "Show cluster-autoscaler decisions"},
+	{"audit", "Tail the api server audit events"},
+	{"top", "Show pods usage totals per namespace, deployment or node"},
+	{"quit", "Bail out of K9s"},
+}
+
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	// Views binding the same key take precedence.
+	if top, ok := a.Content.Top().(interface{ Actions() ui.KeyActions }); ok {
+		if _, ok := top.Actions()[tcell.KeyCtrlP]; ok {
+			return evt
+		}
+	}
+
+	p := ui.NewPalette("Command Palette", a.Styles)
+	p.SetItems(a.paletteItems())
+	p.SetDoneFn(func() {
+		a.Content.RemovePage(commandPaletteKey)
+		a.SetFocus(a.Content.CurrentPage().Item)
+	})
+	a.Content.AddPage(commandPaletteKey, p, true, true)
+	a.Content.ShowPage(commandPaletteKey)
+	a.SetFocus(p)
+
+	return nil
+}
+
+// paletteItems returns all palette entries ranked by frecency.
+func (a *App) paletteItems() ui.PaletteItems {
+	var ii ui.PaletteItems
+	add := func(cmd, name, desc string) {
+		ii = append(ii, ui.PaletteItem{
+			Name:        name,
+			Description: desc,
+			Action: func() {
+				a.runPaletteCmd(cmd)
+			},
+		})
+	}
+
+	for _, c := range a.cmdHistory.List() {
+		add(c, c, "recent")
+	}
+	for _, c := range paletteCommands {
+		add(c.cmd, c.cmd, c.desc)
+	}
+	for _, v := range a.paletteViews() {
+		add(v[0], v[0], "view "+v[1])
+	}
+	if !a.Conn().Config().InClusterMode() {
+		if cc, err := a.Conn().Config().ContextNames(); err == nil {
+			sort.Strings(cc)
+			for _, c := range cc {
+				add("ctx "+c, c, "switch context")
+			}
+		}
+	}
+	view := strings.Split(a.Config.ActiveView(), " ")[0]
+	if view == "" {
+		view = "pod"
+	}
+	for _, ns := range a.Config.FavNamespaces() {
+		add(view+" "+ns, ns, "switch namespace")
+	}
+
+	kk := make([]string, 0, len(ii))
+	idx := make(map[string]ui.PaletteItem, len(ii))
+	for _, i := range ii {
+		k := i.Name + "|" + i.Description
+		if _, ok := idx[k]; ok {
+			continue
+		}
+		idx[k] = i
+		kk = append(kk, k)
+	}
+	now := time.Now()
+	a.frecency.Sort(kk, now)
+	res := make(ui.PaletteItems, 0, len(kk))
+	for _, k := range kk {
+		i := idx[k]
+		key, action := k, i.Action
+		i.Action = func() {
+			a.frecency.Track(key, time.Now())
+			go a.saveFrecency()
+			action()
+		}
+		res = append(res, i)
+	}
+
+	return res
+}
+
+// initFrecency loads the palette entries ranks.
+func (a *App) initFrecency() {
+	f, err := model.LoadFrecency(config.K9sPaletteFile)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load palette ranks %q", config.K9sPaletteFile)
+		return
+	}
+	a.frecency = f
+}
+
+func (a *App) saveFrecency() {
+	if err := a.frecency.Save(config.K9sPaletteFile); err != nil {
+		log.Error().Err(err).Msgf("Unable to save palette ranks %q", config.K9sPaletteFile)
+	}
+}
+
+// paletteViews returns all resource views as command, gvr pairs.
+func (a *App) paletteViews() [][2]string {
+	vv := make([][2]string, 0, 50)
+	for gvr, aliases := range a.command.alias.ShortNames() {
+		cmd := client.NewGVR(gvr).R()
+		if !a.command.alias.Check(cmd) {
+			sort.Strings(aliases)
+			cmd = aliases[0]
+		}
+		vv = append(vv, [2]string{cmd, gvr})
+	}
+	sort.Slice(vv, func(i, j int) bool {
+		return vv[i][0] < vv[j][0]
+	})
+
+	return vv
+}
+
+func (a *App) runPaletteCmd(cmd string) {
+	if err := a.gotoResource(cmd, "", true); err != nil {
+		log.Error().Err(err).Msgf("Palette command %q failed", cmd)
+		a.Flash().Err(err)
+	}
+}
@@ -37,8 +37,8 @@ func (c *Container) Name() string { return containerTitle }
 
 func (c *Container) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Shell", c.shellCmd, true),
-		ui.KeyA: ui.NewKeyAction("Attach", c.attachCmd, true),
+		ui.KeyS: ui.NewKeyActionWithID(ui.ActionShell, "Shell", c.shellCmd, true),
+		ui.KeyA: ui.NewKeyActionWithID(ui.ActionAttach, "Attach", c.attachCmd, true),
 	})
 }
 
@@ -169,11 +169,12 @@ func (c *Container) isForwardable(path string) ([]string, bool) {
 
 	pp := make([]string, 0, len(ports))
 	for _, p := range ports {
-		if !isTCPPort(p) {
-			continue
-		}
 		pp = append(pp, path+"/"+p)
 	}
+	if err := checkForwardable(path, pp); err != nil {
+		c.App().Flash().Err(err)
+		return nil, false
+	}
 
 	return pp, true
 }
@@ -6,9 +6,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -64,9 +67,71 @@ func jobCtx(path, uid string) ContextFunc {
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		ui.KeyZ:        ui.NewKeyAction("Suspend/Resume", c.suspendCmd, true),
 	})
 }
 
+func (c *CronJob) suspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	s, ok := res.(dao.Suspendable)
+	if !ok {
+		c.App().Flash().Err(fmt.Errorf("expecting a suspendable resource for %q", c.GVR()))
+		return nil
+	}
+	suspended, err := s.IsSuspended(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+
+	title, msg := "Confirm Suspend", fmt.Sprintf("Suspend cronjob %s schedule?", path)
+	if suspended {
+		title, msg = "Confirm Resume", fmt.Sprintf("Resume cronjob %s schedule?", path)
+	}
+	level := c.App().Config.ConfirmLevel(config.ActionPause)
+	dialog.ShowConfirm(c.App().Content.Pages, level, confirmName(c.App().Config.K9s.CurrentContext, []string{path}), title, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		defer cancel()
+		if err := s.Suspend(ctx, path, !suspended); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		if suspended {
+			c.App().Flash().Infof("Cronjob %s resumed successfully", path)
+		} else {
+			c.App().Flash().Infof("Cronjob %s suspended successfully", path)
+		}
+		c.App().record(suspendOperation(s, path, !suspended))
+	}, func() {})
+
+	return nil
+}
+
+func suspendOperation(s dao.Suspendable, path string, suspend bool) model.Operation {
+	op, inv := "Suspend", "Resume"
+	if !suspend {
+		op, inv = inv, op
+	}
+
+	return model.Operation{
+		Description: fmt.Sprintf("%s cronjob %s", op, path),
+		Inverse:     fmt.Sprintf("%s cronjob %s", inv, path),
+		Revert: func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			return s.Suspend(ctx, path, !suspend)
+		},
+	}
+}
+
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	debugKey = "debug"
+	// debugTimeout tracks how long to wait for a debug container to run.
+	debugTimeout = 1 * time.Minute
+)
+
+// DebugFunc represents a debug container callback function.
+type DebugFunc func(path string, opts dao.DebugOptions)
+
+// ShowDebug pops an ephemeral debug container dialog.
+func ShowDebug(a *App, path string, defaults dao.DebugOptions, okFn DebugFunc) {
+	styles := a.Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	opts, command := defaults, strings.Join(defaults.Command, " ")
+	f.AddInputField("Image:", opts.Image, 0, nil, func(v string) {
+		opts.Image = strings.TrimSpace(v)
+	})
+	f.AddInputField("Target Container:", opts.Target, 0, nil, func(v string) {
+		opts.Target = strings.TrimSpace(v)
+	})
+	f.AddInputField("Command:", command, 0, nil, func(v string) {
+		command = v
+	})
+
+	pages := a.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissDebug(a, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissDebug(a, pages)
+		opts.Command = strings.Fields(command)
+		okFn(path, opts)
+	})
+
+	modal := tview.NewModalForm("<Debug>", f)
+	modal.SetText(path)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissDebug(a, pages)
+	})
+
+	pages.AddPage(debugKey, modal, false, true)
+	pages.ShowPage(debugKey)
+	a.SetFocus(pages.GetPrimitive(debugKey))
+}
+
+// DismissDebug dismiss the debug dialog.
+func DismissDebug(a *App, p *ui.Pages) {
+	p.RemovePage(debugKey)
+	a.SetFocus(p.CurrentPage().Item)
+}
@@ -0,0 +1,66 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+const maxDependents = 5
+
+// dependentsPreview describes the resources impacted by deleting the given
+// resources. This call blocks on the informer caches and must not run on the
+// UI thread.
+func dependentsPreview(app *App, gvr client.GVR, paths []string) string {
+	var (
+		all     dao.Dependents
+		unknown = make(map[string]struct{})
+	)
+	for _, path := range paths {
+		d, err := dao.FindDependents(app.factory, gvr, path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to compute dependents for %s", path)
+			unknown[path] = struct{}{}
+			continue
+		}
+		all.Cascade = append(all.Cascade, d.Cascade...)
+		all.Orphans = append(all.Orphans, d.Orphans...)
+		for _, u := range d.Unknown {
+			unknown[u] = struct{}{}
+		}
+	}
+
+	var b strings.Builder
+	if len(all.Cascade) > 0 {
+		fmt.Fprintf(&b, "\n\nCascade deletes %d resource(s):", len(all.Cascade))
+		listDependents(&b, all.Cascade)
+	}
+	if len(all.Orphans) > 0 {
+		fmt.Fprintf(&b, "\n\nWithout cascade %d resource(s) are orphaned:", len(all.Orphans))
+		listDependents(&b, all.Orphans)
+	}
+	if len(unknown) > 0 {
+		uu := make([]string, 0, len(unknown))
+		for u := range unknown {
+			uu = append(uu, u)
+		}
+		sort.Strings(uu)
+		fmt.Fprintf(&b, "\n\nDependents unknown, still loading %s", strings.Join(uu, ", "))
+	}
+
+	return b.String()
+}
+
+func listDependents(b *strings.Builder, dd []string) {
+	for i, d := range dd {
+		if i == maxDependents {
+			fmt.Fprintf(b, "\n  ...and %d more", len(dd)-maxDependents)
+			return
+		}
+		b.WriteString("\n  " + d)
+	}
+}
@@ -3,11 +3,13 @@ package view
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"strings"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -27,6 +29,9 @@ type Details struct {
 	model                     *model.Text
 	currentRegion, maxRegions int
 	searchable                bool
+	colorizer                 func(string) string
+	contentType               string
+	viewerText                func() string
 }
 
 // NewDetails returns a details viewer.
@@ -76,7 +81,7 @@ func (d *Details) Init(_ context.Context) error {
 
 // TextChanged notifies the model changed.
 func (d *Details) TextChanged(lines []string) {
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	d.SetText(d.colorize(strings.Join(lines, "\n")))
 	d.ScrollToBeginning()
 }
 
@@ -92,7 +97,7 @@ func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 		d.maxRegions++
 	}
 
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
+	d.SetText(d.colorize(strings.Join(ll, "\n")))
 	d.Highlight()
 	if d.maxRegions > 0 {
 		d.Highlight("search_0")
@@ -126,6 +131,9 @@ func (d *Details) bindKeys() {
 	if !d.searchable {
 		d.actions.Delete(ui.KeyN, ui.KeyShiftN)
 	}
+	if len(d.app.Config.K9s.ViewerFor(d.contentType)) > 0 {
+		d.actions[ui.KeyV] = ui.NewKeyAction("View External", d.viewCmd, true)
+	}
 }
 
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -150,6 +158,31 @@ func (d *Details) Update(buff string) *Details {
 	return d
 }
 
+// SetColorizer overrides the default yaml colorizer.
+func (d *Details) SetColorizer(f func(string) string) {
+	d.colorizer = f
+}
+
+func (d *Details) colorize(s string) string {
+	s = render.RedactText(s)
+	if d.colorizer != nil {
+		return d.colorizer(s)
+	}
+
+	return colorizeYAML(d.app.Styles.Views().Yaml, s)
+}
+
+// SetContentType sets the content type used to pick an external viewer.
+func (d *Details) SetContentType(kind string) *Details {
+	d.contentType = kind
+	return d
+}
+
+// SetViewerText overrides the text handed to the external viewer.
+func (d *Details) SetViewerText(f func() string) {
+	d.viewerText = f
+}
+
 // SetSubject updates the subject.
 func (d *Details) SetSubject(s string) {
 	d.subject = s
@@ -265,6 +298,29 @@ func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// viewCmd pipes the content to the configured external viewer.
+func (d *Details) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	args := d.app.Config.K9s.ViewerFor(d.contentType)
+	if len(args) == 0 {
+		return evt
+	}
+	bin, err := exec.LookPath(args[0])
+	if err != nil {
+		d.app.Flash().Errf("Viewer %q not found: %s", args[0], err)
+		return nil
+	}
+	text := strings.Join(d.model.Peek(), "\n")
+	if d.viewerText != nil {
+		text = d.viewerText()
+	}
+	opts := shellOpts{clear: true, binary: bin, args: args[1:], stdin: strings.NewReader(render.RedactText(text))}
+	if !run(d.app, opts) {
+		d.app.Flash().Errf("Viewer %q failed", args[0])
+	}
+
+	return nil
+}
+
 func (d *Details) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.app.Flash().Info("Content copied to clipboard...")
 	if err := clipboard.WriteAll(d.GetText(true)); err != nil {
@@ -278,7 +334,7 @@ func (d *Details) updateTitle() {
 	if d.title == "" {
 		return
 	}
-	fmat := fmt.Sprintf(detailsTitleFmt, d.title, d.subject)
+	fmat := fmt.Sprintf(detailsTitleFmt, d.title, render.RedactText(d.subject))
 
 	buff := d.cmdBuff.GetText()
 	if buff == "" {
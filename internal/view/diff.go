@@ -0,0 +1,162 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	diffTitle    = "Diff"
+	diffMaxWidth = 80
+	diffSep      = " │ "
+
+	diffRemovedColor = "red"
+	diffAddedColor   = "green"
+)
+
+// Diff presents a side by side diff of two yaml documents.
+type Diff struct {
+	*Details
+
+	from, to string
+	lines    []dao.DiffLine
+}
+
+// NewDiff returns a new diff viewer of the from and to documents.
+func NewDiff(app *App, subject, from, to string, dd []dao.DiffLine) *Diff {
+	d := Diff{
+		Details: NewDetails(app, diffTitle, subject, true),
+		from:    from,
+		to:      to,
+		lines:   dd,
+	}
+	d.SetContentType(config.ContentDiff)
+	d.SetColorizer(func(s string) string {
+		return colorizeDiff(app.Styles.Views().Yaml, s)
+	})
+	d.SetViewerText(func() string {
+		return unifiedDiff(d.from, d.to, d.lines)
+	})
+
+	return &d
+}
+
+// Init initializes the component.
+func (d *Diff) Init(ctx context.Context) error {
+	if err := d.Details.Init(ctx); err != nil {
+		return err
+	}
+	d.SetWrap(false)
+	d.Update(diffText(d.lines))
+
+	return nil
+}
+
+// diffText lays out diff lines side by side, prefixed with a change marker.
+func diffText(dd []dao.DiffLine) string {
+	var width int
+	for _, d := range dd {
+		if w := runewidth.StringWidth(d.Left); w > width {
+			width = w
+		}
+	}
+	if width > diffMaxWidth {
+		width = diffMaxWidth
+	}
+
+	ll := make([]string, 0, len(dd))
+	for _, d := range dd {
+		left := runewidth.FillRight(render.Truncate(d.Left, width), width)
+		ll = append(ll, diffMarker(d.Op)+" "+left+diffSep+d.Right)
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// unifiedDiff renders diff lines as a single hunk unified diff so external
+// diff viewers can pick it up.
+func unifiedDiff(from, to string, dd []dao.DiffLine) string {
+	var left, right int
+	for _, d := range dd {
+		if d.Op != dao.DiffAdded {
+			left++
+		}
+		if d.Op != dao.DiffRemoved {
+			right++
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n@@ -%d,%d +%d,%d @@\n", from, to, hunkStart(left), left, hunkStart(right), right)
+	for _, d := range dd {
+		switch d.Op {
+		case dao.DiffSame:
+			b.WriteString(" " + d.Left + "\n")
+		case dao.DiffRemoved:
+			b.WriteString("-" + d.Left + "\n")
+		case dao.DiffAdded:
+			b.WriteString("+" + d.Right + "\n")
+		case dao.DiffChanged:
+			b.WriteString("-" + d.Left + "\n+" + d.Right + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+func hunkStart(n int) int {
+	if n == 0 {
+		return 0
+	}
+
+	return 1
+}
+
+func diffMarker(op dao.DiffOp) string {
+	switch op {
+	case dao.DiffRemoved:
+		return "-"
+	case dao.DiffAdded:
+		return "+"
+	case dao.DiffChanged:
+		return "~"
+	default:
+		return " "
+	}
+}
+
+func colorizeDiff(style config.Yaml, raw string) string {
+	lines := strings.Split(raw, "\n")
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		i := strings.Index(l, diffSep)
+		if i == -1 {
+			buff = append(buff, enableRegion(tview.Escape(l)))
+			continue
+		}
+		left, right := l[:i], l[i+len(diffSep):]
+		lc, rc := style.ValueColor.String(), style.ValueColor.String()
+		switch left[0] {
+		case '-':
+			lc = diffRemovedColor
+		case '+':
+			rc = diffAddedColor
+		case '~':
+			lc, rc = diffRemovedColor, diffAddedColor
+		}
+		buff = append(buff, fmt.Sprintf("[%s::]%s[%s::]%s[%s::]%s",
+			lc, enableRegion(tview.Escape(left)),
+			style.ColonColor, diffSep,
+			rc, enableRegion(tview.Escape(right)),
+		))
+	}
+
+	return strings.Join(buff, "\n")
+}
@@ -0,0 +1,64 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffText(t *testing.T) {
+	s := diffText([]dao.DiffLine{
+		{Op: dao.DiffSame, Left: "a: 1", Right: "a: 1"},
+		{Op: dao.DiffChanged, Left: "image: nginx:1.18", Right: "image: nginx:1.19"},
+		{Op: dao.DiffAdded, Right: "b: 2"},
+	})
+
+	assert.Equal(t, "  a: 1              │ a: 1\n"+
+		"~ image: nginx:1.18 │ image: nginx:1.19\n"+
+		"+                   │ b: 2", s)
+}
+
+func TestColorizeDiff(t *testing.T) {
+	style := config.Yaml{ValueColor: "white", ColonColor: "gray"}
+
+	uu := map[string]struct {
+		s, e string
+	}{
+		"same": {
+			s: "  a │ a",
+			e: "[white::]  a[gray::] │ [white::]a",
+		},
+		"removed": {
+			s: "- a │ ",
+			e: "[red::]- a[gray::] │ [white::]",
+		},
+		"changed": {
+			s: "~ [a] │ b",
+			e: "[red::]~ [a[][gray::] │ [green::]b",
+		},
+		"plain": {
+			s: "blee",
+			e: "blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, colorizeDiff(style, u.s))
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	s := unifiedDiff("fred@1", "fred@2", []dao.DiffLine{
+		{Op: dao.DiffSame, Left: "a: 1", Right: "a: 1"},
+		{Op: dao.DiffChanged, Left: "b: 1", Right: "b: 2"},
+		{Op: dao.DiffRemoved, Left: "c: 1"},
+		{Op: dao.DiffAdded, Right: "d: 1"},
+	})
+
+	assert.Equal(t, "--- fred@1\n+++ fred@2\n@@ -1,3 +1,3 @@\n a: 1\n-b: 1\n+b: 2\n-c: 1\n+d: 1\n", s)
+}
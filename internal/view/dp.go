@@ -18,12 +18,18 @@ type Deploy struct {
 // NewDeploy returns a new deployment view.
 func NewDeploy(gvr client.GVR) ResourceViewer {
 	d := Deploy{
-		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewLogsExtender(
-						NewBrowser(gvr),
-						nil,
+		ResourceViewer: NewScheduleExtender(
+			NewTopologyExtender(
+				NewPortForwardExtender(
+					NewRestartExtender(
+						NewScaleExtender(
+							NewChaosExtender(
+								NewLogsExtender(
+									NewBrowser(gvr),
+									nil,
+								),
+							),
+						),
 					),
 				),
 			),
@@ -41,6 +47,8 @@ func (d *Deploy) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Revisions", revisionsCmd(d), true),
+		ui.KeyShiftE: ui.NewKeyAction("Timeline", timelineCmd(d), true),
 	})
 }
 
@@ -1,7 +1,9 @@
 package view
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/dao"
@@ -9,7 +11,11 @@ import (
 	"github.com/derailed/tview"
 )
 
-const drainKey = "drain"
+const (
+	drainKey         = "drain"
+	drainProgressKey = "drainProgress"
+	drainMaxLines    = 10
+)
 
 // DrainFunc represents a drain callback function.
 type DrainFunc func(v ResourceViewer, path string, opts dao.DrainOptions)
@@ -26,7 +32,7 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 		SetLabelColor(styles.K9s.Info.FgColor.Color()).
 		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
 
-	var opts dao.DrainOptions
+	opts := defaults
 	f.AddInputField("GracePeriod:", strconv.Itoa(defaults.GracePeriodSeconds), 0, nil, func(v string) {
 		a, err := asIntOpt(v)
 		if err != nil {
@@ -75,12 +81,127 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 	view.App().SetFocus(pages.GetPrimitive(drainKey))
 }
 
-// DismissDrain dismiss the port forward dialog.
+// DismissDrain dismiss the drain dialog.
 func DismissDrain(v ResourceViewer, p *ui.Pages) {
 	p.RemovePage(drainKey)
 	v.App().SetFocus(p.CurrentPage().Item)
 }
 
+// drainProgress tracks a node drain progress overlay.
+type drainProgress struct {
+	view    ResourceViewer
+	path    string
+	tracker *dao.DrainTracker
+	modal   *tview.ModalForm
+	hidden  bool
+	err     error
+	over    bool
+}
+
+// showDrainProgress pops a node drain progress overlay. Hiding the overlay
+// keeps the drain going while canceling it stops any pending evictions.
+func showDrainProgress(view ResourceViewer, path string, t *dao.DrainTracker, cancel func()) *drainProgress {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor())
+
+	p := drainProgress{view: view, path: path, tracker: t}
+	pages := view.App().Content.Pages
+	f.AddButton("Hide", p.hide)
+	f.AddButton("Cancel", func() {
+		cancel()
+		p.hide()
+	})
+
+	p.modal = tview.NewModalForm("<Draining>", f)
+	p.modal.SetDoneFunc(func(_ int, b string) {
+		p.hide()
+	})
+	p.refresh()
+
+	pages.AddPage(drainProgressKey, p.modal, false, true)
+	pages.ShowPage(drainProgressKey)
+	view.App().SetFocus(pages.GetPrimitive(drainProgressKey))
+
+	return &p
+}
+
+func (p *drainProgress) refresh() {
+	if p.hidden {
+		return
+	}
+	p.modal.SetText(drainSummary(p.path, p.tracker, p.over, p.err))
+}
+
+func (p *drainProgress) done(err error) {
+	p.over, p.err = true, err
+	p.refresh()
+}
+
+func (p *drainProgress) hide() {
+	if p.hidden {
+		return
+	}
+	p.hidden = true
+	pages := p.view.App().Content.Pages
+	pages.RemovePage(drainProgressKey)
+	p.view.App().SetFocus(pages.CurrentPage().Item)
+}
+
+// drainSummary renders the drain progress, listing pods still in flight.
+func drainSummary(path string, t *dao.DrainTracker, over bool, err error) string {
+	var status string
+	switch {
+	case !over:
+		status = "[orange::]draining..."
+	case err != nil:
+		status = "[red::]" + tview.Escape(err.Error())
+	default:
+		status = "[green::]drained!"
+	}
+
+	ee := t.Events()
+	ll := []string{
+		fmt.Sprintf("%s %s", path, status),
+		fmt.Sprintf("[white::]%d/%d pods evicted", t.Count(dao.DrainEvicted), len(ee)),
+	}
+	var count int
+	for _, e := range ee {
+		if e.Status == dao.DrainEvicted {
+			continue
+		}
+		if count == drainMaxLines {
+			ll = append(ll, "[gray::]...")
+			break
+		}
+		count++
+		l := fmt.Sprintf("[%s::]%-8s[white::] %s", drainColor(e.Status), e.Status, e.Path)
+		if e.Message != "" {
+			l += " [gray::](" + tview.Escape(e.Message) + ")"
+		}
+		ll = append(ll, l)
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func drainColor(status string) string {
+	switch status {
+	case dao.DrainBlocked:
+		return "orange"
+	case dao.DrainFailed:
+		return "red"
+	case dao.DrainEvicting:
+		return "aqua"
+	default:
+		return "gray"
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -15,9 +15,13 @@ type DaemonSet struct {
 // NewDaemonSet returns a new viewer.
 func NewDaemonSet(gvr client.GVR) ResourceViewer {
 	d := DaemonSet{
-		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewLogsExtender(NewBrowser(gvr), nil),
+		ResourceViewer: NewScheduleExtender(
+			NewPortForwardExtender(
+				NewRestartExtender(
+					NewChaosExtender(
+						NewLogsExtender(NewBrowser(gvr), nil),
+					),
+				),
 			),
 		),
 	}
@@ -35,6 +39,7 @@ func (d *DaemonSet) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Revisions", revisionsCmd(d), true),
 	})
 }
 
@@ -0,0 +1,123 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// runEdit edits a resource with the validated edit session when an editor is
+// configured or with kubectl edit otherwise.
+func runEdit(a *App, gvr client.GVR, path string) {
+	ns, n := client.Namespaced(path)
+	if ok, err := a.Conn().CanI(ns, gvr.String(), []string{"patch"}); !ok || err != nil {
+		a.Flash().Err(fmt.Errorf("Current user can't edit resource %s", gvr))
+		return
+	}
+
+	if _, err := editorBin(); err == nil {
+		switch op, err := editResource(a, gvr, path); {
+		case errors.Is(err, dao.ErrEditCanceled):
+			a.Flash().Info("Edit canceled, no changes made")
+		case err != nil:
+			a.Flash().Err(err)
+		default:
+			a.Flash().Infof("%s %s edited", gvr.R(), path)
+			a.record(op)
+		}
+		return
+	}
+
+	args := make([]string, 0, 10)
+	args = append(args, "edit", gvr.R(), "-n", ns)
+	args = append(args, "--context", a.Config.K9s.CurrentContext)
+	if cfg := a.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
+		args = append(args, "--kubeconfig", *cfg)
+	}
+	if !runK(a, shellOpts{clear: true, args: append(args, n)}) {
+		a.Flash().Err(errors.New("Edit exec failed"))
+	}
+}
+
+// editResource edits a resource manifest in the configured editor. Changes are
+// dry-run server side first and the editor is reopened with the validation
+// failures until the manifest is valid or the edit is aborted. Conflicts are
+// reported rather than reopened as the edit can't resolve them. The returned
+// operation reverts the applied changes.
+func editResource(a *App, gvr client.GVR, path string) (model.Operation, error) {
+	s, err := newEditSession(a, gvr, path)
+	if err != nil {
+		return model.Operation{}, err
+	}
+
+	f, err := ioutil.TempFile("", "k9s-edit-*.yaml")
+	if err != nil {
+		return model.Operation{}, err
+	}
+	file := f.Name()
+	if err := f.Close(); err != nil {
+		return model.Operation{}, err
+	}
+	defer func() {
+		if err := os.Remove(file); err != nil {
+			log.Error().Err(err).Msgf("Removing edit buffer %s", file)
+		}
+	}()
+
+	var verr error
+	for {
+		if err := ioutil.WriteFile(file, s.Buffer(verr), 0600); err != nil {
+			return model.Operation{}, err
+		}
+		if !edit(a, shellOpts{clear: true, args: []string{file}}) {
+			return model.Operation{}, errors.New("Edit exec failed")
+		}
+		buff, err := ioutil.ReadFile(file)
+		if err != nil {
+			return model.Operation{}, err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		u, err := s.Validate(ctx, buff)
+		if err == nil {
+			err = s.Apply(ctx, u)
+		}
+		cancel()
+		if err == nil {
+			return editOperation(s, gvr, path, u), nil
+		}
+		if errors.Is(err, dao.ErrEditCanceled) || kerrors.IsConflict(err) {
+			return model.Operation{}, err
+		}
+		log.Debug().Err(err).Msgf("Edit validation failed for %s", path)
+		verr = err
+	}
+}
+
+func editOperation(s *dao.EditSession, gvr client.GVR, path string, u *unstructured.Unstructured) model.Operation {
+	return model.Operation{
+		Description: fmt.Sprintf("Edit %s %s", gvr, path),
+		Inverse:     fmt.Sprintf("Restore %s %s edited fields", gvr, path),
+		Revert: func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			return s.Revert(ctx, u)
+		},
+	}
+}
+
+func newEditSession(a *App, gvr client.GVR, path string) (*dao.EditSession, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+
+	return dao.NewEditSession(ctx, a.factory, gvr, path)
+}
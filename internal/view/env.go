@@ -14,29 +14,34 @@ type Env map[string]string
 var envRX = regexp.MustCompile(`\$(\!?[\w|\d|\-|]+)`)
 
 // Substitute replaces env variable keys from in a string with their corresponding values.
+// Keys are replaced in a single pass so substituted values are never expanded.
 func (e Env) Substitute(arg string) (string, error) {
-	kk := envRX.FindAllString(arg, -1)
-	if len(kk) == 0 {
-		return arg, nil
-	}
-
-	for _, k := range kk {
+	var err error
+	s := envRX.ReplaceAllStringFunc(arg, func(k string) string {
+		if err != nil {
+			return k
+		}
 		key, inverse := k[1:], false
 		if key[0] == '!' {
 			key, inverse = key[1:], true
 		}
 		v, ok := e[strings.ToUpper(key)]
 		if !ok {
-			return "", fmt.Errorf("no environment matching key %q:%q", k, key)
+			err = fmt.Errorf("no environment matching key %q:%q", k, key)
+			return k
 		}
-		if b, err := strconv.ParseBool(v); err == nil {
+		if b, perr := strconv.ParseBool(v); perr == nil {
 			if inverse {
 				b = !b
 			}
 			v = fmt.Sprintf("%t", b)
 		}
-		arg = strings.Replace(arg, k, v, -1)
+
+		return v
+	})
+	if err != nil {
+		return "", err
 	}
 
-	return arg, nil
+	return s, nil
 }
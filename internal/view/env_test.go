@@ -23,6 +23,7 @@ func TestEnvReplace(t *testing.T) {
 		"subs":      {arg: `{"spec" : {"suspend" : $COL0 }}`, e: `{"spec" : {"suspend" : fred }}`},
 		"boolean":   {arg: "$COL-BOOL", e: "false"},
 		"invert":    {arg: "$!COL-BOOL", e: "true"},
+		"verbatim":  {arg: "$COL-RAW and $A", e: "$A and 10"},
 	}
 
 	e := Env{
@@ -32,6 +33,7 @@ func TestEnvReplace(t *testing.T) {
 		"FRED":     "fred",
 		"COL-NAME": "zorg",
 		"COL-BOOL": "false",
+		"COL-RAW":  "$A",
 	}
 
 	for k := range uu {
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const errorTitle = "Error"
+
+// errorCmd shows the last classified error.
+func (a *App) errorCmd() {
+	r, ok := a.Flash().LastErr()
+	if !ok {
+		a.Flash().Info("No errors reported")
+		return
+	}
+	if err := a.inject(NewErrorPane(a, r)); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// ErrorPane describes an api failure and suggests remediations.
+type ErrorPane struct {
+	*Details
+
+	report client.ErrorReport
+}
+
+// NewErrorPane returns a new error pane.
+func NewErrorPane(app *App, r client.ErrorReport) *ErrorPane {
+	return &ErrorPane{
+		Details: NewDetails(app, errorTitle, string(r.Kind), false),
+		report:  r,
+	}
+}
+
+// Init initializes the component.
+func (e *ErrorPane) Init(ctx context.Context) error {
+	if err := e.Details.Init(ctx); err != nil {
+		return err
+	}
+	e.bindKeys()
+	e.Update(errorDetails(e.report))
+
+	return nil
+}
+
+func (e *ErrorPane) bindKeys() {
+	switch e.report.Kind {
+	case client.ErrKindRBAC:
+		e.Actions().Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("RBAC", e.rbacCmd, true),
+		})
+	case client.ErrKindNotFound, client.ErrKindTimeout:
+		e.Actions().Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Retry", e.backCmd(tcell.KeyCtrlR), true),
+		})
+	case client.ErrKindConflict, client.ErrKindWebhook:
+		e.Actions().Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Retry", e.backCmd(tcell.KeyCtrlR), true),
+			ui.KeyE: ui.NewKeyAction("Edit", e.backCmd(ui.KeyE), true),
+		})
+	}
+}
+
+func (e *ErrorPane) rbacCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := e.app.gotoResource(rbacRolesFor(e.report), "", false); err != nil {
+		e.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// backCmd returns to the previous view and runs the given action on it.
+func (e *ErrorPane) backCmd(key tcell.Key) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		e.app.PrevCmd(evt)
+		top, ok := e.app.Content.Top().(interface{ Actions() ui.KeyActions })
+		if !ok {
+			return nil
+		}
+		if a, ok := top.Actions()[key]; ok {
+			return a.Action(evt)
+		}
+
+		return nil
+	}
+}
+
+// rbacRolesFor returns the roles granting access to the denied resource.
+// Cluster scoped resources and cluster wide requests need clusterroles.
+func rbacRolesFor(r client.ErrorReport) string {
+	if r.ClusterScoped {
+		return "clusterroles"
+	}
+	if r.Status != nil && r.Status.Details != nil {
+		gr := schema.GroupResource{Group: r.Status.Details.Group, Resource: r.Status.Details.Kind}
+		for _, gvr := range dao.MetaAccess.AllGVRs() {
+			if gvr.GR().String() != gr.String() {
+				continue
+			}
+			if m, err := dao.MetaAccess.MetaFor(gvr); err == nil && !m.Namespaced {
+				return "clusterroles"
+			}
+			break
+		}
+	}
+
+	return "roles"
+}
+
+func errorDetails(r client.ErrorReport) string {
+	out := struct {
+		Kind    client.ErrorKind `json:"kind"`
+		Message string           `json:"message"`
+		Hint    string           `json:"hint,omitempty"`
+		Status  *metav1.Status   `json:"status,omitempty"`
+	}{
+		Kind:    r.Kind,
+		Message: r.Message,
+		Hint:    r.Hint,
+		Status:  r.Status,
+	}
+	raw, err := yaml.Marshal(out)
+	if err != nil {
+		return r.Message
+	}
+
+	return string(raw)
+}
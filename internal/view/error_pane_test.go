@@ -0,0 +1,38 @@
+package view_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestErrorPaneNew(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	uu := map[string]struct {
+		err   error
+		hints int
+	}{
+		"rbac":     {err: apierrors.NewForbidden(gr, "fred", errors.New("nope")), hints: 4},
+		"notFound": {err: apierrors.NewNotFound(gr, "fred"), hints: 4},
+		"conflict": {err: apierrors.NewConflict(gr, "fred", errors.New("stale")), hints: 5},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := client.ClassifyError(u.err)
+			v := view.NewErrorPane(view.NewApp(config.NewConfig(ks{})), r)
+
+			assert.Nil(t, v.Init(makeCtx()))
+			assert.Equal(t, "Error", v.Name())
+			assert.Equal(t, u.hints, len(v.Hints()))
+			assert.Contains(t, v.GetText(true), string(r.Kind))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -33,6 +34,7 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	stdin             io.Reader
 }
 
 func runK(a *App, opts shellOpts) bool {
@@ -72,19 +74,25 @@ func run(a *App, opts shellOpts) bool {
 }
 
 func edit(a *App, opts shellOpts) bool {
-	bin, err := exec.LookPath(os.Getenv("K9S_EDITOR"))
+	bin, err := editorBin()
 	if err != nil {
-		bin, err = exec.LookPath(os.Getenv("EDITOR"))
-		if err != nil {
-			log.Error().Err(err).Msgf("K9S_EDITOR|EDITOR not set")
-			return false
-		}
+		log.Error().Err(err).Msgf("K9S_EDITOR|EDITOR not set")
+		return false
 	}
 	opts.binary, opts.background = bin, false
 
 	return run(a, opts)
 }
 
+func editorBin() (string, error) {
+	bin, err := exec.LookPath(os.Getenv("K9S_EDITOR"))
+	if err != nil {
+		return exec.LookPath(os.Getenv("EDITOR"))
+	}
+
+	return bin, nil
+}
+
 func execute(opts shellOpts) error {
 	if opts.clear {
 		clearScreen()
@@ -111,6 +119,9 @@ func execute(opts shellOpts) error {
 		err = cmd.Start()
 	} else {
 		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if opts.stdin != nil {
+			cmd.Stdin = opts.stdin
+		}
 		_, _ = cmd.Stdout.Write([]byte(opts.banner))
 		err = cmd.Run()
 	}
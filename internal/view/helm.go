@@ -38,5 +38,32 @@ func (c *Helm) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftN: ui.NewKeyAction("Sort Name", c.GetTable().SortColCmd(nameCol, true), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", c.GetTable().SortColCmd(ageCol, true), false),
+		ui.KeyH:      ui.NewKeyAction("History", c.historyCmd, true),
+		ui.KeyU:      ui.NewKeyAction("Values", c.valuesCmd(false), true),
+		ui.KeyShiftU: ui.NewKeyAction("All Values", c.valuesCmd(true), true),
 	})
 }
+
+func (c *Helm) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := c.App().inject(NewHelmHistory(path)); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Helm) valuesCmd(all bool) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := c.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		showHelmValues(c.App(), path, 0, all)
+
+		return nil
+	}
+}
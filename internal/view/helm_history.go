@@ -0,0 +1,336 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const helmHistoryTitle = "history"
+
+var helmGVR = client.NewGVR("helm")
+
+// HelmHistory presents a helm release revisions.
+type HelmHistory struct {
+	*Table
+
+	path  string
+	model *staticModel
+	revs  []dao.HelmRevision
+}
+
+// NewHelmHistory returns a new helm release history viewer.
+func NewHelmHistory(path string) *HelmHistory {
+	return &HelmHistory{
+		Table: NewTable(client.NewGVR(helmHistoryTitle)),
+		path:  path,
+		model: &staticModel{Table: model.NewTable(client.NewGVR(helmHistoryTitle))},
+	}
+}
+
+// Init initializes the component.
+func (h *HelmHistory) Init(ctx context.Context) error {
+	if err := h.Table.Init(ctx); err != nil {
+		return err
+	}
+	h.SetModel(h.model)
+	h.SetColorerFn(helmHistoryColorer)
+	h.SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	h.Extras = h.path
+	h.bindKeys()
+	h.model.data = render.TableData{Header: helmHistoryHeader}
+	h.Update(h.model.data)
+	go h.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (h *HelmHistory) Name() string { return helmHistoryTitle }
+
+func (h *HelmHistory) bindKeys() {
+	h.Actions().Delete(tcell.KeyCtrlZ)
+	aa := ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", h.refreshCmd, true),
+		ui.KeyY:         ui.NewKeyAction("Manifest", h.manifestCmd, true),
+		ui.KeyU:         ui.NewKeyAction("Values", h.valuesCmd(false), true),
+		ui.KeyShiftU:    ui.NewKeyAction("All Values", h.valuesCmd(true), true),
+		ui.KeyD:         ui.NewKeyAction("Diff", h.diffCmd, true),
+		ui.KeyShiftV:    ui.NewKeyAction("Sort Revision", h.SortColCmd("REVISION", false), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+	}
+	if !h.app.Config.K9s.GetReadOnly() {
+		aa[ui.KeyR] = ui.NewKeyAction("Rollback", h.rollbackCmd, true)
+	}
+	h.Actions().Add(aa)
+}
+
+func (h *HelmHistory) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go h.load()
+
+	return nil
+}
+
+func (h *HelmHistory) manifestCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev, ok := h.selectedRevision()
+	if !ok {
+		return evt
+	}
+	showHelmManifest(h.app, h.path, rev)
+
+	return nil
+}
+
+func (h *HelmHistory) valuesCmd(all bool) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		rev, ok := h.selectedRevision()
+		if !ok {
+			return evt
+		}
+		showHelmValues(h.app, h.path, rev, all)
+
+		return nil
+	}
+}
+
+// diffCmd diffs the manifests of two marked revisions, the marked and
+// selected revisions or the selected and latest revisions.
+func (h *HelmHistory) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := h.GetSelectedItems()
+	switch {
+	case len(paths) > 2:
+		h.app.Flash().Warn("Mark at most 2 revisions to diff")
+		return nil
+	case len(paths) == 1 && h.MarkCount() == 1:
+		paths = append(paths, h.GetSelectedItem())
+	case len(paths) == 1 && len(h.revs) > 0:
+		paths = append(paths, strconv.Itoa(h.revs[0].Revision))
+	}
+	if len(paths) != 2 || paths[0] == paths[1] {
+		h.app.Flash().Warn("Pick two distinct revisions to diff")
+		return nil
+	}
+	from, _ := strconv.Atoi(paths[0])
+	to, _ := strconv.Atoi(paths[1])
+	if from > to {
+		from, to = to, from
+	}
+	go h.diff(from, to)
+
+	return nil
+}
+
+// diff fetches the revisions manifests off the ui thread and shows their diff.
+func (h *HelmHistory) diff(from, to int) {
+	var left, right string
+	hlm, err := helmAccessor(h.app)
+	if err == nil {
+		left, err = hlm.Manifest(h.path, from)
+	}
+	if err == nil {
+		right, err = hlm.Manifest(h.path, to)
+	}
+	h.app.QueueUpdateDraw(func() {
+		if err != nil {
+			h.app.Flash().Err(err)
+			return
+		}
+		if left == right {
+			h.app.Flash().Infof("Revisions %d and %d render the same manifest", from, to)
+		}
+		subject := fmt.Sprintf("%s %d..%d", h.path, from, to)
+		fromLabel, toLabel := fmt.Sprintf("%s@%d", h.path, from), fmt.Sprintf("%s@%d", h.path, to)
+		if err := h.app.inject(NewDiff(h.app, subject, fromLabel, toLabel, dao.SideBySide(left, right))); err != nil {
+			h.app.Flash().Err(err)
+		}
+	})
+}
+
+func (h *HelmHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev, ok := h.selectedRevision()
+	if !ok {
+		return evt
+	}
+	if len(h.revs) > 0 && h.revs[0].Revision == rev {
+		h.app.Flash().Warnf("Revision %d is the latest revision", rev)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rollback %s to revision %d?", h.path, rev)
+	level := h.app.Config.ConfirmLevel(config.ActionRollback)
+	dialog.ShowConfirm(h.app.Content.Pages, level, h.path, "Confirm Rollback", msg, func() {
+		h.app.Flash().Infof("Rolling back %s to revision %d...", h.path, rev)
+		go h.rollback(rev)
+	}, func() {})
+
+	return nil
+}
+
+func (h *HelmHistory) rollback(rev int) {
+	hlm, err := helmAccessor(h.app)
+	if err == nil {
+		err = hlm.Rollback(h.path, rev)
+	}
+	h.app.QueueUpdateDraw(func() {
+		if err != nil {
+			h.app.Flash().Err(err)
+			return
+		}
+		h.app.Flash().Infof("Release %s rolled back to revision %d", h.path, rev)
+	})
+	if err == nil {
+		h.load()
+	}
+}
+
+func (h *HelmHistory) selectedRevision() (int, bool) {
+	sel := h.GetSelectedItem()
+	if sel == "" {
+		return 0, false
+	}
+	rev, err := strconv.Atoi(sel)
+
+	return rev, err == nil
+}
+
+func (h *HelmHistory) load() {
+	var rr []dao.HelmRevision
+	hlm, err := helmAccessor(h.app)
+	if err == nil {
+		rr, err = hlm.History(h.path)
+	}
+	h.app.QueueUpdateDraw(func() {
+		if err != nil {
+			h.app.Flash().Err(err)
+			return
+		}
+		h.revs = rr
+		h.model.data = helmHistoryTableData(rr)
+		h.Update(h.model.data)
+	})
+}
+
+var helmHistoryHeader = render.Header{
+	render.HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "STATUS"},
+	render.HeaderColumn{Name: "CHART"},
+	render.HeaderColumn{Name: "APP VERSION"},
+	render.HeaderColumn{Name: "DESCRIPTION"},
+	render.HeaderColumn{Name: "AGE", Time: true, Decorator: render.AgeDecorator},
+}
+
+func helmHistoryColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 2 {
+		return tcell.ColorMediumSpringGreen
+	}
+	switch re.Row.Fields[1] {
+	case "deployed":
+		return tcell.ColorMediumSpringGreen
+	case "superseded":
+		return render.CompletedColor
+	case "failed":
+		return render.ErrColor
+	}
+
+	return render.ModColor
+}
+
+func helmHistoryTableData(rr []dao.HelmRevision) render.TableData {
+	data := render.TableData{
+		Header:    helmHistoryHeader,
+		RowEvents: make(render.RowEvents, 0, len(rr)),
+	}
+	for _, r := range rr {
+		rev := strconv.Itoa(r.Revision)
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: rev,
+			Fields: render.Fields{
+				rev,
+				r.Status,
+				r.Chart,
+				r.AppVersion,
+				r.Description,
+				time.Since(r.Updated).String(),
+			},
+		}))
+	}
+
+	return data
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func helmAccessor(app *App) (*dao.Helm, error) {
+	res, err := dao.AccessorFor(app.factory, helmGVR)
+	if err != nil {
+		return nil, err
+	}
+	hlm, ok := res.(*dao.Helm)
+	if !ok {
+		return nil, fmt.Errorf("expecting a helm accessor but got %T", res)
+	}
+
+	return hlm, nil
+}
+
+// showHelmManifest fetches a release manifest off the ui thread and shows it.
+func showHelmManifest(app *App, path string, rev int) {
+	go func() {
+		var raw string
+		hlm, err := helmAccessor(app)
+		if err == nil {
+			raw, err = hlm.Manifest(path, rev)
+		}
+		showHelmYAML(app, "Manifest", helmSubject(path, rev), raw, err)
+	}()
+}
+
+// showHelmValues fetches a release values off the ui thread and shows them.
+func showHelmValues(app *App, path string, rev int, all bool) {
+	title := "Values"
+	if all {
+		title = "All Values"
+	}
+	go func() {
+		var raw string
+		hlm, err := helmAccessor(app)
+		if err == nil {
+			raw, err = hlm.Values(path, rev, all)
+		}
+		showHelmYAML(app, title, helmSubject(path, rev), raw, err)
+	}()
+}
+
+func showHelmYAML(app *App, title, subject, raw string, err error) {
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		details := NewDetails(app, title, subject, true).SetContentType(config.ContentYAML).Update(raw)
+		if err := app.inject(details); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
+
+func helmSubject(path string, rev int) string {
+	if rev == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s@%d", path, rev)
+}
@@ -0,0 +1,26 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelmHistoryTableData(t *testing.T) {
+	data := helmHistoryTableData([]dao.HelmRevision{
+		{Revision: 2, Status: "deployed", Chart: "nginx-1.0.1", AppVersion: "1.19", Description: "Upgrade complete", Updated: time.Now()},
+		{Revision: 1, Status: "superseded", Chart: "nginx-1.0.0", AppVersion: "1.18", Description: "Install complete", Updated: time.Now()},
+	})
+
+	assert.Equal(t, len(helmHistoryHeader), len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, "1", data.RowEvents[1].Row.ID)
+	assert.Equal(t, []string{"1", "superseded", "nginx-1.0.0", "1.18", "Install complete"}, []string(data.RowEvents[1].Row.Fields[:5]))
+}
+
+func TestHelmSubject(t *testing.T) {
+	assert.Equal(t, "default/nginx", helmSubject("default/nginx", 0))
+	assert.Equal(t, "default/nginx@3", helmSubject("default/nginx", 3))
+}
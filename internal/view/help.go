@@ -29,6 +29,7 @@ type HelpFunc func() model.MenuHints
 type Help struct {
 	*Table
 
+	target                   model.Component
 	maxKey, maxDesc, maxRows int
 }
 
@@ -44,6 +45,7 @@ func (h *Help) Init(ctx context.Context) error {
 	if err := h.Table.Init(ctx); err != nil {
 		return nil
 	}
+	h.target = h.app.Content.Top()
 	h.SetSelectable(false, false)
 	h.resetTitle()
 	h.SetBorder(true)
@@ -55,15 +57,52 @@ func (h *Help) Init(ctx context.Context) error {
 	return nil
 }
 
+// Start starts the view.
+func (h *Help) Start() {
+	h.Table.Start()
+	h.CmdBuff().RemoveListener(h.Table)
+	h.CmdBuff().AddListener(h)
+}
+
+// Stop terminates the view.
+func (h *Help) Stop() {
+	h.CmdBuff().RemoveListener(h)
+	h.Table.Stop()
+}
+
+// BufferChanged indicates the buffer was changed.
+func (h *Help) BufferChanged(s string) {
+	h.build()
+	h.resetTitle()
+}
+
 func (h *Help) bindKeys() {
 	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS)
 	h.Actions().Set(ui.KeyActions{
-		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.resetCmd, false),
 		ui.KeyHelp:      ui.NewKeyAction("Back", h.app.PrevCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Back", h.filterCmd, false),
 	})
 }
 
+func (h *Help) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !h.CmdBuff().IsActive() {
+		return h.app.PrevCmd(evt)
+	}
+	h.CmdBuff().SetActive(false)
+
+	return nil
+}
+
+func (h *Help) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if h.CmdBuff().Empty() {
+		return h.app.PrevCmd(evt)
+	}
+	h.CmdBuff().Reset()
+
+	return nil
+}
+
 func (h *Help) computeMaxes(hh model.MenuHints) {
 	h.maxKey, h.maxDesc = 0, 0
 	for _, hint := range hh {
@@ -89,35 +128,107 @@ func (h *Help) computeExtraMaxes(ee map[string]string) {
 func (h *Help) build() {
 	h.Clear()
 
-	sections := []string{"RESOURCE", "GENERAL", "NAVIGATION", "HELP"}
+	res, plugins := h.showResource()
+	extras := h.filterExtras()
+	sections := []struct {
+		title string
+		hh    model.MenuHints
+	}{
+		{"RESOURCE", res},
+		{"PLUGINS", plugins},
+		{"GENERAL", h.filterHints(h.showGeneral())},
+		{"NAVIGATION", h.filterHints(h.showNav())},
+	}
+	if hh, err := h.showHotKeys(); err == nil {
+		sections = append(sections, struct {
+			title string
+			hh    model.MenuHints
+		}{"HOTKEYS", h.filterHints(hh)})
+	}
 
-	h.maxRows = len(h.showGeneral())
-	ff := []HelpFunc{
-		h.app.Content.Top().Hints,
-		h.showGeneral,
-		h.showNav,
-		h.showHelp,
+	h.maxRows = len(res) + len(extras)
+	for _, s := range sections {
+		if len(s.hh) > h.maxRows {
+			h.maxRows = len(s.hh)
+		}
 	}
 	var col int
-	extras := h.app.Content.Top().ExtraHints()
-	for i, section := range sections {
-		hh := ff[i]()
-		sort.Sort(hh)
-		h.computeMaxes(hh)
-		if extras != nil {
+	for i, s := range sections {
+		if i > 0 && len(s.hh) == 0 {
+			continue
+		}
+		sort.Sort(s.hh)
+		h.computeMaxes(s.hh)
+		if i == 0 && extras != nil {
 			h.computeExtraMaxes(extras)
 		}
-		h.addSection(col, section, hh)
+		h.addSection(col, s.title, s.hh)
 		if i == 0 && extras != nil {
-			h.addExtras(extras, col, len(hh))
+			h.addExtras(extras, col, len(s.hh))
 		}
 		col += 2
 	}
+}
 
-	if hh, err := h.showHotKeys(); err == nil {
-		h.computeMaxes(hh)
-		h.addSection(col, "HOTKEYS", hh)
+// showResource returns the bindings of the current view split into the view
+// and plugin ones.
+func (h *Help) showResource() (model.MenuHints, model.MenuHints) {
+	if h.target == nil {
+		return nil, nil
+	}
+	plugins := make(map[string]struct{})
+	for k := range pluginKeys() {
+		plugins[tcell.KeyNames[k]] = struct{}{}
 	}
+
+	var res, pp model.MenuHints
+	for _, hint := range h.filterHints(h.target.Hints()) {
+		if _, ok := plugins[hint.Mnemonic]; ok {
+			pp = append(pp, hint)
+			continue
+		}
+		res = append(res, hint)
+	}
+
+	return res, pp
+}
+
+func (h *Help) filterExtras() map[string]string {
+	if h.target == nil {
+		return nil
+	}
+	ee := h.target.ExtraHints()
+	if ee == nil {
+		return nil
+	}
+	q := strings.ToLower(h.CmdBuff().GetText())
+	if q == "" {
+		return ee
+	}
+	res := make(map[string]string, len(ee))
+	for k, v := range ee {
+		if strings.Contains(strings.ToLower(k+" "+v), q) {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+// filterHints returns the hints matching the current filter.
+func (h *Help) filterHints(hh model.MenuHints) model.MenuHints {
+	q := strings.ToLower(h.CmdBuff().GetText())
+	if q == "" {
+		return hh
+	}
+	res := make(model.MenuHints, 0, len(hh))
+	for _, hint := range hh {
+		if strings.Contains(strings.ToLower(hint.Mnemonic+" "+hint.Description), q) {
+			res = append(res, hint)
+		}
+	}
+
+	return res
 }
 
 func (h *Help) addExtras(extras map[string]string, col, size int) {
@@ -134,19 +245,6 @@ func (h *Help) addExtras(extras map[string]string, col, size int) {
 	}
 }
 
-func (h *Help) showHelp() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    "?",
-			Description: "Help",
-		},
-		{
-			Mnemonic:    "Ctrl-a",
-			Description: "Aliases",
-		},
-	}
-}
-
 func (h *Help) showNav() model.MenuHints {
 	return model.MenuHints{
 		{
@@ -204,61 +302,42 @@ func (h *Help) showHotKeys() (model.MenuHints, error) {
 	return mm, nil
 }
 
+// showGeneral returns the application bindings along with the shared ones of
+// the current view.
 func (h *Help) showGeneral() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    ":cmd",
-			Description: "Command mode",
-		},
-		{
-			Mnemonic:    "/term",
-			Description: "Filter mode",
-		},
-		{
-			Mnemonic:    "esc",
-			Description: "Back/Clear",
-		},
-		{
+	aa := make(ui.KeyActions)
+	if top, ok := h.target.(interface{ Actions() ui.KeyActions }); ok {
+		for k, a := range top.Actions() {
+			if a.Shared {
+				aa[k] = a
+			}
+		}
+	}
+	aa.Add(h.app.GetActions())
+	hh := aa.AllHints()
+	hh = append(hh,
+		model.MenuHint{
 			Mnemonic:    "tab",
 			Description: "Next Field",
 		},
-		{
+		model.MenuHint{
 			Mnemonic:    "backtab",
 			Description: "Previous Field",
 		},
-		{
-			Mnemonic:    "Ctrl-r",
-			Description: "Reload",
-		},
-		{
-			Mnemonic:    "Ctrl-u",
-			Description: "Clear command",
-		},
-		{
-			Mnemonic:    "Ctrl-e",
-			Description: "Toggle Header",
-		},
-		{
-			Mnemonic:    "q",
-			Description: "Quit",
-		},
-		{
-			Mnemonic:    "space",
-			Description: "Mark",
-		},
-		{
-			Mnemonic:    "Ctrl-space",
-			Description: "Clear Marks",
-		},
-		{
-			Mnemonic:    "Ctrl-s",
-			Description: "Save",
-		},
-	}
+	)
+
+	return hh
 }
 
 func (h *Help) resetTitle() {
-	h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
+	title := helpTitle
+	if h.target != nil {
+		title += "(" + h.target.Name() + ")"
+	}
+	if q := h.CmdBuff().GetText(); q != "" {
+		title += " /" + tview.Escape(q)
+	}
+	h.SetTitle(fmt.Sprintf(helpTitleFmt, title))
 }
 
 func (h *Help) addSpacer(c int) {
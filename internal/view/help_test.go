@@ -21,8 +21,25 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp()
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 22, v.GetRowCount())
-	assert.Equal(t, 8, v.GetColumnCount())
+	assert.Equal(t, 25, v.GetRowCount())
+	assert.Equal(t, 6, v.GetColumnCount())
+	assert.Equal(t, "<m>", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "Actions", strings.TrimSpace(v.GetCell(1, 1).Text))
+}
+
+func TestHelpFilter(t *testing.T) {
+	ctx := makeCtx()
+
+	app := ctx.Value(internal.KeyApp).(*view.App)
+	po := view.NewPod(client.NewGVR("v1/pods"))
+	po.Init(ctx)
+	app.Content.Push(po)
+
+	v := view.NewHelp()
+	assert.Nil(t, v.Init(ctx))
+	v.CmdBuff().SetText("attach")
+
+	assert.Equal(t, 2, v.GetRowCount())
 	assert.Equal(t, "<a>", strings.TrimSpace(v.GetCell(1, 0).Text))
 	assert.Equal(t, "Attach", strings.TrimSpace(v.GetCell(1, 1).Text))
 }
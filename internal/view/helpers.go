@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 )
 
 func k8sEnv(c *client.Config) Env {
@@ -69,7 +70,7 @@ func describeResource(app *App, model ui.Tabular, gvr, path string) {
 		return
 	}
 
-	details := NewDetails(app, "Describe", path, true).Update(yaml)
+	details := NewDetails(app, "Describe", path, true).SetContentType(config.ContentDescribe).Update(yaml)
 	if err := app.inject(details); err != nil {
 		app.Flash().Err(err)
 	}
@@ -145,7 +146,17 @@ func fwFQN(po, co string) string {
 }
 
 func isTCPPort(p string) bool {
-	return !strings.Contains(p, "UDP")
+	return portProtocol(p) == v1.ProtocolTCP
+}
+
+// portProtocol returns a rendered port protocol. Ports without suffix are TCP.
+func portProtocol(p string) v1.Protocol {
+	tokens := strings.Split(p, render.Glyph(render.ProtocolSign))
+	if len(tokens) < 2 || tokens[len(tokens)-1] == "" {
+		return v1.ProtocolTCP
+	}
+
+	return v1.Protocol(strings.ToUpper(tokens[len(tokens)-1]))
 }
 
 // ContainerID computes container ID based on ns/po/co.
@@ -177,3 +188,26 @@ func fqn(ns, n string) string {
 	}
 	return ns + "/" + n
 }
+
+// confirmName returns the value to type for typed confirmations. A single
+// resource asks for its name. Multiple resources ask for their shared namespace
+// or for the context name when they span namespaces or are cluster scoped.
+func confirmName(ctx string, paths []string) string {
+	if len(paths) == 1 {
+		_, n := client.Namespaced(paths[0])
+		return n
+	}
+	var ns string
+	for i, p := range paths {
+		pns, _ := client.Namespaced(p)
+		if i > 0 && pns != ns {
+			return ctx
+		}
+		ns = pns
+	}
+	if ns == "" {
+		return ctx
+	}
+
+	return ns
+}
@@ -137,8 +137,10 @@ func TestIsTCPPort(t *testing.T) {
 		p string
 		e bool
 	}{
-		"tcp": {"80╱TCP", true},
-		"udp": {"80╱UDP", false},
+		"tcp":   {"80╱TCP", true},
+		"plain": {"dns:53", true},
+		"udp":   {"80╱UDP", false},
+		"sctp":  {"co/sig:9000╱SCTP", false},
 	}
 
 	for k := range uu {
@@ -165,6 +167,26 @@ func TestFQN(t *testing.T) {
 	}
 }
 
+func TestConfirmName(t *testing.T) {
+	uu := map[string]struct {
+		paths []string
+		e     string
+	}{
+		"single":        {[]string{"blee/fred"}, "fred"},
+		"singleCluster": {[]string{"fred"}, "fred"},
+		"sameNS":        {[]string{"blee/fred", "blee/zorg"}, "blee"},
+		"mixedNS":       {[]string{"blee/fred", "duh/zorg"}, "ctx-1"},
+		"cluster":       {[]string{"fred", "zorg"}, "ctx-1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, confirmName("ctx-1", u.paths))
+		})
+	}
+}
+
 func TestUrlFor(t *testing.T) {
 	uu := map[string]struct {
 		cfg      config.BenchConfig
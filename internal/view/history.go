@@ -0,0 +1,50 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+// initHistory loads the commands histories and picks the current context one.
+func (a *App) initHistory() {
+	size := a.Config.K9s.GetCommandHistory().Size
+	hh, err := model.LoadHistories(config.K9sHistoryFile, size)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to load command history %q", config.K9sHistoryFile)
+		hh = model.NewHistories(size)
+	}
+	a.histories = hh
+	a.cmdHistory = hh.For(a.Config.K9s.CurrentContext)
+}
+
+// useHistory switches to the given context commands history.
+func (a *App) useHistory(ctx string) {
+	if a.histories == nil {
+		return
+	}
+	a.cmdHistory = a.histories.For(ctx)
+}
+
+// pushHistory records a command in the current context history and saves
+// the histories in the background so a crash does not lose them.
+func (a *App) pushHistory(cmd string) {
+	a.cmdHistory.Push(cmd)
+	go a.saveHistory()
+}
+
+func (a *App) saveHistory() {
+	if a.histories == nil {
+		return
+	}
+	if err := a.histories.Save(config.K9sHistoryFile); err != nil {
+		log.Error().Err(err).Msgf("Unable to save command history %q", config.K9sHistoryFile)
+	}
+}
+
+// recallCommand returns the current context past commands fuzzy matching a query.
+func (a *App) recallCommand(q string) sort.StringSlice {
+	return a.cmdHistory.Recall(q)
+}
@@ -0,0 +1,180 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	hpaLiveTitle       = "hpa-live"
+	hpaLiveRefreshRate = 5 * time.Second
+)
+
+// HorizontalPodAutoscaler represents an autoscaler viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{ResourceViewer: NewBrowser(gvr)}
+	h.GetTable().SetEnterFn(showHPALive)
+
+	return &h
+}
+
+func showHPALive(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewHPALive(path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// HPALive presents an autoscaler metrics, replicas trend and scaling events.
+type HPALive struct {
+	*Table
+
+	path   string
+	model  *staticModel
+	cancel context.CancelFunc
+}
+
+// NewHPALive returns a new autoscaler live viewer.
+func NewHPALive(path string) *HPALive {
+	return &HPALive{
+		Table: NewTable(client.NewGVR(hpaLiveTitle)),
+		path:  path,
+		model: &staticModel{Table: model.NewTable(client.NewGVR(hpaLiveTitle))},
+	}
+}
+
+// Init initializes the component.
+func (h *HPALive) Init(ctx context.Context) error {
+	if err := h.Table.Init(ctx); err != nil {
+		return err
+	}
+	h.SetModel(h.model)
+	h.SetColorerFn(render.HPALive{}.ColorerFunc())
+	h.SetBorderFocusColor(tcell.ColorAqua)
+	h.SetSelectedStyle(tcell.ColorBlack, tcell.ColorAqua, tcell.AttrNone)
+	h.Extras = h.path
+	h.bindKeys()
+	h.model.data = render.TableData{Header: render.HPALive{}.Header("")}
+	h.Update(h.model.data)
+
+	return nil
+}
+
+// Name returns the component name.
+func (h *HPALive) Name() string { return hpaLiveTitle }
+
+// Start runs the component and refreshes the autoscaler status periodically.
+func (h *HPALive) Start() {
+	h.Table.Start()
+
+	var ctx context.Context
+	ctx, h.cancel = context.WithCancel(context.Background())
+	go func() {
+		for {
+			h.load()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(hpaLiveRefreshRate):
+			}
+		}
+	}()
+}
+
+// Stop terminates the component.
+func (h *HPALive) Stop() {
+	if h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+	h.Table.Stop()
+}
+
+func (h *HPALive) bindKeys() {
+	h.Actions().Delete(tcell.KeyCtrlZ)
+	h.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", h.refreshCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", h.SortColCmd("KIND", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+	})
+}
+
+func (h *HPALive) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go h.load()
+
+	return nil
+}
+
+func (h *HPALive) load() {
+	r, err := dao.FetchHPAReport(h.app.factory, h.path)
+	h.app.QueueUpdateDraw(func() {
+		if err != nil {
+			h.app.Flash().Err(err)
+			return
+		}
+		data, err := hpaTableData(r)
+		if err != nil {
+			h.app.Flash().Err(err)
+			return
+		}
+		h.model.data = data
+		h.Extras = h.path + " -> " + r.Reference
+		h.Update(data)
+	})
+}
+
+func hpaTableData(r *dao.HPAReport) (render.TableData, error) {
+	var re render.HPALive
+	data := render.TableData{Header: re.Header("")}
+	ii := []render.HPAItem{{
+		ID:      r.Path,
+		Kind:    render.HPAKindReplicas,
+		Name:    fmt.Sprintf("min=%d max=%d", r.Min, r.Max),
+		Current: strconv.Itoa(int(r.Current)),
+		Target:  strconv.Itoa(int(r.Desired)),
+		Live:    render.Sparkline(r.Replicas),
+		Details: fmt.Sprintf("%d samples", len(r.Replicas)),
+	}}
+	for _, m := range r.Metrics {
+		ii = append(ii, render.HPAItem{
+			ID:      m.Type + ":" + m.Name,
+			Kind:    render.HPAKindMetric,
+			Name:    m.Type + " " + m.Name,
+			Current: m.Current,
+			Target:  m.Target,
+			Live:    m.Live,
+		})
+	}
+	for _, e := range r.Events {
+		ii = append(ii, render.HPAItem{
+			ID:      string(e.UID),
+			Kind:    render.HPAKindEvent,
+			Name:    e.Reason,
+			Current: e.Type,
+			Details: e.Message,
+		})
+	}
+
+	for _, i := range ii {
+		var row render.Row
+		if err := re.Render(i, "", &row); err != nil {
+			return data, err
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, row))
+	}
+
+	return data, nil
+}
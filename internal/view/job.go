@@ -1,9 +1,19 @@
 package view
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -18,12 +28,105 @@ type Job struct {
 // NewJob returns a new viewer.
 func NewJob(gvr client.GVR) ResourceViewer {
 	j := Job{ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil)}
+	j.SetBindKeysFn(j.bindKeys)
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetColorerFn(render.Job{}.ColorerFunc())
 
 	return &j
 }
 
+// aggregateLogsTimeout tracks how long to wait for a job pods logs.
+const aggregateLogsTimeout = 30 * time.Second
+
+func (j *Job) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyA:        ui.NewKeyAction("Aggregate Logs", j.aggregateLogsCmd, true),
+		tcell.KeyCtrlT: ui.NewKeyAction("Rerun", j.rerunCmd, true),
+	})
+}
+
+func (j *Job) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := j.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	res, err := dao.AccessorFor(j.App().factory, j.GVR())
+	if err != nil {
+		j.App().Flash().Err(err)
+		return nil
+	}
+	r, ok := res.(dao.Rerunnable)
+	if !ok {
+		j.App().Flash().Err(fmt.Errorf("expecting a rerunnable resource for %q", j.GVR()))
+		return nil
+	}
+
+	level := j.App().Config.ConfirmLevel(config.ActionRestart)
+	msg := fmt.Sprintf("Rerun job %s?", path)
+	dialog.ShowConfirm(j.App().Content.Pages, level, confirmName(j.App().Config.K9s.CurrentContext, []string{path}), "Confirm Rerun", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		defer cancel()
+		n, err := r.Rerun(ctx, path)
+		if err != nil {
+			j.App().Flash().Errf("Job rerun failed %v", err)
+			return
+		}
+		j.App().Flash().Infof("Job %s created", n)
+	}, func() {})
+
+	return nil
+}
+
+func (j *Job) aggregateLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := j.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, _ := client.Namespaced(path)
+	if _, err := j.App().factory.CanForResource(ns, "v1/pods", client.MonitorAccess); err != nil {
+		j.App().Flash().Err(err)
+		return nil
+	}
+
+	var res dao.Job
+	res.Init(j.App().factory, j.GVR())
+	lines := int64(config.DefaultLoggerTailCount)
+	if l := j.App().Config.K9s.Logger; l != nil {
+		lines = l.TailCount
+	}
+	j.App().Flash().Infof("Aggregating logs for job %s...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), aggregateLogsTimeout)
+		defer cancel()
+		ll, err := res.AggregateLogs(ctx, path, lines)
+		j.App().QueueUpdateDraw(func() {
+			if err != nil {
+				j.App().Flash().Err(err)
+				return
+			}
+			if len(ll) == 0 {
+				j.App().Flash().Warnf("No logs found for job %s", path)
+				return
+			}
+			details := NewDetails(j.App(), "Job Logs", path, true).Update(renderJobLogs(ll))
+			if err := j.App().inject(details); err != nil {
+				j.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func renderJobLogs(ll dao.LogItems) string {
+	var b strings.Builder
+	for _, l := range ll {
+		fmt.Fprintf(&b, "[green::]%-30s [aqua::]%s:%s[-::] %s\n", l.Timestamp, l.Pod, l.Container, tview.Escape(string(l.Bytes)))
+	}
+
+	return b.String()
+}
+
 func (*Job) showPods(app *App, model ui.Tabular, gvr, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
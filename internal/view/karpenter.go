@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+// NodePool represents a Karpenter NodePool viewer.
+type NodePool struct {
+	ResourceViewer
+}
+
+// NewNodePool returns a new viewer.
+func NewNodePool(gvr client.GVR) ResourceViewer {
+	n := NodePool{ResourceViewer: NewBrowser(gvr)}
+	n.SetBindKeysFn(n.bindKeys)
+	n.GetTable().SetEnterFn(n.showClaims)
+	n.GetTable().SetColorerFn(render.NodePool{}.ColorerFunc())
+
+	return &n
+}
+
+func (n *NodePool) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Nodes", n.GetTable().SortColCmd("NODES", false), false),
+	})
+}
+
+func (n *NodePool) showClaims(app *App, _ ui.Tabular, _, path string) {
+	gvr, err := dao.KarpenterGVR("nodeclaims")
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	v := NewNodeClaim(gvr)
+	v.SetContextFn(nodeClaimCtx(path))
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func nodeClaimCtx(pool string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, pool)
+		return context.WithValue(ctx, internal.KeyLabels, render.KarpenterNodePoolLabel+"="+pool)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// NodeClaim represents a Karpenter NodeClaim viewer.
+type NodeClaim struct {
+	ResourceViewer
+}
+
+// NewNodeClaim returns a new viewer.
+func NewNodeClaim(gvr client.GVR) ResourceViewer {
+	n := NodeClaim{ResourceViewer: NewBrowser(gvr)}
+	n.SetBindKeysFn(n.bindKeys)
+	n.GetTable().SetEnterFn(n.showPods)
+	n.GetTable().SetColorerFn(render.NodeClaim{}.ColorerFunc())
+
+	return &n
+}
+
+func (n *NodeClaim) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftE: ui.NewKeyAction("Events", n.eventsCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", n.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort NodePool", n.GetTable().SortColCmd("NODEPOOL", true), false),
+	})
+}
+
+func (n *NodeClaim) showPods(app *App, _ ui.Tabular, gvr, path string) {
+	node, err := n.nodeName(gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if node == "" {
+		app.Flash().Warnf("NodeClaim %s has not registered a node yet", path)
+		return
+	}
+	showPods(app, path, "", "spec.nodeName="+node)
+}
+
+// nodeName returns the node registered by a claim off the informer cache the
+// view is already backed by.
+func (n *NodeClaim) nodeName(gvr, path string) (string, error) {
+	o, err := n.App().factory.Get(gvr, path, false, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	node, _, err := unstructured.NestedString(u.Object, "status", "nodeName")
+
+	return node, err
+}
+
+func (n *NodeClaim) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	go func() {
+		node, err := n.nodeName(n.GVR().String(), path)
+		var raw []byte
+		if err == nil {
+			var ee []dao.EventSummary
+			if ee, err = dao.FetchKarpenterEvents(n.App().factory, path, node); err == nil {
+				raw, err = yaml.Marshal(ee)
+			}
+		}
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), "Events", path, true).Update(string(raw))
+			if err := n.App().inject(details); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// showNodeClaim navigates to the Karpenter NodeClaim owning a node.
+func showNodeClaim(app *App, node string) {
+	gvr, claim, err := dao.FetchNodeClaimFor(app.factory, node)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	v := NewNodeClaim(gvr)
+	v.SetInstance(claim)
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,67 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+var knRevisionGVR = client.NewGVR("serving.knative.dev/v1/revisions")
+
+// KnService represents a Knative Service viewer.
+type KnService struct {
+	ResourceViewer
+}
+
+// NewKnService returns a new viewer.
+func NewKnService(gvr client.GVR) ResourceViewer {
+	s := KnService{ResourceViewer: NewBrowser(gvr)}
+	s.GetTable().SetEnterFn(s.showRevisions)
+	s.GetTable().SetColorerFn(render.KnService{}.ColorerFunc())
+
+	return &s
+}
+
+func (s *KnService) showRevisions(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	v := NewKnRevision(knRevisionGVR)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, render.KnServiceLabel+"="+n)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// KnRevision represents a Knative Revision viewer.
+type KnRevision struct {
+	ResourceViewer
+}
+
+// NewKnRevision returns a new viewer.
+func NewKnRevision(gvr client.GVR) ResourceViewer {
+	r := KnRevision{ResourceViewer: NewBrowser(gvr)}
+	r.SetBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.showPods)
+	r.GetTable().SetColorerFn(render.KnRevision{}.ColorerFunc())
+
+	return &r
+}
+
+func (r *KnRevision) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", r.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftG: ui.NewKeyAction("Sort Generation", r.GetTable().SortColCmd("GENERATION", false), false),
+	})
+}
+
+func (r *KnRevision) showPods(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showPods(app, path, render.KnRevisionLabel+"="+n, "")
+}
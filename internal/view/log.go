@@ -5,8 +5,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -38,6 +42,9 @@ type Log struct {
 	indicator  *LogIndicator
 	ansiWriter io.Writer
 	model      *model.Log
+	stream     *logStreamer
+	sink       *logSinker
+	format     dao.LogFormat
 }
 
 var _ model.Component = (*Log)(nil)
@@ -56,12 +63,39 @@ func NewLog(gvr client.GVR, path, co string, prev bool) *Log {
 	return &l
 }
 
+// NewNamespaceLog returns a log viewer aggregating all pods in a namespace
+// matching a label selector.
+func NewNamespaceLog(ns, sel string) *Log {
+	opts := buildLogOpts(ns, "", false, true, config.DefaultLoggerTailCount)
+	opts.Selector = sel
+
+	return &Log{
+		Flex:  tview.NewFlex(),
+		model: model.NewLog(client.NewGVR("v1/namespaces"), opts, flushTimeout),
+	}
+}
+
+// NewTailLog returns a log viewer aggregating all pods in a namespace matching
+// a label selector and pod and container names filters, stern style.
+func NewTailLog(ns, sel string, po, co *regexp.Regexp) *Log {
+	opts := buildLogOpts(ns, "", false, true, config.DefaultLoggerTailCount)
+	opts.Selector, opts.PodFilter, opts.ContainerFilter = sel, po, co
+
+	return &Log{
+		Flex:  tview.NewFlex(),
+		model: model.NewLog(client.NewGVR("v1/namespaces"), opts, flushTimeout),
+	}
+}
+
 // Init initializes the viewer.
 func (l *Log) Init(ctx context.Context) (err error) {
 	if l.app, err = extractApp(ctx); err != nil {
 		return err
 	}
 	l.model.Configure(l.app.Config.K9s.Logger)
+	if err := l.model.SetBackend(l.app.Config.K9s.ActiveCluster().LogBackend); err != nil {
+		l.app.Flash().Err(err)
+	}
 
 	l.SetBorder(true)
 	l.SetDirection(tview.FlexRow)
@@ -163,6 +197,8 @@ func (l *Log) Start() {
 
 // Stop terminates the component.
 func (l *Log) Stop() {
+	l.stopStream()
+	l.stopSink()
 	l.model.Stop()
 	l.model.RemoveListener(l)
 	l.app.Styles.RemoveListener(l)
@@ -188,6 +224,9 @@ func (l *Log) bindKeys() {
 		ui.KeyT:        ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
 		ui.KeyW:        ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
 		tcell.KeyCtrlS: ui.NewKeyAction("Save", l.SaveCmd, true),
+		ui.KeyX:        ui.NewKeyAction("Toggle Web Stream", l.toggleStreamCmd, true),
+		ui.KeyJ:        ui.NewKeyAction("Toggle JSON", l.toggleJSONCmd, true),
+		ui.KeyE:        ui.NewKeyAction("Toggle Sink", l.toggleSinkCmd, true),
 	})
 }
 
@@ -244,7 +283,7 @@ func (l *Log) Flush(lines dao.LogItems) {
 
 	showTime := l.Indicator().showTime
 	ll := make([][]byte, len(lines))
-	lines.Render(showTime, ll)
+	lines.RenderAs(showTime, l.format, l.jsonFields(), ll)
 	fmt.Fprintln(l.ansiWriter, string(bytes.Join(ll, []byte("\n"))))
 	l.logs.ScrollToEnd()
 	l.indicator.Refresh()
@@ -319,6 +358,93 @@ func saveData(cluster, name, data string) (string, error) {
 	return path, nil
 }
 
+// toggleStreamCmd streams the logs to a localhost web page.
+func (l *Log) toggleStreamCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+	if l.stream != nil {
+		l.stopStream()
+		l.app.Flash().Info("Log stream stopped")
+		return nil
+	}
+
+	s, err := NewPaneStream(render.RedactText(l.model.GetPath()))
+	if err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	addr := net.JoinHostPort("127.0.0.1", strconv.Itoa(defaultStreamPort))
+	if err := s.Start(addr); err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	l.stream = &logStreamer{
+		stream:   s,
+		showTime: l.Indicator().Timestamp,
+	}
+	l.model.AddListener(l.stream)
+	l.app.Flash().Infof("Streaming logs on http://%s/?token=%s", addr, s.Token())
+
+	return nil
+}
+
+func (l *Log) stopStream() {
+	if l.stream == nil {
+		return
+	}
+	l.model.RemoveListener(l.stream)
+	l.stream.stream.Stop()
+	l.stream = nil
+}
+
+// toggleSinkCmd continuously writes the filtered logs to a file or a command.
+func (l *Log) toggleSinkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+	if l.sink != nil {
+		target := l.sink.sink.Target()
+		l.stopSink()
+		l.app.Flash().Infof("Log sink %s stopped", target)
+		return nil
+	}
+
+	cfg := l.app.Config.K9s.Logger.LogSink()
+	s, err := dao.NewLogSink(logSinkOptions(cfg, l.app.Config.K9s.CurrentCluster, l.model.GetPath()))
+	if err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	var sinker *logSinker
+	sinker = newLogSinker(s, l.Indicator().Timestamp, func(err error) {
+		l.app.QueueUpdateDraw(func() {
+			l.app.Flash().Err(fmt.Errorf("log sink failed: %w", err))
+			if l.sink == sinker {
+				l.stopSink()
+			}
+		})
+	})
+	l.sink = sinker
+	l.model.AddListener(l.sink)
+	l.app.Flash().Infof("Writing logs to %s", s.Target())
+
+	return nil
+}
+
+func (l *Log) stopSink() {
+	if l.sink == nil {
+		return
+	}
+	l.model.RemoveListener(l.sink)
+	go func(s *dao.LogSink) {
+		if err := s.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing log sink %s", s.Target())
+		}
+	}(l.sink.sink)
+	l.sink = nil
+}
+
 func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
 	l.model.Clear()
 	return nil
@@ -335,6 +461,27 @@ func (l *Log) toggleTimestampCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// toggleJSONCmd rotates JSON log lines through the raw, columns and pretty formats.
+func (l *Log) toggleJSONCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.format = l.format.Next()
+	l.app.Flash().Infof("JSON logs format set to %s", l.format)
+	l.model.Refresh()
+
+	return nil
+}
+
+func (l *Log) jsonFields() []string {
+	if ff := l.app.Config.K9s.Logger.JSONFields; len(ff) > 0 {
+		return ff
+	}
+
+	return dao.DefaultLogColumns
+}
+
 func (l *Log) toggleTextWrapCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -0,0 +1,288 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	logDownloadKey         = "logDownload"
+	logDownloadProgressKey = "logDownloadProgress"
+	logDownloadMaxLines    = 10
+)
+
+// downloadLogsCmd downloads the selected pods complete logs.
+func (p *Pod) downloadLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := p.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+	defaults := dao.LogDownloadOptions{
+		Dir: filepath.Join(config.K9sDumpDir, p.App().Config.K9s.CurrentCluster, "logs", time.Now().Format("20060102-150405")),
+	}
+	ShowLogDownload(p, paths, defaults, downloadLogs)
+
+	return nil
+}
+
+// LogDownloadFunc represents a logs download callback function.
+type LogDownloadFunc func(v ResourceViewer, paths []string, opts dao.LogDownloadOptions)
+
+// ShowLogDownload pops a logs download dialog.
+func ShowLogDownload(view ResourceViewer, paths []string, defaults dao.LogDownloadOptions, okFn LogDownloadFunc) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	opts := defaults
+	f.AddInputField("Directory:", defaults.Dir, 0, nil, func(v string) {
+		opts.Dir = strings.TrimSpace(v)
+	})
+	f.AddInputField("Limit Bytes:", "", 0, nil, func(v string) {
+		b, err := asBytesOpt(v)
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		view.App().Flash().Clear()
+		opts.LimitBytes = b
+	})
+	f.AddInputField("Since:", "", 0, nil, func(v string) {
+		var d time.Duration
+		if v = strings.TrimSpace(v); v != "" {
+			var err error
+			if d, err = asDurOpt(v); err != nil {
+				view.App().Flash().Err(err)
+				return
+			}
+		}
+		view.App().Flash().Clear()
+		opts.Since = d
+	})
+	f.AddCheckbox("Previous:", defaults.Previous, func(v bool) {
+		opts.Previous = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		dismissLogDownload(view, pages)
+	})
+	f.AddButton("OK", func() {
+		if opts.Dir == "" {
+			view.App().Flash().Err(errors.New("a download directory is required"))
+			return
+		}
+		dismissLogDownload(view, pages)
+		okFn(view, paths, opts)
+	})
+
+	modal := tview.NewModalForm("<Download Logs>", f)
+	modal.SetText(fmt.Sprintf("%d pod(s)", len(paths)))
+	modal.SetDoneFunc(func(_ int, b string) {
+		dismissLogDownload(view, pages)
+	})
+
+	pages.AddPage(logDownloadKey, modal, false, true)
+	pages.ShowPage(logDownloadKey)
+	view.App().SetFocus(pages.GetPrimitive(logDownloadKey))
+}
+
+func dismissLogDownload(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(logDownloadKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func downloadLogs(v ResourceViewer, paths []string, opts dao.LogDownloadOptions) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := showLogDownloadProgress(v, opts.Dir, cancel)
+	go func() {
+		defer cancel()
+		err := dao.DownloadLogs(ctx, v.App().factory, paths, opts, func(d dao.LogDownload) {
+			v.App().QueueUpdateDraw(func() {
+				p.report(d)
+			})
+		})
+		v.App().QueueUpdateDraw(func() {
+			p.done(err)
+			switch {
+			case err == nil:
+				v.App().Flash().Infof("Logs downloaded to %s", opts.Dir)
+			case errors.Is(err, context.Canceled):
+				v.App().Flash().Warnf("Logs download canceled")
+			default:
+				v.App().Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// logDownloadProgress tracks a logs download progress overlay.
+type logDownloadProgress struct {
+	view   ResourceViewer
+	dir    string
+	ids    []string
+	dd     map[string]dao.LogDownload
+	modal  *tview.ModalForm
+	hidden bool
+	err    error
+	over   bool
+}
+
+// showLogDownloadProgress pops a logs download progress overlay. Hiding the
+// overlay keeps the download going while canceling it stops it.
+func showLogDownloadProgress(view ResourceViewer, dir string, cancel func()) *logDownloadProgress {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor())
+
+	p := logDownloadProgress{view: view, dir: dir, dd: make(map[string]dao.LogDownload)}
+	pages := view.App().Content.Pages
+	f.AddButton("Hide", p.hide)
+	f.AddButton("Cancel", func() {
+		cancel()
+		p.hide()
+	})
+
+	p.modal = tview.NewModalForm("<Downloading Logs>", f)
+	p.modal.SetDoneFunc(func(_ int, b string) {
+		p.hide()
+	})
+	p.refresh()
+
+	pages.AddPage(logDownloadProgressKey, p.modal, false, true)
+	pages.ShowPage(logDownloadProgressKey)
+	view.App().SetFocus(pages.GetPrimitive(logDownloadProgressKey))
+
+	return &p
+}
+
+func (p *logDownloadProgress) report(d dao.LogDownload) {
+	if _, ok := p.dd[d.ID()]; !ok {
+		p.ids = append(p.ids, d.ID())
+	}
+	p.dd[d.ID()] = d
+	p.refresh()
+}
+
+func (p *logDownloadProgress) refresh() {
+	if p.hidden {
+		return
+	}
+	dd := make([]dao.LogDownload, 0, len(p.ids))
+	for _, id := range p.ids {
+		dd = append(dd, p.dd[id])
+	}
+	p.modal.SetText(logDownloadSummary(p.dir, dd, p.over, p.err))
+}
+
+func (p *logDownloadProgress) done(err error) {
+	p.over, p.err = true, err
+	p.refresh()
+}
+
+func (p *logDownloadProgress) hide() {
+	if p.hidden {
+		return
+	}
+	p.hidden = true
+	pages := p.view.App().Content.Pages
+	pages.RemovePage(logDownloadProgressKey)
+	p.view.App().SetFocus(pages.CurrentPage().Item)
+}
+
+// logDownloadSummary renders the download progress, listing the logs in
+// flight or failed.
+func logDownloadSummary(dir string, dd []dao.LogDownload, over bool, err error) string {
+	var status string
+	switch {
+	case !over:
+		status = "[orange::]downloading..."
+	case err != nil:
+		status = "[red::]" + tview.Escape(err.Error())
+	default:
+		status = "[green::]done!"
+	}
+
+	var done int
+	var total int64
+	for _, d := range dd {
+		if d.Status == dao.DownloadDone {
+			done++
+		}
+		total += d.Bytes
+	}
+	ll := []string{
+		fmt.Sprintf("%s %s", tview.Escape(dir), status),
+		fmt.Sprintf("[white::]%d/%d logs downloaded (%s)", done, len(dd), asBytes(total)),
+	}
+	var count int
+	for _, d := range dd {
+		if d.Status == dao.DownloadDone || d.Status == dao.DownloadPending {
+			continue
+		}
+		if count == logDownloadMaxLines {
+			ll = append(ll, "[gray::]...")
+			break
+		}
+		count++
+		l := fmt.Sprintf("[%s::]%-8s[white::] %s %s", downloadColor(d.Status), d.Status, d.ID(), asBytes(d.Bytes))
+		if d.Message != "" {
+			l += " [gray::](" + tview.Escape(d.Message) + ")"
+		}
+		ll = append(ll, l)
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func downloadColor(status string) string {
+	switch status {
+	case dao.DownloadFailed:
+		return "red"
+	case dao.DownloadRunning:
+		return "aqua"
+	default:
+		return "gray"
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func asBytesOpt(v string) (int64, error) {
+	if v = strings.TrimSpace(v); v == "" {
+		return 0, nil
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte limit %q, ie 10Mi", v)
+	}
+
+	return q.Value(), nil
+}
+
+func asBytes(b int64) string {
+	return render.AsThousands(b/1024) + "Ki"
+}
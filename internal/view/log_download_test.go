@@ -0,0 +1,52 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsBytesOpt(t *testing.T) {
+	uu := map[string]struct {
+		v   string
+		e   int64
+		err string
+	}{
+		"blank": {},
+		"plain": {v: "2048", e: 2048},
+		"mi":    {v: " 10Mi ", e: 10 << 20},
+		"toast": {v: "blee", err: `invalid byte limit "blee", ie 10Mi`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b, err := asBytesOpt(u.v)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, b)
+		})
+	}
+}
+
+func TestLogDownloadSummary(t *testing.T) {
+	dd := []dao.LogDownload{
+		{Path: "fred/p1", Container: "main", Status: dao.DownloadDone, Bytes: 4096},
+		{Path: "fred/p1", Container: "sidecar", Status: dao.DownloadFailed, Message: "boom"},
+		{Path: "fred/p2", Container: "main", Status: dao.DownloadRunning, Bytes: 2048},
+		{Path: "fred/p3", Container: "main", Status: dao.DownloadPending},
+	}
+
+	assert.Equal(t, "/tmp/logs [orange::]downloading...\n"+
+		"[white::]1/4 logs downloaded (6Ki)\n"+
+		"[red::]failed  [white::] fred/p1:sidecar 0Ki [gray::](boom)\n"+
+		"[aqua::]running [white::] fred/p2:main 2Ki",
+		logDownloadSummary("/tmp/logs", dd, false, nil))
+	assert.Equal(t, "/tmp/logs [red::]1/4 logs download failed\n[white::]0/0 logs downloaded (0Ki)",
+		logDownloadSummary("/tmp/logs", nil, true, errors.New("1/4 logs download failed")))
+}
@@ -0,0 +1,85 @@
+package view
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// logSinker writes the tailed log lines to a log sink.
+type logSinker struct {
+	sink     *dao.LogSink
+	showTime func() bool
+	failed   func(error)
+
+	// replay tracks whether the next lines are a replay of the logs buffer.
+	replay bool
+	last   time.Time
+	err    error
+}
+
+func newLogSinker(s *dao.LogSink, showTime func() bool, failed func(error)) *logSinker {
+	return &logSinker{sink: s, showTime: showTime, failed: failed}
+}
+
+// logSinkOptions returns the sink options for a given log resource path.
+func logSinkOptions(cfg *config.LogSink, cluster, path string) dao.LogSinkOptions {
+	opts := dao.LogSinkOptions{
+		Command:  cfg.Command,
+		MaxSize:  int64(cfg.MaxSize) * 1024 * 1024,
+		MaxFiles: cfg.MaxFiles,
+	}
+	if opts.Command != "" {
+		return opts
+	}
+	dir := cfg.Dir
+	if dir == "" {
+		dir = filepath.Join(config.K9sDumpDir, cluster, "logs")
+	}
+	name := fmt.Sprintf("%s-%s.log", strings.Replace(path, "/", "-", -1), time.Now().Format("20060102-150405"))
+	opts.Path = filepath.Join(dir, name)
+
+	return opts
+}
+
+// LogChanged writes new log lines. Replayed lines already written, for
+// instance when the filter or timestamps are toggled, are skipped.
+func (l *logSinker) LogChanged(lines dao.LogItems) {
+	if l.err != nil {
+		return
+	}
+	replay := l.replay
+	l.replay = false
+
+	ll := make([]string, 0, len(lines))
+	for _, line := range lines {
+		t, err := time.Parse(time.RFC3339Nano, line.Timestamp)
+		if err == nil {
+			if replay && !t.After(l.last) {
+				continue
+			}
+			if t.After(l.last) {
+				l.last = t
+			}
+		}
+		ll = append(ll, line.Plain(l.showTime()))
+	}
+	if len(ll) == 0 {
+		return
+	}
+	if l.err = l.sink.Write(ll); l.err != nil {
+		l.failed(l.err)
+	}
+}
+
+// LogCleared flags the upcoming lines as a buffer replay.
+func (l *logSinker) LogCleared() {
+	l.replay = true
+}
+
+// LogFailed ignores failures, only logs are written to the sink.
+func (l *logSinker) LogFailed(error) {}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSinkerReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-sink")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fred.log")
+
+	s, err := dao.NewLogSink(dao.LogSinkOptions{Path: path})
+	assert.Nil(t, err)
+	l := newLogSinker(s, func() bool { return false }, func(err error) { assert.Fail(t, err.Error()) })
+
+	item := func(i int) *dao.LogItem {
+		return dao.NewLogItem([]byte(fmt.Sprintf("2020-01-01T00:00:0%dZ line-%d\n", i, i)))
+	}
+	ii := dao.LogItems{item(1), item(2)}
+	l.LogChanged(ii)
+	l.LogCleared()
+	l.LogChanged(ii)
+	l.LogChanged(dao.LogItems{item(3)})
+	l.LogCleared()
+	l.LogChanged(append(ii, item(3), item(4)))
+	assert.Nil(t, s.Close())
+
+	bb, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "line-1\nline-2\nline-3\nline-4\n", string(bb))
+}
+
+func TestLogSinkOptions(t *testing.T) {
+	opts := logSinkOptions(&config.LogSink{Dir: "/tmp/blee", MaxSize: 2, MaxFiles: 3}, "c1", "fred/p1")
+	assert.True(t, strings.HasPrefix(opts.Path, "/tmp/blee/fred-p1-"))
+	assert.Equal(t, int64(2*1024*1024), opts.MaxSize)
+	assert.Equal(t, 3, opts.MaxFiles)
+
+	opts = logSinkOptions(&config.LogSink{Command: "gzip > fred.gz", MaxSize: 2}, "c1", "fred/p1")
+	assert.Equal(t, "", opts.Path)
+	assert.Equal(t, "gzip > fred.gz", opts.Target())
+}
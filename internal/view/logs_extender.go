@@ -27,7 +27,7 @@ func NewLogsExtender(v ResourceViewer, f ContainerFunc) ResourceViewer {
 // BindKeys injects new menu actions.
 func (l *LogsExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyL:      ui.NewKeyAction("Logs", l.logsCmd(false), true),
+		ui.KeyL:      ui.NewKeyActionWithID(ui.ActionLogs, "Logs", l.logsCmd(false), true),
 		ui.KeyShiftL: ui.NewKeyAction("Logs Previous", l.logsCmd(true), true),
 	})
 }
@@ -0,0 +1,150 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	metricsPeekTitle   = "metrics"
+	promPortAnnotation = "prometheus.io/port"
+	promPathAnnotation = "prometheus.io/path"
+)
+
+// MetricsPeek presents a pod prometheus metrics.
+type MetricsPeek struct {
+	*Table
+
+	path, port, target string
+	model              *staticModel
+}
+
+// NewMetricsPeek returns a new metrics viewer for the given pod endpoint.
+func NewMetricsPeek(path, port, target string) *MetricsPeek {
+	return &MetricsPeek{
+		Table:  NewTable(client.NewGVR(metricsPeekTitle)),
+		path:   path,
+		port:   port,
+		target: target,
+		model:  &staticModel{Table: model.NewTable(client.NewGVR(metricsPeekTitle))},
+	}
+}
+
+// Init initializes the component.
+func (m *MetricsPeek) Init(ctx context.Context) error {
+	if err := m.Table.Init(ctx); err != nil {
+		return err
+	}
+	m.SetModel(m.model)
+	m.SetColorerFn(render.PromMetric{}.ColorerFunc())
+	m.SetBorderFocusColor(tcell.ColorLightSkyBlue)
+	m.SetSelectedStyle(tcell.ColorBlack, tcell.ColorLightSkyBlue, tcell.AttrNone)
+	m.Extras = fmt.Sprintf("%s:%s%s", m.path, m.port, m.target)
+	m.bindKeys()
+	m.model.data = render.TableData{Header: render.PromMetric{}.Header("")}
+	m.Update(m.model.data)
+	go m.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (m *MetricsPeek) Name() string { return metricsPeekTitle }
+
+func (m *MetricsPeek) bindKeys() {
+	m.Actions().Delete(tcell.KeyCtrlZ)
+	m.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", m.refreshCmd, true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", m.SortColCmd("TYPE", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", m.app.PrevCmd, false),
+	})
+}
+
+func (m *MetricsPeek) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go m.load()
+
+	return nil
+}
+
+func (m *MetricsPeek) load() {
+	ss, err := dao.PeekMetrics(m.app.factory, m.path, m.port, m.target)
+	m.app.QueueUpdateDraw(func() {
+		if err != nil {
+			m.app.Flash().Err(err)
+			return
+		}
+		data, err := promTableData(ss)
+		if err != nil {
+			m.app.Flash().Err(err)
+			return
+		}
+		m.model.data = data
+		m.Update(data)
+		m.app.Flash().Infof("Scraped %d metrics samples", len(ss))
+	})
+}
+
+func promTableData(ss []render.PromSample) (render.TableData, error) {
+	var re render.PromMetric
+	data := render.TableData{
+		Header:    re.Header(""),
+		RowEvents: make(render.RowEvents, 0, len(ss)),
+	}
+	for _, s := range ss {
+		var r render.Row
+		if err := re.Render(s, "", &r); err != nil {
+			return data, err
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, r))
+	}
+
+	return data, nil
+}
+
+// metricsEndpoint returns a pod metrics port and path honoring the prometheus
+// scrape annotations. Defaults to the first exposed tcp port and /metrics.
+func metricsEndpoint(po *v1.Pod) (string, string) {
+	port, target := po.Annotations[promPortAnnotation], po.Annotations[promPathAnnotation]
+	if target == "" {
+		target = defaultProxyPath
+	}
+	if port != "" {
+		return port, target
+	}
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+				return strconv.Itoa(int(p.ContainerPort)), target
+			}
+		}
+	}
+
+	return "", target
+}
+
+func (p *Pod) metricsPeekCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	po, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	port, target := metricsEndpoint(po)
+	if err := p.App().inject(NewMetricsPeek(path, port, target)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
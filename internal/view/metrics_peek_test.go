@@ -0,0 +1,53 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	uu := map[string]struct {
+		po           v1.Pod
+		port, target string
+	}{
+		"annotated": {
+			po: v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				promPortAnnotation: "9090",
+				promPathAnnotation: "/stats",
+			}}},
+			port: "9090", target: "/stats",
+		},
+		"first-tcp": {
+			po: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{
+				{Ports: []v1.ContainerPort{{ContainerPort: 53, Protocol: v1.ProtocolUDP}, {ContainerPort: 8080, Protocol: v1.ProtocolTCP}}},
+			}}},
+			port: "8080", target: "/metrics",
+		},
+		"none": {target: "/metrics"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			port, target := metricsEndpoint(&u.po)
+			assert.Equal(t, u.port, port)
+			assert.Equal(t, u.target, target)
+		})
+	}
+}
+
+func TestPromTableData(t *testing.T) {
+	data, err := promTableData([]render.PromSample{
+		{Name: "up", Value: "1"},
+		{Name: "reqs", Type: "counter", Value: "3", Labels: map[string]string{"code": "200"}},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, "reqs{code=200}", data.RowEvents[1].Row.ID)
+}
@@ -1,19 +1,21 @@
 package view
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 // Node represents a node view.
@@ -39,6 +41,10 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 		ui.KeyC:      ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
 		ui.KeyU:      ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
 		ui.KeyR:      ui.NewKeyAction("Drain", n.drainCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Disk Pressure", n.diskCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("NodeClaim", n.nodeClaimCmd, true),
+		ui.KeyShiftI: ui.NewKeyAction("Interruption", n.interruptionCmd, true),
+		ui.KeyShiftG: ui.NewKeyAction("Graphs", graphsCmd(n), true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", n.GetTable().SortColCmd("%CPU", false), false),
@@ -48,7 +54,7 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 	cl := n.App().Config.K9s.CurrentCluster
 	if n.App().Config.K9s.Clusters[cl].FeatureGates.NodeShell {
 		aa.Add(ui.KeyActions{
-			ui.KeyS: ui.NewKeyAction("Shell", n.sshCmd, true),
+			ui.KeyS: ui.NewKeyActionWithID(ui.ActionShell, "Shell", n.sshCmd, true),
 		})
 	}
 }
@@ -65,7 +71,7 @@ func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	defaults := dao.DrainOptions{
 		GracePeriodSeconds:  -1,
-		Timeout:             5 * time.Second,
+		Timeout:             2 * time.Minute,
 		DeleteLocalData:     false,
 		IgnoreAllDaemonSets: false,
 	}
@@ -80,41 +86,54 @@ func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
 		v.App().Flash().Err(err)
 		return
 	}
-	m, ok := res.(dao.NodeMaintainer)
+	d, ok := res.(dao.Drainable)
 	if !ok {
-		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
+		v.App().Flash().Err(fmt.Errorf("expecting a drainable for %q", v.GVR()))
 		return
 	}
 
-	buff := bytes.NewBufferString("")
-	if err := m.Drain(path, opts, buff); err != nil {
-		v.App().Flash().Err(err)
-		return
-	}
-	lines := strings.Split(buff.String(), "\n")
-	for _, l := range lines {
-		if len(l) > 0 {
-			v.App().Flash().Info(l)
-		}
-	}
-	v.Refresh()
+	ctx, cancel := context.WithCancel(context.Background())
+	t := dao.NewDrainTracker()
+	p := showDrainProgress(v, path, t, cancel)
+	go func() {
+		defer cancel()
+		err := d.Drain(ctx, path, opts, func(e dao.DrainEvent) {
+			t.Report(e)
+			v.App().QueueUpdateDraw(p.refresh)
+		})
+		v.App().QueueUpdateDraw(func() {
+			p.done(err)
+			switch {
+			case err == nil:
+				v.App().Flash().Infof("Node %s drained!", path)
+			case errors.Is(err, context.Canceled):
+				v.App().Flash().Warnf("Drain %s canceled", path)
+			default:
+				v.App().Flash().Err(err)
+			}
+			v.Refresh()
+		})
+	}()
 }
 
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
-		path := n.GetTable().GetSelectedItem()
-		if path == "" {
+		paths := n.GetTable().GetSelectedItems()
+		if len(paths) == 0 {
 			return evt
 		}
 
-		title, msg := "Confirm ", ""
-		if cordon {
-			title, msg = title+"Cordon", "Cordon "
-		} else {
-			title, msg = title+"Uncordon", "Uncordon "
+		title, action := "Confirm ", "Cordon"
+		if !cordon {
+			action = "Uncordon"
 		}
-		msg += path + "?"
-		dialog.ShowConfirm(n.App().Content.Pages, title, msg, func() {
+		title += action
+		msg := action + " " + paths[0] + "?"
+		if len(paths) > 1 {
+			msg = fmt.Sprintf("%s %d marked nodes?", action, len(paths)) + bulkTargets(paths)
+		}
+		level := n.App().Config.ConfirmLevel(config.ActionCordon)
+		dialog.ShowConfirm(n.App().Content.Pages, level, confirmName(n.App().Config.K9s.CurrentContext, paths), title, msg, func() {
 			res, err := dao.AccessorFor(n.App().factory, n.GVR())
 			if err != nil {
 				n.App().Flash().Err(err)
@@ -125,16 +144,37 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
-			if err := m.ToggleCordon(path, cordon); err != nil {
-				n.App().Flash().Err(err)
-			}
-			n.Refresh()
+			runBulkAsync(n.App(), action, n.GVR(), paths, func(path string) error {
+				return m.ToggleCordon(path, cordon)
+			}, func(rr bulkResults) {
+				for _, r := range rr {
+					if r.err == nil {
+						n.App().record(cordonOperation(m, r.path, cordon))
+					}
+				}
+				n.Refresh()
+			})
 		}, func() {})
 
 		return nil
 	}
 }
 
+func cordonOperation(m dao.NodeMaintainer, path string, cordon bool) model.Operation {
+	op, inv := "Cordon", "Uncordon"
+	if !cordon {
+		op, inv = inv, op
+	}
+
+	return model.Operation{
+		Description: fmt.Sprintf("%s node %s", op, path),
+		Inverse:     fmt.Sprintf("%s node %s", inv, path),
+		Revert: func() error {
+			return m.ToggleCordon(path, !cordon)
+		},
+	}
+}
+
 func (n *Node) sshCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := n.GetTable().GetSelectedItem()
 	if path == "" {
@@ -179,3 +219,69 @@ func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+func (n *Node) nodeClaimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showNodeClaim(n.App(), path)
+
+	return nil
+}
+
+func (n *Node) interruptionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	go func() {
+		ni, err := dao.FetchNodeInterruption(n.App().factory, path)
+		var raw []byte
+		if err == nil {
+			raw, err = yaml.Marshal(ni)
+		}
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), "Interruption", path, true).Update(string(raw))
+			if err := n.App().inject(details); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (n *Node) diskCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	n.App().Flash().Infof("Collecting disk usage for node %s...", path)
+	go func() {
+		d, err := dao.FetchNodeDisk(n.App().factory, path)
+		var raw []byte
+		if err == nil {
+			raw, err = yaml.Marshal(d)
+		}
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			n.App().Flash().Clear()
+			details := NewDetails(n.App(), "Disk", path, true).Update(string(raw))
+			if err := n.App().inject(details); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
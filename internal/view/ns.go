@@ -1,6 +1,7 @@
 package view
 
 import (
+	"errors"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -9,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -37,9 +39,21 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyL: ui.NewKeyActionWithID(ui.ActionLogs, "Logs", n.logsCmd, true),
 	})
 }
 
+func (n *Namespace) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	showNamespaceLogs(n.App(), ns, "")
+
+	return nil
+}
+
 func (n *Namespace) switchNs(app *App, model ui.Tabular, gvr, path string) {
 	n.useNamespace(path)
 	if err := app.gotoResource("pods", "", true); err != nil {
@@ -105,3 +119,33 @@ func (n *Namespace) decorate(data render.TableData) render.TableData {
 
 	return data
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showNamespaceLogs(app *App, ns, sel string) {
+	if _, err := labels.Parse(sel); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if _, err := app.factory.CanForResource(ns, "v1/pods", client.MonitorAccess); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.inject(NewNamespaceLog(ns, sel)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *Command) logsCmd(tokens []string) error {
+	if len(tokens) < 2 || len(tokens) > 3 {
+		return errors.New("Usage: logs NAMESPACE [SELECTOR]")
+	}
+	var sel string
+	if len(tokens) == 3 {
+		sel = tokens[2]
+	}
+	showNamespaceLogs(c.app, tokens[1], sel)
+
+	return nil
+}
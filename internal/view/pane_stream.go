@@ -0,0 +1,236 @@
+package view
+
+import (
+	"html/template"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	defaultStreamPort = 7778
+	streamBacklog     = 1000
+	streamBuffer      = 100
+)
+
+var streamTmpl = template.Must(template.New("stream").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>K9s {{.}}</title>
+<style>
+body { background: #000; color: #87ceeb; font-family: monospace; margin: 0.5em; }
+pre { white-space: pre-wrap; margin: 0; }
+</style>
+</head>
+<body>
+<pre id="pane"></pre>
+<script>
+const pane = document.getElementById("pane");
+const ws = new WebSocket("ws://" + location.host + "/ws" + location.search);
+ws.onmessage = (evt) => {
+  const msg = JSON.parse(evt.data);
+  if (msg.reset) { pane.textContent = ""; }
+  if (msg.lines) {
+    pane.textContent += msg.lines.join("\n") + "\n";
+    window.scrollTo(0, document.body.scrollHeight);
+  }
+};
+ws.onclose = () => { pane.textContent += "\n-- stream closed --\n"; };
+</script>
+</body>
+</html>
+`))
+
+type streamMsg struct {
+	Reset bool     `json:"reset,omitempty"`
+	Lines []string `json:"lines,omitempty"`
+}
+
+// PaneStream streams a pane content to browsers over a web socket.
+// Requests must target a loopback host and carry the stream access token.
+type PaneStream struct {
+	title   string
+	token   string
+	backlog []string
+	clients map[chan streamMsg]struct{}
+	srv     *http.Server
+	mx      sync.Mutex
+}
+
+// NewPaneStream returns a new pane stream guarded by a random access token.
+func NewPaneStream(title string) (*PaneStream, error) {
+	token, err := newServeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaneStream{
+		title:   title,
+		token:   token,
+		clients: make(map[chan streamMsg]struct{}),
+	}, nil
+}
+
+// Token returns the token required to access the stream.
+func (p *PaneStream) Token() string {
+	return p.token
+}
+
+// Write broadcasts new lines. Lines are dropped for clients that can't keep up.
+func (p *PaneStream) Write(lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.backlog = append(p.backlog, lines...)
+	if over := len(p.backlog) - streamBacklog; over > 0 {
+		p.backlog = p.backlog[over:]
+	}
+	p.broadcast(streamMsg{Lines: lines})
+}
+
+// Reset clears the pane.
+func (p *PaneStream) Reset() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.backlog = nil
+	p.broadcast(streamMsg{Reset: true})
+}
+
+func (p *PaneStream) broadcast(msg streamMsg) {
+	for c := range p.clients {
+		select {
+		case c <- msg:
+		default:
+		}
+	}
+}
+
+func (p *PaneStream) subscribe() chan streamMsg {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	c := make(chan streamMsg, streamBuffer)
+	if len(p.backlog) > 0 {
+		c <- streamMsg{Lines: append([]string(nil), p.backlog...)}
+	}
+	p.clients[c] = struct{}{}
+
+	return c
+}
+
+func (p *PaneStream) unsubscribe(c chan streamMsg) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if _, ok := p.clients[c]; ok {
+		delete(p.clients, c)
+		close(c)
+	}
+}
+
+// Handler returns the stream routes. The pane is rendered on / and streamed on /ws.
+func (p *PaneStream) Handler() http.Handler {
+	mux := http.NewServeMux()
+	ws := websocket.Server{
+		Handshake: localOrigin,
+		Handler: func(ws *websocket.Conn) {
+			c := p.subscribe()
+			defer p.unsubscribe(c)
+			for msg := range c {
+				if err := websocket.JSON.Send(ws, msg); err != nil {
+					log.Debug().Err(err).Msg("Pane stream client left")
+					return
+				}
+			}
+		},
+	}
+	mux.HandleFunc("/ws", tokenGuard(p.token, ws.ServeHTTP))
+	mux.HandleFunc("/", tokenGuard(p.token, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := streamTmpl.Execute(w, p.title); err != nil {
+			log.Error().Err(err).Msg("Serving pane stream")
+		}
+	}))
+
+	return mux
+}
+
+// Start streams the pane on the given local address.
+func (p *PaneStream) Start(addr string) error {
+	srv, err := serveLocal(addr, p.Handler())
+	if err != nil {
+		return err
+	}
+	p.srv = srv
+
+	return nil
+}
+
+// Stop closes all clients and shuts the server down.
+func (p *PaneStream) Stop() {
+	p.mx.Lock()
+	for c := range p.clients {
+		delete(p.clients, c)
+		close(c)
+	}
+	p.mx.Unlock()
+	shutdown(p.srv)
+}
+
+// localOrigin rejects web socket connections initiated by non local pages.
+func localOrigin(cfg *websocket.Config, r *http.Request) error {
+	o := r.Header.Get("Origin")
+	if o == "" {
+		return websocket.ErrBadWebSocketOrigin
+	}
+	u, err := url.Parse(o)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(u.Hostname()); u.Hostname() != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return websocket.ErrBadWebSocketOrigin
+	}
+	cfg.Origin = u
+
+	return nil
+}
+
+// logStreamer forwards log lines to a pane stream.
+type logStreamer struct {
+	stream   *PaneStream
+	showTime func() bool
+}
+
+// LogChanged streams new log lines redacted by the privacy rules.
+func (l *logStreamer) LogChanged(lines dao.LogItems) {
+	ll := make([]string, 0, len(lines))
+	for _, line := range lines {
+		ll = append(ll, render.RedactText(line.Plain(l.showTime())))
+	}
+	l.stream.Write(ll)
+}
+
+// LogCleared resets the stream.
+func (l *logStreamer) LogCleared() {
+	l.stream.Reset()
+}
+
+// LogFailed streams the failure.
+func (l *logStreamer) LogFailed(err error) {
+	l.stream.Write([]string{render.RedactText(err.Error())})
+}
@@ -0,0 +1,80 @@
+package view_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+type paneMsg struct {
+	Reset bool     `json:"reset"`
+	Lines []string `json:"lines"`
+}
+
+func TestPaneStream(t *testing.T) {
+	p, err := view.NewPaneStream("blee/fred")
+	assert.Nil(t, err)
+	srv := httptest.NewServer(p.Handler())
+	defer srv.Close()
+	defer p.Stop()
+
+	p.Write([]string{"line1", "line2"})
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws?token=" + p.Token()
+	ws, err := websocket.Dial(url, "", "http://localhost/")
+	assert.Nil(t, err)
+	defer ws.Close()
+
+	var m paneMsg
+	assert.Nil(t, websocket.JSON.Receive(ws, &m))
+	assert.Equal(t, []string{"line1", "line2"}, m.Lines)
+
+	p.Reset()
+	m = paneMsg{}
+	assert.Nil(t, websocket.JSON.Receive(ws, &m))
+	assert.True(t, m.Reset)
+
+	p.Write([]string{"line3"})
+	m = paneMsg{}
+	assert.Nil(t, websocket.JSON.Receive(ws, &m))
+	assert.Equal(t, []string{"line3"}, m.Lines)
+}
+
+func TestPaneStreamGuard(t *testing.T) {
+	p, err := view.NewPaneStream("blee/fred")
+	assert.Nil(t, err)
+	srv := httptest.NewServer(p.Handler())
+	defer srv.Close()
+	defer p.Stop()
+
+	uu := map[string]struct {
+		token, origin string
+		ok            bool
+	}{
+		"ok":        {token: p.Token(), origin: "http://localhost/", ok: true},
+		"noToken":   {origin: "http://localhost/"},
+		"badToken":  {token: "zorg", origin: "http://localhost/"},
+		"badOrigin": {token: p.Token(), origin: "http://evil.example.com/"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws?token=" + u.token
+			ws, err := websocket.Dial(url, "", u.origin)
+			assert.Equal(t, u.ok, err == nil)
+			if err == nil {
+				ws.Close()
+			}
+		})
+	}
+
+	resp, err := http.Get(srv.URL + "/")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -50,6 +50,7 @@ func (p *PortForward) bindKeys(aa ui.KeyActions) {
 		tcell.KeyEnter: ui.NewKeyAction("View Benchmarks", p.showBenchCmd, true),
 		tcell.KeyCtrlL: ui.NewKeyAction("Benchmark Run/Stop", p.toggleBenchCmd, true),
 		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Toggle Persist", p.toggleProfileCmd, true),
 		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", p.GetTable().SortColCmd("PORTS", true), false),
 		ui.KeyShiftU:   ui.NewKeyAction("Sort URL", p.GetTable().SortColCmd("URL", true), false),
 	})
@@ -115,6 +116,41 @@ func (p *PortForward) runBenchmark() {
 	})
 }
 
+func (p *PortForward) toggleProfileCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	removed, err := p.App().removeForwardProfile(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if removed {
+		p.App().Flash().Infof("PortForward %s will no longer be restored", path)
+		return nil
+	}
+
+	f, ok := p.App().factory.ForwarderFor(path)
+	if !ok {
+		p.App().Flash().Errf("No port-forward found for %s", path)
+		return nil
+	}
+	pf, ok := f.(*dao.PortForwarder)
+	if !ok {
+		p.App().Flash().Errf("Expecting a port-forwarder but got %T", f)
+		return nil
+	}
+	if err := p.App().saveForwardProfile(path, pf); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.App().Flash().Infof("PortForward %s will be restored on startup", path)
+
+	return nil
+}
+
 func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !p.GetTable().CmdBuff().Empty() {
 		p.GetTable().CmdBuff().Reset()
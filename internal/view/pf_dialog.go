@@ -2,12 +2,19 @@ package view
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
 )
 
 const portForwardKey = "portforward"
@@ -27,7 +34,14 @@ func ShowPortForwards(v ResourceViewer, path string, ports []string, okFn PortFo
 		SetLabelColor(styles.K9s.Info.FgColor.Color()).
 		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
 
-	p1, p2, address := ports[0], extractPort(ports[0]), "localhost"
+	p1 := ports[0]
+	for _, p := range ports {
+		if isTCPPort(p) {
+			p1 = p
+			break
+		}
+	}
+	p2, address := extractPort(p1), "localhost"
 	f.AddInputField("Container Port:", p1, 30, nil, func(p string) {
 		p1 = p
 	})
@@ -37,14 +51,40 @@ func ShowPortForwards(v ResourceViewer, path string, ports []string, okFn PortFo
 	f.AddInputField("Address:", address, 30, nil, func(h string) {
 		address = h
 	})
+	autoPort := v.App().Config.K9s.GetPortForwards().AutoPort
+	f.AddCheckbox("Auto Port:", autoPort, func(b bool) {
+		autoPort = b
+	})
+	var (
+		mirror  bool
+		bodyCap = "0"
+	)
+	f.AddCheckbox("Mirror HTTP:", mirror, func(b bool) {
+		mirror = b
+	})
+	f.AddInputField("Mirror Body Cap:", bodyCap, 30, nil, func(c string) {
+		bodyCap = c
+	})
 
 	pages := v.App().Content.Pages
 
 	f.AddButton("OK", func() {
+		if err := dao.CheckForwardProtocol(protocolFor(ports, p1)); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
 		tunnel := client.PortTunnel{
 			Address:       address,
 			LocalPort:     p2,
 			ContainerPort: extractPort(p1),
+			AutoPort:      autoPort,
+		}
+		if mirror {
+			var err error
+			if tunnel.Mirror, tunnel.MirrorBodyCap, err = mirrorSpec(v.App(), path, p2, bodyCap); err != nil {
+				v.App().Flash().Err(err)
+				return
+			}
 		}
 		okFn(v, path, extractContainer(p1), tunnel)
 	})
@@ -72,8 +112,59 @@ func DismissPortForwards(v ResourceViewer, p *ui.Pages) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// mirrorSpec returns the traffic mirror file and body cap for a forward.
+func mirrorSpec(app *App, path, port, bodyCap string) (string, int64, error) {
+	c, err := strconv.ParseInt(bodyCap, 10, 64)
+	if err != nil || c < 0 {
+		return "", 0, fmt.Errorf("invalid mirror body cap %q", bodyCap)
+	}
+	dir := filepath.Join(config.K9sDumpDir, app.Config.K9s.CurrentCluster)
+	if err := ensureDir(dir); err != nil {
+		return "", 0, err
+	}
+	_, n := client.Namespaced(path)
+	name := fmt.Sprintf("mirror-%s-%s-%d.log", n, port, time.Now().UnixNano())
+
+	return filepath.Join(dir, name), c, nil
+}
+
+// protocolFor returns the protocol of the selected port. Bare port numbers are
+// matched against the exposed ports.
+func protocolFor(ports []string, sel string) v1.Protocol {
+	if strings.Contains(sel, render.Glyph(render.ProtocolSign)) {
+		return portProtocol(sel)
+	}
+	co, port := extractContainer(sel), extractPort(sel)
+	for _, p := range ports {
+		if extractPort(p) != port {
+			continue
+		}
+		if c := extractContainer(p); co != "n/a" && c != co {
+			continue
+		}
+		return portProtocol(p)
+	}
+
+	return v1.ProtocolTCP
+}
+
+// checkForwardable returns an error if none of the exposed ports can be forwarded.
+func checkForwardable(path string, ports []string) error {
+	if len(ports) == 0 {
+		return fmt.Errorf("no ports exposed on %s", path)
+	}
+	for _, p := range ports {
+		if isTCPPort(p) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no forwardable ports on %s: %w", path, dao.CheckForwardProtocol(portProtocol(ports[0])))
+}
+
 func extractPort(p string) string {
-	rx := regexp.MustCompile(`\A([\w|-]+)/?([\w|-]+)?:?(\d+)?(╱UDP)?\z`)
+	sep := regexp.QuoteMeta(render.Glyph(render.ProtocolSign))
+	rx := regexp.MustCompile(`\A([\w|-]+)/?([\w|-]+)?:?(\d+)?(` + sep + `(?:UDP|SCTP))?\z`)
 	mm := rx.FindStringSubmatch(p)
 	if len(mm) != 5 {
 		return p
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestExtractPort(t *testing.T) {
@@ -25,6 +26,9 @@ func TestExtractPort(t *testing.T) {
 		"protocol": {
 			"dns:53╱UDP", "53",
 		},
+		"sctp": {
+			"co/sig:9000╱SCTP", "9000",
+		},
 		"unamed": {
 			"dns/53", "53",
 		},
@@ -66,3 +70,48 @@ func TestExtractContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestProtocolFor(t *testing.T) {
+	ports := []string{"co/http:80", "co/dns:53╱UDP", "co/sig:9000╱SCTP"}
+	uu := map[string]struct {
+		sel string
+		e   v1.Protocol
+	}{
+		"tcp":      {"co/http:80", v1.ProtocolTCP},
+		"suffixed": {"co/dns:53╱UDP", v1.ProtocolUDP},
+		"bare":     {"9000", v1.ProtocolSCTP},
+		"named":    {"co/dns:53", v1.ProtocolUDP},
+		"unknown":  {"co/:8080", v1.ProtocolTCP},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, protocolFor(ports, u.sel))
+		})
+	}
+}
+
+func TestCheckForwardable(t *testing.T) {
+	uu := map[string]struct {
+		ports []string
+		err   string
+	}{
+		"tcp":  {ports: []string{"co/dns:53╱UDP", "co/http:80"}},
+		"none": {err: "no ports exposed on fred"},
+		"udp":  {ports: []string{"co/dns:53╱UDP"}, err: "no forwardable ports on fred: UDP ports cannot be forwarded: the Kubernetes port-forward api only tunnels TCP"},
+		"sctp": {ports: []string{"co/sig:9000╱SCTP"}, err: "no forwardable ports on fred: SCTP ports cannot be forwarded: the Kubernetes port-forward api only tunnels TCP"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := checkForwardable("fred", u.ports)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
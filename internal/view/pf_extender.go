@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/netutil"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,29 +49,35 @@ func (p *PortForwardExtender) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	pod, err := p.fetchPodName(path)
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
 	if err != nil {
 		p.App().Flash().Err(err)
 		return nil
 	}
-	if err := showFwdDialog(p, pod, startFwdCB); err != nil {
-		p.App().Flash().Err(err)
+	ctrl, ok := res.(dao.Controller)
+	if !ok {
+		p.App().Flash().Errf("expecting a controller resource for %q", p.GVR())
+		return nil
 	}
-
-	return nil
-}
-
-func (p *PortForwardExtender) fetchPodName(path string) (string, error) {
-	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	pod, err := ctrl.Pod(path)
 	if err != nil {
-		return "", nil
+		p.App().Flash().Err(err)
+		return nil
 	}
-	ctrl, ok := res.(dao.Controller)
-	if !ok {
-		return "", fmt.Errorf("expecting a controller resource for %q", p.GVR())
+	cb := startFwdCB
+	if s, ok := res.(dao.PodSelector); ok {
+		sel, err := s.PodSelector(path)
+		if err != nil {
+			p.App().Flash().Err(err)
+			return nil
+		}
+		cb = selectorFwdCB(sel)
+	}
+	if err := showFwdDialog(p, pod, cb); err != nil {
+		p.App().Flash().Err(err)
 	}
 
-	return ctrl.Pod(path)
+	return nil
 }
 
 // ----------------------------------------------------------------------------
@@ -80,28 +91,88 @@ func tryListenPort(address, port string) error {
 	return server.Close()
 }
 
-func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForwarder) {
-	v.App().factory.AddForwarder(pf)
+func runForward(app *App, pf *dao.PortForwarder, f *portforward.PortForwarder) {
+	pf.SetActive(true)
+	for f != nil {
+		done := make(chan struct{})
+		go probeForward(pf, done)
+		err := f.ForwardPorts()
+		close(done)
+		if pf.Stopped() {
+			break
+		}
+		log.Warn().Err(err).Msgf("PortForward %s broke. Restarting", pf.FQN())
+		f = restartForward(app, pf)
+	}
 
-	v.App().QueueUpdateDraw(func() {
-		v.App().Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
-		DismissPortForwards(v, v.App().Content.Pages)
+	app.QueueUpdateDraw(func() {
+		app.factory.DeleteForwarder(pf.FQN())
+		pf.SetActive(false)
 	})
+}
 
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		v.App().Flash().Err(err)
-		return
+// probeForward checks the tunnel liveness until done and breaks it on failure
+// so it gets restarted.
+func probeForward(pf *dao.PortForwarder, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(dao.ForwardProbeInterval):
+			if err := dao.ProbeForward(pf.TunnelAddress(), dao.ForwardProbeTimeout); err != nil {
+				log.Warn().Err(err).Msgf("PortForward %s liveness probe failed", pf.FQN())
+				pf.Break()
+				return
+			}
+		}
 	}
+}
 
-	v.App().QueueUpdateDraw(func() {
-		v.App().factory.DeleteForwarder(pf.FQN())
-		pf.SetActive(false)
+// restartForward re-establishes a broken tunnel with backoff. Returns nil
+// once the forward was stopped or the restarts budget is exhausted.
+func restartForward(app *App, pf *dao.PortForwarder) *portforward.PortForwarder {
+	var backoff time.Duration
+	for i := 0; i < dao.ForwardMaxRestarts; i++ {
+		pf.SetState(dao.ForwardRestarting)
+		backoff = dao.NextBackoff(backoff)
+		<-time.After(backoff)
+		if pf.Stopped() {
+			return nil
+		}
+		prev := pf.Path()
+		f, err := pf.Restart()
+		if err == nil {
+			pf.SetActive(true)
+			if pf.Path() != prev {
+				app.QueueUpdateDraw(func() {
+					app.forwardMoved(prev, pf)
+				})
+			}
+			return f
+		}
+		log.Warn().Err(err).Msgf("PortForward %s restart #%d failed", pf.FQN(), i+1)
+	}
+	app.QueueUpdateDraw(func() {
+		app.Flash().Errf("PortForward %s could not be restarted", pf.FQN())
 	})
+
+	return nil
 }
 
 func startFwdCB(v ResourceViewer, path, co string, t client.PortTunnel) {
-	err := tryListenPort(t.Address, t.LocalPort)
+	startForward(v, path, co, t, nil)
+}
+
+// selectorFwdCB returns a callback starting forwards that fail over to
+// another ready pod matching the selector.
+func selectorFwdCB(sel *metav1.LabelSelector) PortForwardCB {
+	return func(v ResourceViewer, path, co string, t client.PortTunnel) {
+		startForward(v, path, co, t, sel)
+	}
+}
+
+func startForward(v ResourceViewer, path, co string, t client.PortTunnel, sel *metav1.LabelSelector) {
+	requested, err := resolveLocalPort(v.App(), &t)
 	if err != nil {
 		v.App().Flash().Err(err)
 		return
@@ -113,14 +184,63 @@ func startFwdCB(v ResourceViewer, path, co string, t client.PortTunnel) {
 	}
 
 	pf := dao.NewPortForwarder(v.App().factory)
+	pf.SetSelector(sel)
 	fwd, err := pf.Start(path, co, t)
 	if err != nil {
 		v.App().Flash().Err(err)
 		return
 	}
 
+	msg := fmt.Sprintf("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+	if requested != t.LocalPort {
+		if requested == "" {
+			msg += fmt.Sprintf(" on free local port %s", t.LocalPort)
+		} else {
+			msg += fmt.Sprintf(" on local port %s (%s is busy)", t.LocalPort, requested)
+		}
+	}
+	if t.Mirror != "" {
+		msg += " mirroring to " + t.Mirror
+	}
 	log.Debug().Msgf(">>> Starting port forward %q %#v", path, t)
-	go runForward(v, pf, fwd)
+	v.App().factory.AddForwarder(pf)
+	v.App().Flash().Info(msg)
+	DismissPortForwards(v, v.App().Content.Pages)
+	go runForward(v.App(), pf, fwd)
+}
+
+// resolveLocalPort checks the tunnel local port is available. Blank or busy
+// ports are swapped for a free one when auto port is enabled. Returns the
+// originally requested port.
+func resolveLocalPort(app *App, t *client.PortTunnel) (string, error) {
+	requested := t.LocalPort
+	if requested == "0" {
+		requested = ""
+	}
+	if requested != "" {
+		err := tryListenPort(t.Address, requested)
+		if err == nil {
+			return requested, nil
+		}
+		if !t.AutoPort {
+			return requested, err
+		}
+		log.Warn().Err(err).Msgf("Local port %s is busy. Picking a free port", requested)
+	} else if !t.AutoPort {
+		return requested, errors.New("a local port is required when auto port is disabled")
+	}
+
+	address := strings.Split(t.Address, ",")[0]
+	if address == "" {
+		address = "localhost"
+	}
+	port, err := netutil.FreePortIn(address, app.Config.K9s.GetPortForwards().Ranges())
+	if err != nil {
+		return requested, err
+	}
+	t.LocalPort = port
+
+	return requested, nil
 }
 
 func showFwdDialog(v ResourceViewer, path string, cb PortForwardCB) error {
@@ -131,14 +251,15 @@ func showFwdDialog(v ResourceViewer, path string, cb PortForwardCB) error {
 	ports := make([]string, 0, len(mm))
 	for co, pp := range mm {
 		for _, p := range pp {
-			if p.Protocol != v1.ProtocolTCP {
-				continue
+			port := client.FQN(co, p.Name) + ":" + strconv.Itoa(int(p.ContainerPort))
+			if p.Protocol != "" && p.Protocol != v1.ProtocolTCP {
+				port += render.Glyph(render.ProtocolSign) + string(p.Protocol)
 			}
-			ports = append(ports, client.FQN(co, p.Name)+":"+strconv.Itoa(int(p.ContainerPort)))
+			ports = append(ports, port)
 		}
 	}
-	if len(ports) == 0 {
-		return fmt.Errorf("no tcp ports found on %s", path)
+	if err := checkForwardable(path, ports); err != nil {
+		return err
 	}
 	ShowPortForwards(v, path, ports, cb)
 
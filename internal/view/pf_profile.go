@@ -0,0 +1,120 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// restoreForwards re-establishes the current cluster saved port-forwards.
+func (a *App) restoreForwards() {
+	cl := a.Config.CurrentCluster()
+	if cl == nil {
+		return
+	}
+	for _, p := range cl.Forwards {
+		go a.restoreForward(p)
+	}
+}
+
+func (a *App) restoreForward(p config.ForwardProfile) {
+	if _, ok := a.factory.ForwarderFor(p.ID()); ok {
+		return
+	}
+	t := client.PortTunnel{
+		Address:       p.Address,
+		LocalPort:     p.LocalPort,
+		ContainerPort: p.ContainerPort,
+		AutoPort:      a.Config.K9s.GetPortForwards().AutoPort,
+	}
+	if t.Address == "" {
+		t.Address = "localhost"
+	}
+	if _, err := resolveLocalPort(a, &t); err != nil {
+		a.restoreFailed(p, err)
+		return
+	}
+
+	pf := dao.NewPortForwarder(a.factory)
+	if len(p.Selector) > 0 || len(p.MatchExpressions) > 0 {
+		pf.SetSelector(&metav1.LabelSelector{MatchLabels: p.Selector, MatchExpressions: p.MatchExpressions})
+	}
+	fwd, err := pf.Start(p.Path, p.Container, t)
+	if err != nil {
+		a.restoreFailed(p, err)
+		return
+	}
+	log.Debug().Msgf(">>> Restoring port forward %q %#v", pf.Path(), t)
+	a.factory.AddForwarder(pf)
+	a.QueueUpdateDraw(func() {
+		if pf.Path() != p.ID() {
+			a.forwardMoved(p.ID(), pf)
+		}
+		a.Flash().Infof("PortForward restored %s:%s", pf.Path(), pf.Ports()[0])
+	})
+	runForward(a, pf, fwd)
+}
+
+func (a *App) restoreFailed(p config.ForwardProfile, err error) {
+	log.Warn().Err(err).Msgf("PortForward %s could not be restored", p.ID())
+	a.QueueUpdateDraw(func() {
+		a.Flash().Errf("PortForward %s could not be restored: %s", p.ID(), err)
+	})
+}
+
+// forwardMoved tracks a port-forward that moved to a replacement pod.
+func (a *App) forwardMoved(prev string, pf *dao.PortForwarder) {
+	a.factory.RekeyForwarder(prev, pf)
+	if !a.hasForwardProfile(prev) {
+		return
+	}
+	if err := a.saveForwardProfile(prev, pf); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) hasForwardProfile(id string) bool {
+	cl := a.Config.CurrentCluster()
+	if cl == nil {
+		return false
+	}
+	_, ok := cl.Forwards.Find(id)
+
+	return ok
+}
+
+// saveForwardProfile records a port-forward in the current cluster config so
+// it gets re-established on startup.
+func (a *App) saveForwardProfile(prev string, pf *dao.PortForwarder) error {
+	t := pf.Tunnel()
+	p := config.ForwardProfile{
+		Path:          strings.TrimSuffix(pf.Path(), ":"+pf.Container()),
+		Container:     pf.Container(),
+		LocalPort:     t.LocalPort,
+		ContainerPort: t.ContainerPort,
+		Address:       t.Address,
+	}
+	if sel := pf.Selector(); sel != nil {
+		p.Selector, p.MatchExpressions = sel.MatchLabels, sel.MatchExpressions
+	}
+	cl := a.Config.K9s.ActiveCluster()
+	cl.Forwards = cl.Forwards.Upsert(prev, p)
+
+	return a.Config.Save()
+}
+
+// removeForwardProfile deletes a saved port-forward from the current cluster
+// config.
+func (a *App) removeForwardProfile(id string) (bool, error) {
+	cl := a.Config.K9s.ActiveCluster()
+	var ok bool
+	if cl.Forwards, ok = cl.Forwards.Remove(id); !ok {
+		return false, nil
+	}
+
+	return true, a.Config.Save()
+}
@@ -0,0 +1,204 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pluginOutputTitle = "Plugin"
+
+	// pluginMaxOutput caps the size of each plugin output stream.
+	pluginMaxOutput = 1 << 20
+
+	// pluginRefreshRate tracks how often a running plugin output is refreshed.
+	pluginRefreshRate = 500 * time.Millisecond
+)
+
+// PluginOutput captures a plugin command output in a searchable pane.
+type PluginOutput struct {
+	*Details
+
+	binary string
+	args   []string
+	cancel context.CancelFunc
+	mx     sync.Mutex
+}
+
+// NewPluginOutput returns a new plugin output pane.
+func NewPluginOutput(app *App, p config.Plugin, args []string) *PluginOutput {
+	return &PluginOutput{
+		Details: NewDetails(app, pluginOutputTitle, p.Description, true),
+		binary:  p.Command,
+		args:    args,
+	}
+}
+
+// Init initializes the component and runs the plugin command.
+func (p *PluginOutput) Init(ctx context.Context) error {
+	if err := p.Details.Init(ctx); err != nil {
+		return err
+	}
+	p.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR: ui.NewKeyAction("Rerun", p.rerunCmd, true),
+	})
+	p.run()
+
+	return nil
+}
+
+// Stop terminates the plugin command if still running.
+func (p *PluginOutput) Stop() {
+	p.Details.Stop()
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+}
+
+func (p *PluginOutput) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.run()
+
+	return nil
+}
+
+// run runs the plugin command in the background, canceling any previous run.
+// The output is refreshed while the command runs.
+func (p *PluginOutput) run() {
+	p.mx.Lock()
+	if p.cancel != nil {
+		p.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.mx.Unlock()
+
+	cmd := strings.TrimSpace(p.binary + " " + strings.Join(p.args, " "))
+	p.Update(pluginOutputText(cmd, "Running...", "", nil))
+	stdout, stderr := newCappedBuffer(pluginMaxOutput), newCappedBuffer(pluginMaxOutput)
+	done := make(chan error, 1)
+	go func() {
+		done <- pluginRun(ctx, p.binary, p.args, stdout, stderr)
+	}()
+	go func() {
+		var rev int
+		for {
+			select {
+			case err := <-done:
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil {
+					log.Warn().Err(err).Msgf("Plugin %q failed", cmd)
+				}
+				p.app.QueueUpdateDraw(func() {
+					p.Update(pluginOutputText(cmd, stdout.String(), stderr.String(), err))
+				})
+				return
+			case <-time.After(pluginRefreshRate):
+				out, r := stdout.Snapshot()
+				if r == rev || ctx.Err() != nil {
+					continue
+				}
+				rev = r
+				p.app.QueueUpdateDraw(func() {
+					p.Update(pluginOutputText(cmd, out, "", nil))
+				})
+			}
+		}
+	}()
+}
+
+// pluginRun runs a plugin command, writing its outputs as they come.
+func pluginRun(ctx context.Context, bin string, args []string, stdout, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+
+	return cmd.Run()
+}
+
+// cappedBuffer retains a command output up to a given size.
+type cappedBuffer struct {
+	buff      bytes.Buffer
+	max       int
+	rev       int
+	truncated bool
+	mx        sync.Mutex
+}
+
+func newCappedBuffer(max int) *cappedBuffer {
+	return &cappedBuffer{max: max}
+}
+
+// Write appends to the buffer, dropping anything past its capacity so the
+// command never stalls on a full buffer.
+func (b *cappedBuffer) Write(bb []byte) (int, error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if len(bb) == 0 || b.truncated {
+		return len(bb), nil
+	}
+	b.rev++
+	if room := b.max - b.buff.Len(); len(bb) > room {
+		b.buff.Write(bb[:room])
+		b.truncated = true
+		return len(bb), nil
+	}
+	b.buff.Write(bb)
+
+	return len(bb), nil
+}
+
+// Snapshot returns the buffer content along with its revision.
+func (b *cappedBuffer) Snapshot() (string, int) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	s := b.buff.String()
+	if b.truncated {
+		s += fmt.Sprintf("\n<output truncated at %d bytes>\n", b.max)
+	}
+
+	return s, b.rev
+}
+
+// String returns the buffer content.
+func (b *cappedBuffer) String() string {
+	s, _ := b.Snapshot()
+
+	return s
+}
+
+// pluginOutputText returns a plugin command output along with its errors if any.
+func pluginOutputText(cmd, stdout, stderr string, err error) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ %s\n\n%s", tview.Escape(cmd), tview.Escape(stdout))
+	if err == nil {
+		return b.String()
+	}
+	if !strings.HasSuffix(stdout, "\n") && stdout != "" {
+		b.WriteString("\n")
+	}
+	if stderr != "" {
+		fmt.Fprintf(&b, "\n%s", tview.Escape(stderr))
+	}
+	fmt.Fprintf(&b, "\n%s", tview.Escape(err.Error()))
+
+	return b.String()
+}
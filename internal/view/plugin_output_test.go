@@ -0,0 +1,56 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginOutputText(t *testing.T) {
+	uu := map[string]struct {
+		stdout, stderr string
+		err            error
+		e              string
+	}{
+		"ok": {
+			stdout: "fred\n",
+			e:      "$ duh -n ns1\n\nfred\n",
+		},
+		"escaped": {
+			stdout: "[red]fred",
+			e:      "$ duh -n ns1\n\n[red[]fred",
+		},
+		"failed": {
+			stdout: "fred",
+			stderr: "boom\n",
+			err:    errors.New("exit status 1"),
+			e:      "$ duh -n ns1\n\nfred\n\nboom\n\nexit status 1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, pluginOutputText("duh -n ns1", u.stdout, u.stderr, u.err))
+		})
+	}
+}
+
+func TestCappedBuffer(t *testing.T) {
+	b := newCappedBuffer(5)
+	n, err := b.Write([]byte("fred"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+	s, rev := b.Snapshot()
+	assert.Equal(t, "fred", s)
+	assert.Equal(t, 1, rev)
+
+	n, err = b.Write([]byte("blee"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+	_, _ = b.Write([]byte("duh"))
+	s, rev = b.Snapshot()
+	assert.Equal(t, "fredb\n<output truncated at 5 bytes>\n", s)
+	assert.Equal(t, 2, rev)
+}
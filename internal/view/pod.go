@@ -23,13 +23,19 @@ import (
 // Pod represents a pod viewer.
 type Pod struct {
 	ResourceViewer
+
+	cleanupCancelFn context.CancelFunc
 }
 
 // NewPod returns a new viewer.
 func NewPod(gvr client.GVR) ResourceViewer {
 	p := Pod{}
-	p.ResourceViewer = NewPortForwardExtender(
-		NewLogsExtender(NewBrowser(gvr), p.selectedContainer),
+	p.ResourceViewer = NewScheduleExtender(
+		NewProxyExtender(
+			NewPortForwardExtender(
+				NewLogsExtender(NewBrowser(gvr), p.selectedContainer),
+			),
+		),
 	)
 	p.SetBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
@@ -38,11 +44,25 @@ func NewPod(gvr client.GVR) ResourceViewer {
 	return &p
 }
 
+// Start starts the pod view and its cleanup rule.
+func (p *Pod) Start() {
+	p.ResourceViewer.Start()
+	p.startCleanup()
+}
+
+// Stop terminates the pod view and its cleanup rule.
+func (p *Pod) Stop() {
+	p.stopCleanup()
+	p.ResourceViewer.Stop()
+}
+
 func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
-		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		tcell.KeyCtrlK: ui.NewKeyActionWithID(ui.ActionKill, "Kill", p.killCmd, true),
+		ui.KeyS:        ui.NewKeyActionWithID(ui.ActionShell, "Shell", p.shellCmd, true),
+		ui.KeyA:        ui.NewKeyActionWithID(ui.ActionAttach, "Attach", p.attachCmd, true),
+		ui.KeyB:        ui.NewKeyAction("Debug", p.debugCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Clean Pods", p.cleanCmd, true),
 	})
 }
 
@@ -52,6 +72,10 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 	}
 
 	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Metrics Peek", p.metricsPeekCmd, true),
+		ui.KeyShiftG: ui.NewKeyAction("Graphs", graphsCmd(p), true),
+		ui.KeyShiftW: ui.NewKeyAction("Download Logs", p.downloadLogsCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Timeline", timelineCmd(p), true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
@@ -109,15 +133,14 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 	p.GetTable().ShowDeleted()
-	for _, res := range sels {
-		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, true); err != nil {
-			p.App().Flash().Errf("Delete failed with %s", err)
-		} else {
-			p.App().factory.DeleteForwarder(res)
+	runTrashDelete(p.App(), p.GVR(), sels, nukeFn(nuker, true, true), func(rr bulkResults) {
+		for _, r := range rr {
+			if r.err == nil {
+				p.App().factory.DeleteForwarder(r.path)
+			}
 		}
-	}
-	p.Refresh()
+		p.Refresh()
+	})
 
 	return nil
 }
@@ -158,6 +181,63 @@ func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	cc, err := fetchContainers(p.App().factory, path, false)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	cfg := p.App().Config.K9s.GetDebugContainer()
+	opts := dao.DebugOptions{Image: cfg.Image, Command: cfg.Command}
+	if len(cc) > 0 {
+		opts.Target = cc[0]
+	}
+	ShowDebug(p.App(), path, opts, p.debug)
+
+	return nil
+}
+
+// debug launches an ephemeral debug container and attaches to it once running.
+func (p *Pod) debug(path string, opts dao.DebugOptions) {
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return
+	}
+	d, ok := res.(dao.Debuggable)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a debuggable resource for %q", p.GVR()))
+		return
+	}
+
+	p.App().Flash().Infof("Launching debug container %s on %s...", opts.Image, path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), debugTimeout)
+		defer cancel()
+		co, err := d.Debug(ctx, path, opts)
+		if err == nil {
+			err = d.WaitForDebug(ctx, path, co)
+		}
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				p.App().Flash().Err(err)
+				return
+			}
+			resumeAttachIn(p.App(), p, path, co)
+		})
+	}()
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -195,9 +275,15 @@ func resumeShellIn(a *App, c model.Component, path, co string) {
 }
 
 func shellIn(a *App, path, co string) {
-	args := computeShellArgs(path, co, a.Conn().Config().Flags().KubeConfig)
-
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	if a.Config.K9s.GetShellRecording().Enabled {
+		if !recordShell(a, path, co, []string{"sh", "-c", shellCheck}, c.Sprintf(bannerFmt, path, co)) {
+			a.Flash().Err(errors.New("Shell exec failed"))
+		}
+		return
+	}
+
+	args := computeShellArgs(path, co, a.Conn().Config().Flags().KubeConfig)
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
 		a.Flash().Err(errors.New("Shell exec failed"))
 	}
@@ -237,8 +323,15 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 }
 
 func attachIn(a *App, path, co string) {
-	args := buildShellArgs("attach", path, co, a.Conn().Config().Flags().KubeConfig)
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	if a.Config.K9s.GetShellRecording().Enabled {
+		if !recordShell(a, path, co, nil, c.Sprintf(bannerFmt, path, co)) {
+			a.Flash().Err(errors.New("Attach exec failed"))
+		}
+		return
+	}
+
+	args := buildShellArgs("attach", path, co, a.Conn().Config().Flags().KubeConfig)
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
 		a.Flash().Err(errors.New("Attach exec failed"))
 	}
@@ -0,0 +1,145 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const cleanupInterval = time.Minute
+
+func (p *Pod) cleanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns := client.CleanseNamespace(p.App().Config.ActiveNamespace())
+	age := p.App().Config.K9s.GetPodCleanup().Age()
+	go func() {
+		pp, err := p.stalePods(ns, age)
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				p.App().Flash().Err(err)
+				return
+			}
+			p.confirmClean(ns, age, pp)
+		})
+	}()
+
+	return nil
+}
+
+func (p *Pod) confirmClean(ns string, age time.Duration, pp []string) {
+	if len(pp) == 0 {
+		p.App().Flash().Infof("No completed or evicted pods older than %s", age)
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Delete %d completed or evicted pod(s) older than %s?", len(pp), age)
+	level := p.App().Config.ConfirmLevel(config.ActionDelete)
+	if client.IsAllNamespaces(ns) {
+		b.WriteString("\nThis sweeps all namespaces.")
+		level = config.ConfirmTyped
+	}
+	listDependents(&b, pp)
+	dialog.ShowConfirm(p.App().Content.Pages, level, confirmName(p.App().Config.K9s.CurrentContext, pp), "Clean Pods", b.String(), func() {
+		go func() {
+			p.cleanPods(pp)
+			p.App().QueueUpdateDraw(p.Refresh)
+		}()
+	}, func() {})
+}
+
+// stalePods returns the stale pods in a namespace the user is allowed to delete.
+func (p *Pod) stalePods(ns string, age time.Duration) ([]string, error) {
+	auth, err := p.App().factory.Client().CanI(ns, p.GVR().String(), []string{client.DeleteVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to delete pods in namespace %q", ns)
+	}
+
+	return dao.StalePods(p.App().factory, ns, age)
+}
+
+func (p *Pod) cleanPods(pp []string) {
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
+		return
+	}
+	snapshot(p.App(), p.GVR(), pp)
+	var count int
+	for _, path := range pp {
+		if err := nuker.Delete(path, true, false); err != nil {
+			log.Warn().Err(err).Msgf("Unable to clean pod %s", path)
+			continue
+		}
+		count++
+	}
+	p.App().Flash().Infof("Cleaned %d of %d pod(s)", count, len(pp))
+}
+
+func (p *Pod) startCleanup() {
+	p.stopCleanup()
+	if !p.App().Config.K9s.GetPodCleanup().Enabled {
+		return
+	}
+
+	var ctx context.Context
+	ctx, p.cleanupCancelFn = context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(cleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.cleanupRule()
+			}
+		}
+	}()
+}
+
+func (p *Pod) stopCleanup() {
+	if p.cleanupCancelFn != nil {
+		p.cleanupCancelFn()
+		p.cleanupCancelFn = nil
+	}
+}
+
+// cleanupRule flags or deletes stale pods in the current namespace. Stale pods
+// are only flagged when viewing all namespaces so the rule never sweeps the
+// whole cluster unattended.
+func (p *Pod) cleanupRule() {
+	cfg := p.App().Config.K9s.GetPodCleanup()
+	if !cfg.Enabled {
+		return
+	}
+	ns := client.CleanseNamespace(p.App().Config.ActiveNamespace())
+	pp, err := p.stalePods(ns, cfg.Age())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Pod cleanup failed")
+		return
+	}
+	if len(pp) == 0 {
+		return
+	}
+	if !cfg.AutoDelete || p.App().Config.K9s.GetReadOnly() || client.IsAllNamespaces(ns) {
+		p.App().Flash().Warnf("%d completed or evicted pod(s) older than %s. Use <shift-k> to clean", len(pp), cfg.Age())
+		return
+	}
+	p.cleanPods(pp)
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// initPrivacy loads the privacy mode redaction rules.
+func (a *App) initPrivacy() {
+	a.loadPrivacyRules()
+	render.SetPrivacyMode(a.Config.K9s.GetPrivacy().Enabled)
+}
+
+// reloadPrivacy reapplies the privacy rules once the configuration reloaded.
+// Privacy mode is never turned off by a reload.
+func (a *App) reloadPrivacy() {
+	a.loadPrivacyRules()
+	if a.Config.K9s.GetPrivacy().Enabled {
+		render.SetPrivacyMode(true)
+	}
+	a.refreshPrivacy()
+}
+
+func (a *App) loadPrivacyRules() {
+	p := a.Config.K9s.GetPrivacy()
+	rr := make([]render.PrivacyRule, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		rule, err := render.NewPrivacyRule(r.Resources, r.Columns, r.Pattern)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid privacy rule pattern %q", r.Pattern)
+			continue
+		}
+		rr = append(rr, rule)
+	}
+	render.SetPrivacyRules(rr)
+}
+
+// refreshPrivacy redraws the views showing possibly masked values.
+func (a *App) refreshPrivacy() {
+	if l, ok := a.Content.Top().(config.StyleListener); ok {
+		l.StylesChanged(a.Styles)
+	}
+	a.Crumbs().StylesChanged(a.Styles)
+}
+
+func (a *App) privacyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+
+	on := !render.IsPrivacyMode()
+	render.SetPrivacyMode(on)
+	a.refreshPrivacy()
+	if on {
+		a.Flash().Info("Privacy mode on. Sensitive values are masked")
+	} else {
+		a.Flash().Info("Privacy mode off")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// PromoteExtender represents a progressive delivery resource.
+type PromoteExtender struct {
+	ResourceViewer
+}
+
+// NewPromoteExtender returns a new extender.
+func NewPromoteExtender(v ResourceViewer) ResourceViewer {
+	p := PromoteExtender{ResourceViewer: v}
+	p.bindKeys(v.Actions())
+
+	return &p
+}
+
+func (p *PromoteExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Promote", p.promoteCmd(false), true),
+		ui.KeyShiftP: ui.NewKeyAction("Promote Full", p.promoteCmd(true), true),
+		ui.KeyShiftX: ui.NewKeyAction("Abort", p.abortCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Retry", p.retryCmd, true),
+	})
+}
+
+func (p *PromoteExtender) promoteCmd(full bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		verb, done := "Promote", "promoted"
+		if full {
+			verb = "Fully promote"
+		}
+		if p.GVR().R() == "canaries" {
+			done = "promoted. Its analysis is skipped until the promotion completes"
+		}
+		p.confirm(verb, done, func(ctx context.Context, pr dao.Promotable, path string) error {
+			return pr.Promote(ctx, path, full)
+		})
+
+		return nil
+	}
+}
+
+func (p *PromoteExtender) abortCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.confirm("Abort", "aborted", func(ctx context.Context, pr dao.Promotable, path string) error {
+		return pr.Abort(ctx, path)
+	})
+
+	return nil
+}
+
+func (p *PromoteExtender) retryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.confirm("Retry", "retried", func(ctx context.Context, pr dao.Promotable, path string) error {
+		return pr.Retry(ctx, path)
+	})
+
+	return nil
+}
+
+func (p *PromoteExtender) confirm(verb, done string, fn func(context.Context, dao.Promotable, string) error) {
+	paths := p.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
+		return
+	}
+
+	p.Stop()
+	defer p.Start()
+	msg := fmt.Sprintf("%s %s?", verb, paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("%s %d %s?", verb, len(paths), p.GVR().R())
+	}
+	level := p.App().Config.ConfirmLevel(config.ActionPromote)
+	dialog.ShowConfirm(p.App().Content.Pages, level, confirmName(p.App().Config.K9s.CurrentContext, paths), "Confirm "+verb, msg, func() {
+		res, err := dao.AccessorFor(p.App().factory, p.GVR())
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		pr, ok := res.(dao.Promotable)
+		if !ok {
+			p.App().Flash().Err(errors.New("resource is not promotable"))
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		defer cancel()
+		for _, path := range paths {
+			if err := fn(ctx, pr, path); err != nil {
+				p.App().Flash().Err(err)
+			} else {
+				p.App().Flash().Infof("Rollout %s %s", path, done)
+			}
+		}
+	}, func() {})
+}
@@ -0,0 +1,144 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	proxyKey         = "proxy"
+	proxyTitle       = "Proxy"
+	defaultProxyPath = "/metrics"
+)
+
+// ProxyExtender adds api server proxy requests extensions.
+type ProxyExtender struct {
+	ResourceViewer
+}
+
+// NewProxyExtender returns a new extender.
+func NewProxyExtender(r ResourceViewer) ResourceViewer {
+	p := ProxyExtender{ResourceViewer: r}
+	p.bindKeys(p.Actions())
+
+	return &p
+}
+
+func (p *ProxyExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftH: ui.NewKeyAction("HTTP Proxy", p.proxyCmd, true),
+	})
+}
+
+func (p *ProxyExtender) proxyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	go func() {
+		port := p.defaultPort(path)
+		p.App().QueueUpdateDraw(func() {
+			p.showProxyDialog(path, port)
+		})
+	}()
+
+	return nil
+}
+
+// defaultPort returns the first tcp port exposed by the selected resource.
+// It hits the api server so must be called off the UI thread.
+func (p *ProxyExtender) defaultPort(path string) string {
+	switch p.GVR().R() {
+	case "services":
+		var res dao.Service
+		res.Init(p.App().factory, p.GVR())
+		svc, err := res.GetInstance(path)
+		if err != nil {
+			return ""
+		}
+		for _, sp := range svc.Spec.Ports {
+			if sp.Protocol == v1.ProtocolTCP || sp.Protocol == "" {
+				return strconv.Itoa(int(sp.Port))
+			}
+		}
+	default:
+		mm, err := fetchPodPorts(p.App().factory, path)
+		if err != nil {
+			return ""
+		}
+		for _, pp := range mm {
+			for _, cp := range pp {
+				if cp.Protocol == v1.ProtocolTCP || cp.Protocol == "" {
+					return strconv.Itoa(int(cp.ContainerPort))
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func (p *ProxyExtender) showProxyDialog(path, port string) {
+	styles := p.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	target := defaultProxyPath
+	f.AddInputField("Port:", port, 30, nil, func(s string) {
+		port = s
+	})
+	f.AddInputField("Path:", target, 30, nil, func(s string) {
+		target = s
+	})
+
+	pages := p.App().Content.Pages
+	f.AddButton("OK", func() {
+		pages.RemovePage(proxyKey)
+		go p.proxy(path, port, target)
+	})
+	f.AddButton("Cancel", func() {
+		pages.RemovePage(proxyKey)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<Proxy GET on %s>", path), f)
+	modal.SetDoneFunc(func(_ int, b string) {
+		pages.RemovePage(proxyKey)
+	})
+	pages.AddPage(proxyKey, modal, false, true)
+	pages.ShowPage(proxyKey)
+	p.App().SetFocus(pages.GetPrimitive(proxyKey))
+}
+
+func (p *ProxyExtender) proxy(path, port, target string) {
+	raw, err := dao.ProxyGet(p.App().factory, p.GVR(), path, port, target)
+	p.App().QueueUpdateDraw(func() {
+		if err != nil && len(raw) == 0 {
+			p.App().Flash().Err(err)
+			return
+		}
+		subject := path
+		if port != "" {
+			subject += ":" + port
+		}
+		details := NewDetails(p.App(), proxyTitle, subject+target, true)
+		buff := fmt.Sprintf("GET %s\n\n%s", target, raw)
+		if err != nil {
+			buff = fmt.Sprintf("GET %s\n%s\n\n%s", target, err, raw)
+		}
+		if err := p.App().inject(details.Update(buff)); err != nil {
+			p.App().Flash().Err(err)
+		}
+	})
+}
@@ -0,0 +1,248 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/kubectl/pkg/util/term"
+)
+
+const (
+	recordingStartFmt = "k9s session started | Context: %s | Pod: %s | Container: %s | User: %s"
+	recordingStopFmt  = "k9s session ended | Duration: %s"
+	recordingCapFmt   = "k9s recording stopped | Size cap of %dMB reached"
+)
+
+// recordShell runs a pod shell or attach session in process, recording its
+// output to the configured recordings directory.
+func recordShell(a *App, path, co string, cmd []string, banner string) bool {
+	a.Halt()
+	defer a.Resume()
+
+	var file string
+	ok := a.Suspend(func() {
+		var err error
+		if file, err = recordSession(a, path, co, cmd, banner); err != nil {
+			a.Flash().Errf("Command exited: %v", err)
+		}
+	})
+	if ok && file != "" {
+		a.Flash().Infof("Session recorded to %s", file)
+	}
+
+	return ok
+}
+
+func recordSession(a *App, path, co string, cmd []string, banner string) (string, error) {
+	clearScreen()
+	defer clearScreen()
+
+	t := term.TTY{In: os.Stdin, Out: os.Stdout, Raw: true}
+	size := t.GetSize()
+	meta := recordingMeta{
+		context:   a.Config.K9s.CurrentContext,
+		path:      render.RedactText(path),
+		container: render.RedactText(co),
+		user:      config.MustK9sUser(),
+	}
+	if size != nil {
+		meta.width, meta.height = int(size.Width), int(size.Height)
+	}
+	rc := a.Config.K9s.GetShellRecording()
+	file, rec, err := openRecording(rc, meta)
+	if err != nil {
+		return "", err
+	}
+	log.Debug().Msgf("Recording shell session %s:%s to %s", path, co, file)
+
+	_, _ = os.Stdout.Write([]byte(banner))
+	err = t.Safe(func() error {
+		return dao.Exec(a.Conn(), path, dao.ExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdin:     os.Stdin,
+			Stdout:    io.MultiWriter(os.Stdout, rec),
+			SizeQueue: t.MonitorSize(size),
+		})
+	})
+	if e := rec.Close(); e != nil {
+		log.Error().Err(e).Msgf("Closing recording %s", file)
+	}
+
+	return file, err
+}
+
+func openRecording(rc *config.ShellRecording, meta recordingMeta) (string, *sessionRecorder, error) {
+	if err := ensureDir(rc.Dir); err != nil {
+		return "", nil, err
+	}
+	ext := ".cast"
+	if rc.Format == config.RecordingTranscript {
+		ext = ".log"
+	}
+	ns, n := client.Namespaced(meta.path)
+	name := strings.Join([]string{meta.context, ns, n, meta.container}, "-")
+	name = strings.NewReplacer("/", "-", ":", "-", " ", "_").Replace(name)
+	file := filepath.Join(rc.Dir, fmt.Sprintf("%s-%d%s", name, time.Now().Unix(), ext))
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return "", nil, err
+	}
+	rec, err := newSessionRecorder(f, rc.Format, meta)
+	if err != nil {
+		_ = f.Close()
+		return "", nil, err
+	}
+	rec.maxMB = rc.MaxMB
+
+	return file, rec, nil
+}
+
+// recordingMeta tracks a recorded session details.
+type recordingMeta struct {
+	context, path, container, user string
+	width, height                  int
+}
+
+// sessionRecorder records a session output either as an asciinema v2 cast or
+// a plain transcript, annotated with the session start and end. Output is
+// redacted in privacy mode and no longer recorded past the size cap.
+type sessionRecorder struct {
+	out     io.WriteCloser
+	format  string
+	start   time.Time
+	pending []byte
+	maxMB   int
+	size    int64
+	stopped bool
+}
+
+func newSessionRecorder(out io.WriteCloser, format string, meta recordingMeta) (*sessionRecorder, error) {
+	r := sessionRecorder{out: out, format: format, start: time.Now()}
+	msg := fmt.Sprintf(recordingStartFmt, meta.context, meta.path, meta.container, meta.user)
+	if format == config.RecordingTranscript {
+		_, err := fmt.Fprintf(out, "[%s] %s\n", r.start.Format(time.RFC3339), msg)
+		return &r, err
+	}
+
+	width, height := meta.width, meta.height
+	if width == 0 || height == 0 {
+		width, height = 80, 24
+	}
+	header := map[string]interface{}{
+		"version":   2,
+		"width":     width,
+		"height":    height,
+		"timestamp": r.start.Unix(),
+		"title":     meta.path + ":" + meta.container,
+		"env":       map[string]string{"TERM": os.Getenv("TERM"), "SHELL": "sh"},
+	}
+	raw, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := out.Write(append(raw, '\n')); err != nil {
+		return nil, err
+	}
+
+	return &r, r.event("m", msg)
+}
+
+// Write records session output. Casts hold back incomplete utf8 sequences
+// until the next write so events stay valid json strings. Recording errors
+// never fail the session output.
+func (r *sessionRecorder) Write(p []byte) (int, error) {
+	if r.stopped {
+		return len(p), nil
+	}
+	r.size += int64(len(p))
+	if r.maxMB > 0 && r.size > int64(r.maxMB)<<20 {
+		r.stop(fmt.Sprintf(recordingCapFmt, r.maxMB))
+		return len(p), nil
+	}
+	if r.format == config.RecordingTranscript {
+		if _, err := io.WriteString(r.out, render.RedactText(string(p))); err != nil {
+			log.Error().Err(err).Msg("Recording failed")
+			r.stopped = true
+		}
+		return len(p), nil
+	}
+
+	data := append(r.pending, p...)
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	r.pending = append([]byte(nil), data[cut:]...)
+	if cut == 0 {
+		return len(p), nil
+	}
+	if err := r.event("o", render.RedactText(string(data[:cut]))); err != nil {
+		log.Error().Err(err).Msg("Recording failed")
+		r.stopped = true
+	}
+
+	return len(p), nil
+}
+
+// stop annotates the recording was stopped before the session ended.
+func (r *sessionRecorder) stop(msg string) {
+	r.stopped, r.pending = true, nil
+	var err error
+	if r.format == config.RecordingTranscript {
+		_, err = fmt.Fprintf(r.out, "\n[%s] %s\n", time.Now().Format(time.RFC3339), msg)
+	} else {
+		err = r.event("m", msg)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Recording failed")
+	}
+}
+
+// Close annotates the session end and closes the recording.
+func (r *sessionRecorder) Close() error {
+	msg := fmt.Sprintf(recordingStopFmt, time.Since(r.start).Round(time.Second))
+	var err error
+	if r.format == config.RecordingTranscript {
+		_, err = fmt.Fprintf(r.out, "\n[%s] %s\n", time.Now().Format(time.RFC3339), msg)
+	} else {
+		if len(r.pending) > 0 {
+			err = r.event("o", render.RedactText(string(r.pending)))
+			r.pending = nil
+		}
+		if err == nil {
+			err = r.event("m", msg)
+		}
+	}
+	if e := r.out.Close(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+func (r *sessionRecorder) event(kind, data string) error {
+	raw, err := json.Marshal([]interface{}{time.Since(r.start).Seconds(), kind, data})
+	if err != nil {
+		return err
+	}
+	_, err = r.out.Write(append(raw, '\n'))
+
+	return err
+}
@@ -0,0 +1,96 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRecorderCast(t *testing.T) {
+	var buff closingBuffer
+	meta := recordingMeta{context: "ctx", path: "default/fred", container: "blee", user: "duh", width: 100, height: 40}
+	r, err := newSessionRecorder(&buff, config.RecordingCast, meta)
+	assert.Nil(t, err)
+
+	s := []byte("héllo")
+	_, err = r.Write(s[:2])
+	assert.Nil(t, err)
+	_, err = r.Write(s[2:])
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+	assert.True(t, buff.closed)
+
+	ll := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	assert.Equal(t, 5, len(ll))
+
+	var header map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(ll[0]), &header))
+	assert.Equal(t, float64(2), header["version"])
+	assert.Equal(t, float64(100), header["width"])
+	assert.Equal(t, "default/fred:blee", header["title"])
+
+	ee := make([][]interface{}, 0, len(ll)-1)
+	for _, l := range ll[1:] {
+		var e []interface{}
+		assert.Nil(t, json.Unmarshal([]byte(l), &e))
+		ee = append(ee, e)
+	}
+	assert.Equal(t, "m", ee[0][1])
+	assert.Contains(t, ee[0][2], "Pod: default/fred")
+	assert.Equal(t, []interface{}{"o", "h"}, ee[1][1:])
+	assert.Equal(t, []interface{}{"o", "éllo"}, ee[2][1:])
+	assert.Equal(t, "m", ee[3][1])
+	assert.Contains(t, ee[3][2], "session ended")
+}
+
+func TestSessionRecorderTranscript(t *testing.T) {
+	var buff closingBuffer
+	meta := recordingMeta{context: "ctx", path: "default/fred", container: "blee", user: "duh"}
+	r, err := newSessionRecorder(&buff, config.RecordingTranscript, meta)
+	assert.Nil(t, err)
+
+	_, err = r.Write([]byte("$ ls\r\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+
+	ll := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	assert.Equal(t, 4, len(ll))
+	assert.Contains(t, ll[0], "k9s session started | Context: ctx | Pod: default/fred | Container: blee | User: duh")
+	assert.Equal(t, "$ ls\r", ll[1])
+	assert.Contains(t, ll[3], "k9s session ended")
+}
+
+func TestSessionRecorderCap(t *testing.T) {
+	var buff closingBuffer
+	meta := recordingMeta{context: "ctx", path: "default/fred", container: "blee", user: "duh"}
+	r, err := newSessionRecorder(&buff, config.RecordingTranscript, meta)
+	assert.Nil(t, err)
+	r.maxMB = 1
+
+	_, err = r.Write(bytes.Repeat([]byte("a"), 1<<20))
+	assert.Nil(t, err)
+	n, err := r.Write([]byte("zorg"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+	assert.Nil(t, r.Close())
+
+	assert.NotContains(t, buff.String(), "zorg")
+	assert.Contains(t, buff.String(), "Size cap of 1MB reached")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
@@ -14,8 +14,12 @@ func loadCustomViewers() MetaViewers {
 	appsViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
+	autoscalingViewers(m)
 	extViewers(m)
 	helmViewers(m)
+	karpenterViewers(m)
+	rolloutViewers(m)
+	knativeViewers(m)
 
 	return m
 }
@@ -26,6 +30,38 @@ func helmViewers(vv MetaViewers) {
 	}
 }
 
+func karpenterViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("karpenter.sh/"+v+"/nodepools")] = MetaViewer{
+			viewerFn: NewNodePool,
+		}
+		vv[client.NewGVR("karpenter.sh/"+v+"/nodeclaims")] = MetaViewer{
+			viewerFn: NewNodeClaim,
+		}
+	}
+}
+
+func rolloutViewers(vv MetaViewers) {
+	vv[client.NewGVR("argoproj.io/v1alpha1/rollouts")] = MetaViewer{
+		viewerFn: NewRollout,
+	}
+	vv[client.NewGVR("argoproj.io/v1alpha1/analysisruns")] = MetaViewer{
+		viewerFn: NewAnalysisRun,
+	}
+	vv[client.NewGVR("flagger.app/v1beta1/canaries")] = MetaViewer{
+		viewerFn: NewCanary,
+	}
+}
+
+func knativeViewers(vv MetaViewers) {
+	vv[client.NewGVR("serving.knative.dev/v1/services")] = MetaViewer{
+		viewerFn: NewKnService,
+	}
+	vv[knRevisionGVR] = MetaViewer{
+		viewerFn: NewKnRevision,
+	}
+}
+
 func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/namespaces")] = MetaViewer{
 		viewerFn: NewNamespace,
@@ -66,6 +102,9 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("screendumps")] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.NewGVR("trash")] = MetaViewer{
+		viewerFn: NewTrash,
+	}
 	vv[client.NewGVR("benchmarks")] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
@@ -135,6 +174,14 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v2beta1", "v2beta2"} {
+		vv[client.NewGVR("autoscaling/"+v+"/horizontalpodautoscalers")] = MetaViewer{
+			viewerFn: NewHorizontalPodAutoscaler,
+		}
+	}
+}
+
 func extViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		enterFn: showCRD,
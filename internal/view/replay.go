@@ -0,0 +1,223 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	replayTitle  = "replay"
+	recordingExt = ".rec"
+)
+
+// recordCmd toggles the session recording.
+func (a *App) recordCmd() {
+	if a.recordFn != nil {
+		path, err := a.stopRecording()
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Session recorded to %s", path)
+		return
+	}
+
+	var ctx context.Context
+	ctx, a.recordFn = context.WithCancel(context.Background())
+	a.recording = model.NewRecording(model.MaxRecordingFrames)
+	a.recordFrame()
+	go a.recorder(ctx)
+	a.Flash().Info("Recording session. Enter :record again to stop")
+}
+
+func (a *App) recorder(ctx context.Context) {
+	rate := time.Duration(a.Config.K9s.GetRefreshRate()) * time.Second
+	if rate <= 0 {
+		rate = time.Second
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+			a.QueueUpdate(a.recordFrame)
+		}
+	}
+}
+
+// recordFrame snapshots the current view.
+func (a *App) recordFrame() {
+	if a.recording == nil {
+		return
+	}
+	if top := a.Content.Top(); top != nil && top.Name() == replayTitle {
+		return
+	}
+	if f, ok := a.topFrame(); ok {
+		a.recording.Record(f)
+	}
+}
+
+// topFrame snapshots the top table view if any, redacted by the privacy rules.
+func (a *App) topFrame() (model.Frame, bool) {
+	top := a.Content.Top()
+	if top == nil {
+		return model.Frame{}, false
+	}
+	v, ok := top.(interface{ GetTable() *Table })
+	if !ok {
+		return model.Frame{}, false
+	}
+
+	t := v.GetTable()
+	f := model.NewFrame(time.Now(), top.Name(), t.GetModel().Peek())
+
+	return f.Redact(t.GVR().String()), true
+}
+
+// stopRecording stops the session recording if any and saves it.
+func (a *App) stopRecording() (string, error) {
+	if a.recordFn == nil {
+		return "", nil
+	}
+	a.recordFn()
+	a.recordFn = nil
+
+	return a.saveRecording()
+}
+
+// flushRecording saves an in flight session recording on exit.
+func (a *App) flushRecording() {
+	path, err := a.stopRecording()
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to save session recording")
+		return
+	}
+	if path != "" {
+		log.Info().Msgf("Session recorded to %q", path)
+	}
+}
+
+func (a *App) saveRecording() (string, error) {
+	dir := filepath.Join(config.K9sDumpDir, a.Config.K9s.CurrentCluster)
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("session-%d%s", time.Now().UnixNano(), recordingExt))
+	if err := a.recording.Save(path); err != nil {
+		return "", err
+	}
+	log.Debug().Msgf("Saved %d session frames to %q", a.recording.Len(), path)
+	a.recording = nil
+
+	return path, nil
+}
+
+// Replay plays back a recorded session.
+type Replay struct {
+	*Table
+
+	recording *model.Recording
+	model     *staticModel
+}
+
+// NewReplay returns a new session replay viewer.
+func NewReplay(r *model.Recording) *Replay {
+	return &Replay{
+		Table:     NewTable(client.NewGVR(replayTitle)),
+		recording: r,
+		model:     &staticModel{Table: model.NewTable(client.NewGVR(replayTitle))},
+	}
+}
+
+// Init initializes the component.
+func (r *Replay) Init(ctx context.Context) error {
+	if err := r.Table.Init(ctx); err != nil {
+		return err
+	}
+	r.SetModel(r.model)
+	r.SetBorderFocusColor(tcell.ColorMediumPurple)
+	r.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumPurple, tcell.AttrNone)
+	r.bindKeys()
+	r.show()
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *Replay) Name() string { return replayTitle }
+
+func (r *Replay) bindKeys() {
+	r.Actions().Delete(tcell.KeyCtrlZ, tcell.KeyCtrlG)
+	r.Actions().Add(ui.KeyActions{
+		ui.KeyRBracket:  ui.NewKeyAction("Next Frame", r.nextCmd, true),
+		ui.KeyLBracket:  ui.NewKeyAction("Prev Frame", r.prevCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.app.PrevCmd, false),
+	})
+}
+
+func (r *Replay) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !r.recording.Next() {
+		r.app.Flash().Info("Last frame reached")
+		return nil
+	}
+	r.show()
+
+	return nil
+}
+
+func (r *Replay) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !r.recording.Prev() {
+		r.app.Flash().Info("First frame reached")
+		return nil
+	}
+	r.show()
+
+	return nil
+}
+
+func (r *Replay) show() {
+	f, ok := r.recording.Current()
+	if !ok {
+		r.app.Flash().Warn("No frames recorded")
+		return
+	}
+	n, total := r.recording.Position()
+	r.model.SetNamespace(f.Namespace)
+	r.model.data = f.TableData()
+	r.Extras = fmt.Sprintf("%s %d/%d@%s", f.View, n, total, f.At.Format("15:04:05"))
+	r.Update(r.model.data)
+}
+
+// staticModel serves fixed table data, ie a replay frame.
+type staticModel struct {
+	*model.Table
+
+	data render.TableData
+}
+
+// Empty returns true if there are no rows.
+func (m *staticModel) Empty() bool {
+	return len(m.data.RowEvents) == 0
+}
+
+// Peek returns the table data.
+func (m *staticModel) Peek() render.TableData {
+	return m.data
+}
+
+// Watch is a noop since the data is static.
+func (m *staticModel) Watch(context.Context) {}
+
+// Refresh is a noop since the data is static.
+func (m *staticModel) Refresh(context.Context) {}
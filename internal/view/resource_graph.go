@@ -0,0 +1,273 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	resourceGraphTitle = "Graphs"
+	graphRefreshRate   = 30 * time.Second
+
+	graphCPU = "cpu"
+	graphMEM = "mem"
+	graphNET = "net"
+)
+
+// ResourceGraph presents a pod or node usage history from Prometheus.
+type ResourceGraph struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	path     string
+	provider dao.MetricsProvider
+	actions  ui.KeyActions
+	charts   []*tchart.SparkLine
+	ctx      context.Context
+	cancelFn context.CancelFunc
+}
+
+// NewResourceGraph returns a new usage graphs viewer for a pod or a node.
+func NewResourceGraph(gvr client.GVR, path string) *ResourceGraph {
+	return &ResourceGraph{
+		Flex:    tview.NewFlex(),
+		gvr:     gvr,
+		path:    path,
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the component.
+func (r *ResourceGraph) Init(ctx context.Context) error {
+	var err error
+	if r.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	r.SetDirection(tview.FlexRow)
+	r.SetBorder(true)
+	r.SetBorderPadding(0, 0, 1, 1)
+	r.SetTitle(fmt.Sprintf(" %s([%s::b]%s[-::]) ", resourceGraphTitle, r.app.Styles.Frame().Title.CounterColor.String(), r.path))
+	ns := client.CleanseNamespace(r.app.Config.ActiveNamespace())
+	r.provider = dao.NewMetricsProvider(r.app.factory, r.app.Config.K9s.ActiveCluster().GetPrometheus(), ns)
+	for _, id := range []string{graphCPU, graphMEM, graphNET} {
+		s := tchart.NewSparkLine(id)
+		s.SetBorderPadding(0, 1, 0, 1)
+		s.SetInputCapture(r.keyboard)
+		s.SetLegend(graphLegend(id, nil, nil))
+		r.charts = append(r.charts, s)
+		r.AddItem(s, 0, 1, id == graphCPU)
+	}
+	r.bindKeys()
+	r.StylesChanged(r.app.Styles)
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *ResourceGraph) Name() string { return resourceGraphTitle }
+
+// StylesChanged notifies the skin changed.
+func (r *ResourceGraph) StylesChanged(s *config.Styles) {
+	r.SetBackgroundColor(s.Charts().BgColor.Color())
+	for _, c := range r.charts {
+		c.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorColor.String())
+		c.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+		c.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+		if cc, ok := s.Charts().ResourceColors[c.ID()]; ok {
+			c.SetSeriesColors(cc.Colors()...)
+		}
+	}
+}
+
+// Start starts the graphs refresh loop.
+func (r *ResourceGraph) Start() {
+	r.Stop()
+	r.app.Styles.AddListener(r)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, r.app.factory)
+	r.ctx, r.cancelFn = context.WithCancel(ctx)
+	go r.refresh(r.ctx)
+}
+
+// Stop terminates the graphs refresh loop.
+func (r *ResourceGraph) Stop() {
+	if r.cancelFn == nil {
+		return
+	}
+	r.cancelFn()
+	r.cancelFn, r.ctx = nil, nil
+	r.app.Styles.RemoveListener(r)
+}
+
+// Hints returns the view hints.
+func (r *ResourceGraph) Hints() model.MenuHints {
+	return r.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (r *ResourceGraph) ExtraHints() map[string]string {
+	return nil
+}
+
+func (r *ResourceGraph) bindKeys() {
+	r.actions.Add(ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", r.refreshCmd, true),
+		tcell.KeyTab:    ui.NewKeyAction("Next", r.nextFocusCmd(1), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.app.PrevCmd, false),
+	})
+}
+
+func (r *ResourceGraph) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := r.actions[key]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (r *ResourceGraph) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if r.ctx == nil {
+		return nil
+	}
+	go r.load(r.ctx)
+
+	return nil
+}
+
+func (r *ResourceGraph) nextFocusCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		for i, c := range r.charts {
+			if c.HasFocus() {
+				r.app.SetFocus(r.charts[(i+direction+len(r.charts))%len(r.charts)])
+				return nil
+			}
+		}
+		r.app.SetFocus(r.charts[0])
+
+		return nil
+	}
+}
+
+func (r *ResourceGraph) refresh(ctx context.Context) {
+	r.load(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(graphRefreshRate):
+			r.load(ctx)
+		}
+	}
+}
+
+func (r *ResourceGraph) load(ctx context.Context) {
+	var (
+		ss  *dao.ResourceSeries
+		err error
+	)
+	if r.gvr.String() == "v1/nodes" {
+		ss, err = r.provider.NodeSeries(ctx, r.path)
+	} else {
+		ss, err = r.provider.PodSeries(ctx, r.path)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	r.app.QueueUpdateDraw(func() {
+		if err != nil {
+			r.app.Flash().Err(err)
+			return
+		}
+		r.update(ss)
+	})
+}
+
+func (r *ResourceGraph) update(ss *dao.ResourceSeries) {
+	for _, c := range r.charts {
+		var s1, s2 []int64
+		switch c.ID() {
+		case graphCPU:
+			s1 = ss.CPU
+		case graphMEM:
+			s1 = ss.MEM
+		case graphNET:
+			s1, s2 = ss.RX, ss.TX
+		}
+		c.SetMetrics(toMetrics(s1, s2))
+		c.SetLegend(graphLegend(c.ID(), s1, s2))
+	}
+}
+
+func toMetrics(s1, s2 []int64) []tchart.Metric {
+	mm := make([]tchart.Metric, len(s1))
+	for i := range s1 {
+		mm[i].S1 = s1[i]
+		if i < len(s2) {
+			mm[i].S2 = s2[i]
+		}
+	}
+
+	return mm
+}
+
+// graphLegend returns a graph legend with the latest and peak values.
+func graphLegend(id string, s1, s2 []int64) string {
+	switch id {
+	case graphCPU:
+		cur, peak := seriesStats(s1)
+		return fmt.Sprintf(" CPU %sm (peak %sm) ", render.AsThousands(cur), render.AsThousands(peak))
+	case graphMEM:
+		cur, peak := seriesStats(s1)
+		return fmt.Sprintf(" MEM %sMi (peak %sMi) ", render.AsThousands(cur), render.AsThousands(peak))
+	default:
+		rx, _ := seriesStats(s1)
+		tx, _ := seriesStats(s2)
+		return fmt.Sprintf(" NET rx %sKi/s tx %sKi/s ", render.AsThousands(rx), render.AsThousands(tx))
+	}
+}
+
+func seriesStats(ss []int64) (int64, int64) {
+	var cur, peak int64
+	for _, s := range ss {
+		if s > peak {
+			peak = s
+		}
+	}
+	if len(ss) > 0 {
+		cur = ss[len(ss)-1]
+	}
+
+	return cur, peak
+}
+
+// graphsCmd shows the selected resource usage graphs.
+func graphsCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewResourceGraph(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphLegend(t *testing.T) {
+	uu := map[string]struct {
+		id     string
+		s1, s2 []int64
+		e      string
+	}{
+		"cpu": {
+			id: graphCPU,
+			s1: []int64{10, 1500, 20},
+			e:  " CPU 20m (peak 1,500m) ",
+		},
+		"mem": {
+			id: graphMEM,
+			s1: []int64{128, 64},
+			e:  " MEM 64Mi (peak 128Mi) ",
+		},
+		"net": {
+			id: graphNET,
+			s1: []int64{1, 2},
+			s2: []int64{3, 4},
+			e:  " NET rx 2Ki/s tx 4Ki/s ",
+		},
+		"empty": {
+			id: graphCPU,
+			e:  " CPU 0m (peak 0m) ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, graphLegend(u.id, u.s1, u.s2))
+		})
+	}
+}
+
+func TestToMetrics(t *testing.T) {
+	assert.Equal(t, []tchart.Metric{{S1: 1, S2: 3}, {S1: 2}}, toMetrics([]int64{1, 2}, []int64{3}))
+}
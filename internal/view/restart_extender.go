@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -28,7 +29,7 @@ func NewRestartExtender(v ResourceViewer) ResourceViewer {
 // BindKeys creates additional menu actions.
 func (r *RestartExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Restart", r.restartCmd, true),
+		tcell.KeyCtrlT: ui.NewKeyActionWithID(ui.ActionRestart, "Restart", r.restartCmd, true),
 	})
 }
 
@@ -42,18 +43,15 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 	defer r.Start()
 	msg := fmt.Sprintf("Restart deployment %s?", paths[0])
 	if len(paths) > 1 {
-		msg = fmt.Sprintf("Restart %d deployments?", len(paths))
+		msg = fmt.Sprintf("Restart %d marked %s?", len(paths), r.GVR()) + bulkTargets(paths)
 	}
-	dialog.ShowConfirm(r.App().Content.Pages, "Confirm Restart", msg, func() {
-		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
-		defer cancel()
-		for _, path := range paths {
-			if err := r.restartRollout(ctx, path); err != nil {
-				r.App().Flash().Err(err)
-			} else {
-				r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
-			}
-		}
+	level := r.App().Config.ConfirmLevel(config.ActionRestart)
+	dialog.ShowConfirm(r.App().Content.Pages, level, confirmName(r.App().Config.K9s.CurrentContext, paths), "Confirm Restart", msg, func() {
+		runBulkAsync(r.App(), "Restart", r.GVR(), paths, func(path string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			return r.restartRollout(ctx, path)
+		}, nil)
 	}, func() {})
 
 	return nil
@@ -62,7 +60,7 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (r *RestartExtender) restartRollout(ctx context.Context, path string) error {
 	res, err := dao.AccessorFor(r.App().factory, r.GVR())
 	if err != nil {
-		return nil
+		return err
 	}
 	s, ok := res.(dao.Restartable)
 	if !ok {
@@ -0,0 +1,314 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const revisionsTitle = "revisions"
+
+var (
+	dpGVR = client.NewGVR("apps/v1/deployments")
+	rsGVR = client.NewGVR("apps/v1/replicasets")
+	crGVR = client.NewGVR("apps/v1/controllerrevisions")
+)
+
+// Revisions presents a deployment replicasets or a statefulset/daemonset
+// controller revisions history.
+type Revisions struct {
+	*Table
+
+	owner client.GVR
+	path  string
+	model *staticModel
+	revs  []dao.Revision
+}
+
+// NewRevisions returns a new workload revisions viewer.
+func NewRevisions(owner client.GVR, path string) *Revisions {
+	return &Revisions{
+		Table: NewTable(client.NewGVR(revisionsTitle)),
+		owner: owner,
+		path:  path,
+		model: &staticModel{Table: model.NewTable(client.NewGVR(revisionsTitle))},
+	}
+}
+
+// revisionsCmd shows the selected workload revisions.
+func revisionsCmd(v ResourceViewer) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewRevisions(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+// Init initializes the component.
+func (r *Revisions) Init(ctx context.Context) error {
+	if err := r.Table.Init(ctx); err != nil {
+		return err
+	}
+	r.SetModel(r.model)
+	r.SetColorerFn(revisionsColorer)
+	r.SetBorderFocusColor(tcell.ColorDodgerBlue)
+	r.SetSelectedStyle(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone)
+	r.Extras = r.path
+	r.bindKeys()
+	r.model.data = render.TableData{Header: revisionsHeader}
+	r.Update(r.model.data)
+	go r.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *Revisions) Name() string { return revisionsTitle }
+
+func (r *Revisions) bindKeys() {
+	r.Actions().Delete(tcell.KeyCtrlZ)
+	aa := ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto Pods", r.gotoPodsCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", r.refreshCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Diff", r.diffCmd, true),
+		ui.KeyShiftV:    ui.NewKeyAction("Sort Revision", r.SortColCmd("REVISION", false), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.app.PrevCmd, false),
+	}
+	if r.revisionGVR() == rsGVR && !r.app.Config.K9s.GetReadOnly() {
+		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete Garbage", r.pruneCmd, true)
+	}
+	r.Actions().Add(aa)
+}
+
+func (r *Revisions) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go r.load()
+
+	return nil
+}
+
+func (r *Revisions) gotoPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	if r.revisionGVR() == crGVR {
+		showPodsFromSelector(r.app, path, r.revisionSelector(path))
+		return nil
+	}
+	var drs dao.ReplicaSet
+	rs, err := drs.Load(r.app.factory, path)
+	if err != nil {
+		r.app.Flash().Err(err)
+		return nil
+	}
+	showPodsFromSelector(r.app, path, rs.Spec.Selector)
+
+	return nil
+}
+
+// revisionSelector matches pods tracking a controller revision by either
+// name (statefulsets) or hash (daemonsets).
+func (r *Revisions) revisionSelector(path string) *metav1.LabelSelector {
+	_, n := client.Namespaced(path)
+	vv := []string{n}
+	if rev, ok := r.revision(path); ok && rev.Hash != "" && rev.Hash != n {
+		vv = append(vv, rev.Hash)
+	}
+
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: dao.RevisionHashLabel, Operator: metav1.LabelSelectorOpIn, Values: vv},
+		},
+	}
+}
+
+// diffCmd diffs the pod templates of two marked revisions, the marked and
+// selected revisions or the selected and current revisions.
+func (r *Revisions) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := r.GetSelectedItems()
+	switch {
+	case len(paths) > 2:
+		r.app.Flash().Warn("Mark at most 2 revisions to diff")
+		return nil
+	case len(paths) == 1 && r.MarkCount() == 1:
+		paths = append(paths, r.GetSelectedItem())
+	case len(paths) == 1 && len(r.revs) > 0:
+		paths = append(paths, r.revs[0].Path)
+	}
+	if len(paths) != 2 || paths[0] == paths[1] {
+		r.app.Flash().Warn("Pick two distinct revisions to diff")
+		return nil
+	}
+	from, okf := r.revision(paths[0])
+	to, okt := r.revision(paths[1])
+	if !okf || !okt {
+		return nil
+	}
+	if from.Revision > to.Revision {
+		from, to = to, from
+	}
+
+	go r.diff(from, to)
+
+	return nil
+}
+
+// diff fetches the revisions pod templates off the ui thread and shows their
+// diff.
+func (r *Revisions) diff(from, to dao.Revision) {
+	var right string
+	gvr := r.revisionGVR()
+	left, err := dao.RevisionTemplate(r.app.factory, gvr, from.Path)
+	if err == nil {
+		right, err = dao.RevisionTemplate(r.app.factory, gvr, to.Path)
+	}
+	r.app.QueueUpdateDraw(func() {
+		if err != nil {
+			r.app.Flash().Err(err)
+			return
+		}
+		if left == right {
+			r.app.Flash().Infof("Revisions %d and %d share the same pod template", from.Revision, to.Revision)
+		}
+		subject := fmt.Sprintf("%s %d..%d", r.path, from.Revision, to.Revision)
+		fromLabel, toLabel := fmt.Sprintf("%s@%d", from.Path, from.Revision), fmt.Sprintf("%s@%d", to.Path, to.Revision)
+		if err := r.app.inject(NewDiff(r.app, subject, fromLabel, toLabel, dao.SideBySide(left, right))); err != nil {
+			r.app.Flash().Err(err)
+		}
+	})
+}
+
+func (r *Revisions) revision(path string) (dao.Revision, bool) {
+	for _, rev := range r.revs {
+		if rev.Path == path {
+			return rev, true
+		}
+	}
+
+	return dao.Revision{}, false
+}
+
+// revisionGVR returns the resource tracking the owner revisions.
+func (r *Revisions) revisionGVR() client.GVR {
+	if r.owner.String() == dpGVR.String() {
+		return rsGVR
+	}
+
+	return crGVR
+}
+
+func (r *Revisions) pruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := dao.GarbageRevisions(r.revs)
+	if len(paths) == 0 {
+		r.app.Flash().Info("No replicasets beyond the revision history limit")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Delete %d idle replicaset(s) beyond the revision history limit of %s?", len(paths), r.path)
+	level := r.app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowConfirm(r.app.Content.Pages, level, confirmName(r.app.Config.K9s.CurrentContext, paths), "Confirm Delete", msg, func() {
+		res, err := dao.AccessorFor(r.app.factory, rsGVR)
+		if err != nil {
+			r.app.Flash().Err(err)
+			return
+		}
+		nuker, ok := res.(dao.Nuker)
+		if !ok {
+			r.app.Flash().Errf("Invalid nuker %T", res)
+			return
+		}
+		runTrashDelete(r.app, rsGVR, paths, nukeFn(nuker, true, false), func(bulkResults) {
+			go r.load()
+		})
+	}, func() {})
+
+	return nil
+}
+
+func (r *Revisions) load() {
+	var (
+		rr  []dao.Revision
+		err error
+	)
+	if r.revisionGVR() == rsGVR {
+		rr, err = dao.FetchRevisions(r.app.factory, r.path)
+	} else {
+		rr, err = dao.FetchControllerRevisions(r.app.factory, r.owner, r.path)
+	}
+	r.app.QueueUpdateDraw(func() {
+		if err != nil {
+			r.app.Flash().Err(err)
+			return
+		}
+		r.revs = rr
+		r.model.data = revisionsTableData(rr)
+		r.Update(r.model.data)
+		r.app.Flash().Infof("%d revisions, %d garbage", len(rr), len(dao.GarbageRevisions(rr)))
+	})
+}
+
+var revisionsHeader = render.Header{
+	render.HeaderColumn{Name: "NAME"},
+	render.HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "READY", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "STATUS"},
+	render.HeaderColumn{Name: "AGE", Time: true, Decorator: render.AgeDecorator},
+}
+
+func revisionsColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 6 {
+		return tcell.ColorDodgerBlue
+	}
+	switch re.Row.Fields[5] {
+	case dao.RevisionGarbage:
+		return render.ErrColor
+	case dao.RevisionRetained:
+		return render.CompletedColor
+	}
+
+	return tcell.ColorDodgerBlue
+}
+
+func revisionsTableData(rr []dao.Revision) render.TableData {
+	data := render.TableData{
+		Header:    revisionsHeader,
+		RowEvents: make(render.RowEvents, 0, len(rr)),
+	}
+	for _, r := range rr {
+		_, n := client.Namespaced(r.Path)
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: r.Path,
+			Fields: render.Fields{
+				n,
+				strconv.FormatInt(r.Revision, 10),
+				strconv.Itoa(int(r.Desired)),
+				strconv.Itoa(int(r.Current)),
+				strconv.Itoa(int(r.Ready)),
+				r.Status,
+				time.Since(r.Created).String(),
+			},
+		}))
+	}
+
+	return data
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionsTableData(t *testing.T) {
+	data := revisionsTableData([]dao.Revision{
+		{Path: "default/fred-2", Revision: 2, Desired: 1, Current: 1, Ready: 1, Created: time.Now(), Status: dao.RevisionCurrent},
+		{Path: "default/fred-1", Revision: 1, Created: time.Now(), Status: dao.RevisionGarbage},
+	})
+
+	assert.Equal(t, len(revisionsHeader), len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, "default/fred-1", data.RowEvents[1].Row.ID)
+	assert.Equal(t, []string{"fred-1", "1", "0", "0", "0", dao.RevisionGarbage}, []string(data.RowEvents[1].Row.Fields[:6]))
+}
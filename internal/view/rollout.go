@@ -0,0 +1,150 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const rolloutHashLabel = "rollouts-pod-template-hash"
+
+var analysisRunGVR = client.NewGVR("argoproj.io/v1alpha1/analysisruns")
+
+// Rollout represents an Argo Rollout viewer.
+type Rollout struct {
+	ResourceViewer
+}
+
+// NewRollout returns a new viewer.
+func NewRollout(gvr client.GVR) ResourceViewer {
+	r := Rollout{
+		ResourceViewer: NewPromoteExtender(
+			NewRestartExtender(
+				NewScaleExtender(
+					NewLogsExtender(NewBrowser(gvr), nil),
+				),
+			),
+		),
+	}
+	r.SetBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.showPods)
+	r.GetTable().SetColorerFn(render.Rollout{}.ColorerFunc())
+
+	return &r
+}
+
+func (r *Rollout) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyA:      ui.NewKeyAction("Analysis Runs", r.analysisRunsCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(readyCol, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
+
+func (r *Rollout) showPods(app *App, _ ui.Tabular, gvr, path string) {
+	u, err := r.load(gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	var sel metav1.LabelSelector
+	m, _, _ := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &sel); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	showPodsFromSelector(app, path, &sel)
+}
+
+func (r *Rollout) analysisRunsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	u, err := r.load(r.GVR().String(), path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	hash, _, _ := unstructured.NestedString(u.Object, "status", "currentPodHash")
+	if hash == "" {
+		r.App().Flash().Warnf("Rollout %s has no current revision yet", path)
+		return nil
+	}
+
+	v := NewAnalysisRun(analysisRunGVR)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, rolloutHashLabel+"="+hash)
+	})
+	if err := r.App().inject(v); err != nil {
+		r.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (r *Rollout) load(gvr, path string) (*unstructured.Unstructured, error) {
+	o, err := r.App().factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// AnalysisRun represents an Argo Rollouts AnalysisRun viewer.
+type AnalysisRun struct {
+	ResourceViewer
+}
+
+// NewAnalysisRun returns a new viewer.
+func NewAnalysisRun(gvr client.GVR) ResourceViewer {
+	a := AnalysisRun{ResourceViewer: NewBrowser(gvr)}
+	a.GetTable().SetColorerFn(render.AnalysisRun{}.ColorerFunc())
+
+	return &a
+}
+
+// ----------------------------------------------------------------------------
+
+// Canary represents a Flagger Canary viewer.
+type Canary struct {
+	ResourceViewer
+}
+
+// NewCanary returns a new viewer.
+func NewCanary(gvr client.GVR) ResourceViewer {
+	c := Canary{
+		ResourceViewer: NewPromoteExtender(
+			NewRestartExtender(NewBrowser(gvr)),
+		),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetColorerFn(render.Canary{}.ColorerFunc())
+
+	return &c
+}
+
+func (c *Canary) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Weight", c.GetTable().SortColCmd("WEIGHT", false), false),
+	})
+}
@@ -0,0 +1,25 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollout(t *testing.T) {
+	v := view.NewRollout(client.NewGVR("argoproj.io/v1alpha1/rollouts"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Rollouts", v.Name())
+	assert.Equal(t, 16, len(v.Hints()))
+}
+
+func TestCanary(t *testing.T) {
+	v := view.NewCanary(client.NewGVR("flagger.app/v1beta1/canaries"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Canaries", v.Name())
+	assert.Equal(t, 11, len(v.Hints()))
+}
@@ -3,15 +3,21 @@ package view
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 )
 
 // ScaleExtender adds scaling extensions.
@@ -30,22 +36,93 @@ func NewScaleExtender(r ResourceViewer) ResourceViewer {
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyS: ui.NewKeyAction("Scale", s.scaleCmd, true),
+		ui.KeyZ: ui.NewKeyAction("Pause/Resume", s.pauseCmd, true),
 	})
 }
 
 func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := s.GetTable().GetSelectedItem()
-	if path == "" {
+	paths := s.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return nil
 	}
 
 	s.Stop()
 	defer s.Start()
-	s.showScaleDialog(path)
+	if len(paths) > 1 {
+		s.showBulkScaleDialog(paths)
+		return nil
+	}
+	s.showScaleDialog(paths[0])
 
 	return nil
 }
 
+func (s *ScaleExtender) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	paused, err := dao.IsPaused(s.App().factory, s.GVR(), path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	title, msg := "Confirm Pause", fmt.Sprintf("Pause %s %s by scaling it to zero?", s.GVR(), path)
+	if paused {
+		title, msg = "Confirm Resume", fmt.Sprintf("Resume %s %s to its paused replica count?", s.GVR(), path)
+	}
+	level := s.App().Config.ConfirmLevel(config.ActionPause)
+	dialog.ShowConfirm(s.App().Content.Pages, level, confirmName(s.App().Config.K9s.CurrentContext, []string{path}), title, msg, func() {
+		if err := togglePause(s.App().factory, s.GVR(), path, !paused); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		if paused {
+			s.App().Flash().Infof("Resource %s:%s resumed successfully", s.GVR(), path)
+		} else {
+			s.App().Flash().Infof("Resource %s:%s paused successfully", s.GVR(), path)
+		}
+		s.App().record(pauseOperation(s.App().factory, s.GVR(), path, !paused))
+	}, func() {})
+
+	return nil
+}
+
+func pauseOperation(f dao.Factory, gvr client.GVR, path string, pause bool) model.Operation {
+	desc, inv := "Pause", "Resume"
+	if !pause {
+		desc, inv = inv, desc
+	}
+
+	return model.Operation{
+		Description: fmt.Sprintf("%s %s %s", desc, gvr, path),
+		Inverse:     fmt.Sprintf("%s %s %s", inv, gvr, path),
+		Revert: func() error {
+			return togglePause(f, gvr, path, !pause)
+		},
+	}
+}
+
+func togglePause(f dao.Factory, gvr client.GVR, path string, pause bool) error {
+	res, err := dao.AccessorFor(f, gvr)
+	if err != nil {
+		return err
+	}
+	scaler, ok := res.(dao.Scalable)
+	if !ok {
+		return fmt.Errorf("expecting a scalable resource for %q", gvr)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	if pause {
+		return dao.Pause(ctx, f, gvr, scaler, path)
+	}
+
+	return dao.Resume(ctx, f, gvr, scaler, path)
+}
+
 func (s *ScaleExtender) showScaleDialog(path string) {
 	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path))
 	confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), path))
@@ -58,9 +135,10 @@ func (s *ScaleExtender) showScaleDialog(path string) {
 
 func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 	f := s.makeStyledForm()
-	replicas := strings.TrimSpace(s.GetTable().GetCell(s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1).Text)
+	replicas := ui.TrimCell(s.GetTable().SelectTable, s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1)
 	tokens := strings.Split(replicas, "/")
 	replicas = tokens[1]
+	prev, _ := strconv.Atoi(replicas)
 	f.AddInputField("Replicas:", replicas, 4, func(textToCheck string, lastChar rune) bool {
 		_, err := strconv.Atoi(textToCheck)
 		return err == nil
@@ -75,14 +153,13 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
-		defer cancel()
-		if err := s.scale(ctx, sel, count); err != nil {
-			log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-			s.App().Flash().Err(err)
-		} else {
-			s.App().Flash().Infof("Resource %s:%s scaled successfully", s.GVR(), sel)
+		if count <= prev {
+			s.scaleTo(sel, prev, count)
+			return
 		}
+		s.checkCapacity(map[string]int{sel: count - prev}, func() {
+			s.scaleTo(sel, prev, count)
+		})
 	})
 
 	f.AddButton("Cancel", func() {
@@ -92,6 +169,185 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 	return f
 }
 
+// checkCapacity estimates off the UI thread whether the cluster fits the
+// additional replicas of each resource and confirms the scale up when it falls
+// short or can't be estimated.
+func (s *ScaleExtender) checkCapacity(ups map[string]int, scale func()) {
+	if len(ups) == 0 {
+		scale()
+		return
+	}
+	paths := make([]string, 0, len(ups))
+	for path := range ups {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	s.App().Flash().Info("Estimating cluster capacity...")
+	f, gvr := s.App().factory, s.GVR()
+	go func() {
+		msgs := make([]string, 0, len(paths))
+		for _, path := range paths {
+			var msg string
+			e, err := dao.EstimateScale(f, gvr, path, ups[path])
+			switch {
+			case err != nil:
+				log.Warn().Err(err).Msgf("Capacity estimate failed for %s", path)
+				msg = fmt.Sprintf("Unable to estimate the cluster capacity (%s). Scale anyway?", err)
+			case e.Short():
+				msg = scaleShortfallMsg(e)
+			default:
+				continue
+			}
+			if len(paths) > 1 {
+				msg = path + ": " + msg
+			}
+			msgs = append(msgs, msg)
+		}
+		s.App().QueueUpdateDraw(func() {
+			if len(msgs) == 0 {
+				scale()
+				return
+			}
+			level := s.App().Config.ConfirmLevel(config.ActionScale)
+			dialog.ShowConfirm(s.App().Content.Pages, level, confirmName(s.App().Config.K9s.CurrentContext, paths), "Confirm Scale", strings.Join(msgs, "\n"), scale, func() {})
+		})
+	}()
+}
+
+func (s *ScaleExtender) scaleTo(path string, prev, count int) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	if err := s.scale(ctx, path, count); err != nil {
+		log.Error().Err(err).Msgf("DP %s scaling failed", path)
+		s.App().Flash().Err(err)
+		return
+	}
+	s.App().Flash().Infof("Resource %s:%s scaled successfully", s.GVR(), path)
+	s.journal(path, prev, count)
+	if count >= prev {
+		return
+	}
+	s.promptScaledClaims([]string{path}, count)
+}
+
+// promptScaledClaims offers to delete the claims scaled down statefulsets
+// left behind, listing them off the UI thread.
+func (s *ScaleExtender) promptScaledClaims(paths []string, count int) {
+	gvr := s.GVR()
+	go func() {
+		retained, purged := orphanedClaims(s.App(), gvr, paths, int32(count), false)
+		s.App().QueueUpdateDraw(func() {
+			if len(purged) > 0 {
+				s.App().Flash().Infof("%d volume claims will be deleted per the retention policy", len(purged))
+			}
+			promptOrphanedClaims(s.App(), retained.For(paths))
+		})
+	}()
+}
+
+func (s *ScaleExtender) showBulkScaleDialog(paths []string) {
+	f := s.makeStyledForm()
+	var replicas string
+	f.AddInputField("Replicas:", replicas, 4, func(textToCheck string, lastChar rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(changed string) {
+		replicas = changed
+	})
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		count, err := strconv.Atoi(replicas)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.scaleAll(paths, count)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	confirm := tview.NewModalForm("<Scale>", f)
+	confirm.SetText(fmt.Sprintf("Scale %d marked %s", len(paths), s.GVR()) + bulkTargets(paths))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(scaleDialogKey, confirm, false, false)
+	s.App().Content.ShowPage(scaleDialogKey)
+}
+
+// scaleAll scales the marked resources concurrently once their capacity is
+// checked, journaling the scales of the resources whose current replicas are
+// known and offering to delete the claims scaled down sets left behind.
+func (s *ScaleExtender) scaleAll(paths []string, count int) {
+	prevs := make(map[string]int, len(paths))
+	ups := make(map[string]int)
+	for _, path := range paths {
+		if prev, ok := s.replicas(path); ok {
+			prevs[path] = prev
+			if count > prev {
+				ups[path] = count - prev
+			}
+		}
+	}
+	s.checkCapacity(ups, func() {
+		runBulkAsync(s.App(), "Scale", s.GVR(), paths, func(path string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			return s.scale(ctx, path, count)
+		}, func(rr bulkResults) {
+			downs := make([]string, 0, len(rr))
+			for _, r := range rr {
+				if r.err != nil {
+					continue
+				}
+				prev, ok := prevs[r.path]
+				if ok {
+					s.journal(r.path, prev, count)
+				}
+				if !ok || count < prev {
+					downs = append(downs, r.path)
+				}
+			}
+			if len(downs) > 0 {
+				s.promptScaledClaims(downs, count)
+			}
+		})
+	})
+}
+
+// replicas returns a resource desired replicas as displayed in the table.
+func (s *ScaleExtender) replicas(path string) (int, bool) {
+	row, ok := s.GetTable().GetSelectedRow(path)
+	if !ok {
+		return 0, false
+	}
+	idx := s.GetTable().GetModel().Peek().Header.IndexOf("READY", true)
+	if idx < 0 || idx >= len(row.Fields) {
+		return 0, false
+	}
+	tokens := strings.Split(row.Fields[idx], "/")
+	if len(tokens) != 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(tokens[1]))
+
+	return n, err == nil
+}
+
+func scaleShortfallMsg(e *dao.ScaleEstimate) string {
+	cpu, mem := e.Shortfall[v1.ResourceCPU], e.Shortfall[v1.ResourceMemory]
+
+	return fmt.Sprintf(
+		"Cluster is short of capacity for %d of %d additional replicas (cpu %s, mem %s). Scale anyway?",
+		e.Replicas-e.Placed,
+		e.Replicas,
+		render.ToMillicore(cpu.MilliValue()),
+		render.ToMi(client.ToMB(mem.Value())),
+	)
+}
+
 func (s *ScaleExtender) dismissDialog() {
 	s.App().Content.RemovePage(scaleDialogKey)
 }
@@ -108,14 +364,34 @@ func (s *ScaleExtender) makeStyledForm() *tview.Form {
 	return f
 }
 
+func (s *ScaleExtender) journal(path string, prev, count int) {
+	if prev == count {
+		return
+	}
+	f, gvr := s.App().factory, s.GVR()
+	s.App().record(model.Operation{
+		Description: fmt.Sprintf("Scale %s %s to %d", gvr, path, count),
+		Inverse:     fmt.Sprintf("Scale %s %s back to %d", gvr, path, prev),
+		Revert: func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+			defer cancel()
+			return scaleResource(ctx, f, gvr, path, prev)
+		},
+	})
+}
+
 func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int) error {
-	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	return scaleResource(ctx, s.App().factory, s.GVR(), path, replicas)
+}
+
+func scaleResource(ctx context.Context, f dao.Factory, gvr client.GVR, path string, replicas int) error {
+	res, err := dao.AccessorFor(f, gvr)
 	if err != nil {
-		return nil
+		return err
 	}
 	scaler, ok := res.(dao.Scalable)
 	if !ok {
-		return fmt.Errorf("expecting a scalable resource for %q", s.GVR())
+		return fmt.Errorf("expecting a scalable resource for %q", gvr)
 	}
 
 	return scaler.Scale(ctx, path, int32(replicas))
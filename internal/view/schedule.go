@@ -0,0 +1,175 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const scheduleTitle = "schedule"
+
+// ScheduleExtender adds scheduling simulation extensions.
+type ScheduleExtender struct {
+	ResourceViewer
+}
+
+// NewScheduleExtender returns a new extender.
+func NewScheduleExtender(r ResourceViewer) ResourceViewer {
+	s := ScheduleExtender{ResourceViewer: r}
+	s.bindKeys(s.Actions())
+
+	return &s
+}
+
+func (s *ScheduleExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyW: ui.NewKeyAction("Where Schedulable", s.scheduleCmd, true),
+	})
+}
+
+func (s *ScheduleExtender) scheduleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := s.App().inject(NewSchedule(s.GVR(), path)); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Schedule presents the nodes a pod spec could be scheduled on.
+type Schedule struct {
+	*Table
+
+	workload client.GVR
+	path     string
+	model    *staticModel
+}
+
+// NewSchedule returns a new scheduling simulation viewer.
+func NewSchedule(gvr client.GVR, path string) *Schedule {
+	return &Schedule{
+		Table:    NewTable(client.NewGVR(scheduleTitle)),
+		workload: gvr,
+		path:     path,
+		model:    &staticModel{Table: model.NewTable(client.NewGVR(scheduleTitle))},
+	}
+}
+
+// Init initializes the component.
+func (s *Schedule) Init(ctx context.Context) error {
+	if err := s.Table.Init(ctx); err != nil {
+		return err
+	}
+	s.SetModel(s.model)
+	s.SetColorerFn(scheduleColorer)
+	s.SetBorderFocusColor(tcell.ColorCadetBlue)
+	s.SetSelectedStyle(tcell.ColorWhite, tcell.ColorCadetBlue, tcell.AttrNone)
+	s.Extras = s.path
+	s.bindKeys()
+	s.model.data = render.TableData{Header: scheduleHeader}
+	s.Update(s.model.data)
+	go s.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Schedule) Name() string { return scheduleTitle }
+
+func (s *Schedule) bindKeys() {
+	s.Actions().Delete(tcell.KeyCtrlZ)
+	s.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto Node Pods", s.gotoPodsCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", s.refreshCmd, true),
+		ui.KeyShiftF:    ui.NewKeyAction("Sort Fit", s.SortColCmd("FIT", false), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, false),
+	})
+}
+
+func (s *Schedule) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go s.load()
+
+	return nil
+}
+
+func (s *Schedule) gotoPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	r, _ := s.GetSelection()
+	if r <= 0 {
+		return nil
+	}
+	showPods(s.app, s.path, "", "spec.nodeName="+ui.TrimCell(s.SelectTable, r, 0))
+
+	return nil
+}
+
+func (s *Schedule) load() {
+	ff, err := dao.FetchNodeFits(s.app.factory, s.workload, s.path)
+	s.app.QueueUpdateDraw(func() {
+		if err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.model.data = scheduleTableData(ff)
+		s.Update(s.model.data)
+		var fits int
+		for _, f := range ff {
+			if f.Fits {
+				fits++
+			}
+		}
+		s.app.Flash().Infof("%s could schedule on %d/%d nodes", s.path, fits, len(ff))
+	})
+}
+
+var scheduleHeader = render.Header{
+	render.HeaderColumn{Name: "NODE"},
+	render.HeaderColumn{Name: "FIT"},
+	render.HeaderColumn{Name: "FREE CPU", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "FREE MEM", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "REASONS"},
+}
+
+func scheduleColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) > 1 && re.Row.Fields[1] == "false" {
+		return render.ErrColor
+	}
+
+	return tcell.ColorCadetBlue
+}
+
+func scheduleTableData(ff []dao.NodeFit) render.TableData {
+	data := render.TableData{
+		Header:    scheduleHeader,
+		RowEvents: make(render.RowEvents, 0, len(ff)),
+	}
+	for _, f := range ff {
+		fit := "false"
+		if f.Fits {
+			fit = "true"
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: f.Node,
+			Fields: render.Fields{
+				f.Node,
+				fit,
+				render.ToMillicore(f.FreeCPU.MilliValue()),
+				render.ToMi(client.ToMB(f.FreeMEM.Value())),
+				strings.Join(f.Reasons, ", "),
+			},
+		}))
+	}
+
+	return data
+}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestScheduleTableData(t *testing.T) {
+	ff := []dao.NodeFit{
+		{Node: "n1", Fits: true, FreeCPU: resource.MustParse("1500m"), FreeMEM: resource.MustParse("2Gi")},
+		{Node: "n2", FreeCPU: resource.MustParse("100m"), FreeMEM: resource.MustParse("512Mi"), Reasons: []string{"node is cordoned", "insufficient cpu (requests 200m, free 100m)"}},
+	}
+
+	data := scheduleTableData(ff)
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, render.Fields{"n1", "true", "1500", "2048", ""}, data.RowEvents[0].Row.Fields)
+	assert.Equal(t, render.Fields{"n2", "false", "100", "512", "node is cordoned, insufficient cpu (requests 200m, free 100m)"}, data.RowEvents[1].Row.Fields)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -45,9 +46,24 @@ func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
 func (s *ScreenDump) edit(app *App, model ui.Tabular, gvr, path string) {
 	log.Debug().Msgf("ScreenDump selection is %q", path)
 
+	if filepath.Ext(path) == recordingExt {
+		s.replay(app, path)
+		return
+	}
 	s.Stop()
 	defer s.Start()
 	if !edit(app, shellOpts{clear: true, args: []string{path}}) {
 		app.Flash().Err(errors.New("Failed to launch editor"))
 	}
 }
+
+func (s *ScreenDump) replay(app *App, path string) {
+	r, err := model.LoadRecording(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.inject(NewReplay(r)); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,282 @@
+package view
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultServePort = 7777
+
+var peekTmpl = template.Must(template.New("peek").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta http-equiv="refresh" content="{{.Refresh}}">
+<title>K9s {{.Frame.View}}</title>
+<style>
+body { background: #000; color: #87ceeb; font-family: monospace; }
+th { color: #fff; text-align: left; padding-right: 1em; }
+td { padding-right: 1em; white-space: nowrap; }
+</style>
+</head>
+<body>
+<h3>{{.Frame.View}}{{if .Frame.Namespace}}({{.Frame.Namespace}}){{end}} @ {{.Frame.At.Format "15:04:05"}}</h3>
+<table>
+<tr>{{range .Frame.Header}}<th>{{.}}</th>{{end}}</tr>
+{{range .Frame.Rows}}<tr>{{range .Fields}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// PeekServer serves a read only snapshot of the current view over http.
+// Requests must target a loopback host and carry the server access token.
+type PeekServer struct {
+	frame   model.Frame
+	refresh int
+	token   string
+	srv     *http.Server
+	mx      sync.RWMutex
+}
+
+// NewPeekServer returns a new view server refreshing html pages at the given
+// rate in seconds and guarded by a random access token.
+func NewPeekServer(refresh int) (*PeekServer, error) {
+	if refresh <= 0 {
+		refresh = 1
+	}
+	token, err := newServeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeekServer{refresh: refresh, token: token}, nil
+}
+
+// Token returns the token required to access the server.
+func (p *PeekServer) Token() string {
+	return p.token
+}
+
+// Update sets the served snapshot.
+func (p *PeekServer) Update(f model.Frame) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.frame = f
+}
+
+func (p *PeekServer) current() model.Frame {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.frame
+}
+
+// Handler returns the server routes. The view is served as json on /api/view
+// and as html on /.
+func (p *PeekServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/view", p.readOnly(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(p.current()); err != nil {
+			log.Error().Err(err).Msg("Serving view json")
+		}
+	}))
+	mux.HandleFunc("/", p.readOnly(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		err := peekTmpl.Execute(w, struct {
+			Frame   model.Frame
+			Refresh int
+		}{Frame: p.current(), Refresh: p.refresh})
+		if err != nil {
+			log.Error().Err(err).Msg("Serving view html")
+		}
+	}))
+
+	return mux
+}
+
+func (p *PeekServer) readOnly(h http.HandlerFunc) http.HandlerFunc {
+	return tokenGuard(p.token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "read only", http.StatusMethodNotAllowed)
+			return
+		}
+		h(w, r)
+	})
+}
+
+// tokenGuard only lets through requests targeting a loopback host and
+// carrying the access token.
+func tokenGuard(token string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !isLoopbackHost(r.Host) {
+			http.Error(w, "forbidden host", http.StatusForbidden)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(token)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// isLoopbackHost checks a request host targets the local machine, guarding
+// against dns rebinding.
+func isLoopbackHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	switch host {
+	case "127.0.0.1", "localhost", "::1":
+		return true
+	default:
+		return false
+	}
+}
+
+func newServeToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// Start serves the view on the given local address.
+func (p *PeekServer) Start(addr string) error {
+	srv, err := serveLocal(addr, p.Handler())
+	if err != nil {
+		return err
+	}
+	p.srv = srv
+
+	return nil
+}
+
+// Stop shuts the server down.
+func (p *PeekServer) Stop() {
+	shutdown(p.srv)
+}
+
+func serveLocal(addr string, h http.Handler) (*http.Server, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	srv := http.Server{Handler: h, ReadTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msgf("Server on %s failed", addr)
+		}
+	}()
+
+	return &srv, nil
+}
+
+func shutdown(srv *http.Server) {
+	if srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Error().Err(err).Msg("Server shutdown failed")
+	}
+}
+
+// serveCmd serves the current view over http on localhost, redacted by the
+// privacy rules. The view is only served to loopback hosts carrying the access
+// token, so forward the port over ssh to share it.
+// Usage: serve [PORT] or serve stop.
+func (c *Command) serveCmd(tokens []string) error {
+	if len(tokens) == 2 && tokens[1] == "stop" {
+		if c.app.peek == nil {
+			return errors.New("view server is not running")
+		}
+		c.app.stopServe()
+		c.app.Flash().Info("View server stopped")
+		return nil
+	}
+	if c.app.peek != nil {
+		return errors.New("view server is already running. Use `serve stop` to end it")
+	}
+	port := defaultServePort
+	if len(tokens) == 2 {
+		p, err := strconv.Atoi(tokens[1])
+		if err != nil || p <= 0 || p > 65535 {
+			return fmt.Errorf("invalid port %q", tokens[1])
+		}
+		port = p
+	}
+
+	rate := c.app.Config.K9s.GetRefreshRate()
+	peek, err := NewPeekServer(rate)
+	if err != nil {
+		return err
+	}
+	addr := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+	if err := peek.Start(addr); err != nil {
+		return err
+	}
+	if f, ok := c.app.topFrame(); ok {
+		peek.Update(f)
+	}
+	var ctx context.Context
+	c.app.peek = peek
+	ctx, c.app.peekFn = context.WithCancel(context.Background())
+	go c.app.peeker(ctx, time.Duration(rate)*time.Second)
+	c.app.Flash().Infof("Serving view on http://%s/?token=%s", addr, peek.Token())
+
+	return nil
+}
+
+func (a *App) peeker(ctx context.Context, rate time.Duration) {
+	if rate <= 0 {
+		rate = time.Second
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+			a.QueueUpdate(func() {
+				if f, ok := a.topFrame(); ok && a.peek != nil {
+					a.peek.Update(f)
+				}
+			})
+		}
+	}
+}
+
+func (a *App) stopServe() {
+	if a.peekFn != nil {
+		a.peekFn()
+		a.peekFn = nil
+	}
+	if a.peek != nil {
+		a.peek.Stop()
+		a.peek = nil
+	}
+}
@@ -0,0 +1,72 @@
+package view_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeekServer(t *testing.T) {
+	p, err := view.NewPeekServer(2)
+	assert.Nil(t, err)
+	p.Update(model.NewFrame(time.Now(), "Pods", render.TableData{
+		Namespace: "default",
+		Header:    render.Header{{Name: "NAME"}, {Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "default/fred", Fields: render.Fields{"fred", "<Running>"}}},
+		},
+	}))
+
+	token := "?token=" + p.Token()
+	uu := map[string]struct {
+		method, host, path string
+		code               int
+		body               string
+	}{
+		"json":      {method: http.MethodGet, path: "/api/view" + token, code: http.StatusOK, body: `"view":"Pods"`},
+		"html":      {method: http.MethodGet, path: "/" + token, code: http.StatusOK, body: "<td>&lt;Running&gt;</td>"},
+		"readOnly":  {method: http.MethodPost, path: "/api/view" + token, code: http.StatusMethodNotAllowed},
+		"missing":   {method: http.MethodGet, path: "/blee" + token, code: http.StatusNotFound},
+		"noToken":   {method: http.MethodGet, path: "/api/view", code: http.StatusUnauthorized},
+		"badToken":  {method: http.MethodGet, path: "/api/view?token=blee", code: http.StatusUnauthorized},
+		"rebinding": {method: http.MethodGet, host: "evil.com:7777", path: "/api/view" + token, code: http.StatusForbidden},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(u.method, u.path, nil)
+			r.Host = "127.0.0.1:7777"
+			if u.host != "" {
+				r.Host = u.host
+			}
+			p.Handler().ServeHTTP(w, r)
+
+			assert.Equal(t, u.code, w.Code)
+			assert.Contains(t, w.Body.String(), u.body)
+		})
+	}
+}
+
+func TestPeekServerJSON(t *testing.T) {
+	p, err := view.NewPeekServer(1)
+	assert.Nil(t, err)
+	p.Update(model.Frame{View: "Services", Header: []string{"NAME"}})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/view?token="+p.Token(), nil)
+	r.Host = "localhost:7777"
+	p.Handler().ServeHTTP(w, r)
+
+	var f model.Frame
+	assert.Nil(t, json.NewDecoder(w.Body).Decode(&f))
+	assert.Equal(t, "Services", f.View)
+	assert.Equal(t, []string{"NAME"}, f.Header)
+}
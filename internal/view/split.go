@@ -0,0 +1,281 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const splitTitle = "Split"
+
+// Split presents a resource side by side across several cluster contexts.
+type Split struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	contexts []string
+	panes    []*splitPane
+	focus    int
+}
+
+// NewSplit returns a new multi cluster split view.
+func NewSplit(gvr client.GVR, contexts []string) *Split {
+	return &Split{
+		Flex:     tview.NewFlex(),
+		gvr:      gvr,
+		contexts: contexts,
+	}
+}
+
+// Init initializes the component.
+func (s *Split) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	s.SetDirection(tview.FlexColumn)
+	for i, c := range s.contexts {
+		p, err := newSplitPane(s.app, s.gvr, c)
+		if err != nil {
+			return err
+		}
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+		p.Actions().Add(ui.KeyActions{
+			tcell.KeyTab:     ui.NewKeyAction("Next Cluster", s.nextFocusCmd(1), true),
+			tcell.KeyBacktab: ui.NewKeyAction("Prev Cluster", s.nextFocusCmd(-1), true),
+			tcell.KeyEscape:  ui.NewKeyAction("Back", s.app.PrevCmd, false),
+		})
+		s.panes = append(s.panes, p)
+		s.AddItem(p, 0, 1, i == 0)
+	}
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Split) Name() string { return splitTitle }
+
+// Start starts the panes watch loops.
+func (s *Split) Start() {
+	for _, p := range s.panes {
+		p.Start()
+	}
+}
+
+// Stop terminates the panes watch loops.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.Stop()
+	}
+}
+
+// Hints returns the focused pane menu hints.
+func (s *Split) Hints() model.MenuHints {
+	if len(s.panes) == 0 {
+		return nil
+	}
+
+	return s.panes[s.focus].Hints()
+}
+
+// ExtraHints returns additional hints.
+func (s *Split) ExtraHints() map[string]string {
+	return nil
+}
+
+func (s *Split) nextFocusCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		s.focus = (s.focus + direction + len(s.panes)) % len(s.panes)
+		s.app.SetFocus(s.panes[s.focus])
+		s.app.hydrateMenu(s.Hints())
+
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// splitPane watches a resource in a given context with its own informers and
+// refresh loop.
+type splitPane struct {
+	*Table
+
+	context  string
+	cfg      *client.Config
+	factory  *watch.Factory
+	owned    bool
+	cancelFn context.CancelFunc
+}
+
+func newSplitPane(app *App, gvr client.GVR, ctx string) (*splitPane, error) {
+	p := splitPane{Table: NewTable(gvr), context: ctx}
+	if ctx == app.Config.K9s.CurrentContext {
+		p.factory = app.factory
+		return &p, nil
+	}
+	cfg, err := app.Conn().Config().ForContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.cfg = cfg
+
+	return &p, nil
+}
+
+// Init initializes the pane.
+func (p *splitPane) Init(ctx context.Context) error {
+	if err := p.Table.Init(ctx); err != nil {
+		return err
+	}
+	p.Extras = p.context
+	if r, ok := model.Registry[p.GVR().String()]; ok && r.Renderer != nil {
+		p.SetColorerFn(r.Renderer.ColorerFunc())
+	} else {
+		p.SetColorerFn(render.DefaultColorer)
+	}
+	p.GetModel().SetNamespace(p.namespace())
+
+	return nil
+}
+
+// Start starts the pane informers and watch loop. Panes on other contexts
+// connect off the ui thread on first start.
+func (p *splitPane) Start() {
+	p.Stop()
+	p.Table.Start()
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancelFn = cancel
+	if p.factory == nil {
+		p.Extras = p.context + " (connecting)"
+		p.UpdateTitle()
+		go p.connect(ctx)
+		return
+	}
+	p.watch(ctx)
+}
+
+// connect checks the pane context connectivity and starts watching once
+// the cluster is reachable.
+func (p *splitPane) connect(ctx context.Context) {
+	conn := client.InitConnectionOrDie(p.cfg)
+	if !conn.CheckConnectivity() {
+		p.app.QueueUpdateDraw(func() {
+			p.Extras = p.context + " (unreachable)"
+			p.UpdateTitle()
+			p.app.Flash().Errf("unable to connect to context %s", p.context)
+		})
+		return
+	}
+	p.app.QueueUpdateDraw(func() {
+		if ctx.Err() != nil || p.factory != nil {
+			return
+		}
+		p.Extras = p.context
+		p.factory, p.owned = watch.NewFactory(conn), true
+		p.watch(ctx)
+	})
+}
+
+func (p *splitPane) watch(ctx context.Context) {
+	if p.owned {
+		p.factory.Start(p.namespace())
+	}
+	p.GetModel().AddListener(p)
+
+	ctx = context.WithValue(ctx, internal.KeyFactory, p.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, p.GVR().String())
+	ctx = context.WithValue(ctx, internal.KeyPath, "")
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyNamespace, p.namespace())
+	p.GetModel().Watch(ctx)
+}
+
+// Stop terminates the pane watch loop and informers.
+func (p *splitPane) Stop() {
+	p.GetModel().RemoveListener(p)
+	p.Table.Stop()
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+	if p.owned && p.factory != nil {
+		p.factory.Terminate()
+	}
+}
+
+// TableDataChanged notifies the pane data changed.
+func (p *splitPane) TableDataChanged(data render.TableData) {
+	p.app.QueueUpdateDraw(func() {
+		p.Update(data)
+	})
+}
+
+// TableLoadFailed notifies the pane load failed.
+func (p *splitPane) TableLoadFailed(err error) {
+	log.Error().Err(err).Msgf("Split %s load failed", p.context)
+	p.app.QueueUpdateDraw(func() {
+		p.app.Flash().Errf("%s: %s", p.context, err)
+	})
+}
+
+func (p *splitPane) namespace() string {
+	if m, err := dao.MetaAccess.MetaFor(p.GVR()); err == nil && !m.Namespaced {
+		return client.ClusterScope
+	}
+
+	return client.CleanseNamespace(p.app.Config.ActiveNamespace())
+}
+
+// ----------------------------------------------------------------------------
+
+func (c *Command) splitCmd(tokens []string) error {
+	if len(tokens) < 4 {
+		return errors.New("Usage: split RESOURCE CONTEXT CONTEXT [CONTEXT...]")
+	}
+	gvr, ok := c.alias.AsGVR(tokens[1])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", tokens[1])
+	}
+	cc, err := splitContexts(tokens[2:])
+	if err != nil {
+		return err
+	}
+	for _, ctx := range cc {
+		if _, err := c.app.Conn().Config().GetContext(ctx); err != nil {
+			return fmt.Errorf("context %s does not exist", ctx)
+		}
+	}
+
+	return c.app.inject(NewSplit(gvr, cc))
+}
+
+// splitContexts returns the distinct contexts to split across.
+func splitContexts(ss []string) ([]string, error) {
+	cc := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if !config.InList(cc, s) {
+			cc = append(cc, s)
+		}
+	}
+	if len(cc) < 2 {
+		return nil, errors.New("split requires at least two distinct contexts")
+	}
+
+	return cc, nil
+}
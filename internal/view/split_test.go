@@ -0,0 +1,29 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitContexts(t *testing.T) {
+	uu := map[string]struct {
+		ss  []string
+		e   []string
+		err bool
+	}{
+		"pair":   {ss: []string{"staging", "prod"}, e: []string{"staging", "prod"}},
+		"dups":   {ss: []string{"staging", "prod", "staging"}, e: []string{"staging", "prod"}},
+		"same":   {ss: []string{"prod", "prod"}, err: true},
+		"single": {ss: []string{"prod"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := splitContexts(u.ss)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
@@ -18,10 +18,14 @@ type StatefulSet struct {
 // NewStatefulSet returns a new viewer.
 func NewStatefulSet(gvr client.GVR) ResourceViewer {
 	s := StatefulSet{
-		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewLogsExtender(NewBrowser(gvr), nil),
+		ResourceViewer: NewScheduleExtender(
+			NewTopologyExtender(
+				NewPortForwardExtender(
+					NewRestartExtender(
+						NewScaleExtender(
+							NewLogsExtender(NewBrowser(gvr), nil),
+						),
+					),
 				),
 			),
 		),
@@ -36,6 +40,8 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 func (s *StatefulSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Revisions", revisionsCmd(s), true),
+		ui.KeyU:      ui.NewKeyAction("Rollout", stsRolloutCmd(s), true),
 	})
 }
 
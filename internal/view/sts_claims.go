@@ -0,0 +1,118 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const maxClaimsPreview = 10
+
+var (
+	stsGVR = client.NewGVR("apps/v1/statefulsets")
+	pvcGVR = client.NewGVR("v1/persistentvolumeclaims")
+)
+
+// stsClaims tracks the volume claims a statefulset leaves behind by set path.
+type stsClaims map[string][]string
+
+// For returns the claims left behind by the given statefulsets in order.
+func (s stsClaims) For(paths []string) []string {
+	var cc []string
+	for _, p := range paths {
+		cc = append(cc, s[p]...)
+	}
+
+	return cc
+}
+
+// Succeeded returns the claims left behind by the statefulsets whose action succeeded.
+func (s stsClaims) Succeeded(rr bulkResults) []string {
+	paths := make([]string, 0, len(rr))
+	for _, r := range rr {
+		if r.err == nil {
+			paths = append(paths, r.path)
+		}
+	}
+
+	return s.For(paths)
+}
+
+// orphanedClaims returns the claims left behind once statefulsets are scaled
+// to the given replicas or deleted. Claims removed per the statefulset
+// retention policy are reported separately. It hits the api server so must be
+// called off the UI thread.
+func orphanedClaims(app *App, gvr client.GVR, paths []string, replicas int32, deleted bool) (retained stsClaims, purged []string) {
+	if gvr != stsGVR {
+		return nil, nil
+	}
+	retained = make(stsClaims, len(paths))
+	for _, p := range paths {
+		c, err := dao.FetchStsClaims(app.factory, p, replicas, deleted)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list %s volume claims", p)
+			continue
+		}
+		if c.Policy == dao.ClaimDelete {
+			purged = append(purged, c.Claims...)
+			continue
+		}
+		retained[p] = c.Retained()
+	}
+
+	return
+}
+
+// claimsPreview summarizes the claims a statefulset scale down or deletion leaves behind.
+func claimsPreview(retained, purged []string) string {
+	var b strings.Builder
+	if len(retained) > 0 {
+		fmt.Fprintf(&b, "\n\n%d volume claim(s) will be retained:", len(retained))
+		listClaims(&b, retained)
+	}
+	if len(purged) > 0 {
+		fmt.Fprintf(&b, "\n\n%d volume claim(s) will be deleted per the retention policy:", len(purged))
+		listClaims(&b, purged)
+	}
+
+	return b.String()
+}
+
+func listClaims(b *strings.Builder, cc []string) {
+	for i, c := range cc {
+		if i == maxClaimsPreview {
+			fmt.Fprintf(b, "\n...and %d more", len(cc)-maxClaimsPreview)
+			return
+		}
+		b.WriteString("\n  " + c)
+	}
+}
+
+// promptOrphanedClaims offers to delete the volume claims retained by statefulsets.
+func promptOrphanedClaims(app *App, claims []string) {
+	if len(claims) == 0 {
+		return
+	}
+	msg := fmt.Sprintf("Delete the %d volume claim(s) left behind?", len(claims)) + claimsPreview(claims, nil)
+	level := app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowConfirm(app.Content.Pages, level, confirmName(app.Config.K9s.CurrentContext, claims), "Delete Volume Claims", msg, func() {
+		res, err := dao.AccessorFor(app.factory, pvcGVR)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		nuker, ok := res.(dao.Nuker)
+		if !ok {
+			app.Flash().Errf("Invalid nuker %T", res)
+			return
+		}
+		runTrashDelete(app, pvcGVR, claims, nukeFn(nuker, true, false), nil)
+	}, func() {
+		app.Flash().Infof("Retained %d volume claims", len(claims))
+	})
+}
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimsPreview(t *testing.T) {
+	uu := map[string]struct {
+		retained, purged []string
+		e                string
+	}{
+		"none": {},
+		"retained": {
+			retained: []string{"default/data-db-1"},
+			e:        "\n\n1 volume claim(s) will be retained:\n  default/data-db-1",
+		},
+		"purged": {
+			purged: []string{"default/data-db-1", "default/data-db-2"},
+			e:      "\n\n2 volume claim(s) will be deleted per the retention policy:\n  default/data-db-1\n  default/data-db-2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, claimsPreview(u.retained, u.purged))
+		})
+	}
+}
+
+func TestStsClaimsSucceeded(t *testing.T) {
+	cc := stsClaims{
+		"default/db":  {"default/data-db-1"},
+		"default/web": {"default/www-web-1", "default/www-web-2"},
+	}
+	rr := bulkResults{
+		{path: "default/db", err: errors.New("boom")},
+		{path: "default/web"},
+	}
+
+	assert.Equal(t, []string{"default/www-web-1", "default/www-web-2"}, cc.Succeeded(rr))
+	assert.Equal(t, []string{"default/data-db-1", "default/www-web-1", "default/www-web-2"}, cc.For([]string{"default/db", "default/web"}))
+	assert.Nil(t, stsClaims(nil).Succeeded(rr))
+}
@@ -0,0 +1,343 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	stsRolloutTitle       = "sts-rollout"
+	stsRolloutRefreshRate = 5 * time.Second
+	partitionDialogKey    = "partition"
+)
+
+// StsRollout presents a statefulset pods revisions per ordinal and stages
+// its rolling update via the update partition.
+type StsRollout struct {
+	*Table
+
+	path    string
+	model   *staticModel
+	rollout *dao.StsRollout
+	cancel  context.CancelFunc
+}
+
+// NewStsRollout returns a new statefulset rollout viewer.
+func NewStsRollout(path string) *StsRollout {
+	return &StsRollout{
+		Table: NewTable(client.NewGVR(stsRolloutTitle)),
+		path:  path,
+		model: &staticModel{Table: model.NewTable(client.NewGVR(stsRolloutTitle))},
+	}
+}
+
+// stsRolloutCmd shows the selected statefulset rollout.
+func stsRolloutCmd(v ResourceViewer) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewStsRollout(path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+// Init initializes the component.
+func (s *StsRollout) Init(ctx context.Context) error {
+	if err := s.Table.Init(ctx); err != nil {
+		return err
+	}
+	s.SetModel(s.model)
+	s.SetColorerFn(stsRolloutColorer)
+	s.SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	s.Extras = s.path
+	s.bindKeys()
+	s.model.data = render.TableData{Header: stsRolloutHeader}
+	s.Update(s.model.data)
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *StsRollout) Name() string { return stsRolloutTitle }
+
+// Start runs the component and refreshes the rollout periodically.
+func (s *StsRollout) Start() {
+	s.Table.Start()
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	go func() {
+		for {
+			s.load()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(stsRolloutRefreshRate):
+			}
+		}
+	}()
+}
+
+// Stop terminates the component.
+func (s *StsRollout) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.Table.Stop()
+}
+
+func (s *StsRollout) bindKeys() {
+	s.Actions().Delete(tcell.KeyCtrlZ)
+	aa := ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", s.refreshCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", s.SortColCmd("STATUS", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, false),
+	}
+	if !s.app.Config.K9s.GetReadOnly() {
+		aa[ui.KeyP] = ui.NewKeyAction("Partition", s.partitionCmd, true)
+		aa[ui.KeyN] = ui.NewKeyAction("Next Ordinal", s.nextCmd, true)
+		aa[ui.KeyZ] = ui.NewKeyAction("Pause/Resume", s.pauseCmd, true)
+	}
+	s.Actions().Add(aa)
+}
+
+func (s *StsRollout) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go s.load()
+
+	return nil
+}
+
+// nextCmd lowers the partition by one so the next ordinal gets updated.
+func (s *StsRollout) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.rollout == nil {
+		return nil
+	}
+	if s.rollout.Partition == 0 {
+		s.app.Flash().Infof("All %s ordinals are already rolled out", s.path)
+		return nil
+	}
+	p := s.rollout.Partition - 1
+	if p >= s.rollout.Replicas {
+		p = s.rollout.Replicas - 1
+	}
+	msg := fmt.Sprintf("Update %s ordinal %d by setting its partition to %d?", s.path, p, p)
+	level := s.app.Config.ConfirmLevel(config.ActionPromote)
+	dialog.ShowConfirm(s.app.Content.Pages, level, confirmName(s.app.Config.K9s.CurrentContext, []string{s.path}), "Confirm Partition", msg, func() {
+		s.setPartition(p)
+	}, func() {})
+
+	return nil
+}
+
+func (s *StsRollout) partitionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.rollout == nil {
+		return nil
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	partition := strconv.Itoa(int(s.rollout.Partition))
+	f.AddInputField("Partition:", partition, 4, func(textToCheck string, lastChar rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(changed string) {
+		partition = changed
+	})
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		p, err := strconv.Atoi(partition)
+		if err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.setPartition(int32(p))
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	modal := tview.NewModalForm("<Partition>", f)
+	modal.SetText(fmt.Sprintf("Update the %s ordinals at or past the partition (0-%d)", s.path, s.rollout.Replicas))
+	modal.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.app.Content.AddPage(partitionDialogKey, modal, false, false)
+	s.app.Content.ShowPage(partitionDialogKey)
+
+	return nil
+}
+
+func (s *StsRollout) dismissDialog() {
+	s.app.Content.RemovePage(partitionDialogKey)
+}
+
+func (s *StsRollout) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p, err := s.partitionable()
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	paused, err := p.IsRolloutPaused(s.path)
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+
+	title, msg := "Confirm Pause", fmt.Sprintf("Pause %s rollout by holding all its ordinals?", s.path)
+	if paused {
+		title, msg = "Confirm Resume", fmt.Sprintf("Resume %s rollout to its paused partition?", s.path)
+	}
+	level := s.app.Config.ConfirmLevel(config.ActionPause)
+	dialog.ShowConfirm(s.app.Content.Pages, level, confirmName(s.app.Config.K9s.CurrentContext, []string{s.path}), title, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		defer cancel()
+		if err := p.PauseRollout(ctx, s.path, !paused); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		if paused {
+			s.app.Flash().Infof("Rollout %s resumed successfully", s.path)
+		} else {
+			s.app.Flash().Infof("Rollout %s paused successfully", s.path)
+		}
+		go s.load()
+	}, func() {})
+
+	return nil
+}
+
+func (s *StsRollout) setPartition(partition int32) {
+	p, err := s.partitionable()
+	if err != nil {
+		s.app.Flash().Err(err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+	defer cancel()
+	if err := p.SetPartition(ctx, s.path, partition); err != nil {
+		s.app.Flash().Err(err)
+		return
+	}
+	s.app.Flash().Infof("Rollout %s partition set to %d", s.path, partition)
+	go s.load()
+}
+
+func (s *StsRollout) partitionable() (dao.Partitionable, error) {
+	res, err := dao.AccessorFor(s.app.factory, stsGVR)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := res.(dao.Partitionable)
+	if !ok {
+		return nil, fmt.Errorf("expecting a partitionable resource for %q", stsGVR)
+	}
+
+	return p, nil
+}
+
+func (s *StsRollout) load() {
+	r, err := dao.FetchStsRollout(s.app.factory, s.path)
+	s.app.QueueUpdateDraw(func() {
+		if err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.rollout = r
+		s.Extras = stsRolloutExtras(r)
+		s.model.data = stsRolloutTableData(r)
+		s.Update(s.model.data)
+	})
+}
+
+var stsRolloutHeader = render.Header{
+	render.HeaderColumn{Name: "ORDINAL", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "NAME"},
+	render.HeaderColumn{Name: "REVISION"},
+	render.HeaderColumn{Name: "STATUS"},
+	render.HeaderColumn{Name: "PHASE"},
+	render.HeaderColumn{Name: "READY"},
+	render.HeaderColumn{Name: "AGE", Time: true, Decorator: render.AgeDecorator},
+}
+
+func stsRolloutColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 6 {
+		return render.StdColor
+	}
+	switch {
+	case re.Row.Fields[3] == dao.StsPodMissing:
+		return render.ErrColor
+	case re.Row.Fields[5] != "true":
+		return tcell.ColorOrange
+	case re.Row.Fields[3] == dao.StsPodUpdated:
+		return render.StdColor
+	}
+
+	return render.CompletedColor
+}
+
+// stsRolloutExtras summarizes a rollout progress in the view title.
+func stsRolloutExtras(r *dao.StsRollout) string {
+	s := fmt.Sprintf("%s %d/%d updated", r.Path, r.Updated(), len(r.Ordinals))
+	if r.Strategy != string(appsv1.RollingUpdateStatefulSetStrategyType) {
+		return s + " " + r.Strategy
+	}
+	s += fmt.Sprintf(" partition:%d", r.Partition)
+	if r.Paused {
+		s += " paused"
+	}
+
+	return s
+}
+
+func stsRolloutTableData(r *dao.StsRollout) render.TableData {
+	data := render.TableData{
+		Header:    stsRolloutHeader,
+		RowEvents: make(render.RowEvents, 0, len(r.Ordinals)),
+	}
+	for _, o := range r.Ordinals {
+		_, n := client.Namespaced(o.Path)
+		var age string
+		if !o.Created.IsZero() {
+			age = time.Since(o.Created).String()
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: o.Path,
+			Fields: render.Fields{
+				strconv.Itoa(o.Ordinal),
+				n,
+				o.Revision,
+				o.Status,
+				o.Phase,
+				strconv.FormatBool(o.Ready),
+				age,
+			},
+		}))
+	}
+
+	return data
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStsRolloutTableData(t *testing.T) {
+	r := dao.StsRollout{
+		Path:      "ns1/db",
+		Strategy:  "RollingUpdate",
+		Replicas:  2,
+		Partition: 1,
+		Ordinals: []dao.StsOrdinal{
+			{Ordinal: 0, Path: "ns1/db-0", Revision: "db-1", Status: dao.StsPodHeld, Phase: "Running", Ready: true},
+			{Ordinal: 1, Path: "ns1/db-1", Status: dao.StsPodMissing},
+		},
+	}
+	data := stsRolloutTableData(&r)
+
+	assert.Equal(t, len(stsRolloutHeader), len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, []string{"0", "db-0", "db-1", dao.StsPodHeld, "Running", "true"}, []string(data.RowEvents[0].Row.Fields[:6]))
+	assert.Equal(t, "", data.RowEvents[1].Row.Fields[6])
+	assert.Equal(t, "ns1/db 0/2 updated partition:1", stsRolloutExtras(&r))
+
+	r.Paused = true
+	assert.Equal(t, "ns1/db 0/2 updated partition:1 paused", stsRolloutExtras(&r))
+	r.Strategy = "OnDelete"
+	assert.Equal(t, "ns1/db 0/2 updated OnDelete", stsRolloutExtras(&r))
+}
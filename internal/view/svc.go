@@ -31,8 +31,10 @@ type Service struct {
 // NewService returns a new viewer.
 func NewService(gvr client.GVR) ResourceViewer {
 	s := Service{
-		ResourceViewer: NewPortForwardExtender(
-			NewLogsExtender(NewBrowser(gvr), nil),
+		ResourceViewer: NewProxyExtender(
+			NewPortForwardExtender(
+				NewLogsExtender(NewBrowser(gvr), nil),
+			),
 		),
 	}
 	s.SetBindKeysFn(s.bindKeys)
@@ -77,7 +79,7 @@ func (s *Service) getExternalPort(svc *v1.Service) (string, error) {
 	ports := render.ToPorts(svc.Spec.Ports)
 	pp := strings.Split(ports, " ")
 	// Grap the first port pair for now...
-	tokens := strings.Split(pp[0], "►")
+	tokens := strings.Split(pp[0], render.Glyph(render.PortMapSign))
 	if len(tokens) < 2 {
 		return "", errors.New("No ports pair found")
 	}
@@ -125,6 +125,22 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta("argoproj.io/v1alpha1/rollouts", metav1.APIResource{
+		Name:         "rollouts",
+		SingularName: "rollout",
+		Namespaced:   true,
+		Kind:         "Rollouts",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("flagger.app/v1beta1/canaries", metav1.APIResource{
+		Name:         "canaries",
+		SingularName: "canary",
+		Namespaced:   true,
+		Kind:         "Canaries",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 }
 
 func TestServiceNew(t *testing.T) {
@@ -132,5 +148,5 @@ func TestServiceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "Services", s.Name())
-	assert.Equal(t, 9, len(s.Hints()))
+	assert.Equal(t, 12, len(s.Hints()))
 }
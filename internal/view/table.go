@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/atotto/clipboard"
@@ -9,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -21,6 +23,7 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn BindKeysFunc
+	announced  string
 }
 
 // NewTable returns a new viewer.
@@ -46,12 +49,36 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	t.Table.Init(ctx)
 	t.SetInputCapture(t.keyboard)
 	t.bindKeys()
-	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
+	t.SetFrozen(t.refreshRate() == 0)
+	t.applyRefreshRate()
 	t.CmdBuff().AddListener(t)
+	t.SetMarksChangedFn(func(int) {
+		t.app.Crumbs().MarksChanged()
+	})
+	t.SetFilterErrFn(func(err error) {
+		t.app.Flash().Err(err)
+	})
+	t.SetChangedFn(t.rowChanged)
 
 	return nil
 }
 
+func (t *Table) rowChanged(r int) {
+	t.ExtendVisual()
+	if t.app.Config.K9s.GetScreenReader() {
+		t.announceRow(r)
+	}
+}
+
+func (t *Table) announceRow(r int) {
+	s := t.Linearize(r)
+	if s == "" || s == t.announced {
+		return
+	}
+	t.announced = s
+	t.app.Flash().Info(s)
+}
+
 // SendKey sends an keyboard event (testing only!).
 func (t *Table) SendKey(evt *tcell.EventKey) {
 	t.app.Prompt().SendKey(evt)
@@ -64,6 +91,9 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	if a, ok := t.Actions()[ui.AsKey(evt)]; ok && !t.app.Content.IsTopDialog() {
+		if !t.checkAccess(a) {
+			return nil
+		}
 		return a.Action(evt)
 	}
 
@@ -152,20 +182,133 @@ func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
 		ui.KeySpace:        ui.NewSharedKeyAction("Mark", t.markCmd, false),
 		tcell.KeyCtrlSpace: ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
+		tcell.KeyCtrlV:     ui.NewSharedKeyAction("Mark All", t.markAllCmd, false),
+		ui.KeyTilde:        ui.NewSharedKeyAction("Invert Marks", t.invertMarksCmd, false),
+		ui.KeyV:            ui.NewSharedKeyAction("Visual Mode", t.visualCmd, false),
+		ui.KeyM:            ui.NewKeyAction("Actions", t.actionMenuCmd, true),
 		tcell.KeyCtrlS:     ui.NewSharedKeyAction("Save", t.saveCmd, false),
 		ui.KeySlash:        ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
+		ui.KeyShift8:       ui.NewSharedKeyAction("Pin Filter", t.pinFilterCmd, false),
+		ui.KeyLess:         ui.NewSharedKeyAction("Prev Column", t.pivotCmd(-1), false),
+		ui.KeyGreater:      ui.NewSharedKeyAction("Next Column", t.pivotCmd(1), false),
+		ui.KeyEqual:        ui.NewSharedKeyAction("Quick Filter", t.quickFilterCmd, false),
+		ui.KeyI:            ui.NewSharedKeyAction("Inspect Cell", t.inspectCellCmd, false),
 		tcell.KeyCtrlZ:     ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
 		tcell.KeyCtrlW:     ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
+		tcell.KeyCtrlG:     ui.NewKeyAction("Toggle Freeze", t.toggleFreezeCmd, false),
 		ui.KeyShiftN:       ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false),
 		ui.KeyShiftA:       ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
 	})
 }
 
+// pinFilterCmd carries the current filter across views or lifts the pinned
+// filter.
+func (t *Table) pinFilterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.app.pinnedFilter != "" {
+		t.app.pinFilter("")
+		t.app.Flash().Info("Filter unpinned")
+		return nil
+	}
+	q := t.CmdBuff().GetText()
+	if q == "" {
+		t.app.Flash().Warn("No filter to pin. Use / to filter the view first")
+		return nil
+	}
+	t.app.pinFilter(q)
+	t.app.Flash().Infof("Filter %q pinned across views", q)
+
+	return nil
+}
+
+func (t *Table) pivotCmd(delta int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		t.MovePivot(delta)
+		if t.app.Config.K9s.GetScreenReader() {
+			t.app.Flash().Infof("Column %s", t.Pivot())
+		}
+
+		return nil
+	}
+}
+
+// inspectCellCmd shows the full value of the selected row cell under the
+// column cursor.
+func (t *Table) inspectCellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	col, v, ok := t.SelectedCell()
+	if !ok {
+		return evt
+	}
+
+	const page = "cell"
+	m := tview.NewModal().
+		AddButtons([]string{"Copy", "Close"}).
+		SetTextColor(t.app.Styles.FgColor()).
+		SetText(fmt.Sprintf("%s\n\n%s", col, tview.Escape(v))).
+		SetDoneFunc(func(_ int, label string) {
+			t.app.Content.RemovePage(page)
+			if label != "Copy" {
+				return
+			}
+			if err := clipboard.WriteAll(v); err != nil {
+				t.app.Flash().Err(err)
+				return
+			}
+			t.app.Flash().Infof("%s value copied to clipboard...", col)
+		})
+	m.SetBackgroundColor(t.app.Styles.BgColor())
+	t.app.Content.AddPage(page, m, false, false)
+	t.app.Content.ShowPage(page)
+
+	return nil
+}
+
+func (t *Table) quickFilterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	col, v, ok := t.ToggleQuickFilter()
+	if col == "" {
+		return evt
+	}
+	if ok {
+		t.app.Flash().Infof("Filtering on %s=%s. Press <esc> to clear", col, v)
+	} else {
+		t.app.Flash().Infof("Filter on %s lifted", col)
+	}
+
+	return nil
+}
+
 func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleToast()
 	return nil
 }
 
+func (t *Table) toggleFreezeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.SetFrozen(!t.IsFrozen())
+	t.applyRefreshRate()
+	if t.IsFrozen() {
+		t.app.Flash().Info("Auto refresh paused. Use <ctrl-r> to refresh")
+	} else {
+		t.app.Flash().Info("Auto refresh resumed")
+	}
+
+	return nil
+}
+
+// refreshRate returns the configured refresh rate for this view in seconds.
+func (t *Table) refreshRate() int {
+	return t.app.Config.K9s.GetRefreshRateFor(t.GVR().String(), t.GVR().R())
+}
+
+// applyRefreshRate updates the model refresh rate, honoring the frozen state.
+func (t *Table) applyRefreshRate() {
+	rate := t.refreshRate()
+	if t.IsFrozen() {
+		rate = 0
+	} else if rate <= 0 {
+		rate = t.app.Config.K9s.GetRefreshRate()
+	}
+	t.GetModel().SetRefreshRate(time.Duration(rate) * time.Second)
+}
+
 func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleWide()
 	return nil
@@ -194,6 +337,7 @@ func (t *Table) markCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 	t.ToggleMark()
 	t.Refresh()
+	t.MarksChanged()
 
 	return nil
 }
@@ -203,7 +347,42 @@ func (t *Table) clearMarksCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if path == "" {
 		return evt
 	}
+	t.StopVisual()
 	t.ClearMarks()
+	t.MarksChanged()
+
+	return nil
+}
+
+// markAllCmd marks all rows matching the current filter.
+func (t *Table) markAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.MarkAll(t.VisibleIDs())
+	t.Refresh()
+	t.MarksChanged()
+
+	return nil
+}
+
+// invertMarksCmd toggles the marks of all rows matching the current filter.
+func (t *Table) invertMarksCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.InvertMarks(t.VisibleIDs())
+	t.Refresh()
+	t.MarksChanged()
+
+	return nil
+}
+
+// visualCmd toggles a range selection marking all rows the cursor moves across.
+func (t *Table) visualCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.InVisual() {
+		t.StopVisual()
+		t.app.Flash().Infof("%d marked", t.MarkCount())
+		return nil
+	}
+	if !t.StartVisual() {
+		return evt
+	}
+	t.app.Flash().Info("Visual mode. Move the cursor to extend the range, <v> to keep, <esc> to cancel")
 
 	return nil
 }
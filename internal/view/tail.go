@@ -0,0 +1,93 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const tailUsage = "Usage: tail POD-REGEX [-n NAMESPACE|-A] [-l SELECTOR] [-c CONTAINER-REGEX]"
+
+// tailQuery represents a stern style multi pods tail query.
+type tailQuery struct {
+	ns, sel string
+	po, co  *regexp.Regexp
+}
+
+// parseTailQuery parses a tail command. The namespace defaults to ns.
+func parseTailQuery(tokens []string, ns string) (tailQuery, error) {
+	q := tailQuery{ns: ns}
+	var args []string
+	for _, t := range tokens[1:] {
+		if t != "" {
+			args = append(args, t)
+		}
+	}
+	next := func(i int, flag string) (string, error) {
+		if i+1 >= len(args) {
+			return "", fmt.Errorf("flag %s needs a value. %s", flag, tailUsage)
+		}
+		return args[i+1], nil
+	}
+
+	var err error
+	for i := 0; i < len(args); i++ {
+		switch a := args[i]; a {
+		case "-A", "--all-namespaces":
+			q.ns = client.NamespaceAll
+		case "-n", "--namespace":
+			if q.ns, err = next(i, a); err != nil {
+				return q, err
+			}
+			i++
+		case "-l", "--selector":
+			if q.sel, err = next(i, a); err != nil {
+				return q, err
+			}
+			if _, err := labels.Parse(q.sel); err != nil {
+				return q, err
+			}
+			i++
+		case "-c", "--container":
+			v, err := next(i, a)
+			if err != nil {
+				return q, err
+			}
+			if q.co, err = regexp.Compile(v); err != nil {
+				return q, fmt.Errorf("invalid container regex: %w", err)
+			}
+			i++
+		default:
+			if strings.HasPrefix(a, "-") {
+				return q, fmt.Errorf("unknown flag %s. %s", a, tailUsage)
+			}
+			if q.po != nil {
+				return q, errors.New(tailUsage)
+			}
+			if q.po, err = regexp.Compile(a); err != nil {
+				return q, fmt.Errorf("invalid pod regex: %w", err)
+			}
+		}
+	}
+	if q.po == nil && q.sel == "" {
+		return q, errors.New(tailUsage)
+	}
+
+	return q, nil
+}
+
+func (c *Command) tailCmd(tokens []string) error {
+	q, err := parseTailQuery(tokens, c.app.Config.ActiveNamespace())
+	if err != nil {
+		return err
+	}
+	if _, err := c.app.factory.CanForResource(client.CleanseNamespace(q.ns), "v1/pods", client.MonitorAccess); err != nil {
+		return err
+	}
+
+	return c.app.inject(NewTailLog(q.ns, q.sel, q.po, q.co))
+}
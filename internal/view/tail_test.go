@@ -0,0 +1,78 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTailQuery(t *testing.T) {
+	uu := map[string]struct {
+		cmd         string
+		ns, sel     string
+		po, co, err string
+	}{
+		"pod": {
+			cmd: "tail ^nginx-",
+			ns:  "default",
+			po:  "^nginx-",
+		},
+		"flags": {
+			cmd: "tail  web|api -n  fred -l app=blee,tier!=db -c ^main$",
+			ns:  "fred",
+			sel: "app=blee,tier!=db",
+			po:  "web|api",
+			co:  "^main$",
+		},
+		"allNamespaces": {
+			cmd: "tail -A -l app=blee",
+			ns:  "all",
+			sel: "app=blee",
+		},
+		"noQuery": {
+			cmd: "tail -n fred",
+			err: tailUsage,
+		},
+		"missingValue": {
+			cmd: "tail nginx -n",
+			err: "flag -n needs a value. " + tailUsage,
+		},
+		"unknownFlag": {
+			cmd: "tail nginx -f",
+			err: "unknown flag -f. " + tailUsage,
+		},
+		"twoQueries": {
+			cmd: "tail nginx api",
+			err: tailUsage,
+		},
+		"badRegex": {
+			cmd: "tail nginx(",
+			err: "invalid pod regex: error parsing regexp: missing closing ): `nginx(`",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := parseTailQuery(strings.Split(u.cmd, " "), "default")
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.ns, q.ns)
+			assert.Equal(t, u.sel, q.sel)
+			if u.po == "" {
+				assert.Nil(t, q.po)
+			} else {
+				assert.Equal(t, u.po, q.po.String())
+			}
+			if u.co == "" {
+				assert.Nil(t, q.co)
+			} else {
+				assert.Equal(t, u.co, q.co.String())
+			}
+		})
+	}
+}
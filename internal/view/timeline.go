@@ -0,0 +1,188 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	timelineTitle       = "timeline"
+	timelineRefreshRate = 5 * time.Second
+)
+
+// Timeline presents a pod or workload events and containers state
+// transitions in chronological order.
+type Timeline struct {
+	*Table
+
+	gvr    client.GVR
+	path   string
+	model  *staticModel
+	cancel context.CancelFunc
+}
+
+// NewTimeline returns a new resource timeline viewer.
+func NewTimeline(gvr client.GVR, path string) *Timeline {
+	return &Timeline{
+		Table: NewTable(client.NewGVR(timelineTitle)),
+		gvr:   gvr,
+		path:  path,
+		model: &staticModel{Table: model.NewTable(client.NewGVR(timelineTitle))},
+	}
+}
+
+// timelineCmd shows the selected resource timeline.
+func timelineCmd(v ResourceViewer) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewTimeline(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+// Init initializes the component.
+func (t *Timeline) Init(ctx context.Context) error {
+	if err := t.Table.Init(ctx); err != nil {
+		return err
+	}
+	t.SetModel(t.model)
+	t.SetColorerFn(timelineColorer)
+	t.SetBorderFocusColor(tcell.ColorDodgerBlue)
+	t.SetSelectedStyle(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone)
+	t.Extras = t.path
+	t.bindKeys()
+	t.model.data = render.TableData{Header: timelineHeader}
+	t.Update(t.model.data)
+
+	return nil
+}
+
+// Name returns the component name.
+func (t *Timeline) Name() string { return timelineTitle }
+
+// Start runs the component and refreshes the timeline periodically.
+func (t *Timeline) Start() {
+	t.Table.Start()
+
+	var ctx context.Context
+	ctx, t.cancel = context.WithCancel(context.Background())
+	go func() {
+		for {
+			t.load()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(timelineRefreshRate):
+			}
+		}
+	}()
+}
+
+// Stop terminates the component.
+func (t *Timeline) Stop() {
+	if t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+	t.Table.Stop()
+}
+
+func (t *Timeline) bindKeys() {
+	t.Actions().Delete(tcell.KeyCtrlZ)
+	t.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", t.refreshCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Severity", t.SortColCmd("SEVERITY", true), false),
+		ui.KeyShiftO:    ui.NewKeyAction("Sort Object", t.SortColCmd("OBJECT", true), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, false),
+	})
+}
+
+func (t *Timeline) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go t.load()
+
+	return nil
+}
+
+func (t *Timeline) load() {
+	tt, err := dao.FetchTimeline(t.app.factory, t.gvr, t.path)
+	t.app.QueueUpdateDraw(func() {
+		if err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.model.data = timelineTableData(tt)
+		t.Update(t.model.data)
+	})
+}
+
+var timelineHeader = render.Header{
+	render.HeaderColumn{Name: "TIME"},
+	render.HeaderColumn{Name: "SEVERITY"},
+	render.HeaderColumn{Name: "SOURCE"},
+	render.HeaderColumn{Name: "OBJECT"},
+	render.HeaderColumn{Name: "REASON"},
+	render.HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "MESSAGE"},
+}
+
+func timelineColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 3 {
+		return render.StdColor
+	}
+	switch re.Row.Fields[1] {
+	case dao.TimelineError:
+		return render.ErrColor
+	case dao.TimelineWarning:
+		return tcell.ColorOrange
+	}
+	if re.Row.Fields[2] == dao.TimelineEvent {
+		return render.StdColor
+	}
+
+	return render.CompletedColor
+}
+
+// timelineTableData lists the timeline entries, latest first.
+func timelineTableData(tt []dao.TimelineEntry) render.TableData {
+	data := render.TableData{
+		Header:    timelineHeader,
+		RowEvents: make(render.RowEvents, 0, len(tt)),
+	}
+	for i := len(tt) - 1; i >= 0; i-- {
+		e := tt[i]
+		var count string
+		if e.Count > 1 {
+			count = strconv.Itoa(int(e.Count))
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: fmt.Sprintf("%d-%s-%s-%s", e.Time.UnixNano(), e.Source, e.Object, e.Reason),
+			Fields: render.Fields{
+				e.Time.Local().Format("2006-01-02 15:04:05"),
+				e.Severity,
+				e.Source,
+				e.Object,
+				e.Reason,
+				count,
+				e.Message,
+			},
+		}))
+	}
+
+	return data
+}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineTableData(t *testing.T) {
+	t0 := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	data := timelineTableData([]dao.TimelineEntry{
+		{Time: t0, Severity: dao.TimelineNormal, Source: dao.TimelinePod, Object: "pod/p1", Reason: "Scheduled"},
+		{Time: t0.Add(time.Second), Severity: dao.TimelineWarning, Source: dao.TimelineEvent, Object: "pod/p1 c1", Reason: "BackOff", Message: "restarting", Count: 3},
+	})
+
+	assert.Equal(t, len(timelineHeader), len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, []string{dao.TimelineWarning, dao.TimelineEvent, "pod/p1 c1", "BackOff", "3", "restarting"}, []string(data.RowEvents[0].Row.Fields[1:]))
+	assert.Equal(t, "", data.RowEvents[1].Row.Fields[5])
+}
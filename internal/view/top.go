@@ -0,0 +1,278 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	topTitle       = "top"
+	topRefreshRate = 15 * time.Second
+	// Rows ids carry a colon so they never collide with resource names.
+	topTotalID     = "total:"
+	topSubtotalID  = "subtotal:"
+	topSubtotalFmt = "%s (%d)"
+)
+
+// topCmd shows the cluster pods usage aggregated by namespace, deployment
+// or node.
+// Usage: top [namespace|deployment|node].
+func (c *Command) topCmd(tokens []string) error {
+	by := dao.TopByNamespace
+	if len(tokens) > 1 {
+		by = tokens[1]
+	}
+	if !topValidGroup(by) {
+		return fmt.Errorf("invalid top aggregation %q. Expecting one of %v", by, dao.TopGroups)
+	}
+
+	return c.app.inject(NewTop(by))
+}
+
+// Top presents the cluster pods usage aggregates with their subtotals.
+type Top struct {
+	*Table
+
+	by       string
+	sortCol  string
+	asc      bool
+	report   *dao.TopReport
+	model    *staticModel
+	cancelFn context.CancelFunc
+}
+
+// NewTop returns a new usage aggregates viewer.
+func NewTop(by string) *Top {
+	return &Top{
+		Table:   NewTable(client.NewGVR(topTitle)),
+		by:      by,
+		sortCol: dao.TopSortCPU,
+		model:   &staticModel{Table: model.NewTable(client.NewGVR(topTitle))},
+	}
+}
+
+// Init initializes the component.
+func (t *Top) Init(ctx context.Context) error {
+	if err := t.Table.Init(ctx); err != nil {
+		return err
+	}
+	t.SetModel(t.model)
+	t.SetColorerFn(topColorer)
+	t.SetBorderFocusColor(tcell.ColorCadetBlue)
+	t.SetSelectedStyle(tcell.ColorWhite, tcell.ColorCadetBlue, tcell.AttrNone)
+	// Rows are ordered by the report so subtotals stay with their breakdown.
+	t.SetSortCol("NONE", true)
+	t.bindKeys()
+	t.updateExtras()
+	t.model.data = render.TableData{Header: topHeader}
+	t.Update(t.model.data)
+
+	return nil
+}
+
+// Name returns the component name.
+func (t *Top) Name() string { return topTitle }
+
+// Start starts refreshing the usage aggregates.
+func (t *Top) Start() {
+	t.Stop()
+	t.Table.Start()
+	var ctx context.Context
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	go t.refresh(ctx)
+}
+
+// Stop stops refreshing the usage aggregates.
+func (t *Top) Stop() {
+	if t.cancelFn != nil {
+		t.cancelFn()
+		t.cancelFn = nil
+	}
+	t.Table.Stop()
+}
+
+func (t *Top) refresh(ctx context.Context) {
+	t.load()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(topRefreshRate):
+			t.load()
+		}
+	}
+}
+
+func (t *Top) bindKeys() {
+	t.Actions().Delete(tcell.KeyCtrlZ, ui.KeyShiftA, ui.KeyShiftN)
+	t.Actions().Add(ui.KeyActions{
+		ui.KeyG:         ui.NewKeyAction("Group By", t.groupCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", t.refreshCmd, true),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", t.sortCmd(dao.TopSortName, true), false),
+		ui.KeyShiftP:    ui.NewKeyAction("Sort Pods", t.sortCmd(dao.TopSortPods, false), false),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort CPU", t.sortCmd(dao.TopSortCPU, false), false),
+		ui.KeyShiftM:    ui.NewKeyAction("Sort MEM", t.sortCmd(dao.TopSortMEM, false), false),
+		ui.KeyShiftX:    ui.NewKeyAction("Sort %CPU (LIM)", t.sortCmd(dao.TopSortCPULimit, false), false),
+		ui.KeyShiftZ:    ui.NewKeyAction("Sort %MEM (LIM)", t.sortCmd(dao.TopSortMEMLimit, false), false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, false),
+	})
+}
+
+func (t *Top) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go t.load()
+
+	return nil
+}
+
+// groupCmd rotates the aggregation between namespaces, deployments and nodes.
+func (t *Top) groupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	for i, g := range dao.TopGroups {
+		if g == t.by {
+			t.by = dao.TopGroups[(i+1)%len(dao.TopGroups)]
+			break
+		}
+	}
+	t.updateExtras()
+	go t.load()
+
+	return nil
+}
+
+// sortCmd orders the aggregates, toggling the order on the current column.
+func (t *Top) sortCmd(col string, asc bool) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if t.sortCol == col {
+			t.asc = !t.asc
+		} else {
+			t.sortCol, t.asc = col, asc
+		}
+		t.updateExtras()
+		t.render()
+
+		return nil
+	}
+}
+
+func (t *Top) updateExtras() {
+	order := "↓"
+	if t.asc {
+		order = "↑"
+	}
+	t.Extras = fmt.Sprintf("by %s %s%s", t.by, t.sortCol, order)
+}
+
+func (t *Top) load() {
+	r, err := dao.FetchTop(t.app.factory, t.by)
+	t.app.QueueUpdateDraw(func() {
+		if err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.report = r
+		t.render()
+	})
+}
+
+func (t *Top) render() {
+	if t.report == nil {
+		return
+	}
+	t.report.Sort(t.sortCol, t.asc)
+	t.model.data = topTableData(t.report)
+	t.Update(t.model.data)
+}
+
+var topHeader = render.Header{
+	render.HeaderColumn{Name: "NAME"},
+	render.HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "CPU", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "MEM", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "CPU/L", Align: tview.AlignRight, Wide: true},
+	render.HeaderColumn{Name: "MEM/L", Align: tview.AlignRight, Wide: true},
+}
+
+func topColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	switch {
+	case re.Row.ID == topTotalID:
+		return render.HighlightColor
+	case len(re.Row.Fields) < 6:
+		return render.StdColor
+	}
+	for _, f := range re.Row.Fields[4:6] {
+		if p, err := strconv.Atoi(f); err == nil && p >= 90 {
+			return render.ErrColor
+		}
+	}
+	if strings.HasPrefix(re.Row.ID, topSubtotalID) {
+		return tcell.ColorCadetBlue
+	}
+
+	return render.StdColor
+}
+
+// topTableData lists the aggregates followed by their breakdown if any and
+// the cluster total.
+func topTableData(r *dao.TopReport) render.TableData {
+	data := render.TableData{Header: topHeader}
+	add := func(id string, u dao.TopUsage) {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+			ID: id,
+			Fields: render.Fields{
+				u.Name,
+				strconv.Itoa(u.Pods),
+				render.ToMillicore(u.CPU),
+				render.ToMi(client.ToMB(u.MEM)),
+				topPercent(u.CPUPercent()),
+				topPercent(u.MEMPercent()),
+				render.ToMillicore(u.CPULimit),
+				render.ToMi(client.ToMB(u.MEMLimit)),
+			},
+		}))
+	}
+	for _, g := range r.Groups {
+		if len(g.Items) == 0 {
+			add(g.Name, g.TopUsage)
+			continue
+		}
+		sub := g.TopUsage
+		sub.Name = fmt.Sprintf(topSubtotalFmt, g.Name, len(g.Items))
+		add(topSubtotalID+g.Name, sub)
+		for _, u := range g.Items {
+			add(u.Name, u)
+		}
+	}
+	add(topTotalID, r.Total)
+
+	return data
+}
+
+func topPercent(p int) string {
+	if p < 0 {
+		return render.NAValue
+	}
+
+	return strconv.Itoa(p)
+}
+
+func topValidGroup(by string) bool {
+	for _, g := range dao.TopGroups {
+		if g == by {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopTableData(t *testing.T) {
+	r := dao.TopReport{
+		By:    dao.TopByDeployment,
+		Total: dao.TopUsage{Name: "TOTAL", Pods: 3, CPU: 450},
+		Groups: []dao.TopGroup{
+			{
+				TopUsage: dao.TopUsage{Name: "ns1", Pods: 2, CPU: 150, CPULimit: 400},
+				Items:    []dao.TopUsage{{Name: "ns1/web", Pods: 2, CPU: 150, CPULimit: 400}},
+			},
+		},
+	}
+
+	data := topTableData(&r)
+	assert.Equal(t, 3, len(data.RowEvents))
+	assert.Equal(t, "subtotal:ns1", data.RowEvents[0].Row.ID)
+	assert.Equal(t, []string{"ns1 (1)", "2", "150", "0", "37", render.NAValue}, []string(data.RowEvents[0].Row.Fields[:6]))
+	assert.Equal(t, "ns1/web", data.RowEvents[1].Row.ID)
+	assert.Equal(t, topTotalID, data.RowEvents[2].Row.ID)
+}
+
+func TestTopValidGroup(t *testing.T) {
+	assert.True(t, topValidGroup(dao.TopByNode))
+	assert.False(t, topValidGroup("fred"))
+}
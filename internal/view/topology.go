@@ -0,0 +1,193 @@
+package view
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	topologyTitle  = "topology"
+	topologyDomain = "Domain"
+)
+
+// TopologyExtender adds topology spread extensions.
+type TopologyExtender struct {
+	ResourceViewer
+}
+
+// NewTopologyExtender returns a new extender.
+func NewTopologyExtender(r ResourceViewer) ResourceViewer {
+	t := TopologyExtender{ResourceViewer: r}
+	t.bindKeys(t.Actions())
+
+	return &t
+}
+
+func (t *TopologyExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Topology", t.topologyCmd, true),
+	})
+}
+
+func (t *TopologyExtender) topologyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := t.App().inject(NewTopology(t.GVR(), path)); err != nil {
+		t.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Topology presents a workload pods distribution across topology domains.
+type Topology struct {
+	*Table
+
+	workload client.GVR
+	path     string
+	model    *staticModel
+}
+
+// NewTopology returns a new topology viewer.
+func NewTopology(gvr client.GVR, path string) *Topology {
+	return &Topology{
+		Table:    NewTable(client.NewGVR(topologyTitle)),
+		workload: gvr,
+		path:     path,
+		model:    &staticModel{Table: model.NewTable(client.NewGVR(topologyTitle))},
+	}
+}
+
+// Init initializes the component.
+func (t *Topology) Init(ctx context.Context) error {
+	if err := t.Table.Init(ctx); err != nil {
+		return err
+	}
+	t.SetModel(t.model)
+	t.SetColorerFn(topologyColorer)
+	t.SetBorderFocusColor(tcell.ColorMediumPurple)
+	t.SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumPurple, tcell.AttrNone)
+	t.Extras = t.path
+	t.bindKeys()
+	t.model.data = render.TableData{Header: topologyHeader}
+	t.Update(t.model.data)
+	go t.load()
+
+	return nil
+}
+
+// Name returns the component name.
+func (t *Topology) Name() string { return topologyTitle }
+
+func (t *Topology) bindKeys() {
+	t.Actions().Delete(tcell.KeyCtrlZ)
+	t.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", t.refreshCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, false),
+	})
+}
+
+func (t *Topology) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go t.load()
+
+	return nil
+}
+
+func (t *Topology) load() {
+	r, err := dao.FetchTopology(t.app.factory, t.workload, t.path)
+	t.app.QueueUpdateDraw(func() {
+		if err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.model.data = topologyTableData(r)
+		t.Update(t.model.data)
+		var violations int
+		for _, c := range r.Checks {
+			if c.Violated {
+				violations++
+			}
+		}
+		t.app.Flash().Infof("%d topology checks, %d violated", len(r.Checks), violations)
+	})
+}
+
+var topologyHeader = render.Header{
+	render.HeaderColumn{Name: "KIND"},
+	render.HeaderColumn{Name: "KEY"},
+	render.HeaderColumn{Name: "DOMAIN"},
+	render.HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "SKEW", Align: tview.AlignRight},
+	render.HeaderColumn{Name: "STATUS"},
+	render.HeaderColumn{Name: "DETAILS"},
+}
+
+func topologyColorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	if len(re.Row.Fields) < 6 {
+		return tcell.ColorMediumPurple
+	}
+	switch re.Row.Fields[5] {
+	case "Violated":
+		return render.ErrColor
+	case "Unsatisfied":
+		return tcell.ColorOrange
+	}
+	if re.Row.Fields[0] == topologyDomain {
+		return render.StdColor
+	}
+
+	return tcell.ColorMediumPurple
+}
+
+func topologyTableData(r *dao.TopologyReport) render.TableData {
+	data := render.TableData{Header: topologyHeader}
+	add := func(id string, ff ...string) {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{ID: id, Fields: ff}))
+	}
+	for i, c := range r.Checks {
+		var total int
+		for _, d := range c.Domains {
+			total += len(d.Pods)
+		}
+		skew := strconv.Itoa(c.Skew)
+		if c.Kind != dao.TopologyDistribution {
+			skew += "/" + strconv.Itoa(c.MaxSkew)
+		}
+		id := strconv.Itoa(i) + ":" + c.Kind + ":" + c.Key
+		add(id, c.Kind, c.Key, "", strconv.Itoa(total), skew, topologyStatus(c), c.Policy)
+		for _, d := range c.Domains {
+			add(id+":"+d.Value, topologyDomain, c.Key, d.Value, strconv.Itoa(len(d.Pods)), "", "", strings.Join(d.Pods, ","))
+		}
+	}
+
+	return data
+}
+
+// topologyStatus returns a check status. Soft constraints are unsatisfied
+// rather than violated.
+func topologyStatus(c dao.TopologyCheck) string {
+	switch {
+	case c.Kind == dao.TopologyDistribution:
+		return render.NAValue
+	case !c.Violated:
+		return "OK"
+	case c.Policy == string(v1.ScheduleAnyway), c.Policy == dao.TopologyPreferred:
+		return "Unsatisfied"
+	default:
+		return "Violated"
+	}
+}
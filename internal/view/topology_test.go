@@ -0,0 +1,65 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologyTableData(t *testing.T) {
+	r := dao.TopologyReport{
+		Workload: "default/fred",
+		Checks: []dao.TopologyCheck{
+			{
+				Kind:     dao.TopologySpread,
+				Key:      "topology.kubernetes.io/zone",
+				Policy:   "DoNotSchedule",
+				MaxSkew:  1,
+				Skew:     2,
+				Violated: true,
+				Domains: []dao.TopologyDomain{
+					{Value: "z1", Pods: []string{"default/p1", "default/p2"}},
+					{Value: "z2"},
+				},
+			},
+		},
+	}
+
+	data := topologyTableData(&r)
+	assert.Equal(t, 3, len(data.RowEvents))
+	assert.Equal(t, render.Fields{"Spread", "topology.kubernetes.io/zone", "", "2", "2/1", "Violated", "DoNotSchedule"}, data.RowEvents[0].Row.Fields)
+	assert.Equal(t, render.Fields{"Domain", "topology.kubernetes.io/zone", "z1", "2", "", "", "default/p1,default/p2"}, data.RowEvents[1].Row.Fields)
+}
+
+func TestTopologyStatus(t *testing.T) {
+	uu := map[string]struct {
+		c dao.TopologyCheck
+		e string
+	}{
+		"distribution": {
+			c: dao.TopologyCheck{Kind: dao.TopologyDistribution},
+			e: render.NAValue,
+		},
+		"ok": {
+			c: dao.TopologyCheck{Kind: dao.TopologySpread, Policy: "DoNotSchedule"},
+			e: "OK",
+		},
+		"violated": {
+			c: dao.TopologyCheck{Kind: dao.TopologyAntiAffinity, Policy: dao.TopologyRequired, Violated: true},
+			e: "Violated",
+		},
+		"soft": {
+			c: dao.TopologyCheck{Kind: dao.TopologySpread, Policy: "ScheduleAnyway", Violated: true},
+			e: "Unsatisfied",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, topologyStatus(u.c))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const tourDoneMsg = "Tour completed! Press <?> anytime to list all key bindings"
+
+// tourSteps returns the guided tour steps.
+func tourSteps(readOnly bool) []model.TourStep {
+	ss := []model.TourStep{
+		{
+			Message: "Press <0> to view pods in all namespaces",
+			Keys:    []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
+		},
+		{
+			Message: "Press </>, type part of a pod name and press <enter> to filter",
+			Keys:    []string{"/"},
+		},
+		{
+			Message: "Press <esc> to clear the filter",
+			Keys:    []string{"Esc"},
+		},
+		{
+			Message: "Press <d> to describe the selected pod",
+			Keys:    []string{"d"},
+		},
+		{
+			Message: "Press <esc> to go back",
+			Keys:    []string{"Esc"},
+		},
+		{
+			Message: "Press <l> to view the selected pod logs",
+			Keys:    []string{"l"},
+		},
+		{
+			Message: "Press <esc> to go back",
+			Keys:    []string{"Esc"},
+		},
+	}
+	if !readOnly {
+		ss = append(ss, model.TourStep{
+			Message: "Press <s> to shell into the selected pod. Exit the shell to come back",
+			Keys:    []string{"s"},
+		})
+	}
+
+	return ss
+}
+
+// tourCmd toggles the guided tour.
+func (a *App) tourCmd() {
+	if a.tour != nil {
+		a.tour = nil
+		a.Flash().Info("Tour stopped")
+		return
+	}
+	if err := a.gotoResource("pod", "", true); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.tour = model.NewTour(tourSteps(a.Config.K9s.GetReadOnly()))
+	a.showTourStep()
+}
+
+// tourKey advances the tour when the key completes the current step.
+func (a *App) tourKey(evt *tcell.EventKey) {
+	if a.tour == nil {
+		return
+	}
+	a.tour.Advance(tcell.KeyNames[ui.AsKey(evt)])
+	// Show the step once the key was processed so view messages don't hide it.
+	a.QueueUpdateDraw(a.showTourStep)
+}
+
+func (a *App) showTourStep() {
+	if a.tour == nil {
+		return
+	}
+	s, ok := a.tour.Current()
+	if !ok {
+		a.tour = nil
+		a.Flash().Info(tourDoneMsg)
+		return
+	}
+	n, total := a.tour.Progress()
+	a.Flash().Infof("Tour %d/%d: %s", n, total, s.Message)
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Trash presents snapshots of deleted resources.
+type Trash struct {
+	ResourceViewer
+}
+
+// NewTrash returns a new viewer.
+func NewTrash(gvr client.GVR) ResourceViewer {
+	t := Trash{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetBorderFocusColor(tcell.ColorIndianRed)
+	t.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorIndianRed, tcell.AttrNone)
+	t.GetTable().SetColorerFn(render.Trash{}.ColorerFunc())
+	t.GetTable().SetSortCol(ageCol, true)
+	t.GetTable().SetEnterFn(t.viewManifest)
+	t.SetContextFn(t.dirContext)
+	t.SetBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *Trash) bindKeys(aa ui.KeyActions) {
+	if t.App().Config.K9s.GetReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Restore", t.restoreCmd, true),
+	})
+}
+
+func (t *Trash) dirContext(ctx context.Context) context.Context {
+	dir := trashDir(t.App().Config)
+	config.EnsureFullPath(dir, config.DefaultDirMod)
+
+	return context.WithValue(ctx, internal.KeyDir, dir)
+}
+
+func (t *Trash) viewManifest(app *App, model ui.Tabular, gvr, path string) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		app.Flash().Errf("Unable to load trash file %s", err)
+		return
+	}
+
+	details := NewDetails(app, "YAML", filepath.Base(path), true).SetContentType(config.ContentYAML).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (t *Trash) restoreCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	name := t.GetTable().GetSelectedCell(0)
+
+	t.Stop()
+	defer t.Start()
+	msg := fmt.Sprintf("Restore %s?", name)
+	dialog.ShowConfirm(t.App().Content.Pages, config.ConfirmYesNo, "", "Confirm Restore", msg, func() {
+		res, err := dao.AccessorFor(t.App().factory, t.GVR())
+		if err != nil {
+			t.App().Flash().Err(err)
+			return
+		}
+		trash, ok := res.(*dao.Trash)
+		if !ok {
+			t.App().Flash().Err(errors.New("expecting a trash accessor"))
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), client.CallTimeout)
+		defer cancel()
+		if err := trash.Restore(ctx, path); err != nil {
+			t.App().Flash().Errf("Restore failed %s", err)
+			return
+		}
+		t.App().Flash().Infof("Resource %s restored successfully", name)
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func trashDir(cfg *config.Config) string {
+	return filepath.Join(config.K9sTrashDir, cfg.K9s.CurrentCluster)
+}
+
+// runTrashDelete trashes resources manifests prior to deleting them off the
+// ui thread. The trash is pruned once the whole batch is done.
+func runTrashDelete(app *App, gvr client.GVR, paths []string, del func(string) error, done func(bulkResults)) {
+	dir := trashDir(app.Config)
+	runBulkAsync(app, "Delete", gvr, paths, func(path string) error {
+		snapshotOne(app, gvr, path, dir)
+		return del(path)
+	}, func(rr bulkResults) {
+		go dao.PruneTrash(dir)
+		if done != nil {
+			done(rr)
+		}
+	})
+}
+
+// nukeFn returns a deletion of a resource using the given options.
+func nukeFn(nuker dao.Nuker, cascade, force bool) func(string) error {
+	return func(path string) error {
+		return nuker.Delete(path, cascade, force)
+	}
+}
+
+// snapshot saves the manifests of resources about to be deleted. Snapshots
+// hit the cluster cache so this must be called off the ui thread.
+func snapshot(app *App, gvr client.GVR, paths []string) {
+	dir := trashDir(app.Config)
+	for _, path := range paths {
+		snapshotOne(app, gvr, path, dir)
+	}
+	dao.PruneTrash(dir)
+}
+
+func snapshotOne(app *App, gvr client.GVR, path, dir string) {
+	if _, err := dao.Snapshot(app.factory, gvr, path, dir, app.Config.K9s.TrashSecrets); err != nil {
+		log.Warn().Err(err).Msgf("Unable to snapshot %s %s", gvr, path)
+	}
+}
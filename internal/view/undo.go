@@ -0,0 +1,53 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+// record journals a reversible operation against the current context. The
+// operation is refused on revert if the context was switched since.
+func (a *App) record(op model.Operation) {
+	op.Context = a.Config.K9s.CurrentContext
+	revert := op.Revert
+	op.Revert = func() error {
+		if ctx := a.Config.K9s.CurrentContext; ctx != op.Context {
+			return fmt.Errorf("operation was performed on context %q not %q", op.Context, ctx)
+		}
+		return revert()
+	}
+	a.journal.Push(op)
+}
+
+func (a *App) undoCmd() {
+	ctx := a.Config.K9s.CurrentContext
+	op, ok := a.journal.Peek(ctx)
+	if !ok {
+		a.Flash().Warn("Nothing to undo")
+		return
+	}
+	if a.Config.K9s.GetReadOnly() {
+		a.Flash().Warn("Undo is disabled in readonly mode")
+		return
+	}
+
+	msg := "Undo " + op.Description + "?\n" + op.Inverse
+	dialog.ShowConfirm(a.Content.Pages, config.ConfirmYesNo, "", "Confirm Undo", msg, func() {
+		if a.Config.K9s.CurrentContext != ctx {
+			a.Flash().Warnf("Undo canceled, context switched away from %q", ctx)
+			return
+		}
+		op, ok := a.journal.Pop(ctx)
+		if !ok {
+			return
+		}
+		if err := op.Revert(); err != nil {
+			a.Flash().Errf("Undo failed %s", err)
+			return
+		}
+		a.Flash().Infof("Undo %s succeeded", op.Description)
+	}, func() {})
+}
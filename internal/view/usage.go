@@ -0,0 +1,128 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	usageTitle = "Usage"
+
+	// usageSaveInterval tracks how often usage stats are persisted.
+	usageSaveInterval = time.Minute
+)
+
+// UsageTracker records the time spent on views.
+type UsageTracker struct {
+	usage *model.Usage
+}
+
+// NewUsageTracker returns a new view usage tracker.
+func NewUsageTracker(u *model.Usage) *UsageTracker {
+	return &UsageTracker{usage: u}
+}
+
+// StackPushed notifies a new view was added.
+func (u *UsageTracker) StackPushed(c model.Component) {
+	u.track(c)
+}
+
+// StackPopped notifies a view was removed.
+func (u *UsageTracker) StackPopped(_, top model.Component) {
+	u.track(top)
+}
+
+// StackTop notifies the top view.
+func (u *UsageTracker) StackTop(top model.Component) {
+	u.track(top)
+}
+
+func (u *UsageTracker) track(c model.Component) {
+	if c == nil || c.Name() == usageTitle {
+		return
+	}
+	u.usage.EnterView(c.Name(), time.Now())
+}
+
+func (a *App) initUsage() {
+	a.usage = model.NewUsage()
+	a.usage.SetEnabled(false)
+	a.Content.Stack.AddListener(NewUsageTracker(a.usage))
+	a.reloadUsage()
+}
+
+// reloadUsage turns usage tracking on or off as configured. Stats are
+// reloaded from disk when turned on and saved when turned off.
+func (a *App) reloadUsage() {
+	on := a.Config.K9s.UsageInsights
+	if on == a.usage.Enabled() {
+		return
+	}
+	if !on {
+		a.saveUsage()
+		a.usage.SetEnabled(false)
+		return
+	}
+	if err := a.usage.Reload(config.K9sUsageFile); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load usage stats %q", config.K9sUsageFile)
+	}
+	a.usage.SetEnabled(true)
+	if top := a.Content.Top(); top != nil {
+		a.usage.EnterView(top.Name(), time.Now())
+	}
+}
+
+// usageSaver periodically persists usage stats so they survive a crash.
+func (a *App) usageSaver(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(usageSaveInterval):
+			a.saveUsage()
+		}
+	}
+}
+
+func (a *App) trackCommand(cmd string) {
+	if a.usage != nil {
+		a.usage.TrackCommand(cmd)
+	}
+}
+
+func (a *App) saveUsage() {
+	if a.usage == nil || !a.usage.Enabled() {
+		return
+	}
+	if err := a.usage.Save(config.K9sUsageFile, time.Now()); err != nil {
+		log.Error().Err(err).Msgf("Unable to save usage stats %q", config.K9sUsageFile)
+	}
+}
+
+// usageCmd shows the most used commands and views.
+func (a *App) usageCmd() {
+	if a.usage == nil || !a.usage.Enabled() {
+		a.Flash().Warn("Usage insights are disabled. Set usageInsights: true in your K9s config")
+		return
+	}
+	raw, err := yaml.Marshal(struct {
+		Views    []model.UsageStat `json:"views"`
+		Commands []model.UsageStat `json:"commands"`
+	}{
+		Views:    a.usage.ViewStats(time.Now()),
+		Commands: a.usage.CommandStats(),
+	})
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	d := NewDetails(a, usageTitle, "local", true).Update(string(raw))
+	if err := a.inject(d); err != nil {
+		a.Flash().Err(err)
+	}
+}
@@ -0,0 +1,133 @@
+package view
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	watchStatsTitle = "Watches"
+
+	watchStatsRefresh = 2 * time.Second
+)
+
+// WatchStats displays the informers watches health, refreshing periodically.
+type WatchStats struct {
+	*Details
+
+	cancel context.CancelFunc
+	mx     sync.Mutex
+}
+
+// NewWatchStats returns a new watches health pane.
+func NewWatchStats(app *App) *WatchStats {
+	return &WatchStats{
+		Details: NewDetails(app, watchStatsTitle, app.Config.K9s.CurrentContext, true),
+	}
+}
+
+// Init initializes the component and starts refreshing the stats.
+func (w *WatchStats) Init(ctx context.Context) error {
+	if err := w.Details.Init(ctx); err != nil {
+		return err
+	}
+	w.Update(watchStatsText(time.Now()))
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	var c context.Context
+	c, w.cancel = context.WithCancel(context.Background())
+	go w.refresh(c)
+
+	return nil
+}
+
+// Stop terminates the refresh.
+func (w *WatchStats) Stop() {
+	w.Details.Stop()
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+}
+
+func (w *WatchStats) refresh(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchStatsRefresh):
+			raw := watchStatsText(time.Now())
+			w.app.QueueUpdateDraw(func() {
+				if ctx.Err() == nil {
+					w.Update(raw)
+				}
+			})
+		}
+	}
+}
+
+// watchStat represents a resource watches health for display.
+type watchStat struct {
+	GVR        string `json:"gvr"`
+	Watches    int    `json:"watches"`
+	Reconnects int    `json:"reconnects"`
+	Failures   int    `json:"failures"`
+	Backoff    string `json:"backoff,omitempty"`
+	LastError  string `json:"lastError,omitempty"`
+	Since      string `json:"since,omitempty"`
+}
+
+// statsCmd shows the current context informers watches health.
+func (a *App) statsCmd() {
+	if len(client.Watches().Stats()) == 0 {
+		a.Flash().Info("No watches tracked yet")
+		return
+	}
+	if err := a.inject(NewWatchStats(a)); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// watchStatsText returns the current watches health as yaml.
+func watchStatsText(now time.Time) string {
+	raw, err := yaml.Marshal(struct {
+		Watches []watchStat `json:"watches"`
+	}{
+		Watches: watchStats(client.Watches().Stats(), now),
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(raw)
+}
+
+func watchStats(ss []client.WatchStat, now time.Time) []watchStat {
+	ww := make([]watchStat, 0, len(ss))
+	for _, s := range ss {
+		w := watchStat{
+			GVR:        s.GVR,
+			Watches:    s.Watches,
+			Reconnects: s.Reconnects,
+			Failures:   s.Failures,
+			LastError:  s.LastError,
+		}
+		if s.Backoff > 0 {
+			w.Backoff = s.Backoff.String()
+		}
+		if !s.LastErrorAt.IsZero() {
+			w.Since = now.Sub(s.LastErrorAt).Round(time.Second).String()
+		}
+		ww = append(ww, w)
+	}
+
+	return ww
+}
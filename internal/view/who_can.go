@@ -0,0 +1,126 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const whoCanUsage = "Usage: who-can VERB RESOURCE[/SUBRESOURCE] [-n NAMESPACE|-A]"
+
+// WhoCan presents the users, groups and service accounts granted a verb on a
+// resource, ie a reverse policy lookup.
+type WhoCan struct {
+	ResourceViewer
+
+	query dao.WhoCanQuery
+}
+
+// NewWhoCan returns a new viewer.
+func NewWhoCan(q dao.WhoCanQuery) *WhoCan {
+	w := WhoCan{
+		ResourceViewer: NewBrowser(client.NewGVR("whocan")),
+		query:          q,
+	}
+	w.GetTable().SetColorerFn(render.WhoCan{}.ColorerFunc())
+	w.SetBindKeysFn(w.bindKeys)
+	w.GetTable().SetSortCol("KIND", true)
+	w.SetContextFn(w.queryCtx)
+	w.GetTable().SetEnterFn(w.rulesFn)
+
+	return &w
+}
+
+func (w *WhoCan) queryCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, w.query.String())
+	return context.WithValue(ctx, internal.KeyWhoCan, w.query)
+}
+
+func (w *WhoCan) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", w.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Scope", w.GetTable().SortColCmd("SCOPE", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Binding", w.GetTable().SortColCmd("BINDING", true), false),
+	})
+}
+
+// rulesFn shows the policies of the selected subject.
+func (w *WhoCan) rulesFn(app *App, _ ui.Tabular, _, path string) {
+	row, ok := w.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 2 {
+		return
+	}
+	if err := app.inject(NewPolicy(app, row.Fields[1], row.Fields[0])); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *Command) whoCanCmd(tokens []string) error {
+	q, err := parseWhoCanQuery(tokens, c.app.Config.ActiveNamespace(), c.alias.AsGVR)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewWhoCan(q))
+}
+
+// parseWhoCanQuery parses a who-can command. The namespace defaults to ns and
+// resources are resolved from their aliases when known.
+func parseWhoCanQuery(tokens []string, ns string, resolve func(string) (client.GVR, bool)) (dao.WhoCanQuery, error) {
+	q := dao.WhoCanQuery{Namespace: client.CleanseNamespace(ns)}
+	var args []string
+	for _, t := range tokens[1:] {
+		if t != "" {
+			args = append(args, t)
+		}
+	}
+
+	var pos []string
+	for i := 0; i < len(args); i++ {
+		switch a := args[i]; a {
+		case "-A", "--all-namespaces":
+			q.Namespace = ""
+		case "-n", "--namespace":
+			if i+1 >= len(args) {
+				return q, fmt.Errorf("flag %s needs a value. %s", a, whoCanUsage)
+			}
+			q.Namespace = client.CleanseNamespace(args[i+1])
+			i++
+		default:
+			if strings.HasPrefix(a, "-") {
+				return q, fmt.Errorf("unknown flag %s. %s", a, whoCanUsage)
+			}
+			pos = append(pos, a)
+		}
+	}
+	if len(pos) != 2 {
+		return q, errors.New(whoCanUsage)
+	}
+
+	q.Verb = pos[0]
+	res := pos[1]
+	if i := strings.Index(res, "/"); i != -1 {
+		res, q.SubResource = res[:i], res[i+1:]
+	}
+	// K9s pseudo resources are not versioned and can't be granted.
+	if gvr, ok := resolve(res); ok && strings.Contains(gvr.String(), "/") {
+		q.Group, q.Resource = gvr.G(), gvr.R()
+		return q, nil
+	}
+	q.Resource = res
+	if i := strings.Index(res, "."); i != -1 {
+		q.Resource, q.Group = res[:i], res[i+1:]
+	}
+
+	return q, nil
+}
@@ -0,0 +1,74 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWhoCanQuery(t *testing.T) {
+	aliases := map[string]string{
+		"po":     "v1/pods",
+		"dp":     "apps/v1/deployments",
+		"policy": "policy",
+	}
+	resolve := func(s string) (client.GVR, bool) {
+		gvr, ok := aliases[s]
+		return client.NewGVR(gvr), ok
+	}
+
+	uu := map[string]struct {
+		cmd string
+		q   dao.WhoCanQuery
+		err string
+	}{
+		"alias": {
+			cmd: "who-can delete po",
+			q:   dao.WhoCanQuery{Verb: "delete", Resource: "pods", Namespace: "default"},
+		},
+		"group": {
+			cmd: "who-can  patch dp -n fred",
+			q:   dao.WhoCanQuery{Verb: "patch", Group: "apps", Resource: "deployments", Namespace: "fred"},
+		},
+		"subresource": {
+			cmd: "who-can get po/log -A",
+			q:   dao.WhoCanQuery{Verb: "get", Resource: "pods", SubResource: "log"},
+		},
+		"literal": {
+			cmd: "who-can list certificates.cert-manager.io -n all",
+			q:   dao.WhoCanQuery{Verb: "list", Group: "cert-manager.io", Resource: "certificates"},
+		},
+		"pseudo": {
+			cmd: "who-can get policy",
+			q:   dao.WhoCanQuery{Verb: "get", Resource: "policy", Namespace: "default"},
+		},
+		"missingResource": {
+			cmd: "who-can delete",
+			err: whoCanUsage,
+		},
+		"missingValue": {
+			cmd: "who-can delete po -n",
+			err: "flag -n needs a value. " + whoCanUsage,
+		},
+		"unknownFlag": {
+			cmd: "who-can delete po -l app=fred",
+			err: "unknown flag -l. " + whoCanUsage,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := parseWhoCanQuery(strings.Split(u.cmd, " "), "default", resolve)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.q, q)
+		})
+	}
+}
@@ -2,7 +2,6 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -94,7 +93,7 @@ func (x *Xray) Init(ctx context.Context) error {
 
 // ExtraHints returns additional hints.
 func (x *Xray) ExtraHints() map[string]string {
-	if x.app.Config.K9s.NoIcons {
+	if x.app.Config.K9s.GetNoIcons() {
 		return nil
 	}
 	return xray.EmojiInfo()
@@ -124,7 +123,7 @@ func (x *Xray) refreshActions() {
 		hotKeyActions(x, aa)
 
 		x.Actions().Add(aa)
-		x.app.Menu().HydrateMenu(x.Hints())
+		x.app.hydrateMenu(x.Hints())
 	}()
 
 	x.Actions().Clear()
@@ -145,14 +144,14 @@ func (x *Xray) refreshActions() {
 	}
 
 	if client.Can(x.meta.Verbs, "edit") {
-		aa[ui.KeyE] = ui.NewKeyAction("Edit", x.editCmd, true)
+		aa[ui.KeyE] = ui.NewKeyActionWithID(ui.ActionEdit, "Edit", x.editCmd, true)
 	}
 	if client.Can(x.meta.Verbs, "delete") {
-		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", x.deleteCmd, true)
+		aa[tcell.KeyCtrlD] = ui.NewKeyActionWithID(ui.ActionDelete, "Delete", x.deleteCmd, true)
 	}
 	if !dao.IsK9sMeta(x.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", x.viewCmd, true)
-		aa[ui.KeyD] = ui.NewKeyAction("Describe", x.describeCmd, true)
+		aa[ui.KeyD] = ui.NewKeyActionWithID(ui.ActionDescribe, "Describe", x.describeCmd, true)
 	}
 
 	switch gvr {
@@ -160,13 +159,13 @@ func (x *Xray) refreshActions() {
 		x.Actions().Delete(tcell.KeyEnter)
 	case "containers":
 		x.Actions().Delete(tcell.KeyEnter)
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
-		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
+		aa[ui.KeyS] = ui.NewKeyActionWithID(ui.ActionShell, "Shell", x.shellCmd, true)
+		aa[ui.KeyL] = ui.NewKeyActionWithID(ui.ActionLogs, "Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	case "v1/pods":
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
-		aa[ui.KeyA] = ui.NewKeyAction("Attach", x.attachCmd, true)
-		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
+		aa[ui.KeyS] = ui.NewKeyActionWithID(ui.ActionShell, "Shell", x.shellCmd, true)
+		aa[ui.KeyA] = ui.NewKeyActionWithID(ui.ActionAttach, "Attach", x.attachCmd, true)
+		aa[ui.KeyL] = ui.NewKeyActionWithID(ui.ActionLogs, "Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	}
 	x.Actions().Add(aa)
@@ -327,7 +326,7 @@ func (x *Xray) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(x.app, "YAML", spec.Path(), true).Update(raw)
+	details := NewDetails(x.app, "YAML", spec.Path(), true).SetContentType(config.ContentYAML).Update(raw)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -350,7 +349,13 @@ func (x *Xray) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 			log.Warn().Msgf("NO meta for %q -- %s", spec.GVR(), err)
 			return nil
 		}
-		x.resourceDelete(gvr, spec, fmt.Sprintf("Delete %s %s?", meta.SingularName, spec.Path()))
+		msg := fmt.Sprintf("Delete %s %s?", meta.SingularName, spec.Path())
+		go func() {
+			deps := dependentsPreview(x.app, gvr, []string{spec.Path()})
+			x.app.QueueUpdateDraw(func() {
+				x.resourceDelete(gvr, spec, msg+deps)
+			})
+		}()
 	}
 
 	return nil
@@ -377,7 +382,7 @@ func (x *Xray) describe(gvr, path string) {
 		return
 	}
 
-	details := NewDetails(x.app, "Describe", path, true).Update(yaml)
+	details := NewDetails(x.app, "Describe", path, true).SetContentType(config.ContentDescribe).Update(yaml)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -391,22 +396,9 @@ func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	x.Stop()
 	defer x.Start()
-	{
-		ns, n := client.Namespaced(spec.Path())
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, client.NewGVR(spec.GVR()).R())
-		args = append(args, "-n", ns)
-		args = append(args, "--context", x.app.Config.K9s.CurrentContext)
-		if cfg := x.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
-			args = append(args, "--kubeconfig", *cfg)
-		}
-		if !runK(x.app, shellOpts{args: append(args, n)}) {
-			x.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	runEdit(x.app, client.NewGVR(spec.GVR()), spec.Path())
 
-	return evt
+	return nil
 }
 
 func (x *Xray) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -485,7 +477,7 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.GetNoIcons(), x.app.Styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -532,7 +524,7 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 }
 
 func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.GetNoIcons(), x.app.Styles)
 	for _, c := range n.Children {
 		x.hydrate(node, c)
 	}
@@ -642,7 +634,8 @@ func (x *Xray) styleTitle() string {
 }
 
 func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
-	dialog.ShowDelete(x.app.Content.Pages, msg, func(cascade, force bool) {
+	level := x.app.Config.ConfirmLevel(config.ActionDelete)
+	dialog.ShowDelete(x.app.Content.Pages, level, confirmName(x.app.Config.K9s.CurrentContext, []string{spec.Path()}), msg, func(cascade, force bool) {
 		x.app.Flash().Infof("Delete resource %s %s", spec.GVR(), spec.Path())
 		accessor, err := dao.AccessorFor(x.app.factory, gvr)
 		if err != nil {
@@ -655,13 +648,12 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 			x.app.Flash().Errf("Invalid nuker %T", accessor)
 			return
 		}
-		if err := nuker.Delete(spec.Path(), true, true); err != nil {
-			x.app.Flash().Errf("Delete failed with `%s", err)
-		} else {
-			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
-			x.app.factory.DeleteForwarder(spec.Path())
-		}
-		x.Refresh()
+		runTrashDelete(x.app, gvr, []string{spec.Path()}, nukeFn(nuker, true, true), func(rr bulkResults) {
+			if rr.Failed() == 0 {
+				x.app.factory.DeleteForwarder(spec.Path())
+			}
+			x.Refresh()
+		})
 	}, func() {})
 }
 
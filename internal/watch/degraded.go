@@ -0,0 +1,180 @@
+package watch
+
+import (
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// degradedExpiry tracks how long a resource allowed namespaces are cached.
+	degradedExpiry = 1 * time.Minute
+
+	// degradedWait tracks how long a list waits for the allowed namespaces.
+	degradedWait = 2 * time.Second
+)
+
+type degradedNS struct {
+	namespaces []string
+	at         time.Time
+}
+
+// SetNamespaceHints sets the namespaces to probe for access when the user can
+// neither monitor a resource across all namespaces nor list namespaces.
+func (f *Factory) SetNamespaceHints(nn []string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.hints = nn
+	f.degraded = make(map[string]degradedNS)
+	f.resolving = make(map[string]chan struct{})
+}
+
+// rulesReviewer fetches the user resource rules in a namespace.
+type rulesReviewer interface {
+	RulesFor(ns string) ([]authorizationv1.ResourceRule, bool)
+}
+
+// DegradedNamespaces returns the namespaces a resource can be monitored in when
+// the user can't monitor it across all namespaces. It never blocks, pending is
+// true while the allowed namespaces are being resolved in the background.
+func (f *Factory) DegradedNamespaces(ns, gvr string) (nss []string, pending bool) {
+	if !client.IsAllNamespaces(ns) {
+		return nil, false
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	d, ok := f.degraded[gvr]
+	if ok && time.Since(d.at) < degradedExpiry {
+		return d.namespaces, false
+	}
+	if _, ok := f.resolving[gvr]; !ok {
+		f.resolving[gvr] = make(chan struct{})
+		go func() {
+			_ = f.ResolveDegraded(ns, gvr)
+		}()
+	}
+
+	return d.namespaces, !ok
+}
+
+// awaitDegraded waits a bit for the allowed namespaces being resolved.
+func (f *Factory) awaitDegraded(ns, gvr string, d time.Duration) ([]string, bool) {
+	f.mx.RLock()
+	c, ok := f.resolving[gvr]
+	f.mx.RUnlock()
+	if ok {
+		select {
+		case <-c:
+		case <-time.After(d):
+		}
+	}
+
+	return f.DegradedNamespaces(ns, gvr)
+}
+
+// ResolveDegraded resolves the namespaces a resource can be monitored in using
+// rules reviews on the known namespaces. This call blocks.
+func (f *Factory) ResolveDegraded(ns, gvr string) []string {
+	if !client.IsAllNamespaces(ns) {
+		return nil
+	}
+
+	var nss []string
+	if nn, err := f.Client().ValidNamespaces(); err == nil {
+		for _, n := range nn {
+			nss = append(nss, n.Name)
+		}
+	}
+	f.mx.RLock()
+	nss = degradedCandidates(f.guard, append(nss, f.hints...))
+	f.mx.RUnlock()
+
+	allowed := make([]string, 0, len(nss))
+	for _, n := range nss {
+		if f.canMonitorIn(n, gvr) {
+			allowed = append(allowed, n)
+		}
+	}
+	if len(allowed) > 0 {
+		log.Info().Msgf("Degraded mode for %q in namespaces %v", gvr, allowed)
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if c, ok := f.resolving[gvr]; ok {
+		close(c)
+		delete(f.resolving, gvr)
+	}
+	f.degraded[gvr] = degradedNS{namespaces: allowed, at: time.Now()}
+
+	return allowed
+}
+
+// canMonitorIn checks if the user can monitor a resource in a namespace using
+// a single rules review, falling back to access reviews when the rules are
+// not conclusive.
+func (f *Factory) canMonitorIn(ns, gvr string) bool {
+	r, ok := f.Client().(rulesReviewer)
+	if !ok {
+		auth, _ := f.Client().CanI(ns, gvr, client.MonitorAccess)
+		return auth
+	}
+	rr, complete := r.RulesFor(ns)
+	for _, v := range client.MonitorAccess {
+		if client.AllowedByRules(rr, gvr, v) {
+			continue
+		}
+		if !complete {
+			auth, _ := f.Client().CanI(ns, gvr, client.MonitorAccess)
+			return auth
+		}
+		return false
+	}
+
+	return true
+}
+
+// listDegraded lists a resource across the given namespaces using namespaced
+// informers.
+func (f *Factory) listDegraded(nss []string, gvr string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	var oo []runtime.Object
+	for _, ns := range nss {
+		inf := f.ForResource(ns, gvr)
+		if inf == nil {
+			continue
+		}
+		if wait {
+			f.waitForCacheSync(ns)
+		}
+		nn, err := inf.Lister().ByNamespace(ns).List(sel)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, nn...)
+	}
+
+	return oo, nil
+}
+
+// degradedCandidates returns the unique allowed namespaces to probe for access.
+func degradedCandidates(g *client.NamespaceGuard, nss []string) []string {
+	set := make(map[string]struct{}, len(nss))
+	cc := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		if _, ok := set[ns]; ok || client.IsClusterWide(ns) || !g.Allowed(ns) {
+			continue
+		}
+		set[ns] = struct{}{}
+		cc = append(cc, ns)
+	}
+	sort.Strings(cc)
+
+	return cc
+}
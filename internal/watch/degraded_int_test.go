@@ -0,0 +1,37 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDegradedCandidates(t *testing.T) {
+	uu := map[string]struct {
+		allow, deny []string
+		nss, e      []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"dups": {
+			nss: []string{"fred", "blee", "fred", "all", "", "-"},
+			e:   []string{"blee", "fred"},
+		},
+		"guarded": {
+			deny: []string{"kube-.*"},
+			nss:  []string{"kube-system", "fred", "kube-public"},
+			e:    []string{"fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			g, err := client.NewNamespaceGuard(u.allow, u.deny)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, degradedCandidates(g, u.nss))
+		})
+	}
+}
@@ -24,6 +24,10 @@ type Factory struct {
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	guard      *client.NamespaceGuard
+	hints      []string
+	degraded   map[string]degradedNS
+	resolving  map[string]chan struct{}
 	mx         sync.RWMutex
 }
 
@@ -33,6 +37,8 @@ func NewFactory(client client.Connection) *Factory {
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
 		forwarders: NewForwarders(),
+		degraded:   make(map[string]degradedNS),
+		resolving:  make(map[string]chan struct{}),
 	}
 }
 
@@ -61,31 +67,73 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	f.degraded = make(map[string]degradedNS)
+	f.resolving = make(map[string]struct{})
 	f.forwarders.DeleteAll()
 }
 
+// SetNamespaceGuard restricts the namespaces resources are served from. A nil
+// guard lifts all restrictions.
+func (f *Factory) SetNamespaceGuard(g *client.NamespaceGuard) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.guard = g
+}
+
+// NamespaceGuard returns the active namespace guard if any.
+func (f *Factory) NamespaceGuard() *client.NamespaceGuard {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.guard
+}
+
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
+	guard := f.NamespaceGuard()
+	if err := guard.Check(ns); err != nil {
+		return nil, err
+	}
 	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
 	if err != nil {
-		return nil, err
+		nss, pending := f.DegradedNamespaces(ns, gvr)
+		if pending {
+			if nss, pending = f.awaitDegraded(ns, gvr, degradedWait); pending {
+				return nil, fmt.Errorf("resolving %s access. Retrying shortly", gvr)
+			}
+		}
+		if len(nss) == 0 {
+			return nil, err
+		}
+		return f.listDegraded(nss, gvr, wait, labels)
 	}
 	if wait {
 		f.waitForCacheSync(ns)
 	}
+
+	var oo []runtime.Object
 	if client.IsClusterScoped(ns) {
-		return inf.Lister().List(labels)
+		oo, err = inf.Lister().List(labels)
+	} else {
+		if client.IsAllNamespace(ns) {
+			ns = client.AllNamespaces
+		}
+		oo, err = inf.Lister().ByNamespace(ns).List(labels)
 	}
-
-	if client.IsAllNamespace(ns) {
-		ns = client.AllNamespaces
+	if err != nil || guard == nil || !client.IsClusterWide(ns) {
+		return oo, err
 	}
-	return inf.Lister().ByNamespace(ns).List(labels)
+
+	return guardObjects(guard, gvr, oo), nil
 }
 
 // Get retrieves a given resource.
 func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
 	ns, n := namespaced(path)
+	if err := f.NamespaceGuard().Check(guardedNS(gvr, ns, n)); err != nil {
+		return nil, err
+	}
 	inf, err := f.CanForResource(ns, gvr, []string{client.GetVerb})
 	if err != nil {
 		return nil, err
@@ -213,6 +261,17 @@ func (f *Factory) AddForwarder(pf Forwarder) {
 	f.forwarders[pf.Path()] = pf
 }
 
+// RekeyForwarder tracks a portforward that moved to a replacement pod.
+func (f *Factory) RekeyForwarder(prev string, pf Forwarder) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if fwd, ok := f.forwarders[prev]; ok && fwd == pf {
+		delete(f.forwarders, prev)
+	}
+	f.forwarders[pf.Path()] = pf
+}
+
 // DeleteForwarder deletes portforward for a given container.
 func (f *Factory) DeleteForwarder(path string) {
 	count := f.forwarders.Kill(path)
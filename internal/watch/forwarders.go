@@ -39,6 +39,18 @@ type Forwarder interface {
 
 	// HasPortMapping returns true if port mapping exists.
 	HasPortMapping(string) bool
+
+	// State returns the forwarder health state.
+	State() string
+
+	// Restarts returns the number of tunnel restarts.
+	Restarts() int
+
+	// Bytes returns the number of bytes transferred.
+	Bytes() int64
+
+	// Uptime returns the time since the tunnel was last established.
+	Uptime() string
 }
 
 // Forwarders tracks active port forwards.
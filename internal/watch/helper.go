@@ -4,10 +4,42 @@ import (
 	"path"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+const nsGVR = "v1/namespaces"
+
+// guardedNS returns the namespace guarding a resource. Namespaces are guarded
+// by their own name.
+func guardedNS(gvr, ns, n string) string {
+	if gvr == nsGVR {
+		return n
+	}
+
+	return ns
+}
+
+// guardObjects drops resources living in off limits namespaces.
+func guardObjects(g *client.NamespaceGuard, gvr string, oo []runtime.Object) []runtime.Object {
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		m, err := meta.Accessor(o)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to guard %T", o)
+			continue
+		}
+		if g.Allowed(guardedNS(gvr, m.GetNamespace(), m.GetName())) {
+			res = append(res, o)
+		}
+	}
+
+	return res
+}
+
 func toGVR(gvr string) schema.GroupVersionResource {
 	tokens := strings.Split(gvr, "/")
 	if len(tokens) < 3 {